@@ -0,0 +1,236 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package audit records the changes made to Jamf through the classic and pro clients so that compliance
+// teams can reconstruct what automation changed. A Transport wraps the HTTP client of the clients and
+// writes an Entry for every create, update and delete, successful or not, to a Writer.
+//
+//	t := &audit.Transport{Writer: audit.NewJSONWriter(logFile), Actor: "inventory-bot"}
+//	client, _ := pro.NewClient(domain, username, password, &http.Client{Transport: t})
+package audit
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Actions recorded in the audit log
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// maxResponseID is the size of the responses read to find the ID of a created resource
+const maxResponseID = 64 << 10
+
+// classicIdentifiers are the path segments naming how a classic resource is identified
+var classicIdentifiers = map[string]bool{"id": true, "name": true, "serialnumber": true, "udid": true, "macaddress": true}
+
+// Entry records a change made to Jamf
+type Entry struct {
+	Time time.Time `json:"time"`
+	// Actor is who made the change, see Transport.Actor and WithActor
+	Actor string `json:"actor,omitempty"`
+	// Action is ActionCreate for POST requests, ActionUpdate for PUT and PATCH and ActionDelete for DELETE
+	Action string `json:"action"`
+	Method string `json:"method"`
+	// API is classic or pro
+	API string `json:"api"`
+	// Resource is the type of resource changed, e.g. policies or computers-inventory-detail
+	Resource string `json:"resource"`
+	// ID identifies the resource changed, a name for classic resources addressed by name. The ID of a
+	// created resource is read from the response.
+	ID   string `json:"id,omitempty"`
+	Path string `json:"path"`
+	// PayloadHash is the hex encoded SHA-256 of the body sent, empty when there was none
+	PayloadHash string `json:"payloadHash,omitempty"`
+	// Status is the HTTP status of the response, zero when none was received
+	Status int    `json:"status,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose changes are recorded as made by actor, overriding Transport.Actor,
+// e.g. the help-desk technician on whose behalf a tool acts
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Transport is an http.RoundTripper recording the changes sent through it. Requests which do not
+// change anything, such as GET requests and token requests, are sent without being recorded.
+type Transport struct {
+	// Writer receives the entries
+	Writer Writer
+	// Actor is recorded as the author of the changes whose context has no actor set with WithActor
+	Actor string
+	// Base sends the requests, http.DefaultTransport when nil
+	Base http.RoundTripper
+	// OnError, when set, is called with the entries which could not be written. When nil, the error
+	// is returned for the request, even though the change it records was made.
+	OnError func(entry *Entry, err error)
+	// Now returns the time of the entries, time.Now when nil
+	Now func() time.Time
+}
+
+// RoundTrip sends r and records it when it is a change
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	entry := t.entry(r)
+	if entry == nil {
+		return base.RoundTrip(r)
+	}
+
+	var hasher hash.Hash
+	if r.Body != nil && r.Body != http.NoBody {
+		r, hasher = hashBody(r)
+	}
+	res, err := base.RoundTrip(r)
+	if hasher != nil {
+		entry.PayloadHash = hex.EncodeToString(hasher.Sum(nil))
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	} else {
+		entry.Status = res.StatusCode
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			entry.Error = http.StatusText(res.StatusCode)
+		} else if entry.Action == ActionCreate && (entry.ID == "" || entry.ID == "0" || entry.ID == "-1") {
+			// Classic resources are created with the ID 0 or -1, standing for the next available ID
+			entry.ID = createdID(res)
+		}
+	}
+
+	if writeErr := t.Writer.Write(r.Context(), entry); writeErr != nil {
+		writeErr = errors.Wrapf(writeErr, "unable to write audit entry for %s %s", entry.Method, entry.Path)
+		if t.OnError != nil {
+			t.OnError(entry, writeErr)
+		} else if err == nil {
+			res.Body.Close()
+			return nil, writeErr
+		}
+	}
+	return res, err
+}
+
+// entry returns the entry recording r, nil when r changes nothing
+func (t *Transport) entry(r *http.Request) *Entry {
+	var action string
+	switch r.Method {
+	case http.MethodPost:
+		action = ActionCreate
+	case http.MethodPut, http.MethodPatch:
+		action = ActionUpdate
+	case http.MethodDelete:
+		action = ActionDelete
+	default:
+		return nil
+	}
+
+	entry := &Entry{Action: action, Method: r.Method, Path: r.URL.Path, Actor: t.Actor}
+	if actor, ok := r.Context().Value(actorKey{}).(string); ok {
+		entry.Actor = actor
+	}
+	now := time.Now
+	if t.Now != nil {
+		now = t.Now
+	}
+	entry.Time = now().UTC()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	for i, segment := range segments {
+		switch {
+		case segment == "JSSResource" && i+1 < len(segments):
+			entry.API, entry.Resource = "classic", segments[i+1]
+			if i+3 < len(segments) && classicIdentifiers[segments[i+2]] {
+				entry.ID = segments[i+3]
+			}
+			return entry
+		case segment == "api" && i+2 < len(segments):
+			// e.g. api/v1/buildings/3 or api/v1/computer-prestages/3/scope/add-multiple
+			entry.API, entry.Resource = "pro", segments[i+2]
+			if entry.Resource == "auth" {
+				return nil
+			}
+			if i+3 < len(segments) {
+				entry.ID = segments[i+3]
+			}
+			return entry
+		}
+	}
+	entry.Resource = strings.Join(segments, "/")
+	return entry
+}
+
+// hashBody returns r with a body hashed as it is sent. The hash is computed from a copy of the body
+// when the request can provide one, e.g. for bodies held in memory, so the body is left untouched.
+func hashBody(r *http.Request) (*http.Request, hash.Hash) {
+	hasher := sha256.New()
+	if r.GetBody != nil {
+		if body, err := r.GetBody(); err == nil {
+			_, err = io.Copy(hasher, body)
+			body.Close()
+			if err == nil {
+				return r, hasher
+			}
+			hasher.Reset()
+		}
+	}
+	// Streamed bodies, e.g. uploads, are hashed as the transport reads them
+	clone := r.Clone(r.Context())
+	clone.Body = &hashingBody{ReadCloser: r.Body, hasher: hasher}
+	clone.GetBody = nil
+	return clone, hasher
+}
+
+type hashingBody struct {
+	io.ReadCloser
+	hasher hash.Hash
+}
+
+func (b *hashingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.hasher.Write(p[:n])
+	return n, err
+}
+
+// createdID returns the ID of the resource created by a request, read from the JSON response of the
+// pro API or the XML response of the classic API, and restores the body of res
+func createdID(res *http.Response) string {
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxResponseID))
+	res.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), res.Body), res.Body}
+	if err != nil {
+		return ""
+	}
+
+	pro := struct {
+		ID json.RawMessage `json:"id"`
+	}{}
+	if json.Unmarshal(data, &pro) == nil && len(pro.ID) > 0 {
+		return strings.Trim(string(pro.ID), `"`)
+	}
+	classic := struct {
+		ID string `xml:"id"`
+	}{}
+	if xml.Unmarshal(data, &classic) == nil {
+		return strings.TrimSpace(classic.ID)
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/audit"
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// jamfMock serves the endpoints changed by the tests and records the bodies it receives
+type jamfMock struct {
+	mu     sync.Mutex
+	bodies [][]byte
+}
+
+func (m *jamfMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	m.mu.Lock()
+	m.bodies = append(m.bodies, body)
+	m.mu.Unlock()
+	switch fmt.Sprintf("%s %s", r.Method, r.URL.Path) {
+	case "POST /api/v1/auth/token":
+		fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+	case "POST /api/v1/buildings":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "7", "href": "/api/v1/buildings/7"}`)
+	case "GET /api/v1/buildings/7", "PUT /api/v1/buildings/7":
+		fmt.Fprint(w, `{"id": "7", "name": "Annex"}`)
+	case "DELETE /api/v1/buildings/7":
+		w.WriteHeader(http.StatusNoContent)
+	case "DELETE /api/v1/buildings/8":
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": []}`)
+	case "POST /JSSResource/policies/id/-1":
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><policy><id>42</id></policy>`)
+	case "POST /upload":
+		w.WriteHeader(http.StatusCreated)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+type memoryWriter struct {
+	mu      sync.Mutex
+	entries []*audit.Entry
+}
+
+func (w *memoryWriter) Write(ctx context.Context, entry *audit.Entry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.entries = append(w.entries, entry)
+	return nil
+}
+
+func sha(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestTransport(t *testing.T) {
+	mock := &jamfMock{}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	writer := &memoryWriter{}
+	client := &http.Client{Transport: &audit.Transport{Writer: writer, Actor: "inventory-bot", Now: func() time.Time { return now }}}
+	ctx := context.Background()
+
+	j, err := pro.NewClient(server.URL, "api", "secret", client)
+	assert.Nil(t, err)
+	created, err := j.CreateBuilding(ctx, &pro.Building{Name: "Annex"})
+	assert.Nil(t, err)
+	assert.Equal(t, "7", created.ID)
+	_, err = j.BuildingDetails(ctx, "7")
+	assert.Nil(t, err)
+	_, err = j.UpdateBuilding(audit.WithActor(ctx, "jdoe"), "7", &pro.Building{Name: "Annex"})
+	assert.Nil(t, err)
+	assert.Nil(t, j.DeleteBuilding(ctx, "7"))
+	assert.True(t, pro.IsNotFound(j.DeleteBuilding(ctx, "8")))
+
+	c, err := classic.NewClient(server.URL, "api", "secret", client)
+	assert.Nil(t, err)
+	_, err = c.CreatePolicy(&classic.PolicyContents{General: &classic.PolicyGeneral{Name: "Install Chrome"}})
+	assert.Nil(t, err)
+
+	// The body of the token request, the first one, is not recorded
+	assert.Equal(t, []*audit.Entry{
+		{Time: now, Actor: "inventory-bot", Action: audit.ActionCreate, Method: "POST", API: "pro", Resource: "buildings", ID: "7", Path: "/api/v1/buildings", PayloadHash: sha(mock.bodies[1]), Status: 201},
+		{Time: now, Actor: "jdoe", Action: audit.ActionUpdate, Method: "PUT", API: "pro", Resource: "buildings", ID: "7", Path: "/api/v1/buildings/7", PayloadHash: sha(mock.bodies[3]), Status: 200},
+		{Time: now, Actor: "inventory-bot", Action: audit.ActionDelete, Method: "DELETE", API: "pro", Resource: "buildings", ID: "7", Path: "/api/v1/buildings/7", Status: 204},
+		{Time: now, Actor: "inventory-bot", Action: audit.ActionDelete, Method: "DELETE", API: "pro", Resource: "buildings", ID: "8", Path: "/api/v1/buildings/8", Status: 404, Error: "Not Found"},
+		{Time: now, Actor: "inventory-bot", Action: audit.ActionCreate, Method: "POST", API: "classic", Resource: "policies", ID: "42", Path: "/JSSResource/policies/id/-1", PayloadHash: sha(mock.bodies[len(mock.bodies)-1]), Status: 201},
+	}, writer.entries)
+	assert.Contains(t, string(mock.bodies[len(mock.bodies)-1]), "Install Chrome")
+
+	// Streamed bodies are hashed as they are sent
+	writer.entries = nil
+	req, err := http.NewRequest("POST", server.URL+"/upload", io.NopCloser(strings.NewReader("package")))
+	assert.Nil(t, err)
+	res, err := client.Do(req)
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, "package", string(mock.bodies[len(mock.bodies)-1]))
+	assert.Equal(t, sha([]byte("package")), writer.entries[0].PayloadHash)
+}
+
+func TestWriteErrors(t *testing.T) {
+	server := httptest.NewServer(&jamfMock{})
+	defer server.Close()
+	failing := audit.WriterFunc(func(ctx context.Context, entry *audit.Entry) error { return fmt.Errorf("disk full") })
+
+	j, err := pro.NewClient(server.URL, "api", "secret", &http.Client{Transport: &audit.Transport{Writer: failing}})
+	assert.Nil(t, err)
+	err = j.DeleteBuilding(context.Background(), "7")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to write audit entry for DELETE /api/v1/buildings/7: disk full")
+
+	var failed []*audit.Entry
+	j, err = pro.NewClient(server.URL, "api", "secret", &http.Client{Transport: &audit.Transport{
+		Writer:  failing,
+		OnError: func(entry *audit.Entry, err error) { failed = append(failed, entry) },
+	}})
+	assert.Nil(t, err)
+	assert.Nil(t, j.DeleteBuilding(context.Background(), "7"))
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "7", failed[0].ID)
+}
+
+func TestJSONWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := audit.NewJSONWriter(out)
+	entry := &audit.Entry{Time: time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), Action: audit.ActionDelete, Method: "DELETE", API: "pro", Resource: "buildings", ID: "7", Path: "/api/v1/buildings/7", Status: 204}
+	assert.Nil(t, w.Write(context.Background(), entry))
+	assert.Nil(t, w.Write(context.Background(), entry))
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Equal(t, `{"time":"2024-10-01T12:00:00Z","action":"delete","method":"DELETE","api":"pro","resource":"buildings","id":"7","path":"/api/v1/buildings/7","status":204}`, lines[0])
+	decoded := &audit.Entry{}
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), decoded))
+	assert.Equal(t, entry, decoded)
+}
@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Writer receives the entries of the audit log, e.g. to append them to a file or send them to a SIEM.
+// Write is called concurrently, once the mutation it records has been sent.
+type Writer interface {
+	Write(ctx context.Context, entry *Entry) error
+}
+
+// WriterFunc adapts a function to a Writer
+type WriterFunc func(ctx context.Context, entry *Entry) error
+
+// Write calls f
+func (f WriterFunc) Write(ctx context.Context, entry *Entry) error {
+	return f(ctx, entry)
+}
+
+type jsonWriter struct {
+	mu      sync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONWriter returns a Writer writing each entry to w as a line of JSON
+func NewJSONWriter(w io.Writer) Writer {
+	return &jsonWriter{encoder: json.NewEncoder(w)}
+}
+
+func (w *jsonWriter) Write(ctx context.Context, entry *Entry) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return errors.Wrap(w.encoder.Encode(entry), "unable to encode audit entry")
+}
@@ -0,0 +1,194 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package backup exports Jamf configuration objects such as policies, scripts, managed preference
+// profiles, computer groups and extension attributes to a directory of XML and JSON files and restores
+// them to the same or another Jamf Pro server, matching existing objects by name and remapping the IDs
+// objects use to reference each other.
+//
+// Only the legacy MCX managed preference profiles are covered, computer and mobile device configuration
+// profiles (/osxconfigurationprofiles and /mobiledeviceconfigurationprofiles) are not backed up by All
+// since the clients do not support them yet. A Resource can be implemented for them by the caller.
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ManifestFile is the name of the file describing the content of a backup directory
+const ManifestFile = "manifest.json"
+
+// Object identifies a configuration object stored in a backup, File is relative to the backup directory
+type Object struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	File string `json:"file,omitempty"`
+}
+
+// Manifest describes the content of a backup directory, Resources holds the objects of each resource kind
+type Manifest struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Server    string              `json:"server,omitempty"`
+	Resources map[string][]Object `json:"resources"`
+}
+
+// Resource is a type of configuration object that can be backed up and restored
+type Resource interface {
+	// Kind names the resource, it is used as the directory holding its objects and as the key of
+	// its IDs in a Mapping
+	Kind() string
+	// Extension is the extension of the files objects are exported to, "xml" or "json"
+	Extension() string
+	// List returns the ID and name of every object on the server
+	List(ctx context.Context) ([]Object, error)
+	// Export returns the serialized object with the given ID
+	Export(ctx context.Context, id string) ([]byte, error)
+	// Restore creates the serialized object, or updates the object with the ID existing when it is not
+	// empty, remapping the IDs of the objects it references with m, and returns the ID of the object
+	Restore(ctx context.Context, data []byte, existing string, m Mapping) (string, error)
+}
+
+// Mapping holds, for each resource kind, the ID a restored object has on the target server keyed by
+// the ID it had on the backed up server. A nil Mapping keeps every ID, for objects restored to the
+// server they were exported from.
+type Mapping map[string]map[string]string
+
+// Set records that the object of kind with ID from was restored with ID to
+func (m Mapping) Set(kind string, from string, to string) {
+	if m[kind] == nil {
+		m[kind] = map[string]string{}
+	}
+	m[kind][from] = to
+}
+
+// ID returns the ID on the target server of the object of kind with ID from on the backed up server
+func (m Mapping) ID(kind string, from string) (string, bool) {
+	if m == nil {
+		return from, true
+	}
+	to, ok := m[kind][from]
+	return to, ok
+}
+
+// Restored reports an object restored by Restore
+type Restored struct {
+	Kind    string
+	Name    string
+	OldID   string
+	NewID   string
+	Created bool
+}
+
+// Backup exports every object of the given resources to dir, which is created if needed, and
+// writes the manifest describing them
+func Backup(ctx context.Context, dir string, resources ...Resource) (*Manifest, error) {
+	manifest := &Manifest{CreatedAt: time.Now().UTC(), Resources: map[string][]Object{}}
+	for _, r := range resources {
+		objects, err := r.List(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to list %s to back up", r.Kind())
+		}
+		if err := os.MkdirAll(filepath.Join(dir, r.Kind()), 0o755); err != nil {
+			return nil, errors.Wrapf(err, "unable to create the %s backup directory", r.Kind())
+		}
+
+		manifest.Resources[r.Kind()] = []Object{}
+		for _, object := range objects {
+			data, err := r.Export(ctx, object.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to export %s %s", r.Kind(), object.Name)
+			}
+			object.File = filepath.ToSlash(filepath.Join(r.Kind(), fileName(object, r.Extension())))
+			if err := os.WriteFile(filepath.Join(dir, object.File), data, 0o600); err != nil {
+				return nil, errors.Wrapf(err, "unable to write %s %s", r.Kind(), object.Name)
+			}
+			manifest.Resources[r.Kind()] = append(manifest.Resources[r.Kind()], object)
+		}
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to encode the backup manifest")
+	}
+	if err := os.WriteFile(filepath.Join(dir, ManifestFile), data, 0o600); err != nil {
+		return nil, errors.Wrap(err, "unable to write the backup manifest")
+	}
+	return manifest, nil
+}
+
+// ReadManifest reads the manifest of a backup directory
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the backup manifest")
+	}
+	manifest := &Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "unable to decode the backup manifest")
+	}
+	return manifest, nil
+}
+
+// Restore restores the objects of the given resources found in the backup in dir, resources are
+// restored in order so objects referenced by others, e.g. scripts used by policies, must come first.
+// An object is updated when the server already has an object of the same kind and name, and created
+// otherwise. The mapping between the backed up and restored IDs is returned along with the objects
+// restored, even when an error stops the restore.
+func Restore(ctx context.Context, dir string, resources ...Resource) (Mapping, []Restored, error) {
+	mapping := Mapping{}
+	restored := []Restored{}
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return mapping, restored, err
+	}
+
+	for _, r := range resources {
+		objects := manifest.Resources[r.Kind()]
+		if len(objects) == 0 {
+			continue
+		}
+		current, err := r.List(ctx)
+		if err != nil {
+			return mapping, restored, errors.Wrapf(err, "unable to list %s to restore", r.Kind())
+		}
+		byName := map[string]string{}
+		for _, object := range current {
+			byName[object.Name] = object.ID
+		}
+
+		for _, object := range objects {
+			data, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(object.File)))
+			if err != nil {
+				return mapping, restored, errors.Wrapf(err, "unable to read %s %s", r.Kind(), object.Name)
+			}
+			existing := byName[object.Name]
+			id, err := r.Restore(ctx, data, existing, mapping)
+			if err != nil {
+				return mapping, restored, errors.Wrapf(err, "unable to restore %s %s", r.Kind(), object.Name)
+			}
+			mapping.Set(r.Kind(), object.ID, id)
+			restored = append(restored, Restored{Kind: r.Kind(), Name: object.Name, OldID: object.ID, NewID: id, Created: existing == ""})
+		}
+	}
+	return mapping, restored, nil
+}
+
+var unsafeFileName = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// fileName returns a file name unique to the object, prefixed by its ID since names may collide once
+// sanitized
+func fileName(object Object, extension string) string {
+	name := strings.Trim(unsafeFileName.ReplaceAllString(object.Name, "_"), "_.")
+	if name == "" {
+		return fmt.Sprintf("%s.%s", object.ID, extension)
+	}
+	return fmt.Sprintf("%s-%s.%s", object.ID, name, extension)
+}
@@ -0,0 +1,130 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package backup_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/backup"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryResource is a Resource holding JSON objects in memory, objects may reference an object of
+// another kind with their Ref field
+type memoryResource struct {
+	kind    string
+	refKind string
+	objects map[string]memoryObject
+	nextID  int
+}
+
+type memoryObject struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Ref   string `json:"ref,omitempty"`
+}
+
+func newMemoryResource(kind string, refKind string, objects ...memoryObject) *memoryResource {
+	r := &memoryResource{kind: kind, refKind: refKind, objects: map[string]memoryObject{}, nextID: 1}
+	for _, o := range objects {
+		r.add(o)
+	}
+	return r
+}
+
+func (r *memoryResource) add(o memoryObject) string {
+	id := strconv.Itoa(r.nextID)
+	r.nextID++
+	r.objects[id] = o
+	return id
+}
+
+func (r *memoryResource) Kind() string      { return r.kind }
+func (r *memoryResource) Extension() string { return "json" }
+
+func (r *memoryResource) List(ctx context.Context) ([]backup.Object, error) {
+	objects := []backup.Object{}
+	for id, o := range r.objects {
+		objects = append(objects, backup.Object{ID: id, Name: o.Name})
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].ID < objects[j].ID })
+	return objects, nil
+}
+
+func (r *memoryResource) Export(ctx context.Context, id string) ([]byte, error) {
+	return json.Marshal(r.objects[id])
+}
+
+func (r *memoryResource) Restore(ctx context.Context, data []byte, existing string, m backup.Mapping) (string, error) {
+	o := memoryObject{}
+	if err := json.Unmarshal(data, &o); err != nil {
+		return "", err
+	}
+	if o.Ref != "" {
+		ref, ok := m.ID(r.refKind, o.Ref)
+		if !ok {
+			return "", fmt.Errorf("%s %s references unknown %s %s", r.kind, o.Name, r.refKind, o.Ref)
+		}
+		o.Ref = ref
+	}
+	if existing == "" {
+		return r.add(o), nil
+	}
+	r.objects[existing] = o
+	return existing, nil
+}
+
+func TestBackupRestore(t *testing.T) {
+	dir := t.TempDir()
+	scripts := newMemoryResource("scripts", "", memoryObject{Name: "Clean Up", Value: "rm -rf /tmp/cache"}, memoryObject{Name: "Hello / World", Value: "echo hello"})
+	policies := newMemoryResource("policies", "scripts", memoryObject{Name: "Weekly Clean Up", Ref: "1"})
+
+	manifest, err := backup.Backup(context.Background(), dir, scripts, policies)
+	assert.Nil(t, err)
+	assert.Equal(t, []backup.Object{
+		{ID: "1", Name: "Clean Up", File: "scripts/1-Clean_Up.json"},
+		{ID: "2", Name: "Hello / World", File: "scripts/2-Hello_World.json"},
+	}, manifest.Resources["scripts"])
+	data, err := os.ReadFile(filepath.Join(dir, "scripts", "1-Clean_Up.json"))
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"name": "Clean Up", "value": "rm -rf /tmp/cache"}`, string(data))
+
+	read, err := backup.ReadManifest(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, manifest.Resources, read.Resources)
+
+	// the target server already has "Hello / World" and IDs allocated differently
+	targetScripts := newMemoryResource("scripts", "", memoryObject{Name: "Unrelated"}, memoryObject{Name: "Hello / World", Value: "outdated"})
+	targetPolicies := newMemoryResource("policies", "scripts")
+	mapping, restored, err := backup.Restore(context.Background(), dir, targetScripts, targetPolicies)
+	assert.Nil(t, err)
+
+	assert.Equal(t, backup.Mapping{"scripts": {"1": "3", "2": "2"}, "policies": {"1": "1"}}, mapping)
+	assert.Equal(t, []backup.Restored{
+		{Kind: "scripts", Name: "Clean Up", OldID: "1", NewID: "3", Created: true},
+		{Kind: "scripts", Name: "Hello / World", OldID: "2", NewID: "2"},
+		{Kind: "policies", Name: "Weekly Clean Up", OldID: "1", NewID: "1", Created: true},
+	}, restored)
+	assert.Equal(t, "echo hello", targetScripts.objects["2"].Value)
+	assert.Equal(t, "3", targetPolicies.objects["1"].Ref)
+}
+
+func TestRestoreErrors(t *testing.T) {
+	_, _, err := backup.Restore(context.Background(), t.TempDir())
+	assert.Contains(t, err.Error(), "unable to read the backup manifest")
+
+	dir := t.TempDir()
+	_, err = backup.Backup(context.Background(), dir, newMemoryResource("policies", "scripts", memoryObject{Name: "Orphan", Ref: "9"}))
+	assert.Nil(t, err)
+	mapping, restored, err := backup.Restore(context.Background(), dir, newMemoryResource("policies", "scripts"))
+	assert.Contains(t, err.Error(), "unable to restore policies Orphan: policies Orphan references unknown scripts 9")
+	assert.Empty(t, mapping)
+	assert.Empty(t, restored)
+}
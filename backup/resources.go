@@ -0,0 +1,443 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// Kinds of the resources provided by this package
+const (
+	KindCategories                  = "categories"
+	KindScripts                     = "scripts"
+	KindComputerExtensionAttributes = "computer-extension-attributes"
+	KindSmartComputerGroups         = "smart-computer-groups"
+	KindStaticComputerGroups        = "static-computer-groups"
+	KindManagedPreferenceProfiles   = "managed-preference-profiles"
+	KindPolicies                    = "policies"
+)
+
+// All returns every resource provided by this package, ordered so that objects are restored before
+// the objects referencing them. Configuration profiles are not included, see the package documentation.
+func All(c *classic.Client, p *pro.Client) []Resource {
+	return []Resource{
+		Categories(p),
+		Scripts(p),
+		ComputerExtensionAttributes(c),
+		SmartComputerGroups(p),
+		StaticComputerGroups(p),
+		ManagedPreferenceProfiles(c),
+		Policies(c),
+	}
+}
+
+// resource implements Resource for objects of type T serialized as XML or JSON
+type resource[T any] struct {
+	kind      string
+	extension string
+	list      func(ctx context.Context) ([]Object, error)
+	get       func(ctx context.Context, id string) (*T, error)
+	// prepare clears the fields specific to the backed up server and remaps references
+	prepare func(content *T, m Mapping)
+	create  func(ctx context.Context, content *T) (string, error)
+	update  func(ctx context.Context, id string, content *T) error
+}
+
+func (r *resource[T]) Kind() string {
+	return r.kind
+}
+
+func (r *resource[T]) Extension() string {
+	return r.extension
+}
+
+func (r *resource[T]) List(ctx context.Context) ([]Object, error) {
+	return r.list(ctx)
+}
+
+func (r *resource[T]) Export(ctx context.Context, id string) ([]byte, error) {
+	content, err := r.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if r.extension == "xml" {
+		return xml.MarshalIndent(content, "", "  ")
+	}
+	return json.MarshalIndent(content, "", "  ")
+}
+
+func (r *resource[T]) Restore(ctx context.Context, data []byte, existing string, m Mapping) (string, error) {
+	content := new(T)
+	var err error
+	if r.extension == "xml" {
+		err = xml.Unmarshal(data, content)
+	} else {
+		err = json.Unmarshal(data, content)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to decode %s backup: %v", r.kind, err)
+	}
+
+	r.prepare(content, m)
+	if existing == "" {
+		return r.create(ctx, content)
+	}
+	return existing, r.update(ctx, existing, content)
+}
+
+// Categories backs up Jamf Pro categories as JSON
+func Categories(p *pro.Client) Resource {
+	return &resource[pro.Category]{
+		kind:      KindCategories,
+		extension: "json",
+		list: func(ctx context.Context) ([]Object, error) {
+			categories, err := p.AllCategories(ctx, nil)
+			objects := make([]Object, len(categories))
+			for i, c := range categories {
+				objects[i] = Object{ID: c.ID, Name: c.Name}
+			}
+			return objects, err
+		},
+		get: p.CategoryDetails,
+		prepare: func(c *pro.Category, m Mapping) {
+			c.ID = ""
+		},
+		create: func(ctx context.Context, c *pro.Category) (string, error) {
+			created, err := p.CreateCategory(ctx, c)
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		update: func(ctx context.Context, id string, c *pro.Category) error {
+			_, err := p.UpdateCategory(ctx, id, c)
+			return err
+		},
+	}
+}
+
+// Scripts backs up Jamf Pro scripts as JSON, their category is remapped when categories are restored first
+func Scripts(p *pro.Client) Resource {
+	return &resource[pro.Script]{
+		kind:      KindScripts,
+		extension: "json",
+		list: func(ctx context.Context) ([]Object, error) {
+			scripts, err := p.AllScripts(ctx, nil)
+			objects := make([]Object, len(scripts))
+			for i, s := range scripts {
+				objects[i] = Object{ID: s.ID, Name: s.Name}
+			}
+			return objects, err
+		},
+		get: p.ScriptDetails,
+		prepare: func(s *pro.Script, m Mapping) {
+			s.ID = ""
+			if id, ok := m.ID(KindCategories, s.CategoryID); ok {
+				s.CategoryID = id
+			}
+		},
+		create: func(ctx context.Context, s *pro.Script) (string, error) {
+			created, err := p.CreateScript(ctx, s)
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		update: func(ctx context.Context, id string, s *pro.Script) error {
+			_, err := p.UpdateScript(ctx, id, s)
+			return err
+		},
+	}
+}
+
+// SmartComputerGroups backs up Jamf Pro smart computer groups as JSON
+func SmartComputerGroups(p *pro.Client) Resource {
+	return &resource[pro.SmartComputerGroup]{
+		kind:      KindSmartComputerGroups,
+		extension: "json",
+		list: func(ctx context.Context) ([]Object, error) {
+			groups, err := p.AllSmartComputerGroups(ctx, nil)
+			objects := make([]Object, len(groups))
+			for i, g := range groups {
+				objects[i] = Object{ID: g.ID, Name: g.Name}
+			}
+			return objects, err
+		},
+		get: p.SmartComputerGroupDetails,
+		prepare: func(g *pro.SmartComputerGroup, m Mapping) {
+			g.ID = ""
+			g.MembershipCount = 0
+		},
+		create: func(ctx context.Context, g *pro.SmartComputerGroup) (string, error) {
+			created, err := p.CreateSmartComputerGroup(ctx, g)
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		update: func(ctx context.Context, id string, g *pro.SmartComputerGroup) error {
+			_, err := p.UpdateSmartComputerGroup(ctx, id, g)
+			return err
+		},
+	}
+}
+
+// StaticComputerGroups backs up Jamf Pro static computer groups as JSON, the computers assigned are
+// identified by their ID which is only meaningful when restoring to the same server
+func StaticComputerGroups(p *pro.Client) Resource {
+	return &resource[pro.StaticComputerGroup]{
+		kind:      KindStaticComputerGroups,
+		extension: "json",
+		list: func(ctx context.Context) ([]Object, error) {
+			groups, err := p.AllStaticComputerGroups(ctx, nil)
+			objects := make([]Object, len(groups))
+			for i, g := range groups {
+				objects[i] = Object{ID: g.ID, Name: g.Name}
+			}
+			return objects, err
+		},
+		get: p.StaticComputerGroupDetails,
+		prepare: func(g *pro.StaticComputerGroup, m Mapping) {
+			g.ID = ""
+			g.Count = 0
+		},
+		create: func(ctx context.Context, g *pro.StaticComputerGroup) (string, error) {
+			created, err := p.CreateStaticComputerGroup(ctx, g)
+			if err != nil {
+				return "", err
+			}
+			return created.ID, nil
+		},
+		update: func(ctx context.Context, id string, g *pro.StaticComputerGroup) error {
+			_, err := p.UpdateStaticComputerGroup(ctx, id, g)
+			return err
+		},
+	}
+}
+
+// ComputerExtensionAttributes backs up classic computer extension attributes as XML
+func ComputerExtensionAttributes(c *classic.Client) Resource {
+	list := func(ctx context.Context) ([]Object, error) {
+		attributes, err := c.ComputerExtensionAttributes()
+		objects := make([]Object, len(attributes))
+		for i, a := range attributes {
+			objects[i] = Object{ID: strconv.Itoa(a.ID), Name: a.Name}
+		}
+		return objects, err
+	}
+	return &resource[classic.ComputerExtensionAttribute]{
+		kind:      KindComputerExtensionAttributes,
+		extension: "xml",
+		list:      list,
+		get: func(ctx context.Context, id string) (*classic.ComputerExtensionAttribute, error) {
+			details, err := c.ComputerExtensionAttributeDetails(classicID(id))
+			if err != nil {
+				return nil, err
+			}
+			return details.Details, nil
+		},
+		prepare: func(a *classic.ComputerExtensionAttribute, m Mapping) {
+			a.ID = 0
+		},
+		create: func(ctx context.Context, a *classic.ComputerExtensionAttribute) (string, error) {
+			created, err := c.CreateComputerExtensionAttribute(a)
+			if err != nil {
+				return "", err
+			}
+			if created.ID != 0 {
+				return strconv.Itoa(created.ID), nil
+			}
+			return idByName(ctx, list, a.Name)
+		},
+		update: func(ctx context.Context, id string, a *classic.ComputerExtensionAttribute) error {
+			_, err := c.UpdateComputerExtensionAttribue(classicID(id), a)
+			return err
+		},
+	}
+}
+
+// ManagedPreferenceProfiles backs up classic managed preference profiles as XML, the computer groups
+// they are scoped to are remapped when computer groups are restored first
+func ManagedPreferenceProfiles(c *classic.Client) Resource {
+	list := func(ctx context.Context) ([]Object, error) {
+		profiles, err := c.ManagedPreferenceProfiles()
+		objects := make([]Object, len(profiles))
+		for i, p := range profiles {
+			objects[i] = Object{ID: strconv.Itoa(p.ID), Name: p.Name}
+		}
+		return objects, err
+	}
+	return &resource[classic.ManagedPreferenceProfile]{
+		kind:      KindManagedPreferenceProfiles,
+		extension: "xml",
+		list:      list,
+		get: func(ctx context.Context, id string) (*classic.ManagedPreferenceProfile, error) {
+			details, err := c.ManagedPreferenceProfileDetails(classicID(id))
+			if err != nil {
+				return nil, err
+			}
+			return details.Details, nil
+		},
+		prepare: func(p *classic.ManagedPreferenceProfile, m Mapping) {
+			if p.General != nil {
+				p.General.ID = 0
+			}
+			remapScope(p.Scope, m)
+		},
+		create: func(ctx context.Context, p *classic.ManagedPreferenceProfile) (string, error) {
+			if _, err := c.CreateManagedPreferenceProfile(p); err != nil {
+				return "", err
+			}
+			if p.General == nil {
+				return "", fmt.Errorf("managed preference profile without general information")
+			}
+			return idByName(ctx, list, p.General.Name)
+		},
+		update: func(ctx context.Context, id string, p *classic.ManagedPreferenceProfile) error {
+			_, err := c.UpdateManagedPreferenceProfile(classicID(id), p)
+			return err
+		},
+	}
+}
+
+// Policies backs up classic policies as XML, the categories, scripts and computer groups they reference
+// are remapped when restored first, the packages, site, computers, buildings and departments are
+// resolved by name. Printers are not backed up.
+func Policies(c *classic.Client) Resource {
+	list := func(ctx context.Context) ([]Object, error) {
+		policies, err := c.Policies()
+		objects := make([]Object, len(policies))
+		for i, p := range policies {
+			objects[i] = Object{ID: strconv.Itoa(p.ID), Name: p.Name}
+		}
+		return objects, err
+	}
+	return &resource[classic.PolicyContents]{
+		kind:      KindPolicies,
+		extension: "xml",
+		list:      list,
+		get: func(ctx context.Context, id string) (*classic.PolicyContents, error) {
+			policy, err := c.PolicyDetails(classicID(id))
+			if err != nil {
+				return nil, err
+			}
+			if policy.Content == nil {
+				return nil, fmt.Errorf("policy %s has no content", id)
+			}
+			// Printers are decoded generically from JSON and cannot be written back as XML
+			policy.Content.Printers = nil
+			return policy.Content, nil
+		},
+		prepare: func(p *classic.PolicyContents, m Mapping) {
+			if p.General != nil {
+				p.General.ID = 0
+				if p.General.Category != nil {
+					p.General.Category.ID = remapClassicID(m, p.General.Category.ID, KindCategories)
+				}
+				if p.General.Site != nil {
+					p.General.Site.ID = remapClassicID(m, p.General.Site.ID)
+				}
+			}
+			if p.PackageConfiguration != nil {
+				for _, pkg := range p.PackageConfiguration.List {
+					pkg.ID = remapClassicID(m, pkg.ID)
+				}
+			}
+			for _, s := range p.Scripts {
+				s.ID = remapClassicID(m, s.ID, KindScripts)
+			}
+			if p.SelfServices != nil {
+				for _, c := range p.SelfServices.Categories {
+					c.Category.ID = remapClassicID(m, c.Category.ID, KindCategories)
+				}
+			}
+			remapScope(p.Scope, m)
+		},
+		create: func(ctx context.Context, p *classic.PolicyContents) (string, error) {
+			if p.General == nil {
+				return "", fmt.Errorf("policy without general information")
+			}
+			if _, err := c.CreatePolicy(p); err != nil {
+				return "", err
+			}
+			return idByName(ctx, list, p.General.Name)
+		},
+		update: func(ctx context.Context, id string, p *classic.PolicyContents) error {
+			_, err := c.UpdatePolicy(classicID(id), p)
+			return err
+		},
+	}
+}
+
+// remapScope remaps the computer groups targeted and excluded by a classic scope, and clears the IDs of
+// the computers, buildings and departments so that they are resolved by name
+func remapScope(scope *classic.Scope, m Mapping) {
+	if scope == nil {
+		return
+	}
+	groups, computers := scope.ComputerGroups, scope.Computers
+	buildings, departments := scope.Buildings, scope.Departments
+	if scope.Exclusions != nil {
+		groups = append(groups[:len(groups):len(groups)], scope.Exclusions.ComputerGroups...)
+		computers = append(computers[:len(computers):len(computers)], scope.Exclusions.Computers...)
+		buildings = append(buildings[:len(buildings):len(buildings)], scope.Exclusions.Buildings...)
+		departments = append(departments[:len(departments):len(departments)], scope.Exclusions.Departments...)
+	}
+	for _, g := range groups {
+		g.ID = remapClassicID(m, g.ID, KindSmartComputerGroups, KindStaticComputerGroups)
+	}
+	for _, c := range computers {
+		c.ID = remapClassicID(m, c.ID)
+	}
+	for _, b := range buildings {
+		b.ID = remapClassicID(m, b.ID)
+	}
+	for _, d := range departments {
+		d.ID = remapClassicID(m, d.ID)
+	}
+}
+
+// remapClassicID returns the restored ID of a classic reference, or 0 so that the classic API resolves
+// the reference by name when the referenced object was not restored. The ID is kept with a nil Mapping.
+func remapClassicID(m Mapping, id int, kinds ...string) int {
+	if id == 0 || m == nil {
+		return id
+	}
+	for _, kind := range kinds {
+		if to, ok := m.ID(kind, strconv.Itoa(id)); ok {
+			if n, err := strconv.Atoi(to); err == nil {
+				return n
+			}
+		}
+	}
+	return 0
+}
+
+// classicID converts an ID to the identifier expected by the classic client
+func classicID(id string) interface{} {
+	if n, err := strconv.Atoi(id); err == nil {
+		return n
+	}
+	return id
+}
+
+// idByName looks up the ID of an object the classic API created without returning its ID
+func idByName(ctx context.Context, list func(ctx context.Context) ([]Object, error), name string) (string, error) {
+	objects, err := list(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, object := range objects {
+		if object.Name == name {
+			return object.ID, nil
+		}
+	}
+	return "", fmt.Errorf("unable to find the ID of %s once created", name)
+}
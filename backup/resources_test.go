@@ -0,0 +1,158 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/backup"
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func newClients(t *testing.T, mux *http.ServeMux) (*classic.Client, *pro.Client, func()) {
+	mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+	})
+	server := httptest.NewServer(mux)
+	c, err := classic.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	p, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	return c, p, server.Close
+}
+
+func TestBackupRestoreScriptsAndPolicies(t *testing.T) {
+	source := http.NewServeMux()
+	source.HandleFunc("/api/v1/scripts", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "5", "name": "Clean Up"}]}`)
+	})
+	source.HandleFunc("/api/v1/scripts/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "5", "name": "Clean Up", "scriptContents": "rm -rf /tmp/cache"}`)
+	})
+	source.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Weekly Clean Up"}]}`)
+	})
+	source.HandleFunc("/JSSResource/policies/id/10", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policy": {
+			"general": {"id": 10, "name": "Weekly Clean Up", "enabled": true, "category": {"id": 3, "name": "Maintenance"}},
+			"scope": {"computer_groups": [{"id": 8, "name": "All Managed Clients"}]},
+			"scripts": [{"id": 5, "name": "Clean Up", "priority": "After"}],
+			"printers": [{"any": null}]
+		}}`)
+	})
+	c, p, closeSource := newClients(t, source)
+	defer closeSource()
+
+	dir := t.TempDir()
+	manifest, err := backup.Backup(context.Background(), dir, backup.Scripts(p), backup.Policies(c))
+	assert.Nil(t, err)
+	assert.Equal(t, "policies/10-Weekly_Clean_Up.xml", manifest.Resources[backup.KindPolicies][0].File)
+
+	created := map[string]string{}
+	policies := `{"policies": []}`
+	target := http.NewServeMux()
+	target.HandleFunc("/api/v1/scripts", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			data, _ := io.ReadAll(r.Body)
+			created["script"] = string(data)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "42", "href": "/api/v1/scripts/42"}`)
+			return
+		}
+		fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+	})
+	target.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, policies)
+	})
+	target.HandleFunc("/JSSResource/policies/id/-1", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		created["policy"] = string(data)
+		policies = `{"policies": [{"id": 77, "name": "Weekly Clean Up"}]}`
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `<policy><id>77</id></policy>`)
+	})
+	c, p, closeTarget := newClients(t, target)
+	defer closeTarget()
+
+	mapping, restored, err := backup.Restore(context.Background(), dir, backup.All(c, p)...)
+	assert.Nil(t, err)
+	assert.Len(t, restored, 2)
+	assert.Equal(t, backup.Mapping{backup.KindScripts: {"5": "42"}, backup.KindPolicies: {"10": "77"}}, mapping)
+
+	assert.JSONEq(t, `{"name": "Clean Up", "scriptContents": "rm -rf /tmp/cache"}`, created["script"])
+	assert.Contains(t, created["policy"], "<script><id>42</id><name>Clean Up</name>")
+	assert.Contains(t, created["policy"], "<category><name>Maintenance</name></category>")
+	assert.Contains(t, created["policy"], "<computer_group><name>All Managed Clients</name></computer_group>")
+	assert.NotContains(t, created["policy"], "<id>10</id>")
+}
+
+func TestRestorePolicyReferences(t *testing.T) {
+	source := http.NewServeMux()
+	source.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policies": [{"id": 11, "name": "Install Chrome"}]}`)
+	})
+	source.HandleFunc("/JSSResource/policies/id/11", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policy": {
+			"general": {"id": 11, "name": "Install Chrome", "site": {"id": 2, "name": "Paris"}},
+			"package_configuration": {"packages": [{"id": 12, "name": "Chrome.pkg", "action": "Install"}]},
+			"self_service": {"self_service_categories": [{"category": {"id": 3, "name": "Browsers", "display_in": true}}]},
+			"scope": {
+				"computers": [{"id": 30, "name": "lab-01"}],
+				"buildings": [{"id": 4, "name": "HQ"}],
+				"departments": [{"id": 5, "name": "IT"}],
+				"exclusions": {"computers": [{"id": 31, "name": "lab-02"}], "buildings": [{"id": 6, "name": "Annex"}]}
+			}
+		}}`)
+	})
+	c, _, closeSource := newClients(t, source)
+	defer closeSource()
+
+	dir := t.TempDir()
+	_, err := backup.Backup(context.Background(), dir, backup.Policies(c))
+	assert.Nil(t, err)
+
+	// The target server has the same package under another ID
+	var created string
+	policies := `{"policies": []}`
+	target := http.NewServeMux()
+	target.HandleFunc("/JSSResource/packages", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"packages": [{"id": 12, "name": "Firefox.pkg"}, {"id": 40, "name": "Chrome.pkg"}]}`)
+	})
+	target.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, policies)
+	})
+	target.HandleFunc("/JSSResource/policies/id/-1", func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		created = string(data)
+		policies = `{"policies": [{"id": 90, "name": "Install Chrome"}]}`
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `<policy><id>90</id></policy>`)
+	})
+	c, _, closeTarget := newClients(t, target)
+	defer closeTarget()
+
+	mapping, _, err := backup.Restore(context.Background(), dir, backup.Policies(c))
+	assert.Nil(t, err)
+	assert.Equal(t, backup.Mapping{backup.KindPolicies: {"11": "90"}}, mapping)
+
+	assert.Contains(t, created, "<package><name>Chrome.pkg</name><action>Install</action>")
+	assert.Contains(t, created, "<site><name>Paris</name></site>")
+	assert.Contains(t, created, "<category><name>Browsers</name><display_in>true</display_in>")
+	assert.Contains(t, created, "<computer><name>lab-01</name>")
+	assert.Contains(t, created, "<building><name>HQ</name></building>")
+	assert.Contains(t, created, "<department><name>IT</name></department>")
+	assert.Contains(t, created, "<computer><name>lab-02</name>")
+	assert.Contains(t, created, "<building><name>Annex</name></building>")
+	for _, id := range []int{2, 3, 4, 5, 6, 11, 12, 30, 31} {
+		assert.NotContains(t, created, fmt.Sprintf("<id>%d</id>", id))
+	}
+}
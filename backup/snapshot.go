@@ -0,0 +1,116 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package backup
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Snapshot holds an object as it was before being changed, so that bulk modification scripts can undo
+// their changes with Rollback
+type Snapshot struct {
+	Kind string
+	ID   string
+	// Data is the object serialized as by Resource.Export
+	Data []byte
+
+	resource Resource
+}
+
+// TakeSnapshot exports the object of r with the given ID so it can be restored later
+func TakeSnapshot(ctx context.Context, r Resource, id string) (*Snapshot, error) {
+	data, err := r.Export(ctx, id)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to take a snapshot of %s %s", r.Kind(), id)
+	}
+	return &Snapshot{Kind: r.Kind(), ID: id, Data: data, resource: r}, nil
+}
+
+// Guard takes a snapshot of the object of r with the given ID and then calls change, which updates or
+// deletes it. change is not called when the snapshot cannot be taken. The snapshot is returned even
+// when change fails since the object may have been partly changed.
+//
+//	snapshot, err := backup.Guard(ctx, backup.Policies(classicClient), "42", func(ctx context.Context) error {
+//		_, err := classicClient.UpdatePolicy(42, policy)
+//		return err
+//	})
+//	...
+//	_, err = snapshot.Rollback(ctx)
+func Guard(ctx context.Context, r Resource, id string, change func(ctx context.Context) error) (*Snapshot, error) {
+	snapshot, err := TakeSnapshot(ctx, r, id)
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, change(ctx)
+}
+
+// Rollback restores the object to its state when the snapshot was taken and returns its ID. The object
+// is updated when it still exists and created again otherwise, e.g. after it was deleted, in which case
+// it gets a new ID: the objects which referenced it by ID must be restored as well. The IDs of the
+// objects it references are kept as they were snapshotted.
+func (s *Snapshot) Rollback(ctx context.Context) (string, error) {
+	if s.resource == nil {
+		return "", errors.Errorf("unable to roll back %s %s: the snapshot has no resource, see LoadSnapshot", s.Kind, s.ID)
+	}
+	objects, err := s.resource.List(ctx)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to list %s to roll back %s", s.Kind, s.ID)
+	}
+	existing := ""
+	for _, object := range objects {
+		if object.ID == s.ID {
+			existing = s.ID
+			break
+		}
+	}
+	id, err := s.resource.Restore(ctx, s.Data, existing, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to roll back %s %s", s.Kind, s.ID)
+	}
+	return id, nil
+}
+
+// Save writes the snapshot to dir, under the directory of its kind as in a backup, so that it survives
+// the script which took it, and returns the path of the file written
+func (s *Snapshot) Save(dir string) (string, error) {
+	extension := "json"
+	if s.resource != nil {
+		extension = s.resource.Extension()
+	}
+	path := filepath.Join(dir, s.Kind, fileName(Object{ID: s.ID}, extension))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", errors.Wrapf(err, "unable to create the %s snapshot directory", s.Kind)
+	}
+	if err := os.WriteFile(path, s.Data, 0o600); err != nil {
+		return "", errors.Wrapf(err, "unable to write the snapshot of %s %s", s.Kind, s.ID)
+	}
+	return path, nil
+}
+
+// LoadSnapshot reads a snapshot of an object of r written by Save
+func LoadSnapshot(r Resource, path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the snapshot of %s", r.Kind())
+	}
+	id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return &Snapshot{Kind: r.Kind(), ID: id, Data: data, resource: r}, nil
+}
+
+// Snapshots are the snapshots taken by a script, in the order the objects were changed
+type Snapshots []*Snapshot
+
+// Rollback rolls back every snapshot, the last one first, stopping at the first error
+func (snapshots Snapshots) Rollback(ctx context.Context) error {
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if _, err := snapshots[i].Rollback(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
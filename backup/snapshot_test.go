@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package backup_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/backup"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingResource fails to export and restore objects
+type failingResource struct {
+	*memoryResource
+}
+
+func (r *failingResource) Export(ctx context.Context, id string) ([]byte, error) {
+	return nil, fmt.Errorf("server unavailable")
+}
+
+func TestGuard(t *testing.T) {
+	scripts := newMemoryResource("scripts", "", memoryObject{Name: "Clean Up", Value: "v1"}, memoryObject{Name: "Set Dock", Value: "v1"})
+	ctx := context.Background()
+
+	snapshot, err := backup.Guard(ctx, scripts, "1", func(ctx context.Context) error {
+		scripts.objects["1"] = memoryObject{Name: "Clean Up", Value: "v2"}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "scripts", snapshot.Kind)
+	assert.JSONEq(t, `{"name": "Clean Up", "value": "v1"}`, string(snapshot.Data))
+
+	id, err := snapshot.Rollback(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", id)
+	assert.Equal(t, "v1", scripts.objects["1"].Value)
+
+	// Deleted objects are created again
+	snapshot, err = backup.Guard(ctx, scripts, "2", func(ctx context.Context) error {
+		delete(scripts.objects, "2")
+		return fmt.Errorf("deleted but timed out")
+	})
+	assert.EqualError(t, err, "deleted but timed out")
+	id, err = snapshot.Rollback(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "3", id)
+	assert.Equal(t, memoryObject{Name: "Set Dock", Value: "v1"}, scripts.objects["3"])
+
+	called := false
+	snapshot, err = backup.Guard(ctx, &failingResource{scripts}, "1", func(ctx context.Context) error {
+		called = true
+		return nil
+	})
+	assert.Nil(t, snapshot)
+	assert.EqualError(t, err, "unable to take a snapshot of scripts 1: server unavailable")
+	assert.False(t, called)
+}
+
+func TestRollbackPolicy(t *testing.T) {
+	var updated string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Install Chrome"}]}`)
+	})
+	mux.HandleFunc("/JSSResource/policies/id/10", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			data, _ := io.ReadAll(r.Body)
+			updated = regexp.MustCompile(`>\s+<`).ReplaceAllString(string(data), "><")
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<policy><id>10</id></policy>`)
+			return
+		}
+		fmt.Fprint(w, `{"policy": {
+			"general": {"id": 10, "name": "Install Chrome", "category": {"id": 3, "name": "Browsers"}, "site": {"id": 2, "name": "Paris"}},
+			"package_configuration": {"packages": [{"id": 12, "name": "Chrome.pkg", "action": "Install"}]},
+			"scripts": [{"id": 5, "name": "Clean Up"}],
+			"scope": {"computer_groups": [{"id": 8, "name": "Lab"}], "buildings": [{"id": 4, "name": "HQ"}]}
+		}}`)
+	})
+	c, _, closeServer := newClients(t, mux)
+	defer closeServer()
+	ctx := context.Background()
+
+	snapshot, err := backup.TakeSnapshot(ctx, backup.Policies(c), "10")
+	assert.Nil(t, err)
+	id, err := snapshot.Rollback(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "10", id)
+
+	// The references are restored to the same server and keep their IDs
+	assert.Contains(t, updated, "<category><id>3</id><name>Browsers</name></category>")
+	assert.Contains(t, updated, "<site><id>2</id><name>Paris</name></site>")
+	assert.Contains(t, updated, "<package><id>12</id><name>Chrome.pkg</name>")
+	assert.Contains(t, updated, "<script><id>5</id><name>Clean Up</name>")
+	assert.Contains(t, updated, "<computer_group><id>8</id><name>Lab</name></computer_group>")
+	assert.Contains(t, updated, "<building><id>4</id><name>HQ</name></building>")
+}
+
+func TestSnapshots(t *testing.T) {
+	scripts := newMemoryResource("scripts", "", memoryObject{Name: "Clean Up", Value: "v1"})
+	ctx := context.Background()
+
+	snapshots := backup.Snapshots{}
+	for _, value := range []string{"v2", "v3"} {
+		snapshot, err := backup.Guard(ctx, scripts, "1", func(ctx context.Context) error {
+			scripts.objects["1"] = memoryObject{Name: "Clean Up", Value: value}
+			return nil
+		})
+		assert.Nil(t, err)
+		snapshots = append(snapshots, snapshot)
+	}
+	assert.Nil(t, snapshots.Rollback(ctx))
+	assert.Equal(t, "v1", scripts.objects["1"].Value)
+}
+
+func TestSaveSnapshot(t *testing.T) {
+	scripts := newMemoryResource("scripts", "", memoryObject{Name: "Clean Up", Value: "v1"})
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	snapshot, err := backup.TakeSnapshot(ctx, scripts, "1")
+	assert.Nil(t, err)
+	path, err := snapshot.Save(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "scripts", "1.json"), path)
+
+	scripts.objects["1"] = memoryObject{Name: "Clean Up", Value: "v2"}
+	loaded, err := backup.LoadSnapshot(scripts, path)
+	assert.Nil(t, err)
+	assert.Equal(t, snapshot, loaded)
+	_, err = loaded.Rollback(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", scripts.objects["1"].Value)
+
+	_, err = (&backup.Snapshot{Kind: "scripts", ID: "1"}).Rollback(ctx)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,194 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package batch executes many Jamf API operations, e.g. hundreds of computer updates, with bounded
+// concurrency, retrying transient failures and throttling requests, and reports the outcome of each
+// operation
+package batch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/pkg/errors"
+)
+
+// Defaults used when the fields of a Batch are not set
+const (
+	DefaultConcurrency = 4
+	DefaultBackoff     = time.Second
+	MaxBackoff         = 30 * time.Second
+)
+
+// Operation is a single call to the Jamf API, Key identifies it in the report, e.g. the computer updated
+type Operation struct {
+	Key string
+	Do  func(ctx context.Context) error
+}
+
+// Batch executes operations concurrently, its zero value runs DefaultConcurrency operations at a
+// time without retrying them
+type Batch struct {
+	// Concurrency is the maximum number of operations running at once
+	Concurrency int
+	// Retries is the number of times an operation failing with a transient error is attempted again
+	Retries int
+	// Backoff is the delay before the first retry of an operation, it doubles with each retry up to MaxBackoff
+	Backoff time.Duration
+	// Limiter, when set, is waited on before each attempt, e.g. a *rate.Limiter from golang.org/x/time/rate
+	// or Every, and should be shared by everything using the same Jamf server
+	Limiter Limiter
+	// Retryable reports whether an error is transient, IsTransient when nil
+	Retryable func(error) bool
+}
+
+// Result is the outcome of an operation
+type Result struct {
+	Key string
+	// Index of the operation in the batch
+	Index int
+	// Attempts is the number of times the operation was called, zero when the batch was canceled first
+	Attempts int
+	Err      error
+	Duration time.Duration
+}
+
+// Report holds the result of every operation of a batch, in the order of the operations
+type Report struct {
+	Results []Result
+}
+
+// Run executes ops and returns their results once they have all completed, operations which have not
+// started when ctx is canceled fail with the error of ctx
+func (b *Batch) Run(ctx context.Context, ops []Operation) *Report {
+	report := &Report{Results: make([]Result, len(ops))}
+	concurrency := b.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < concurrency && w < len(ops); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				report.Results[i] = b.run(ctx, i, ops[i])
+			}
+		}()
+	}
+	for i := range ops {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return report
+}
+
+func (b *Batch) run(ctx context.Context, index int, op Operation) (res Result) {
+	res = Result{Key: op.Key, Index: index}
+	start := time.Now()
+	defer func() { res.Duration = time.Since(start) }()
+
+	retryable := b.Retryable
+	if retryable == nil {
+		retryable = IsTransient
+	}
+	backoff := b.Backoff
+	if backoff <= 0 {
+		backoff = DefaultBackoff
+	}
+	for {
+		if res.Err = ctx.Err(); res.Err != nil {
+			return res
+		}
+		if b.Limiter != nil {
+			if res.Err = b.Limiter.Wait(ctx); res.Err != nil {
+				return res
+			}
+		}
+		res.Attempts++
+		res.Err = op.Do(ctx)
+		if res.Err == nil || res.Attempts > b.Retries || !retryable(res.Err) {
+			return res
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > MaxBackoff {
+			backoff = MaxBackoff
+		}
+	}
+}
+
+// Failed returns the results of the operations which failed
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, res := range r.Results {
+		if res.Err != nil {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// Err returns an error listing the operations which failed, or nil when they all succeeded
+func (r *Report) Err() error {
+	failed := r.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+	causes := make([]string, 0, 4)
+	for i, res := range failed {
+		if i == 3 {
+			causes = append(causes, "...")
+			break
+		}
+		causes = append(causes, fmt.Sprintf("%s: %v", res.Key, res.Err))
+	}
+	return fmt.Errorf("%d of %d operations failed: %s", len(failed), len(r.Results), strings.Join(causes, "; "))
+}
+
+// IsTransient reports whether err may not happen again when retrying, i.e. it is a Jamf Pro or Classic API
+// error for a throttled request, a timeout or an unavailable server, or a network error
+func IsTransient(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	apiErr := &pro.APIError{}
+	if errors.As(err, &apiErr) {
+		return transientStatus(apiErr.StatusCode)
+	}
+	requestErr := &classic.RequestError{}
+	if errors.As(err, &requestErr) {
+		return transientStatus(requestErr.StatusCode)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func transientStatus(code int) bool {
+	switch code {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
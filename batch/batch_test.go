@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package batch_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/batch"
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	mu := sync.Mutex{}
+	attempts := map[string]int{}
+	running, maxRunning := int32(0), int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/auth/token" {
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+			return
+		}
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		attempts[r.URL.Path]++
+		attempt := attempts[r.URL.Path]
+		mu.Unlock()
+		switch {
+		case r.URL.Path == "/api/v1/computers-inventory-detail/3" && attempt == 1:
+			http.Error(w, `{"httpStatus": 503, "errors": []}`, http.StatusServiceUnavailable)
+		case r.URL.Path == "/api/v1/computers-inventory-detail/7":
+			http.Error(w, `{"httpStatus": 400, "errors": [{"code": "INVALID_FIELD", "description": "invalid asset tag"}]}`, http.StatusBadRequest)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"id": "1"}`)
+		}
+	}))
+	defer server.Close()
+	p, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	ops := make([]batch.Operation, 10)
+	for i := range ops {
+		id := strconv.Itoa(i)
+		ops[i] = batch.Operation{Key: id, Do: func(ctx context.Context) error {
+			_, err := p.UpdateComputerInventory(ctx, id, &pro.ComputerInventoryUpdate{})
+			return err
+		}}
+	}
+	b := &batch.Batch{Concurrency: 3, Retries: 2, Backoff: time.Millisecond, Limiter: batch.Every(time.Millisecond)}
+	report := b.Run(context.Background(), ops)
+
+	assert.Len(t, report.Results, 10)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(3))
+	assert.Equal(t, 2, report.Results[3].Attempts)
+	assert.Nil(t, report.Results[3].Err)
+	assert.Equal(t, 1, report.Results[7].Attempts)
+	failed := report.Failed()
+	assert.Len(t, failed, 1)
+	assert.Equal(t, "7", failed[0].Key)
+	assert.Contains(t, report.Err().Error(), "1 of 10 operations failed: 7: unable to process JAMF update request for computer inventory with ID 7")
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	ops := []batch.Operation{
+		{Key: "first", Do: func(ctx context.Context) error {
+			calls++
+			cancel()
+			return &pro.APIError{StatusCode: http.StatusTooManyRequests}
+		}},
+		{Key: "second", Do: func(ctx context.Context) error {
+			calls++
+			return nil
+		}},
+	}
+	report := (&batch.Batch{Concurrency: 1, Retries: 5}).Run(ctx, ops)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, report.Results[0].Attempts)
+	assert.Equal(t, 0, report.Results[1].Attempts)
+	assert.Equal(t, context.Canceled, report.Results[1].Err)
+	assert.Nil(t, (&batch.Report{}).Err())
+}
+
+func TestIsTransient(t *testing.T) {
+	assert.True(t, batch.IsTransient(errors.Wrap(&pro.APIError{StatusCode: http.StatusTooManyRequests}, "unable to query")))
+	assert.False(t, batch.IsTransient(&pro.APIError{StatusCode: http.StatusNotFound}))
+	assert.False(t, batch.IsTransient(errors.Wrap(context.Canceled, "unable to query")))
+	assert.False(t, batch.IsTransient(fmt.Errorf("request error: invalid")))
+	assert.True(t, batch.IsTransient(errors.Wrap(&classic.RequestError{StatusCode: http.StatusServiceUnavailable}, "unable to update policy")))
+	assert.False(t, batch.IsTransient(&classic.RequestError{StatusCode: http.StatusConflict}))
+}
+
+func TestRunRetriesClassic(t *testing.T) {
+	attempts := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/auth/token" {
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+			return
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			http.Error(w, "<html><body>Service Unavailable</body></html>", http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<policy><id>12</id></policy>`)
+	}))
+	defer server.Close()
+	c, err := classic.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	b := &batch.Batch{Retries: 2, Backoff: time.Millisecond}
+	report := b.Run(context.Background(), []batch.Operation{{Key: "12", Do: func(ctx context.Context) error {
+		_, err := c.UpdatePolicy(12, &classic.PolicyContents{General: &classic.PolicyGeneral{Name: "Updated"}})
+		return err
+	}}})
+	assert.Nil(t, report.Err())
+	assert.Equal(t, 2, report.Results[0].Attempts)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestEvery(t *testing.T) {
+	limiter := batch.Every(20 * time.Millisecond)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, limiter.Wait(context.Background()))
+	}
+	assert.True(t, time.Since(start) >= 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, limiter.Wait(ctx))
+}
@@ -0,0 +1,50 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter throttles requests, Wait blocks until a request may be made or ctx is done
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+type intervalLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Every returns a Limiter allowing a request every interval, e.g. Every(time.Second/10) for 10 requests
+// per second
+func Every(interval time.Duration) Limiter {
+	return &intervalLimiter{interval: interval}
+}
+
+func (l *intervalLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -5,27 +5,27 @@ package classic
 
 // Account represents an account set up in Jamf
 type Account struct {
-	Size    int            `json:"size"`
-	Details AccountDetails `json:"account"`
+	Size    int            `json:"size" xml:"size,omitempty"`
+	Details AccountDetails `json:"account" xml:"account"`
 }
 
 // AccountDetails holds the specific account details
 type AccountDetails struct {
-	Action             string `json:"action"`
-	Username           string `json:"username"`
-	Realname           string `json:"realname"`
-	Password           string `json:"password"`
-	ArchiveHomDir      bool   `json:"archive_home_directory"`
-	ArchiveHomeDirPath string `json:"archive_home_directory_to"`
-	Home               string `json:"home"`
-	Picture            string `json:"picture"`
-	Admin              bool   `json:"admin"`
-	FileVaultEnabled   bool   `json:"filevault_enabled"`
+	Action             string `json:"action" xml:"action"`
+	Username           string `json:"username" xml:"username"`
+	Realname           string `json:"realname" xml:"realname,omitempty"`
+	Password           string `json:"password" xml:"password,omitempty"`
+	ArchiveHomDir      bool   `json:"archive_home_directory" xml:"archive_home_directory"`
+	ArchiveHomeDirPath string `json:"archive_home_directory_to" xml:"archive_home_directory_to,omitempty"`
+	Home               string `json:"home" xml:"home,omitempty"`
+	Picture            string `json:"picture" xml:"picture,omitempty"`
+	Admin              bool   `json:"admin" xml:"admin"`
+	FileVaultEnabled   bool   `json:"filevault_enabled" xml:"filevault_enabled"`
 }
 
 // ManagementAccount represents a management account type
 type ManagementAccount struct {
-	Action                string `json:"action"`
-	ManagedPassword       string `json:"managed_password"`
-	ManagedPasswordLength string `json:"managed_password_length"`
+	Action                string `json:"action" xml:"action"`
+	ManagedPassword       string `json:"managed_password" xml:"managed_password,omitempty"`
+	ManagedPasswordLength string `json:"managed_password_length" xml:"managed_password_length,omitempty"`
 }
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ActivationCode returns the activation code and organization name configured in Jamf
+func (j *Client) ActivationCode() (*ActivationCode, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, activationCodeContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF activation code query request")
+	}
+
+	res := ActivationCodeDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query activation code from %s", ep)
+	}
+	return res.Details, nil
+}
+
+// UpdateActivationCode will update the activation code and/or organization name configured in Jamf
+func (j *Client) UpdateActivationCode(content *ActivationCode) (*ActivationCode, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, activationCodeContext)
+
+	if content == nil || (content.Code == "" && content.OrganizationName == "") {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for activation code: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF update payload for activation code")
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for activation code (%s)", ep)
+	}
+
+	res := ActivationCode{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for activation code (%s)", ep)
+	}
+
+	return &res, nil
+}
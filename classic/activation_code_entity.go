@@ -0,0 +1,17 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// ActivationCodeDetails holds the activation code configured in Jamf
+type ActivationCodeDetails struct {
+	Details *ActivationCode `json:"activation_code"`
+}
+
+// ActivationCode represents the license activation code of a Jamf instance
+type ActivationCode struct {
+	XMLName          xml.Name `json:"-" xml:"activation_code,omitempty"`
+	OrganizationName string   `json:"organization_name,omitempty" xml:"organization_name,omitempty"`
+	Code             string   `json:"code,omitempty" xml:"code,omitempty"`
+}
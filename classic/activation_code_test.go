@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var ACTIVATION_CODE_API_BASE_ENDPOINT = "/JSSResource/activationcode"
+
+func activationCodeResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case ACTIVATION_CODE_API_BASE_ENDPOINT:
+			switch r.Method {
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				codeContents := &jamf.ActivationCode{}
+				err = xml.Unmarshal(data, codeContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				codeData, err := json.MarshalIndent(codeContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(codeData))
+			default:
+				fmt.Fprint(w, `{
+					"activation_code": {
+						"organization_name": "Example School District",
+						"code": "A1B2-C3D4-E5F6-G7H8"
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryActivationCode(t *testing.T) {
+	testServer := activationCodeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	code, err := j.ActivationCode()
+	assert.Nil(t, err)
+	assert.Equal(t, "Example School District", code.OrganizationName)
+	assert.Equal(t, "A1B2-C3D4-E5F6-G7H8", code.Code)
+}
+
+func TestUpdateActivationCode(t *testing.T) {
+	testServer := activationCodeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.UpdateActivationCode(&jamf.ActivationCode{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "empty payload")
+
+	code, err := j.UpdateActivationCode(&jamf.ActivationCode{Code: "Z9Y8-X7W6-V5U4-T3S2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Z9Y8-X7W6-V5U4-T3S2", code.Code)
+}
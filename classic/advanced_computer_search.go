@@ -0,0 +1,162 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AdvancedComputerSearches returns all advanced computer searches
+func (j *Client) AdvancedComputerSearches() ([]BasicAdvancedSearchInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, advancedComputerSearchesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF advanced computer searches query request")
+	}
+
+	res := &AdvancedComputerSearches{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced computer searches from %s", ep)
+	}
+	return res.List, nil
+}
+
+// AdvancedComputerSearchDetails returns the definition and computed results for a specific advanced computer search given its ID or Name
+func (j *Client) AdvancedComputerSearchDetails(identifier interface{}) (*AdvancedComputerSearchDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedComputerSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for advanced computer search: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced computer search: %v", identifier)
+	}
+
+	res := AdvancedComputerSearchDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced computer search with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// AdvancedComputerSearchResults returns the computed results for a specific advanced computer search given its ID or Name
+func (j *Client) AdvancedComputerSearchResults(identifier interface{}) (AdvancedSearchResultList, error) {
+	search, err := j.AdvancedComputerSearchDetails(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if search.Details == nil {
+		return nil, nil
+	}
+	return search.Details.Computers, nil
+}
+
+// CreateAdvancedComputerSearch will create a new advanced computer search in Jamf
+func (j *Client) CreateAdvancedComputerSearch(content *AdvancedComputerSearch) (*AdvancedComputerSearch, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, advancedComputerSearchesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new advanced computer search")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for advanced computer search: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new advanced computer search"), "unable to process JAMF creation request for advanced computer search: (%s)", ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.Computers = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for advanced computer search: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for advanced computer search: %v (%s)", content.Name, ep)
+	}
+
+	res := AdvancedComputerSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for advanced computer search %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedComputerSearch will update an advanced computer search in Jamf by either ID or Name
+func (j *Client) UpdateAdvancedComputerSearch(identifier interface{}, content *AdvancedComputerSearch) (*AdvancedComputerSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedComputerSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced computer search: %v", identifier)
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for advanced computer search: %v (%s)", identifier, ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.Computers = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for advanced computer search: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for advanced computer search: %v (%s)", identifier, ep)
+	}
+
+	res := AdvancedComputerSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for advanced computer search: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedComputerSearchByName will update an advanced computer search in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateAdvancedComputerSearchByName(name string, content *AdvancedComputerSearch) (*AdvancedComputerSearch, error) {
+	return j.UpdateAdvancedComputerSearch(name, content)
+}
+
+// DeleteAdvancedComputerSearch will delete an advanced computer search by either ID or Name
+func (j *Client) DeleteAdvancedComputerSearch(identifier interface{}) (*AdvancedComputerSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedComputerSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced computer search: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for advanced computer search %v", identifier)
+	}
+
+	res := AdvancedComputerSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for advanced computer search %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteAdvancedComputerSearchByName will delete an advanced computer search by name, which is escaped in the request path
+func (j *Client) DeleteAdvancedComputerSearchByName(name string) (*AdvancedComputerSearch, error) {
+	return j.DeleteAdvancedComputerSearch(name)
+}
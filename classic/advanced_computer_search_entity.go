@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// AdvancedComputerSearches represents a list of advanced computer searches in Jamf
+type AdvancedComputerSearches struct {
+	List  []BasicAdvancedSearchInfo `json:"advanced_computer_searches" xml:"advanced_computer_searches>advanced_computer_search,omitempty"`
+	Count int                       `json:"-" xml:"size"`
+}
+
+// AdvancedComputerSearchDetails holds the details for a single advanced computer search
+type AdvancedComputerSearchDetails struct {
+	Details *AdvancedComputerSearch `json:"advanced_computer_search"`
+}
+
+// AdvancedComputerSearch represents an advanced computer search in Jamf including the computed results
+type AdvancedComputerSearch struct {
+	XMLName       xml.Name               `json:"-" xml:"advanced_computer_search,omitempty"`
+	ID            int                    `json:"id,omitempty" xml:"id,omitempty"`
+	Name          string                 `json:"name" xml:"name,omitempty"`
+	ViewAs        string                 `json:"view_as,omitempty" xml:"view_as,omitempty"`
+	Sort1         string                 `json:"sort_1,omitempty" xml:"sort_1,omitempty"`
+	Sort2         string                 `json:"sort_2,omitempty" xml:"sort_2,omitempty"`
+	Sort3         string                 `json:"sort_3,omitempty" xml:"sort_3,omitempty"`
+	Criteria      []SearchCriterion      `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
+	DisplayFields []DisplayField         `json:"display_fields,omitempty" xml:"display_fields>display_field,omitempty"`
+	Computers     []AdvancedSearchResult `json:"computers,omitempty" xml:"computers>computer,omitempty"`
+	Site          *Site                  `json:"site,omitempty" xml:"site,omitempty"`
+}
@@ -0,0 +1,209 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var ADVANCED_COMPUTER_SEARCH_API_BASE_ENDPOINT = "/JSSResource/advancedcomputersearches"
+
+func advancedComputerSearchResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case ADVANCED_COMPUTER_SEARCH_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"advanced_computer_searches": [
+					{
+							"id": 4,
+							"name": "FileVault Disabled"
+					},
+					{
+							"id": 9,
+							"name": "Out of Date macOS"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/4", ADVANCED_COMPUTER_SEARCH_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", ADVANCED_COMPUTER_SEARCH_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/FileVault%sDisabled", ADVANCED_COMPUTER_SEARCH_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchContents := &jamf.AdvancedComputerSearch{}
+				err = xml.Unmarshal(data, searchContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchData, err := json.MarshalIndent(searchContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(searchData))
+			case "DELETE":
+				fmt.Fprint(w, `{"id": 4, "name": "FileVault Disabled"}`)
+			default:
+				fmt.Fprint(w, `{
+					"advanced_computer_search": {
+						"id": 4,
+						"name": "FileVault Disabled",
+						"view_as": "Standard Web Page",
+						"criteria": [
+							{
+								"name": "FileVault 2 Status",
+								"priority": 0,
+								"and_or": "and",
+								"search_type": "is not",
+								"value": "Encrypted",
+								"opening_paren": false,
+								"closing_paren": false
+							}
+						],
+						"display_fields": [
+							{ "name": "Computer Name" },
+							{ "name": "Serial Number" }
+						],
+						"computers": [
+							{
+								"id": 82,
+								"name": "Go Client Test Machine",
+								"udid": "000DF0BF-00FF-D00B-FA00-000F0DA0FE00",
+								"Computer_Name": "Go Client Test Machine",
+								"Serial_Number": "VM0L+J/0cr+l"
+							}
+						]
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllAdvancedComputerSearches(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	searches, err := j.AdvancedComputerSearches()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(searches))
+	assert.Equal(t, 9, searches[1].ID)
+	assert.Equal(t, "Out of Date macOS", searches[1].Name)
+}
+
+func TestQuerySpecificAdvancedComputerSearch(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	search, err := j.AdvancedComputerSearchDetails("FileVault Disabled")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, search.Details.ID)
+	assert.Equal(t, "Standard Web Page", search.Details.ViewAs)
+	assert.Equal(t, "FileVault 2 Status", search.Details.Criteria[0].Name)
+	assert.Equal(t, "is not", search.Details.Criteria[0].SearchType)
+	assert.Equal(t, 2, len(search.Details.DisplayFields))
+	assert.Equal(t, "Serial Number", search.Details.DisplayFields[1].Name)
+}
+
+func TestAdvancedComputerSearchResults(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	results, err := j.AdvancedComputerSearchResults(4)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(results))
+	assert.Equal(t, 82, results[0].ID)
+	assert.Equal(t, "Go Client Test Machine", results[0].Name)
+	assert.Equal(t, "000DF0BF-00FF-D00B-FA00-000F0DA0FE00", results[0].UDID)
+	assert.Equal(t, "VM0L+J/0cr+l", results[0].Fields["Serial_Number"])
+}
+
+func TestAdvancedSearchResultXML(t *testing.T) {
+	data := `<computer><id>82</id><name>Test</name><Serial_Number>C02ABC</Serial_Number></computer>`
+	result := jamf.AdvancedSearchResult{}
+	assert.Nil(t, xml.Unmarshal([]byte(data), &result))
+	assert.Equal(t, 82, result.ID)
+	assert.Equal(t, "C02ABC", result.Fields["Serial_Number"])
+
+	encoded, err := xml.Marshal(result)
+	assert.Nil(t, err)
+	assert.Equal(t, "<AdvancedSearchResult><Serial_Number>C02ABC</Serial_Number><id>82</id><name>Test</name></AdvancedSearchResult>", string(encoded))
+}
+
+func TestCreateAdvancedComputerSearch(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateAdvancedComputerSearch(&jamf.AdvancedComputerSearch{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new advanced computer search")
+
+	newSearch := &jamf.AdvancedComputerSearch{
+		Name: "Low Disk Space",
+		Criteria: []jamf.SearchCriterion{
+			{
+				Name:       "Boot Drive Available MB",
+				AndOr:      "and",
+				SearchType: "less than",
+				Value:      "10000",
+			},
+		},
+		DisplayFields: []jamf.DisplayField{{Name: "Computer Name"}},
+		Computers:     []jamf.AdvancedSearchResult{{ID: 1}},
+	}
+	search, err := j.CreateAdvancedComputerSearch(newSearch)
+	assert.Nil(t, err)
+	assert.Equal(t, "Low Disk Space", search.Name)
+	assert.Equal(t, "less than", search.Criteria[0].SearchType)
+	assert.Equal(t, "Computer Name", search.DisplayFields[0].Name)
+	assert.Empty(t, search.Computers)
+	assert.Equal(t, 1, len(newSearch.Computers))
+}
+
+func TestUpdateAdvancedComputerSearch(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	search, err := j.UpdateAdvancedComputerSearch(4, &jamf.AdvancedComputerSearch{Sort1: "Computer Name"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Computer Name", search.Sort1)
+}
+
+func TestDeleteAdvancedComputerSearch(t *testing.T) {
+	testServer := advancedComputerSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteAdvancedComputerSearch(4)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, removed.ID)
+}
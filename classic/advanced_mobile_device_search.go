@@ -0,0 +1,162 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AdvancedMobileDeviceSearches returns all advanced mobile device searches
+func (j *Client) AdvancedMobileDeviceSearches() ([]BasicAdvancedSearchInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, advancedMobileDeviceSearchesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF advanced mobile device searches query request")
+	}
+
+	res := &AdvancedMobileDeviceSearches{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced mobile device searches from %s", ep)
+	}
+	return res.List, nil
+}
+
+// AdvancedMobileDeviceSearchDetails returns the definition and computed results for a specific advanced mobile device search given its ID or Name
+func (j *Client) AdvancedMobileDeviceSearchDetails(identifier interface{}) (*AdvancedMobileDeviceSearchDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedMobileDeviceSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for advanced mobile device search: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced mobile device search: %v", identifier)
+	}
+
+	res := AdvancedMobileDeviceSearchDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced mobile device search with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// AdvancedMobileDeviceSearchResults returns the computed results for a specific advanced mobile device search given its ID or Name
+func (j *Client) AdvancedMobileDeviceSearchResults(identifier interface{}) (AdvancedSearchResultList, error) {
+	search, err := j.AdvancedMobileDeviceSearchDetails(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if search.Details == nil {
+		return nil, nil
+	}
+	return search.Details.MobileDevices, nil
+}
+
+// CreateAdvancedMobileDeviceSearch will create a new advanced mobile device search in Jamf
+func (j *Client) CreateAdvancedMobileDeviceSearch(content *AdvancedMobileDeviceSearch) (*AdvancedMobileDeviceSearch, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, advancedMobileDeviceSearchesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new advanced mobile device search")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for advanced mobile device search: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new advanced mobile device search"), "unable to process JAMF creation request for advanced mobile device search: (%s)", ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.MobileDevices = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for advanced mobile device search: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for advanced mobile device search: %v (%s)", content.Name, ep)
+	}
+
+	res := AdvancedMobileDeviceSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for advanced mobile device search %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedMobileDeviceSearch will update an advanced mobile device search in Jamf by either ID or Name
+func (j *Client) UpdateAdvancedMobileDeviceSearch(identifier interface{}, content *AdvancedMobileDeviceSearch) (*AdvancedMobileDeviceSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedMobileDeviceSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced mobile device search: %v", identifier)
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for advanced mobile device search: %v (%s)", identifier, ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.MobileDevices = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for advanced mobile device search: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for advanced mobile device search: %v (%s)", identifier, ep)
+	}
+
+	res := AdvancedMobileDeviceSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for advanced mobile device search: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedMobileDeviceSearchByName will update an advanced mobile device search in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateAdvancedMobileDeviceSearchByName(name string, content *AdvancedMobileDeviceSearch) (*AdvancedMobileDeviceSearch, error) {
+	return j.UpdateAdvancedMobileDeviceSearch(name, content)
+}
+
+// DeleteAdvancedMobileDeviceSearch will delete an advanced mobile device search by either ID or Name
+func (j *Client) DeleteAdvancedMobileDeviceSearch(identifier interface{}) (*AdvancedMobileDeviceSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedMobileDeviceSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced mobile device search: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for advanced mobile device search %v", identifier)
+	}
+
+	res := AdvancedMobileDeviceSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for advanced mobile device search %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteAdvancedMobileDeviceSearchByName will delete an advanced mobile device search by name, which is escaped in the request path
+func (j *Client) DeleteAdvancedMobileDeviceSearchByName(name string) (*AdvancedMobileDeviceSearch, error) {
+	return j.DeleteAdvancedMobileDeviceSearch(name)
+}
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// AdvancedMobileDeviceSearches represents a list of advanced mobile device searches in Jamf
+type AdvancedMobileDeviceSearches struct {
+	List  []BasicAdvancedSearchInfo `json:"advanced_mobile_device_searches" xml:"advanced_mobile_device_searches>advanced_mobile_device_search,omitempty"`
+	Count int                       `json:"-" xml:"size"`
+}
+
+// AdvancedMobileDeviceSearchDetails holds the details for a single advanced mobile device search
+type AdvancedMobileDeviceSearchDetails struct {
+	Details *AdvancedMobileDeviceSearch `json:"advanced_mobile_device_search"`
+}
+
+// AdvancedMobileDeviceSearch represents an advanced mobile device search in Jamf including the computed results
+type AdvancedMobileDeviceSearch struct {
+	XMLName       xml.Name               `json:"-" xml:"advanced_mobile_device_search,omitempty"`
+	ID            int                    `json:"id,omitempty" xml:"id,omitempty"`
+	Name          string                 `json:"name" xml:"name,omitempty"`
+	ViewAs        string                 `json:"view_as,omitempty" xml:"view_as,omitempty"`
+	Sort1         string                 `json:"sort_1,omitempty" xml:"sort_1,omitempty"`
+	Sort2         string                 `json:"sort_2,omitempty" xml:"sort_2,omitempty"`
+	Sort3         string                 `json:"sort_3,omitempty" xml:"sort_3,omitempty"`
+	Criteria      []SearchCriterion      `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
+	DisplayFields []DisplayField         `json:"display_fields,omitempty" xml:"display_fields>display_field,omitempty"`
+	MobileDevices []AdvancedSearchResult `json:"mobile_devices,omitempty" xml:"mobile_devices>mobile_device,omitempty"`
+	Site          *Site                  `json:"site,omitempty" xml:"site,omitempty"`
+}
@@ -0,0 +1,147 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var ADVANCED_MOBILE_DEVICE_SEARCH_API_BASE_ENDPOINT = "/JSSResource/advancedmobiledevicesearches"
+
+func advancedMobileDeviceSearchResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case ADVANCED_MOBILE_DEVICE_SEARCH_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"advanced_mobile_device_searches": [
+					{
+							"id": 2,
+							"name": "Unsupervised iPads"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", ADVANCED_MOBILE_DEVICE_SEARCH_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", ADVANCED_MOBILE_DEVICE_SEARCH_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchContents := &jamf.AdvancedMobileDeviceSearch{}
+				err = xml.Unmarshal(data, searchContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchData, err := json.MarshalIndent(searchContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(searchData))
+			case "DELETE":
+				fmt.Fprint(w, `{"id": 2, "name": "Unsupervised iPads"}`)
+			default:
+				fmt.Fprint(w, `{
+					"advanced_mobile_device_search": {
+						"id": 2,
+						"name": "Unsupervised iPads",
+						"criteria": [
+							{
+								"name": "Supervised",
+								"priority": 0,
+								"and_or": "and",
+								"search_type": "is",
+								"value": "No",
+								"opening_paren": false,
+								"closing_paren": false
+							}
+						],
+						"display_fields": [
+							{ "name": "Display Name" }
+						],
+						"mobile_devices": [
+							{
+								"id": 17,
+								"name": "Cart 3 - iPad 12",
+								"udid": "a1b2c3d4e5f6",
+								"Display_Name": "Cart 3 - iPad 12"
+							}
+						]
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllAdvancedMobileDeviceSearches(t *testing.T) {
+	testServer := advancedMobileDeviceSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	searches, err := j.AdvancedMobileDeviceSearches()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(searches))
+	assert.Equal(t, "Unsupervised iPads", searches[0].Name)
+}
+
+func TestAdvancedMobileDeviceSearchResults(t *testing.T) {
+	testServer := advancedMobileDeviceSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	search, err := j.AdvancedMobileDeviceSearchDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "Supervised", search.Details.Criteria[0].Name)
+	assert.Equal(t, "Display Name", search.Details.DisplayFields[0].Name)
+
+	results, err := j.AdvancedMobileDeviceSearchResults(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 17, results[0].ID)
+	assert.Equal(t, "Cart 3 - iPad 12", results[0].Fields["Display_Name"])
+}
+
+func TestCreateUpdateDeleteAdvancedMobileDeviceSearch(t *testing.T) {
+	testServer := advancedMobileDeviceSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateAdvancedMobileDeviceSearch(&jamf.AdvancedMobileDeviceSearch{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new advanced mobile device search")
+
+	search, err := j.CreateAdvancedMobileDeviceSearch(&jamf.AdvancedMobileDeviceSearch{
+		Name:          "Low Battery",
+		Criteria:      []jamf.SearchCriterion{{Name: "Battery Level", AndOr: "and", SearchType: "less than", Value: "20"}},
+		MobileDevices: []jamf.AdvancedSearchResult{{ID: 1}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Low Battery", search.Name)
+	assert.Empty(t, search.MobileDevices)
+
+	search, err = j.UpdateAdvancedMobileDeviceSearch(2, &jamf.AdvancedMobileDeviceSearch{ViewAs: "Standard Web Page"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Standard Web Page", search.ViewAs)
+
+	removed, err := j.DeleteAdvancedMobileDeviceSearch(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.ID)
+}
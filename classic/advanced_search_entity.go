@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// BasicAdvancedSearchInfo holds the basic information for an advanced search in Jamf
+type BasicAdvancedSearchInfo struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// SearchCriterion represents a single criterion of an advanced search
+type SearchCriterion struct {
+	Name         string `json:"name" xml:"name"`
+	Priority     int    `json:"priority" xml:"priority"`
+	AndOr        string `json:"and_or" xml:"and_or"`
+	SearchType   string `json:"search_type" xml:"search_type"`
+	Value        string `json:"value" xml:"value"`
+	OpeningParen bool   `json:"opening_paren" xml:"opening_paren"`
+	ClosingParen bool   `json:"closing_paren" xml:"closing_paren"`
+}
+
+// DisplayField represents an inventory field displayed in the results of an advanced search
+type DisplayField struct {
+	Name string `json:"name" xml:"name"`
+}
+
+// AdvancedSearchResultList is the list of records computed by an advanced search, see Export
+type AdvancedSearchResultList []AdvancedSearchResult
+
+// AdvancedSearchResult represents a single record returned by an advanced search, the
+// fields selected as display fields are returned in Fields keyed by their Jamf element name
+// i.e Display Field "Computer Name" => Fields["Computer_Name"]
+type AdvancedSearchResult struct {
+	ID     int
+	Name   string
+	UDID   string
+	Fields map[string]string
+}
+
+// UnmarshalJSON flattens the dynamic display fields of a search result into Fields
+func (r *AdvancedSearchResult) UnmarshalJSON(data []byte) error {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	r.Fields = map[string]string{}
+	for k, v := range raw {
+		r.set(k, fmt.Sprint(v))
+	}
+	return nil
+}
+
+// MarshalJSON writes the search result using the same flat structure returned by Jamf
+func (r AdvancedSearchResult) MarshalJSON() ([]byte, error) {
+	raw := map[string]interface{}{}
+	for k, v := range r.Fields {
+		raw[k] = v
+	}
+	if r.ID != 0 {
+		raw["id"] = r.ID
+	}
+	if r.Name != "" {
+		raw["name"] = r.Name
+	}
+	if r.UDID != "" {
+		raw["udid"] = r.UDID
+	}
+	return json.Marshal(raw)
+}
+
+// UnmarshalXML flattens the dynamic display fields of a search result into Fields
+func (r *AdvancedSearchResult) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	r.Fields = map[string]string{}
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			var value string
+			if err := d.DecodeElement(&value, &t); err != nil {
+				return err
+			}
+			r.set(t.Name.Local, value)
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// MarshalXML writes the search result using the same flat structure returned by Jamf
+func (r AdvancedSearchResult) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	fields := map[string]string{}
+	for k, v := range r.Fields {
+		fields[k] = v
+	}
+	if r.ID != 0 {
+		fields["id"] = strconv.Itoa(r.ID)
+	}
+	if r.Name != "" {
+		fields["name"] = r.Name
+	}
+	if r.UDID != "" {
+		fields["udid"] = r.UDID
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := e.EncodeElement(fields[k], xml.StartElement{Name: xml.Name{Local: k}}); err != nil {
+			return err
+		}
+	}
+
+	return e.EncodeToken(start.End())
+}
+
+func (r *AdvancedSearchResult) set(key string, value string) {
+	switch key {
+	case "id":
+		// Jamf returns numeric values as floats when decoded generically
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			r.ID = int(f)
+		}
+	case "name":
+		r.Name = value
+	case "udid":
+		r.UDID = value
+	default:
+		r.Fields[key] = value
+	}
+}
@@ -0,0 +1,162 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AdvancedUserSearches returns all advanced user searches
+func (j *Client) AdvancedUserSearches() ([]BasicAdvancedSearchInfo, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, advancedUserSearchesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF advanced user searches query request")
+	}
+
+	res := &AdvancedUserSearches{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced user searches from %s", ep)
+	}
+	return res.List, nil
+}
+
+// AdvancedUserSearchDetails returns the definition and computed results for a specific advanced user search given its ID or Name
+func (j *Client) AdvancedUserSearchDetails(identifier interface{}) (*AdvancedUserSearchDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedUserSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for advanced user search: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced user search: %v", identifier)
+	}
+
+	res := AdvancedUserSearchDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query advanced user search with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// AdvancedUserSearchResults returns the computed results for a specific advanced user search given its ID or Name
+func (j *Client) AdvancedUserSearchResults(identifier interface{}) (AdvancedSearchResultList, error) {
+	search, err := j.AdvancedUserSearchDetails(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if search.Details == nil {
+		return nil, nil
+	}
+	return search.Details.Users, nil
+}
+
+// CreateAdvancedUserSearch will create a new advanced user search in Jamf
+func (j *Client) CreateAdvancedUserSearch(content *AdvancedUserSearch) (*AdvancedUserSearch, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, advancedUserSearchesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new advanced user search")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for advanced user search: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new advanced user search"), "unable to process JAMF creation request for advanced user search: (%s)", ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.Users = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for advanced user search: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for advanced user search: %v (%s)", content.Name, ep)
+	}
+
+	res := AdvancedUserSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for advanced user search %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedUserSearch will update an advanced user search in Jamf by either ID or Name
+func (j *Client) UpdateAdvancedUserSearch(identifier interface{}, content *AdvancedUserSearch) (*AdvancedUserSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedUserSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced user search: %v", identifier)
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for advanced user search: %v (%s)", identifier, ep)
+	}
+
+	// computed results are read-only and should never be sent back to Jamf
+	payload := *content
+	payload.Users = nil
+
+	bodyContent, err := xml.Marshal(payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for advanced user search: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for advanced user search: %v (%s)", identifier, ep)
+	}
+
+	res := AdvancedUserSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for advanced user search: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateAdvancedUserSearchByName will update an advanced user search in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateAdvancedUserSearchByName(name string, content *AdvancedUserSearch) (*AdvancedUserSearch, error) {
+	return j.UpdateAdvancedUserSearch(name, content)
+}
+
+// DeleteAdvancedUserSearch will delete an advanced user search by either ID or Name
+func (j *Client) DeleteAdvancedUserSearch(identifier interface{}) (*AdvancedUserSearch, error) {
+	ep, err := EndpointBuilder(j.Endpoint, advancedUserSearchesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for advanced user search: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for advanced user search %v", identifier)
+	}
+
+	res := AdvancedUserSearch{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for advanced user search %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteAdvancedUserSearchByName will delete an advanced user search by name, which is escaped in the request path
+func (j *Client) DeleteAdvancedUserSearchByName(name string) (*AdvancedUserSearch, error) {
+	return j.DeleteAdvancedUserSearch(name)
+}
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// AdvancedUserSearches represents a list of advanced user searches in Jamf
+type AdvancedUserSearches struct {
+	List  []BasicAdvancedSearchInfo `json:"advanced_user_searches" xml:"advanced_user_searches>advanced_user_search,omitempty"`
+	Count int                       `json:"-" xml:"size"`
+}
+
+// AdvancedUserSearchDetails holds the details for a single advanced user search
+type AdvancedUserSearchDetails struct {
+	Details *AdvancedUserSearch `json:"advanced_user_search"`
+}
+
+// AdvancedUserSearch represents an advanced user search in Jamf including the computed results
+type AdvancedUserSearch struct {
+	XMLName       xml.Name               `json:"-" xml:"advanced_user_search,omitempty"`
+	ID            int                    `json:"id,omitempty" xml:"id,omitempty"`
+	Name          string                 `json:"name" xml:"name,omitempty"`
+	ViewAs        string                 `json:"view_as,omitempty" xml:"view_as,omitempty"`
+	Sort1         string                 `json:"sort_1,omitempty" xml:"sort_1,omitempty"`
+	Sort2         string                 `json:"sort_2,omitempty" xml:"sort_2,omitempty"`
+	Sort3         string                 `json:"sort_3,omitempty" xml:"sort_3,omitempty"`
+	Criteria      []SearchCriterion      `json:"criteria,omitempty" xml:"criteria>criterion,omitempty"`
+	DisplayFields []DisplayField         `json:"display_fields,omitempty" xml:"display_fields>display_field,omitempty"`
+	Users         []AdvancedSearchResult `json:"users,omitempty" xml:"users>user,omitempty"`
+	Site          *Site                  `json:"site,omitempty" xml:"site,omitempty"`
+}
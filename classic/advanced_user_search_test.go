@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var ADVANCED_USER_SEARCH_API_BASE_ENDPOINT = "/JSSResource/advancedusersearches"
+
+func advancedUserSearchResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case ADVANCED_USER_SEARCH_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"advanced_user_searches": [
+					{
+							"id": 2,
+							"name": "Users With Multiple Devices"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", ADVANCED_USER_SEARCH_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", ADVANCED_USER_SEARCH_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchContents := &jamf.AdvancedUserSearch{}
+				err = xml.Unmarshal(data, searchContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				searchData, err := json.MarshalIndent(searchContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(searchData))
+			case "DELETE":
+				fmt.Fprint(w, `{"id": 2, "name": "Users With Multiple Devices"}`)
+			default:
+				fmt.Fprint(w, `{
+					"advanced_user_search": {
+						"id": 2,
+						"name": "Users With Multiple Devices",
+						"criteria": [
+							{
+								"name": "Number of Mobile Devices",
+								"priority": 0,
+								"and_or": "and",
+								"search_type": "more than",
+								"value": "1",
+								"opening_paren": false,
+								"closing_paren": false
+							}
+						],
+						"display_fields": [
+							{ "name": "Full Name" }
+						],
+						"users": [
+							{
+								"id": 17,
+								"name": "jappleseed",
+								"Full_Name": "Johnny Appleseed"
+							}
+						]
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllAdvancedUserSearches(t *testing.T) {
+	testServer := advancedUserSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	searches, err := j.AdvancedUserSearches()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(searches))
+	assert.Equal(t, "Users With Multiple Devices", searches[0].Name)
+}
+
+func TestAdvancedUserSearchResults(t *testing.T) {
+	testServer := advancedUserSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	search, err := j.AdvancedUserSearchDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "Number of Mobile Devices", search.Details.Criteria[0].Name)
+	assert.Equal(t, "Full Name", search.Details.DisplayFields[0].Name)
+
+	results, err := j.AdvancedUserSearchResults(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 17, results[0].ID)
+	assert.Equal(t, "Johnny Appleseed", results[0].Fields["Full_Name"])
+}
+
+func TestCreateUpdateDeleteAdvancedUserSearch(t *testing.T) {
+	testServer := advancedUserSearchResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateAdvancedUserSearch(&jamf.AdvancedUserSearch{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new advanced user search")
+
+	search, err := j.CreateAdvancedUserSearch(&jamf.AdvancedUserSearch{
+		Name:     "No Email Address",
+		Criteria: []jamf.SearchCriterion{{Name: "Email Address", AndOr: "and", SearchType: "is", Value: ""}},
+		Users:    []jamf.AdvancedSearchResult{{ID: 1}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "No Email Address", search.Name)
+	assert.Empty(t, search.Users)
+
+	search, err = j.UpdateAdvancedUserSearch(2, &jamf.AdvancedUserSearch{ViewAs: "Standard Web Page"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Standard Web Page", search.ViewAs)
+
+	removed, err := j.DeleteAdvancedUserSearch(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.ID)
+}
@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// AllowedFileExtensions returns all allowed file extensions
+func (j *Client) AllowedFileExtensions() ([]BasicAllowedFileExtension, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, allowedFileExtensionsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF allowed file extensions query request")
+	}
+
+	res := &AllowedFileExtensions{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query allowed file extensions from %s", ep)
+	}
+	return res.List, nil
+}
+
+// AllowedFileExtensionDetails returns the details for a specific allowed file extension given its ID or
+// the extension itself (e.g. "pkg")
+func (j *Client) AllowedFileExtensionDetails(identifier interface{}) (*AllowedFileExtensionDetails, error) {
+	ep, err := allowedFileExtensionEndpoint(j.Endpoint, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for allowed file extension: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for allowed file extension: %v", identifier)
+	}
+
+	res := AllowedFileExtensionDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query allowed file extension with ID/extension %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateAllowedFileExtension will create a new allowed file extension in Jamf
+func (j *Client) CreateAllowedFileExtension(content *AllowedFileExtension) (*AllowedFileExtension, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, allowedFileExtensionsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new allowed file extension")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for allowed file extension: (%s)", ep)
+	}
+
+	if content.Extension == "" {
+		return nil, errors.Wrapf(fmt.Errorf("extension required for new allowed file extension"), "unable to process JAMF creation request for allowed file extension: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for allowed file extension: %v", content.Extension)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for allowed file extension: %v (%s)", content.Extension, ep)
+	}
+
+	res := AllowedFileExtension{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for allowed file extension %v on %s", content.Extension, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteAllowedFileExtension will delete an allowed file extension by ID
+func (j *Client) DeleteAllowedFileExtension(identifier int) (*AllowedFileExtension, error) {
+	ep, err := EndpointBuilder(j.Endpoint, allowedFileExtensionsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for allowed file extension: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for allowed file extension %v", identifier)
+	}
+
+	res := AllowedFileExtension{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for allowed file extension %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// allowedFileExtensionEndpoint builds the endpoint for a single allowed file extension, Jamf looks these
+// up by extension rather than by name
+func allowedFileExtensionEndpoint(endpoint string, identifier interface{}) (string, error) {
+	if ext, ok := identifier.(string); ok {
+		return fmt.Sprintf("%s/%s/extension/%s", endpoint, allowedFileExtensionsContext, strings.TrimPrefix(ext, ".")), nil
+	}
+	return EndpointBuilder(endpoint, allowedFileExtensionsContext, identifier)
+}
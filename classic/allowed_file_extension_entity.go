@@ -0,0 +1,29 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// AllowedFileExtensions represents the list of file extensions Jamf allows to be uploaded
+type AllowedFileExtensions struct {
+	List  []BasicAllowedFileExtension `json:"allowed_file_extensions" xml:"allowed_file_extensions>allowed_file_extension,omitempty"`
+	Count int                         `json:"-" xml:"size"`
+}
+
+// BasicAllowedFileExtension holds the basic information for an allowed file extension in Jamf
+type BasicAllowedFileExtension struct {
+	ID        int    `json:"id,omitempty" xml:"id,omitempty"`
+	Extension string `json:"extension" xml:"extension,omitempty"`
+}
+
+// AllowedFileExtensionDetails holds the details for a single allowed file extension
+type AllowedFileExtensionDetails struct {
+	Details *AllowedFileExtension `json:"allowed_file_extension"`
+}
+
+// AllowedFileExtension represents a file extension which may be uploaded to Jamf
+type AllowedFileExtension struct {
+	XMLName   xml.Name `json:"-" xml:"allowed_file_extension,omitempty"`
+	ID        int      `json:"id,omitempty" xml:"id,omitempty"`
+	Extension string   `json:"extension" xml:"extension,omitempty"`
+}
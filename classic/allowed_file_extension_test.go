@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var ALLOWED_FILE_EXTENSION_API_BASE_ENDPOINT = "/JSSResource/allowedfileextensions"
+
+func allowedFileExtensionResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case ALLOWED_FILE_EXTENSION_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"allowed_file_extensions": [
+					{
+							"id": 1,
+							"extension": "pkg"
+					},
+					{
+							"id": 2,
+							"extension": "dmg"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", ALLOWED_FILE_EXTENSION_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", ALLOWED_FILE_EXTENSION_API_BASE_ENDPOINT), fmt.Sprintf("%s/extension/dmg", ALLOWED_FILE_EXTENSION_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				extensionContents := &jamf.AllowedFileExtension{}
+				err = xml.Unmarshal(data, extensionContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				extensionData, err := json.MarshalIndent(extensionContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(extensionData))
+			default:
+				mockAllowedFileExtension := &jamf.AllowedFileExtensionDetails{
+					Details: &jamf.AllowedFileExtension{
+						ID:        2,
+						Extension: "dmg",
+					},
+				}
+
+				var (
+					extensionData []byte
+					err           error
+				)
+
+				if r.Method == "DELETE" {
+					extensionData, err = json.MarshalIndent(mockAllowedFileExtension.Details, "", "    ")
+				} else {
+					extensionData, err = json.MarshalIndent(mockAllowedFileExtension, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(extensionData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllAllowedFileExtensions(t *testing.T) {
+	testServer := allowedFileExtensionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	extensions, err := j.AllowedFileExtensions()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(extensions))
+	assert.Equal(t, 2, extensions[1].ID)
+	assert.Equal(t, "dmg", extensions[1].Extension)
+}
+
+func TestQuerySpecificAllowedFileExtension(t *testing.T) {
+	testServer := allowedFileExtensionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	extension, err := j.AllowedFileExtensionDetails(".dmg")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, extension.Details.ID)
+	assert.Equal(t, "dmg", extension.Details.Extension)
+}
+
+func TestCreateAllowedFileExtension(t *testing.T) {
+	testServer := allowedFileExtensionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateAllowedFileExtension(&jamf.AllowedFileExtension{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "extension required")
+
+	extension, err := j.CreateAllowedFileExtension(&jamf.AllowedFileExtension{Extension: "mobileconfig"})
+	assert.Nil(t, err)
+	assert.Equal(t, "mobileconfig", extension.Extension)
+}
+
+func TestDeleteAllowedFileExtension(t *testing.T) {
+	testServer := allowedFileExtensionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteAllowedFileExtension(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.ID)
+}
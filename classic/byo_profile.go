@@ -0,0 +1,138 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// BYOProfiles returns all BYO profiles
+func (j *Client) BYOProfiles() ([]BasicBYOProfile, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, byoProfilesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF BYO profiles query request")
+	}
+
+	res := &BYOProfiles{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query BYO profiles from %s", ep)
+	}
+	return res.List, nil
+}
+
+// BYOProfileDetails returns the details for a specific BYO profile given its ID or Name
+func (j *Client) BYOProfileDetails(identifier interface{}) (*BYOProfileDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, byoProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for BYO profile: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for BYO profile: %v", identifier)
+	}
+
+	res := BYOProfileDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query BYO profile with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateBYOProfile will create a new BYO profile in Jamf
+func (j *Client) CreateBYOProfile(content *BYOProfile) (*BYOProfile, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, byoProfilesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new BYO profile")
+	}
+
+	if content == nil || content.General == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for BYO profile: (%s)", ep)
+	}
+
+	if content.General.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new BYO profile"), "unable to process JAMF creation request for BYO profile: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for BYO profile: %v", content.General.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for BYO profile: %v (%s)", content.General.Name, ep)
+	}
+
+	res := BYOProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for BYO profile %v on %s", content.General.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateBYOProfile will update a BYO profile in Jamf by either ID or Name
+func (j *Client) UpdateBYOProfile(identifier interface{}, content *BYOProfile) (*BYOProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, byoProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for BYO profile: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for BYO profile: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for BYO profile: %v (%s)", identifier, ep)
+	}
+
+	res := BYOProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for BYO profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateBYOProfileByName will update a BYO profile in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateBYOProfileByName(name string, content *BYOProfile) (*BYOProfile, error) {
+	return j.UpdateBYOProfile(name, content)
+}
+
+// DeleteBYOProfile will delete a BYO profile by either ID or Name
+func (j *Client) DeleteBYOProfile(identifier interface{}) (*BYOProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, byoProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for BYO profile: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for BYO profile %v", identifier)
+	}
+
+	res := BYOProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for BYO profile %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteBYOProfileByName will delete a BYO profile by name, which is escaped in the request path
+func (j *Client) DeleteBYOProfileByName(name string) (*BYOProfile, error) {
+	return j.DeleteBYOProfile(name)
+}
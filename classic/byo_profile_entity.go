@@ -0,0 +1,37 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// BYOProfiles represents a list of personal device (BYO) enrollment profiles in Jamf
+type BYOProfiles struct {
+	List  []BasicBYOProfile `json:"byoprofiles" xml:"byoprofiles>byoprofile,omitempty"`
+	Count int               `json:"-" xml:"size"`
+}
+
+// BasicBYOProfile holds the basic information for a BYO profile in Jamf
+type BasicBYOProfile struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// BYOProfileDetails holds the details for a single BYO profile
+type BYOProfileDetails struct {
+	Details *BYOProfile `json:"byoprofile"`
+}
+
+// BYOProfile represents a personal device enrollment profile in Jamf
+type BYOProfile struct {
+	XMLName xml.Name           `json:"-" xml:"byoprofile,omitempty"`
+	General *BYOProfileGeneral `json:"general" xml:"general,omitempty"`
+}
+
+// BYOProfileGeneral holds the general settings of a BYO profile
+type BYOProfileGeneral struct {
+	ID          int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name        string `json:"name" xml:"name,omitempty"`
+	Site        *Site  `json:"site,omitempty" xml:"site,omitempty"`
+	Enabled     bool   `json:"enabled" xml:"enabled"`
+	Description string `json:"description,omitempty" xml:"description,omitempty"`
+}
@@ -0,0 +1,164 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var BYO_PROFILES_API_BASE_ENDPOINT = "/JSSResource/byoprofiles"
+
+func byoProfileResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case BYO_PROFILES_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"byoprofiles": [
+					{
+							"id": 1,
+							"name": "Staff Personal Devices"
+					},
+					{
+							"id": 2,
+							"name": "Student BYOD"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", BYO_PROFILES_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", BYO_PROFILES_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				profileContents := &jamf.BYOProfile{}
+				err = xml.Unmarshal(data, profileContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				profileData, err := json.MarshalIndent(profileContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(profileData))
+			default:
+				mockBYOProfile := &jamf.BYOProfileDetails{
+					Details: &jamf.BYOProfile{
+						General: &jamf.BYOProfileGeneral{
+							ID:          1,
+							Name:        "Staff Personal Devices",
+							Enabled:     true,
+							Description: "Personal iOS enrollment for staff",
+						},
+					},
+				}
+
+				var (
+					profileData []byte
+					err         error
+				)
+
+				if r.Method == "DELETE" {
+					profileData, err = json.MarshalIndent(mockBYOProfile.Details, "", "    ")
+				} else {
+					profileData, err = json.MarshalIndent(mockBYOProfile, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(profileData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllBYOProfiles(t *testing.T) {
+	testServer := byoProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	profiles, err := j.BYOProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(profiles))
+	assert.Equal(t, 2, profiles[1].ID)
+	assert.Equal(t, "Student BYOD", profiles[1].Name)
+}
+
+func TestQuerySpecificBYOProfile(t *testing.T) {
+	testServer := byoProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	profile, err := j.BYOProfileDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff Personal Devices", profile.Details.General.Name)
+	assert.True(t, profile.Details.General.Enabled)
+	assert.Equal(t, "Personal iOS enrollment for staff", profile.Details.General.Description)
+}
+
+func TestCreateBYOProfile(t *testing.T) {
+	testServer := byoProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateBYOProfile(&jamf.BYOProfile{General: &jamf.BYOProfileGeneral{}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new BYO profile")
+
+	profile, err := j.CreateBYOProfile(&jamf.BYOProfile{
+		General: &jamf.BYOProfileGeneral{
+			Name:    "Student BYOD",
+			Enabled: true,
+			Site:    &jamf.Site{ID: 2, Name: "High School"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Student BYOD", profile.General.Name)
+	assert.True(t, profile.General.Enabled)
+	assert.Equal(t, "High School", profile.General.Site.Name)
+}
+
+func TestUpdateBYOProfile(t *testing.T) {
+	testServer := byoProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	profile, err := j.UpdateBYOProfile(1, &jamf.BYOProfile{
+		General: &jamf.BYOProfileGeneral{
+			Enabled: false,
+		},
+	})
+	assert.Nil(t, err)
+	assert.False(t, profile.General.Enabled)
+}
+
+func TestDeleteBYOProfile(t *testing.T) {
+	testServer := byoProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteBYOProfile(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.General.ID)
+}
@@ -0,0 +1,50 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"strings"
+)
+
+// cdataText is encoded as a CDATA section so that scripts are sent as they are written, a ]]> in the
+// text is split across two sections by encoding/xml
+type cdataText struct {
+	Text string `xml:",cdata"`
+}
+
+func newCDATA(text string) *cdataText {
+	if text == "" {
+		return nil
+	}
+	return &cdataText{Text: text}
+}
+
+// MarshalXML encodes the contents of the script in a CDATA section, or in script_contents_encoded as
+// base64 when they contain ]]> and no encoded contents are set
+func (s ScriptContents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type contents ScriptContents
+	payload := struct {
+		contents
+		Contents        *cdataText `xml:"script_contents,omitempty"`
+		EncodedContents string     `xml:"script_contents_encoded,omitempty"`
+	}{contents: contents(s), EncodedContents: s.EncodedContents}
+	if strings.Contains(s.Contents, "]]>") && s.EncodedContents == "" {
+		payload.EncodedContents = base64.StdEncoding.EncodeToString([]byte(s.Contents))
+	} else {
+		payload.Contents = newCDATA(s.Contents)
+	}
+	start.Name = xml.Name{Local: "script"}
+	return e.EncodeElement(payload, start)
+}
+
+// MarshalXML encodes the script of the input type in a CDATA section
+func (it ComputerExtensionAttrInputType) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type inputType ComputerExtensionAttrInputType
+	payload := struct {
+		inputType
+		Script *cdataText `xml:"script,omitempty"`
+	}{inputType: inputType(it), Script: newCDATA(it.Script)}
+	return e.EncodeElement(payload, start)
+}
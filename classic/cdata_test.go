@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScriptContentsCDATA(t *testing.T) {
+	script := &jamf.ScriptContents{Name: "cleanup", Contents: "#!/bin/sh\n[ -f a ] && echo \"<done>\" > /tmp/out\n"}
+	payload, err := xml.Marshal(script)
+	assert.Nil(t, err)
+	assert.Equal(t, "<script><name>cleanup</name><script_contents><![CDATA[#!/bin/sh\n[ -f a ] && echo \"<done>\" > /tmp/out\n]]></script_contents></script>", string(payload))
+
+	decoded := &jamf.ScriptContents{}
+	assert.Nil(t, xml.Unmarshal(payload, decoded))
+	assert.Equal(t, script.Contents, decoded.Contents)
+
+	script.Contents = "cat <<EOF\n<![CDATA[x]]>\nEOF\n"
+	payload, err = xml.Marshal(script)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "<script_contents>")
+	assert.Contains(t, string(payload), "<script_contents_encoded>"+base64.StdEncoding.EncodeToString([]byte(script.Contents))+"</script_contents_encoded>")
+}
+
+func TestExtensionAttributeScriptCDATA(t *testing.T) {
+	ea := &jamf.ComputerExtensionAttribute{Name: "Owner", InputType: &jamf.ComputerExtensionAttrInputType{
+		Type: "script", Platform: "Mac", Script: "#!/bin/sh\necho \"<result>$(id -un)</result>\"",
+	}}
+	payload, err := xml.Marshal(ea)
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<input_type><type>script</type><platform>Mac</platform><script><![CDATA[#!/bin/sh\necho \"<result>$(id -un)</result>\"]]></script></input_type>")
+
+	decoded := &jamf.ComputerExtensionAttribute{}
+	assert.Nil(t, xml.Unmarshal(payload, decoded))
+	assert.Equal(t, ea.InputType.Script, decoded.InputType.Script)
+}
@@ -106,6 +106,11 @@ func (j *Client) UpdateClass(identifier interface{}, content *Class) (*Class, er
 	return &res, nil
 }
 
+// UpdateClassByName will update a mobile device class in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateClassByName(name string, content *Class) (*Class, error) {
+	return j.UpdateClass(name, content)
+}
+
 // DeleteClass will delete a mobile device class by either ID or Name
 func (j *Client) DeleteClass(identifier interface{}) (*Class, error) {
 	ep, err := EndpointBuilder(j.Endpoint, classesContext, identifier)
@@ -125,3 +130,8 @@ func (j *Client) DeleteClass(identifier interface{}) (*Class, error) {
 
 	return &res, nil
 }
+
+// DeleteClassByName will delete a mobile device class by name, which is escaped in the request path
+func (j *Client) DeleteClassByName(name string) (*Class, error) {
+	return j.DeleteClass(name)
+}
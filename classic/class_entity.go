@@ -15,18 +15,44 @@ type ClassDetails struct {
 	Details *Class `json:"class"`
 }
 
+// Class sources reported by Jamf, rosters for Apple School Manager classes are owned by the SIS sync
+const (
+	ClassSourceNone               = "N/A"
+	ClassSourceAppleSchoolManager = "Apple School Manager"
+)
+
 // Class represents an individual mobile device class in Jamf with all its associated information
 type Class struct {
-	XMLName       xml.Name                `json:"-" xml:"class,omitempty"`
-	ID            int                     `json:"id,omitempty" xml:"id,omitempty"`
-	Source        string                  `json:"source,omitempty" xml:"source,omitempty"`
-	Name          string                  `json:"name" xml:"name,omitempty"`
-	Description   string                  `json:"description,omitempty" xml:"description,omitempty"`
-	Site          Site                    `json:"site,omitempty" xml:"site,omitempty"`
-	Students      []string                `json:"students,omitempty" xml:"students>student,omitempty"`
-	Teachers      []string                `json:"teachers,omitempty" xml:"teachers>teacher,omitempty"`
-	MobileDevices []BasicMobileDeviceInfo `json:"mobile_devices,omitempty" xml:"mobile_devices,omitempty"`
-	MeetingTimes  []MeetingTime           `json:"meeting_times,omitempty" xml:"meeting_times,omitempty"`
+	XMLName              xml.Name                `json:"-" xml:"class,omitempty"`
+	ID                   int                     `json:"id,omitempty" xml:"id,omitempty"`
+	Source               string                  `json:"source,omitempty" xml:"source,omitempty"`
+	Name                 string                  `json:"name" xml:"name,omitempty"`
+	Description          string                  `json:"description,omitempty" xml:"description,omitempty"`
+	Site                 Site                    `json:"site,omitempty" xml:"site,omitempty"`
+	MobileDeviceGroup    *ClassMobileDeviceGroup `json:"mobile_device_group,omitempty" xml:"mobile_device_group,omitempty"`
+	Students             []string                `json:"students,omitempty" xml:"students>student,omitempty"`
+	Teachers             []string                `json:"teachers,omitempty" xml:"teachers>teacher,omitempty"`
+	TeacherIDs           []int                   `json:"teacher_ids,omitempty" xml:"teacher_ids>id,omitempty"`
+	StudentGroupIDs      []int                   `json:"student_group_ids,omitempty" xml:"student_group_ids>id,omitempty"`
+	TeacherGroupIDs      []int                   `json:"teacher_group_ids,omitempty" xml:"teacher_group_ids>id,omitempty"`
+	MobileDevices        []BasicMobileDeviceInfo `json:"mobile_devices,omitempty" xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroupIDs []int                   `json:"mobile_device_group_ids,omitempty" xml:"mobile_device_group_ids>id,omitempty"`
+	MeetingTimes         []MeetingTime           `json:"meeting_times,omitempty" xml:"meeting_times>meeting_time,omitempty"`
+	AppleTVs             []ClassAppleTV          `json:"apple_tvs,omitempty" xml:"apple_tvs>apple_tv,omitempty"`
+}
+
+// ClassMobileDeviceGroup holds the mobile device group a class is mapped to
+type ClassMobileDeviceGroup struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name,omitempty" xml:"name,omitempty"`
+}
+
+// ClassAppleTV holds the details of an Apple TV assigned to a class
+type ClassAppleTV struct {
+	Name           string `json:"name,omitempty" xml:"name,omitempty"`
+	UDID           string `json:"udid,omitempty" xml:"udid,omitempty"`
+	WifiMACAddress string `json:"wifi_mac_address,omitempty" xml:"wifi_mac_address,omitempty"`
+	DeviceID       string `json:"device_id,omitempty" xml:"device_id,omitempty"`
 }
 
 // MeetingTime holds values for a mobile device class meeting time
@@ -35,3 +61,61 @@ type MeetingTime struct {
 	StartTime string `json:"start_time,omitempty" xml:"start_time,omitempty"`
 	EndTime   string `json:"end_time,omitempty" xml:"end_time,omitempty"`
 }
+
+// IsAppleSchoolManager reports whether the class is synced from Apple School Manager
+func (c *Class) IsAppleSchoolManager() bool {
+	return c.Source == ClassSourceAppleSchoolManager
+}
+
+// AddStudents adds the given usernames to the class roster skipping any that are already assigned
+func (c *Class) AddStudents(usernames ...string) {
+	c.Students = appendUnique(c.Students, usernames...)
+}
+
+// RemoveStudents removes the given usernames from the class roster
+func (c *Class) RemoveStudents(usernames ...string) {
+	c.Students = removeAll(c.Students, usernames...)
+}
+
+// AddTeachers adds the given usernames to the class teachers skipping any that are already assigned
+func (c *Class) AddTeachers(usernames ...string) {
+	c.Teachers = appendUnique(c.Teachers, usernames...)
+}
+
+// RemoveTeachers removes the given usernames from the class teachers
+func (c *Class) RemoveTeachers(usernames ...string) {
+	c.Teachers = removeAll(c.Teachers, usernames...)
+}
+
+func appendUnique(list []string, values ...string) []string {
+	for _, v := range values {
+		found := false
+		for _, existing := range list {
+			if existing == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func removeAll(list []string, values ...string) []string {
+	filtered := list[:0]
+	for _, existing := range list {
+		keep := true
+		for _, v := range values {
+			if existing == v {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, existing)
+		}
+	}
+	return filtered
+}
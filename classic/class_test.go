@@ -214,3 +214,52 @@ func TestDeleteClass(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 6243, removed.ID)
 }
+
+func TestClassXMLPayload(t *testing.T) {
+	class := &jamf.Class{
+		Name:   "4th - Art",
+		Source: jamf.ClassSourceAppleSchoolManager,
+		MobileDeviceGroup: &jamf.ClassMobileDeviceGroup{
+			ID: 12,
+		},
+		TeacherIDs:      []int{4, 5},
+		StudentGroupIDs: []int{8},
+		MeetingTimes: []jamf.MeetingTime{
+			{
+				Days:      "T TH",
+				StartTime: "1000",
+				EndTime:   "1100",
+			},
+		},
+	}
+
+	data, err := xml.Marshal(class)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<meeting_times><meeting_time><days>T TH</days><start_time>1000</start_time><end_time>1100</end_time></meeting_time></meeting_times>")
+	assert.Contains(t, string(data), "<teacher_ids><id>4</id><id>5</id></teacher_ids>")
+	assert.Contains(t, string(data), "<student_group_ids><id>8</id></student_group_ids>")
+	assert.Contains(t, string(data), "<mobile_device_group><id>12</id></mobile_device_group>")
+
+	decoded := &jamf.Class{}
+	assert.Nil(t, xml.Unmarshal(data, decoded))
+	assert.True(t, decoded.IsAppleSchoolManager())
+	assert.Equal(t, []int{4, 5}, decoded.TeacherIDs)
+	assert.Equal(t, "T TH", decoded.MeetingTimes[0].Days)
+}
+
+func TestClassRosterHelpers(t *testing.T) {
+	class := &jamf.Class{
+		Students: []string{"jappleseed@example.com"},
+	}
+
+	class.AddStudents("jappleseed@example.com", "sappleseed@example.com")
+	assert.Equal(t, []string{"jappleseed@example.com", "sappleseed@example.com"}, class.Students)
+
+	class.RemoveStudents("jappleseed@example.com")
+	assert.Equal(t, []string{"sappleseed@example.com"}, class.Students)
+
+	class.AddTeachers("jdoe@example.com")
+	class.RemoveTeachers("nobody@example.com")
+	assert.Equal(t, []string{"jdoe@example.com"}, class.Teachers)
+	assert.False(t, class.IsAppleSchoolManager())
+}
@@ -13,16 +13,44 @@ import (
 	"strings"
 	"time"
 
+	"github.com/DataDog/jamf-api-client-go/internal/auth"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
 const (
-	classesContext         = "classes"
-	computersContext       = "computers"
-	computerExtAttrContext = "computerextensionattributes"
-	policiesContext        = "policies"
-	scriptsContext         = "scripts"
+	activationCodeContext               = "activationcode"
+	advancedComputerSearchesContext     = "advancedcomputersearches"
+	advancedMobileDeviceSearchesContext = "advancedmobiledevicesearches"
+	advancedUserSearchesContext         = "advancedusersearches"
+	allowedFileExtensionsContext        = "allowedfileextensions"
+	byoProfilesContext                  = "byoprofiles"
+	classesContext                      = "classes"
+	computerCommandsContext             = "computercommands"
+	computerExtAttrContext              = "computerextensionattributes"
+	computersContext                    = "computers"
+	distributionPointsContext           = "distributionpoints"
+	fileUploadsContext                  = "fileuploads"
+	gsxConnectionContext                = "gsxconnection"
+	healthcareListenerRulesContext      = "healthcarelistenerrule"
+	healthcareListenersContext          = "healthcarelistener"
+	infrastructureManagersContext       = "infrastructuremanager"
+	jsonWebTokenConfigurationsContext   = "jsonwebtokenconfigurations"
+	licensedSoftwareContext             = "licensedsoftware"
+	managedPreferenceProfilesContext    = "managedpreferenceprofiles"
+	mobileDeviceCommandsContext         = "mobiledevicecommands"
+	mobileDevicesContext                = "mobiledevices"
+	netbootServersContext               = "netbootservers"
+	peripheralsContext                  = "peripherals"
+	peripheralTypesContext              = "peripheraltypes"
+	policiesContext                     = "policies"
+	scriptsContext                      = "scripts"
+	smtpServerContext                   = "smtpserver"
+	softwareUpdateServersContext        = "softwareupdateservers"
+	usersContext                        = "users"
+	vppAccountsContext                  = "vppaccounts"
+	vppAssignmentsContext               = "vppassignments"
+	vppInvitationsContext               = "vppinvitations"
 )
 
 // Client represents the interface used to communicate with
@@ -33,15 +61,15 @@ type Client struct {
 	Password string
 	Endpoint string
 	Token    *JamfToken
+	// Resolver, when set, fills the IDs of the objects policies reference by name before they are
+	// created or updated
+	Resolver *Resolver
 	logger   *logrus.Logger
 	api      *http.Client
 }
 
 // JamfToken represents the bearer token required for client authentication
-type JamfToken struct {
-	Token   string `json:"token"`
-	Expires string `json:"expires"`
-}
+type JamfToken = auth.Token
 
 // Used if custom client not passed on when NewClient instantiated
 func defaultHTTPClient() *http.Client {
@@ -70,60 +98,23 @@ func NewClient(domain string, username string, password string, client *http.Cli
 	}, nil
 }
 
-func (j *Client) requestToken() error {
-	// Create endpoint for token request
-	endpoint := fmt.Sprintf("%s/api/v1/auth/token", j.Domain)
-
-	req, err := http.NewRequestWithContext(context.Background(), "POST", endpoint, http.NoBody)
-	if err != nil {
-		return errors.Wrapf(err, "error creating bearer token request")
-	}
-	req.SetBasicAuth(j.Username, j.Password)
-
-	res, err := j.api.Do(req)
-	if err != nil {
-		return errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
-	}
-	defer res.Body.Close()
-
-	// If status code is not ok attempt to read the response in plain text
-	if res.StatusCode != 200 && res.StatusCode != 201 {
-		responseData, err := io.ReadAll(res.Body)
-		if err != nil {
-			return errors.Wrapf(err, "request error: %s. unable to retrieve plain text response: %s", res.Status, err.Error())
-		}
-		return fmt.Errorf("request error: %s", string(responseData))
-	}
-
-	if err = json.NewDecoder(res.Body).Decode(j.Token); err != nil {
-		return errors.Wrapf(err, "response was successful but error occured decoding JSON token response")
-	}
-
-	return nil
+// RequestError is returned when the Classic API responds with an unexpected status code, Body holds the
+// plain text response
+type RequestError struct {
+	StatusCode int
+	Body       string
 }
 
-func (j *Client) checkTokenExpiration() error {
-	// Check for the existance of a bearer token and, if we already have a token,
-	// check the expiration timestamp
-	if j.Token.Expires != "" {
-		tokenExpires, err := time.Parse(time.RFC3339, j.Token.Expires)
-		if err != nil {
-			return errors.Wrapf(err, "error parsing the bearer token expiration date: %s", j.Token.Expires)
-		}
-		if time.Until(tokenExpires) > (time.Minute * 5) {
-			return nil
-		}
-	}
-	err := j.requestToken()
-	if err != nil {
-		return errors.Wrapf(err, "error requesting new bearer token")
-	}
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("request error: %s", e.Body)
+}
 
-	return nil
+func (j *Client) checkTokenExpiration(ctx context.Context) error {
+	return auth.Refresh(ctx, j.api, j.Domain, j.Username, j.Password, j.Token)
 }
 
 func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
-	err := j.checkTokenExpiration()
+	err := j.checkTokenExpiration(r.Context())
 	if err != nil {
 		return errors.Wrapf(err, "error checking for bearer token expiration")
 	}
@@ -134,7 +125,7 @@ func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
 	r.Header.Set("Accept", "application/json, application/xml;q=0.9")
 	r.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
 	r.Header.Set("Strict-Transport-Security", "max-age=31536000 ; includeSubDomains")
-	r.Header.Set("Authorization", fmt.Sprintf("Bearer %s", j.Token.Token))
+	r.Header.Set("Authorization", auth.Bearer(j.Token))
 
 	res, err := j.api.Do(r)
 	if err != nil {
@@ -146,9 +137,14 @@ func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
 	if res.StatusCode != 200 && res.StatusCode != 201 {
 		responseData, err := io.ReadAll(res.Body)
 		if err != nil {
-			return errors.Wrapf(err, "request error: %s. unable to retrieve plain text response: %s", res.Status, err.Error())
+			return errors.Wrapf(&RequestError{StatusCode: res.StatusCode, Body: res.Status}, "unable to retrieve plain text response: %s", err.Error())
 		}
-		return fmt.Errorf("request error: %s", string(responseData))
+		return &RequestError{StatusCode: res.StatusCode, Body: string(responseData)}
+	}
+
+	// Some endpoints (e.g. file uploads) return nothing worth decoding
+	if v == nil {
+		return nil
 	}
 
 	// https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/X-Content-Type-Options
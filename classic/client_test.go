@@ -4,6 +4,7 @@ package classic_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -75,3 +76,19 @@ func TestBadNewClient(t *testing.T) {
 	assert.Equal(t, "you must provide a valid Jamf domain, username, and password", err.Error())
 	assert.Nil(t, j)
 }
+
+func TestRequestError(t *testing.T) {
+	testServer := clientResponseMock(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	req, err := http.NewRequestWithContext(context.Background(), "GET", fmt.Sprintf("%s/mock/missing", j.Endpoint), nil)
+	assert.Nil(t, err)
+	_, err = j.MockAPIRequest(req, &MockResponse{})
+	requestErr := &jamf.RequestError{}
+	assert.True(t, errors.As(err, &requestErr))
+	assert.Equal(t, http.StatusInternalServerError, requestErr.StatusCode)
+	assert.Contains(t, err.Error(), "request error: bad API call to /JSSResource/mock/missing")
+}
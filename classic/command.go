@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ComputerCommandStatus returns the status of a computer command given its UUID
+func (j *Client) ComputerCommandStatus(uuid string) (*CommandStatus, error) {
+	return j.computerCommandStatus(context.Background(), uuid)
+}
+
+func (j *Client) computerCommandStatus(ctx context.Context, uuid string) (*CommandStatus, error) {
+	ep := fmt.Sprintf("%s/%s/status/%s", j.Endpoint, computerCommandsContext, url.PathEscape(uuid))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF computer command status request for %s", uuid)
+	}
+
+	res := &ComputerCommandStatusDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer command status for %s from %s", uuid, ep)
+	}
+	if res.Details == nil {
+		return nil, fmt.Errorf("no status returned for computer command %s from %s", uuid, ep)
+	}
+	return res.Details, nil
+}
+
+// MobileDeviceCommandStatus returns the status of a mobile device command given its UUID
+func (j *Client) MobileDeviceCommandStatus(uuid string) (*CommandStatus, error) {
+	return j.mobileDeviceCommandStatus(context.Background(), uuid)
+}
+
+func (j *Client) mobileDeviceCommandStatus(ctx context.Context, uuid string) (*CommandStatus, error) {
+	ep := fmt.Sprintf("%s/%s/uuid/%s", j.Endpoint, mobileDeviceCommandsContext, url.PathEscape(uuid))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF mobile device command status request for %s", uuid)
+	}
+
+	res := &MobileDeviceCommandStatusDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device command status for %s from %s", uuid, ep)
+	}
+	if res.Details == nil {
+		return nil, fmt.Errorf("no status returned for mobile device command %s from %s", uuid, ep)
+	}
+	return res.Details, nil
+}
+
+// DefaultCommandPollInterval is the time between two polls of a command awaited with a non-positive pollInterval
+const DefaultCommandPollInterval = 5 * time.Second
+
+// AwaitComputerCommand polls the status of a computer command every pollInterval until it completes,
+// fails or ctx is done. An exceeded ctx deadline is reported as a CommandTimedOut outcome rather than an error.
+func (j *Client) AwaitComputerCommand(ctx context.Context, uuid string, pollInterval time.Duration) (*CommandResult, error) {
+	return awaitCommand(ctx, uuid, pollInterval, j.computerCommandStatus)
+}
+
+// AwaitMobileDeviceCommand polls the status of a mobile device command every pollInterval until it
+// completes, fails or ctx is done. An exceeded ctx deadline is reported as a CommandTimedOut outcome
+// rather than an error.
+func (j *Client) AwaitMobileDeviceCommand(ctx context.Context, uuid string, pollInterval time.Duration) (*CommandResult, error) {
+	return awaitCommand(ctx, uuid, pollInterval, j.mobileDeviceCommandStatus)
+}
+
+func awaitCommand(ctx context.Context, uuid string, pollInterval time.Duration, status func(context.Context, string) (*CommandStatus, error)) (*CommandResult, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultCommandPollInterval
+	}
+	res := &CommandResult{}
+	for {
+		if err := ctx.Err(); err != nil {
+			if err == context.DeadlineExceeded {
+				res.Outcome = CommandTimedOut
+				return res, nil
+			}
+			return nil, errors.Wrapf(err, "stopped waiting for command %s", uuid)
+		}
+
+		current, err := status(ctx, uuid)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				res.Outcome = CommandTimedOut
+				return res, nil
+			}
+			return nil, err
+		}
+		res.Status, res.Polls = current, res.Polls+1
+		switch current.Status {
+		case CommandStatusCompleted:
+			res.Outcome = CommandCompleted
+			return res, nil
+		case CommandStatusFailed:
+			res.Outcome = CommandFailed
+			return res, nil
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
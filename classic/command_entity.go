@@ -0,0 +1,47 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+// Statuses reported for computer and mobile device commands
+const (
+	CommandStatusPending   = "Pending"
+	CommandStatusCompleted = "Completed"
+	CommandStatusFailed    = "Failed"
+)
+
+// CommandOutcome is how an awaited computer or mobile device command ended
+type CommandOutcome string
+
+// Outcomes of awaited commands
+const (
+	CommandCompleted CommandOutcome = "completed"
+	CommandFailed    CommandOutcome = "failed"
+	CommandTimedOut  CommandOutcome = "timed out"
+)
+
+// CommandStatus holds the status of a computer or mobile device command given its UUID
+type CommandStatus struct {
+	UUID          string   `json:"uuid" xml:"uuid"`
+	Command       string   `json:"command" xml:"command"`
+	Status        string   `json:"status" xml:"status"`
+	DateSent      JamfTime `json:"date_sent,omitempty" xml:"date_sent,omitempty"`
+	DateCompleted JamfTime `json:"date_completed,omitempty" xml:"date_completed,omitempty"`
+}
+
+// ComputerCommandStatusDetails holds the status of a computer command
+type ComputerCommandStatusDetails struct {
+	Details *CommandStatus `json:"computer_command"`
+}
+
+// MobileDeviceCommandStatusDetails holds the status of a mobile device command
+type MobileDeviceCommandStatusDetails struct {
+	Details *CommandStatus `json:"mobile_device_command"`
+}
+
+// CommandResult is returned when awaiting a command, Status holds the last status polled which is nil
+// when the command timed out before it could be polled
+type CommandResult struct {
+	Outcome CommandOutcome
+	Status  *CommandStatus
+	Polls   int
+}
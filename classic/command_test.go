@@ -0,0 +1,103 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func commandsResponseMocks(t *testing.T) *httptest.Server {
+	polls := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/JSSResource/computercommands/status/5e3c-lock":
+			status := jamf.CommandStatusPending
+			if polls++; polls > 2 {
+				status = jamf.CommandStatusCompleted
+			}
+			fmt.Fprintf(w, `{"computer_command": {"uuid": "5e3c-lock", "command": "DeviceLock", "status": %q}}`, status)
+		case "/JSSResource/computercommands/status/9a1f-pending":
+			fmt.Fprint(w, `{"computer_command": {"uuid": "9a1f-pending", "command": "RestartNow", "status": "Pending"}}`)
+		case "/JSSResource/mobiledevicecommands/uuid/77b2-wipe":
+			fmt.Fprint(w, `{"mobile_device_command": {"uuid": "77b2-wipe", "command": "EraseDevice", "status": "Failed"}}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestAwaitComputerCommand(t *testing.T) {
+	testServer := commandsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	res, err := j.AwaitComputerCommand(context.Background(), "5e3c-lock", time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CommandCompleted, res.Outcome)
+	assert.Equal(t, 3, res.Polls)
+	assert.Equal(t, "DeviceLock", res.Status.Command)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	res, err = j.AwaitComputerCommand(ctx, "9a1f-pending", 5*time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CommandTimedOut, res.Outcome)
+	assert.Equal(t, jamf.CommandStatusPending, res.Status.Status)
+
+	_, err = j.AwaitComputerCommand(context.Background(), "unknown", time.Millisecond)
+	assert.Contains(t, err.Error(), "unable to query computer command status for unknown")
+
+	// a non-positive interval polls every DefaultCommandPollInterval instead of continuously
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	res, err = j.AwaitComputerCommand(ctx, "9a1f-pending", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CommandTimedOut, res.Outcome)
+	assert.Equal(t, 1, res.Polls)
+}
+
+func TestAwaitComputerCommandDeadline(t *testing.T) {
+	release := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer testServer.Close()
+	defer close(release)
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	// the deadline of ctx also bounds the status requests
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	res, err := j.AwaitComputerCommand(ctx, "9a1f-pending", time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CommandTimedOut, res.Outcome)
+	assert.Equal(t, 0, res.Polls)
+	assert.Less(t, int64(time.Since(start)), int64(5*time.Second))
+}
+
+func TestAwaitMobileDeviceCommand(t *testing.T) {
+	testServer := commandsResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	res, err := j.AwaitMobileDeviceCommand(context.Background(), "77b2-wipe", time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.CommandFailed, res.Outcome)
+	assert.Equal(t, 1, res.Polls)
+}
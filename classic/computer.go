@@ -8,7 +8,9 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
 )
@@ -33,11 +35,11 @@ func (identifier *ComputerIdentifier) endpoint(endpoint string, context string)
 	case identifier.SerialNumber != "":
 		entity, param = "serialnumber", identifier.SerialNumber
 	}
-	return fmt.Sprintf("%s/%s/%s/%s", endpoint, context, entity, param)
+	return fmt.Sprintf("%s/%s/%s/%s", endpoint, context, entity, url.PathEscape(param))
 }
 
 // Computers returns all enrolled computer devices
-func (j *Client) Computers() ([]BasicComputerInfo, error) {
+func (j *Client) Computers() (ComputerList, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, computersContext)
 	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
 	if err != nil {
@@ -51,6 +53,26 @@ func (j *Client) Computers() ([]BasicComputerInfo, error) {
 	return res.List, nil
 }
 
+// MatchComputers returns the computers whose name, serial number, MAC address, username or other identifying
+// information matches term, "*" matches any characters as in the search of the Jamf Pro interface
+func (j *Client) MatchComputers(term string) (ComputerList, error) {
+	return j.matchComputers(context.Background(), term)
+}
+
+func (j *Client) matchComputers(ctx context.Context, term string) (ComputerList, error) {
+	ep := fmt.Sprintf("%s/%s/match/%s", j.Endpoint, computersContext, url.PathEscape(term))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF computer match request for: %s", term)
+	}
+
+	res := &Computers{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computers matching %s from %s", term, ep)
+	}
+	return res.List, nil
+}
+
 // ComputerDetails returns the details for a specific computer given its ID
 func (j *Client) ComputerDetails(identifier interface{}) (*Computer, error) {
 	ep, err := EndpointBuilder(j.Endpoint, computersContext, identifier)
@@ -69,6 +91,12 @@ func (j *Client) ComputerDetails(identifier interface{}) (*Computer, error) {
 	return res, nil
 }
 
+// IterComputers yields the details of every enrolled computer, requesting them one at a time as they are
+// consumed instead of loading every computer in memory. Canceling ctx ends the iteration.
+func (j *Client) IterComputers(ctx context.Context) iter.Seq2[*Computer, error] {
+	return iterDetails(ctx, j.Computers, func(c BasicComputerInfo) int { return c.ID }, j.ComputerDetails)
+}
+
 // GetComputer takes in a search option and returns the details for a specific computer
 func (j *Client) GetComputer(identifier *ComputerIdentifier) (*Computer, error) {
 	ep := identifier.endpoint(j.Endpoint, computersContext)
@@ -104,3 +132,8 @@ func (j *Client) UpdateComputer(identifier *ComputerIdentifier, updates *Compute
 	}
 	return &res, nil
 }
+
+// UpdateComputerByName updates the device with the given name on the server, the name is escaped in the request path
+func (j *Client) UpdateComputerByName(name string, updates *ComputerDetails) (*ComputerDetails, error) {
+	return j.UpdateComputer(&ComputerIdentifier{Name: name}, updates)
+}
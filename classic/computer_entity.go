@@ -22,6 +22,9 @@ type BasicComputerInfo struct {
 	GeneralInformation
 }
 
+// ComputerList is the list of computers returned by Computers, see Export
+type ComputerList []BasicComputerInfo
+
 // Computer represents an individual computer enrolled in Jamf with all its associated information
 type Computer struct {
 	Info ComputerDetails `json:"computer" xml:"computer,omitempty"`
@@ -35,22 +38,22 @@ type ComputerDetails struct {
 	Hardware            HardwareInformation      `json:"hardware" xml:"-"`
 	Certificates        []CertificateInformation `json:"certificates" xml:"-"`
 	Software            SoftwareInformation      `json:"software" xml:"-"`
-	ExtensionAttributes []ExtensionAttributes    `json:"extension_attributes" xml:"extension_attributes,omitempty"`
+	ExtensionAttributes []ExtensionAttributes    `json:"extension_attributes" xml:"extension_attributes>extension_attribute,omitempty"`
 	Groups              GroupInformation         `json:"groups_accounts" xml:"-"`
-	ConfigProfiles      []ConfigProfile          `json:"configuration_profiles" xml:"configuration_profiles,omitempty"`
+	ConfigProfiles      []ConfigProfile          `json:"configuration_profiles" xml:"configuration_profiles>configuration_profile,omitempty"`
 }
 
 // GeneralInformation holds basic information associated with Jamf device
 type GeneralInformation struct {
-	ID           int    `json:"id,omitempty" xml:"id,omitempty"`
-	Name         string `json:"name" xml:"name,omitempty"`
-	MACAddress   string `json:"mac_address" xml:"mac_address,omitempty"`
-	SerialNumber string `json:"serial_number" xml:"serial_number,omitempty"`
-	UDID         string `json:"udid" xml:"udid,omitempty"`
-	JamfVersion  string `json:"jamf_version" xml:"jamf_version,omitempty"`
-	Platform     string `json:"platform" xml:"platform,omitempty"`
-	MDMCapable   bool   `json:"mdm_capable" xml:"mdm_capable,omitempty"`
-	ReportDate   string `json:"report_date" xml:"report_date,omitempty"`
+	ID           int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name         string   `json:"name" xml:"name,omitempty"`
+	MACAddress   string   `json:"mac_address" xml:"mac_address,omitempty"`
+	SerialNumber string   `json:"serial_number" xml:"serial_number,omitempty"`
+	UDID         string   `json:"udid" xml:"udid,omitempty"`
+	JamfVersion  string   `json:"jamf_version" xml:"jamf_version,omitempty"`
+	Platform     string   `json:"platform" xml:"platform,omitempty"`
+	MDMCapable   bool     `json:"mdm_capable" xml:"mdm_capable,omitempty"`
+	ReportDate   JamfTime `json:"report_date" xml:"report_date,omitempty"`
 }
 
 // LocationInformation holds the information in the User & Locations section
@@ -77,10 +80,10 @@ type HardwareInformation struct {
 
 // CertificateInformation holds information about certs intalled on the device
 type CertificateInformation struct {
-	CommonName string `json:"common_name"`
-	Identity   bool   `json:"identity"`
-	ExpiresUTC string `json:"expires_utc"`
-	Name       string `json:"name"`
+	CommonName string   `json:"common_name"`
+	Identity   bool     `json:"identity"`
+	ExpiresUTC JamfTime `json:"expires_utc"`
+	Name       string   `json:"name"`
 }
 
 // SoftwareInformation holds information about the software installed on a device
@@ -102,10 +105,10 @@ type ApplicationInformation struct {
 
 // ExtensionAttributes holds extension attribute information for a device
 type ExtensionAttributes struct {
-	ID    int    `json:"id,omitempty"`
-	Name  string `json:"name"`
-	Type  string `json:"type"`
-	Value string `json:"value"`
+	ID    int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name  string `json:"name" xml:"name,omitempty"`
+	Type  string `json:"type" xml:"type,omitempty"`
+	Value string `json:"value" xml:"value"`
 }
 
 // GroupInformation holds the groups the device is a member of
@@ -122,8 +125,8 @@ type GroupInformation struct {
 
 // ConfigProfile represents an active configuration profile in Jamf
 type ConfigProfile struct {
-	ID        int    `json:"id,omitempty"`
-	Name      string `json:"name"`
-	UUID      string `json:"uuid"`
-	Removable bool   `json:"is_removable"`
+	ID        int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name      string `json:"name" xml:"name,omitempty"`
+	UUID      string `json:"uuid" xml:"uuid,omitempty"`
+	Removable bool   `json:"is_removable" xml:"is_removable"`
 }
@@ -93,6 +93,11 @@ func (j *Client) UpdateComputerExtensionAttribue(identifier interface{}, content
 	return &res, nil
 }
 
+// UpdateComputerExtensionAttributeByName will update a computer extension attribute in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateComputerExtensionAttributeByName(name string, content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
+	return j.UpdateComputerExtensionAttribue(name, content)
+}
+
 // CreateComputerExtensionAttribute will create a computer extension attribute in Jamf
 func (j *Client) CreateComputerExtensionAttribute(content *ComputerExtensionAttribute) (*ComputerExtensionAttribute, error) {
 	// -1 denotes the next available ID
@@ -152,3 +157,8 @@ func (j *Client) DeleteComputerExtensionAttribute(identifier interface{}) (*Comp
 
 	return &res, nil
 }
+
+// DeleteComputerExtensionAttributeByName will delete a computer extension attribute by name, which is escaped in the request path
+func (j *Client) DeleteComputerExtensionAttributeByName(name string) (*ComputerExtensionAttribute, error) {
+	return j.DeleteComputerExtensionAttribute(name)
+}
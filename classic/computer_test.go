@@ -3,6 +3,7 @@
 package classic_test
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
@@ -156,7 +157,7 @@ func computerResponseMocks(t *testing.T) *httptest.Server {
 						}]
 				}
 			}`)
-		case fmt.Sprintf("%s/serialnumber/VM0L+J/0cr+l", COMPUTER_API_BASE_ENDPOINT):
+		case fmt.Sprintf("%s/serialnumber/VM0L+J%s0cr+l", COMPUTER_API_BASE_ENDPOINT, "%2F"):
 			switch r.Method {
 			case "GET":
 				fmt.Fprintf(w, `{
@@ -213,6 +214,22 @@ func TestListComputers(t *testing.T) {
 	assert.Equal(t, "Test MacBook #3", computers[0].Name)
 }
 
+func TestMatchComputers(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("%s/match/C02%s", COMPUTER_API_BASE_ENDPOINT, "%2A"), r.RequestURI)
+		fmt.Fprint(w, `{"computers": [{"id": 82, "name": "Test MacBook #82", "serial_number": "C02ABC123"}]}`)
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	computers, err := j.MatchComputers("C02*")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(computers))
+	assert.Equal(t, 82, computers[0].ID)
+	assert.Equal(t, "C02ABC123", computers[0].SerialNumber)
+}
+
 func TestQuerySpecificComputer(t *testing.T) {
 	testServer := computerResponseMocks(t)
 	defer testServer.Close()
@@ -322,3 +339,35 @@ func TestUpdateComputer__SerialNumber(t *testing.T) {
 	assert.Equal(t, "Updated_Computer", comp.General.Name)
 	assert.Equal(t, "test@email.com", comp.UserLocation.EmailAddress)
 }
+
+func TestIterComputers(t *testing.T) {
+	requests := []string{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.RequestURI)
+		switch r.RequestURI {
+		case COMPUTER_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{"computers": [{"id": 3, "name": "Test MacBook #3"}, {"id": 82, "name": "Test MacBook #82"}, {"id": 91, "name": "Test MacBook #91"}]}`)
+		case fmt.Sprintf("%s/id/82", COMPUTER_API_BASE_ENDPOINT):
+			fmt.Fprint(w, `{"computer": {"general": {"id": 82, "name": "Test MacBook #82"}}}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	j.Token = &testToken
+
+	names, errs := []string{}, 0
+	for computer, err := range j.IterComputers(context.Background()) {
+		if err != nil {
+			errs++
+			continue
+		}
+		names = append(names, computer.Info.General.Name)
+		break
+	}
+	assert.Equal(t, 1, errs)
+	assert.Equal(t, []string{"Test MacBook #82"}, names)
+	assert.Equal(t, []string{COMPUTER_API_BASE_ENDPOINT, COMPUTER_API_BASE_ENDPOINT + "/id/3", COMPUTER_API_BASE_ENDPOINT + "/id/82"}, requests)
+}
@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "fmt"
+
+// Search types accepted by advanced search criteria, which ones apply depends on the field
+const (
+	SearchTypeIs                 = "is"
+	SearchTypeIsNot              = "is not"
+	SearchTypeLike               = "like"
+	SearchTypeNotLike            = "not like"
+	SearchTypeHas                = "has"
+	SearchTypeDoesNotHave        = "does not have"
+	SearchTypeMatchesRegex       = "matches regex"
+	SearchTypeDoesNotMatchRegex  = "does not match regex"
+	SearchTypeGreaterThan        = "greater than"
+	SearchTypeLessThan           = "less than"
+	SearchTypeGreaterThanOrEqual = "greater than or equal"
+	SearchTypeLessThanOrEqual    = "less than or equal"
+	SearchTypeMemberOf           = "member of"
+	SearchTypeNotMemberOf        = "not member of"
+	SearchTypeBefore             = "before (yyyy-mm-dd)"
+	SearchTypeAfter              = "after (yyyy-mm-dd)"
+	SearchTypeMoreThanDaysAgo    = "more than x days ago"
+	SearchTypeLessThanDaysAgo    = "less than x days ago"
+)
+
+// CriteriaBuilder builds the criteria of an advanced search, priorities are numbered in the order
+// criteria are added and parentheses are checked to be balanced. Jamf only supports a single level
+// of parentheses so groups cannot be nested.
+//
+//	criteria, err := classic.NewCriteria().
+//		Where("Operating System Version", classic.SearchTypeLike, "14.").
+//		Open().
+//		And("Model", classic.SearchTypeLike, "MacBook").
+//		Or("Model", classic.SearchTypeLike, "iMac").
+//		Close().
+//		Build()
+type CriteriaBuilder struct {
+	criteria []SearchCriterion
+	open     bool
+	grouped  bool
+	err      error
+}
+
+// NewCriteria returns an empty CriteriaBuilder
+func NewCriteria() *CriteriaBuilder {
+	return &CriteriaBuilder{}
+}
+
+// Where adds the first criterion
+func (b *CriteriaBuilder) Where(name string, searchType string, value string) *CriteriaBuilder {
+	if len(b.criteria) > 0 {
+		return b.fail(fmt.Errorf("criterion %s: Where must only be used for the first criterion, use And or Or", name))
+	}
+	return b.add("and", name, searchType, value)
+}
+
+// And adds a criterion that must match along with the previous one
+func (b *CriteriaBuilder) And(name string, searchType string, value string) *CriteriaBuilder {
+	return b.add("and", name, searchType, value)
+}
+
+// Or adds a criterion that can match instead of the previous one
+func (b *CriteriaBuilder) Or(name string, searchType string, value string) *CriteriaBuilder {
+	if len(b.criteria) == 0 {
+		return b.fail(fmt.Errorf("criterion %s: Or cannot be used for the first criterion", name))
+	}
+	return b.add("or", name, searchType, value)
+}
+
+// Open starts a parenthesized group with the next criterion added
+func (b *CriteriaBuilder) Open() *CriteriaBuilder {
+	if b.open || b.grouped {
+		return b.fail(fmt.Errorf("nested parentheses are not supported"))
+	}
+	b.grouped = true
+	return b
+}
+
+// Close ends the parenthesized group with the last criterion added
+func (b *CriteriaBuilder) Close() *CriteriaBuilder {
+	switch {
+	case b.grouped:
+		return b.fail(fmt.Errorf("parentheses closed before any criterion was added to the group"))
+	case !b.open:
+		return b.fail(fmt.Errorf("parentheses closed without being opened"))
+	}
+	b.open = false
+	b.criteria[len(b.criteria)-1].ClosingParen = true
+	return b
+}
+
+// Build returns the criteria or the first error found while building them
+func (b *CriteriaBuilder) Build() ([]SearchCriterion, error) {
+	switch {
+	case b.err != nil:
+		return nil, b.err
+	case b.open || b.grouped:
+		return nil, fmt.Errorf("parentheses opened without being closed")
+	}
+	criteria := make([]SearchCriterion, len(b.criteria))
+	copy(criteria, b.criteria)
+	return criteria, nil
+}
+
+func (b *CriteriaBuilder) add(andOr string, name string, searchType string, value string) *CriteriaBuilder {
+	switch {
+	case name == "":
+		return b.fail(fmt.Errorf("criterion %d: name required", len(b.criteria)))
+	case searchType == "":
+		return b.fail(fmt.Errorf("criterion %s: search type required", name))
+	}
+	b.criteria = append(b.criteria, SearchCriterion{
+		Name:         name,
+		Priority:     len(b.criteria),
+		AndOr:        andOr,
+		SearchType:   searchType,
+		Value:        value,
+		OpeningParen: b.grouped,
+	})
+	if b.grouped {
+		b.grouped, b.open = false, true
+	}
+	return b
+}
+
+func (b *CriteriaBuilder) fail(err error) *CriteriaBuilder {
+	if b.err == nil {
+		b.err = err
+	}
+	return b
+}
@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriteriaBuilder(t *testing.T) {
+	criteria, err := jamf.NewCriteria().
+		Where("Operating System Version", jamf.SearchTypeLike, "14.").
+		Open().
+		And("Model", jamf.SearchTypeLike, "MacBook").
+		Or("Model", jamf.SearchTypeLike, "iMac").
+		Close().
+		And("Computer Group", jamf.SearchTypeNotMemberOf, "Loaners").
+		Build()
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.SearchCriterion{
+		{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "14."},
+		{Name: "Model", Priority: 1, AndOr: "and", SearchType: "like", Value: "MacBook", OpeningParen: true},
+		{Name: "Model", Priority: 2, AndOr: "or", SearchType: "like", Value: "iMac", ClosingParen: true},
+		{Name: "Computer Group", Priority: 3, AndOr: "and", SearchType: "not member of", Value: "Loaners"},
+	}, criteria)
+
+	data, err := xml.Marshal(&jamf.AdvancedComputerSearch{Name: "Sonoma Laptops", Criteria: criteria[:2]})
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<criteria>"+
+		"<criterion><name>Operating System Version</name><priority>0</priority><and_or>and</and_or><search_type>like</search_type><value>14.</value><opening_paren>false</opening_paren><closing_paren>false</closing_paren></criterion>"+
+		"<criterion><name>Model</name><priority>1</priority><and_or>and</and_or><search_type>like</search_type><value>MacBook</value><opening_paren>true</opening_paren><closing_paren>false</closing_paren></criterion>"+
+		"</criteria>")
+}
+
+func TestCriteriaBuilderErrors(t *testing.T) {
+	_, err := jamf.NewCriteria().Or("Model", jamf.SearchTypeIs, "iMac").Build()
+	assert.Contains(t, err.Error(), "Or cannot be used for the first criterion")
+
+	_, err = jamf.NewCriteria().Where("Model", jamf.SearchTypeIs, "iMac").Where("Model", jamf.SearchTypeIs, "Mac mini").Build()
+	assert.Contains(t, err.Error(), "Where must only be used for the first criterion")
+
+	_, err = jamf.NewCriteria().Where("Model", "", "iMac").Build()
+	assert.Contains(t, err.Error(), "search type required")
+
+	_, err = jamf.NewCriteria().Where("", jamf.SearchTypeIs, "iMac").Build()
+	assert.Contains(t, err.Error(), "name required")
+
+	_, err = jamf.NewCriteria().Open().Where("Model", jamf.SearchTypeIs, "iMac").Open().Build()
+	assert.Contains(t, err.Error(), "nested parentheses")
+
+	_, err = jamf.NewCriteria().Where("Model", jamf.SearchTypeIs, "iMac").Close().Build()
+	assert.Contains(t, err.Error(), "closed without being opened")
+
+	_, err = jamf.NewCriteria().Where("Model", jamf.SearchTypeIs, "iMac").Open().Close().Build()
+	assert.Contains(t, err.Error(), "closed before any criterion")
+
+	_, err = jamf.NewCriteria().Open().Where("Model", jamf.SearchTypeIs, "iMac").Build()
+	assert.Contains(t, err.Error(), "opened without being closed")
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// DistributionPoints returns all distribution points
+func (j *Client) DistributionPoints() ([]BasicDistributionPoint, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, distributionPointsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF distribution points query request")
+	}
+
+	res := &DistributionPoints{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query distribution points from %s", ep)
+	}
+	return res.List, nil
+}
+
+// DistributionPointDetails returns the details for a specific distribution point given its ID or Name
+func (j *Client) DistributionPointDetails(identifier interface{}) (*DistributionPointDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, distributionPointsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for distribution point: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for distribution point: %v", identifier)
+	}
+
+	res := DistributionPointDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query distribution point with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateDistributionPoint will create a new distribution point in Jamf
+func (j *Client) CreateDistributionPoint(content *DistributionPoint) (*DistributionPoint, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, distributionPointsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new distribution point")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for distribution point: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new distribution point"), "unable to process JAMF creation request for distribution point: (%s)", ep)
+	}
+
+	if err := ValidateDistributionPoint(content); err != nil {
+		return nil, errors.Wrapf(err, "distribution point validation failed: %v", content.Name)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for distribution point: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for distribution point: %v (%s)", content.Name, ep)
+	}
+
+	res := DistributionPoint{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for distribution point %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateDistributionPoint will update a distribution point in Jamf by either ID or Name
+func (j *Client) UpdateDistributionPoint(identifier interface{}, content *DistributionPoint) (*DistributionPoint, error) {
+	ep, err := EndpointBuilder(j.Endpoint, distributionPointsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for distribution point: %v", identifier)
+	}
+
+	if err := ValidateDistributionPoint(content); err != nil {
+		return nil, errors.Wrapf(err, "distribution point validation failed: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for distribution point: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for distribution point: %v (%s)", identifier, ep)
+	}
+
+	res := DistributionPoint{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for distribution point: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateDistributionPointByName will update a distribution point in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateDistributionPointByName(name string, content *DistributionPoint) (*DistributionPoint, error) {
+	return j.UpdateDistributionPoint(name, content)
+}
+
+// DeleteDistributionPoint will delete a distribution point by either ID or Name
+func (j *Client) DeleteDistributionPoint(identifier interface{}) (*DistributionPoint, error) {
+	ep, err := EndpointBuilder(j.Endpoint, distributionPointsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for distribution point: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for distribution point %v", identifier)
+	}
+
+	res := DistributionPoint{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for distribution point %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteDistributionPointByName will delete a distribution point by name, which is escaped in the request path
+func (j *Client) DeleteDistributionPointByName(name string) (*DistributionPoint, error) {
+	return j.DeleteDistributionPoint(name)
+}
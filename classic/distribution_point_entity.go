@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// DistributionPoints represents a list of file share distribution points in Jamf
+type DistributionPoints struct {
+	List  []BasicDistributionPoint `json:"distribution_points" xml:"distribution_points>distribution_point,omitempty"`
+	Count int                      `json:"-" xml:"size"`
+}
+
+// BasicDistributionPoint holds the basic information for a distribution point in Jamf
+type BasicDistributionPoint struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// DistributionPointDetails holds the details for a single distribution point
+type DistributionPointDetails struct {
+	Details *DistributionPoint `json:"distribution_point"`
+}
+
+// DistributionPoint represents a file share distribution point configured in Jamf
+type DistributionPoint struct {
+	XMLName                  xml.Name `json:"-" xml:"distribution_point,omitempty"`
+	ID                       int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name                     string   `json:"name" xml:"name,omitempty"`
+	IPAddress                string   `json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	IsMaster                 bool     `json:"is_master" xml:"is_master"`
+	FailoverPoint            string   `json:"failover_point,omitempty" xml:"failover_point,omitempty"`
+	FailoverPointURL         string   `json:"failover_point_url,omitempty" xml:"failover_point_url,omitempty"`
+	EnableLoadBalancing      bool     `json:"enable_load_balancing" xml:"enable_load_balancing"`
+	LocalPath                string   `json:"local_path,omitempty" xml:"local_path,omitempty"`
+	SSHUsername              string   `json:"ssh_username,omitempty" xml:"ssh_username,omitempty"`
+	SSHPassword              string   `json:"ssh_password,omitempty" xml:"ssh_password,omitempty"`
+	ConnectionType           string   `json:"connection_type,omitempty" xml:"connection_type,omitempty"`
+	ShareName                string   `json:"share_name,omitempty" xml:"share_name,omitempty"`
+	WorkgroupOrDomain        string   `json:"workgroup_or_domain,omitempty" xml:"workgroup_or_domain,omitempty"`
+	SharePort                int      `json:"share_port,omitempty" xml:"share_port,omitempty"`
+	ReadOnlyUsername         string   `json:"read_only_username,omitempty" xml:"read_only_username,omitempty"`
+	ReadOnlyPassword         string   `json:"read_only_password,omitempty" xml:"read_only_password,omitempty"`
+	ReadWriteUsername        string   `json:"read_write_username,omitempty" xml:"read_write_username,omitempty"`
+	ReadWritePassword        string   `json:"read_write_password,omitempty" xml:"read_write_password,omitempty"`
+	NoAuthenticationRequired bool     `json:"no_authentication_required" xml:"no_authentication_required"`
+	HTTPDownloadsEnabled     bool     `json:"http_downloads_enabled" xml:"http_downloads_enabled"`
+	Protocol                 string   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	Port                     int      `json:"port,omitempty" xml:"port,omitempty"`
+	Context                  string   `json:"context,omitempty" xml:"context,omitempty"`
+	UsernamePasswordRequired bool     `json:"username_password_required" xml:"username_password_required"`
+	HTTPUsername             string   `json:"http_username,omitempty" xml:"http_username,omitempty"`
+	HTTPPassword             string   `json:"http_password,omitempty" xml:"http_password,omitempty"`
+	HTTPURL                  string   `json:"http_url,omitempty" xml:"http_url,omitempty"`
+}
+
+// ValidateDistributionPoint will validate that a distribution point's share and download options are valid
+func ValidateDistributionPoint(dp *DistributionPoint) error {
+	switch strings.ToUpper(dp.ConnectionType) {
+	case "", "AFP", "SMB":
+	default:
+		return fmt.Errorf("%s is not a valid distribution point connection type must be of type [ AFP, SMB ]", dp.ConnectionType)
+	}
+
+	switch strings.ToLower(dp.Protocol) {
+	case "", "http", "https":
+	default:
+		return fmt.Errorf("%s is not a valid distribution point protocol must be of type [ http, https ]", dp.Protocol)
+	}
+
+	if dp.UsernamePasswordRequired && dp.HTTPUsername == "" {
+		return fmt.Errorf("http username is required when distribution point username and password are required")
+	}
+
+	return nil
+}
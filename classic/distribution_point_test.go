@@ -0,0 +1,193 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var DISTRIBUTION_POINTS_API_BASE_ENDPOINT = "/JSSResource/distributionpoints"
+
+func distributionPointResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case DISTRIBUTION_POINTS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"distribution_points": [
+					{
+							"id": 1,
+							"name": "Primary File Share"
+					},
+					{
+							"id": 2,
+							"name": "Boston Office"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", DISTRIBUTION_POINTS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", DISTRIBUTION_POINTS_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Primary%sFile%sShare", DISTRIBUTION_POINTS_API_BASE_ENDPOINT, "%20", "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				dpContents := &jamf.DistributionPoint{}
+				err = xml.Unmarshal(data, dpContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				dpData, err := json.MarshalIndent(dpContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(dpData))
+			default:
+				mockDistributionPoint := &jamf.DistributionPointDetails{
+					Details: &jamf.DistributionPoint{
+						ID:                   1,
+						Name:                 "Primary File Share",
+						IPAddress:            "fileshare.example.com",
+						IsMaster:             true,
+						FailoverPoint:        "Boston Office",
+						ConnectionType:       "SMB",
+						ShareName:            "CasperShare",
+						SharePort:            445,
+						ReadOnlyUsername:     "casperinstall",
+						HTTPDownloadsEnabled: true,
+						Protocol:             "https",
+						Port:                 443,
+						Context:              "CasperShare",
+					},
+				}
+
+				var (
+					dpData []byte
+					err    error
+				)
+
+				if r.Method == "DELETE" {
+					dpData, err = json.MarshalIndent(mockDistributionPoint.Details, "", "    ")
+				} else {
+					dpData, err = json.MarshalIndent(mockDistributionPoint, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(dpData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllDistributionPoints(t *testing.T) {
+	testServer := distributionPointResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	dps, err := j.DistributionPoints()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(dps))
+	assert.Equal(t, 2, dps[1].ID)
+	assert.Equal(t, "Boston Office", dps[1].Name)
+}
+
+func TestQuerySpecificDistributionPoint(t *testing.T) {
+	testServer := distributionPointResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	dp, err := j.DistributionPointDetails("Primary File Share")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, dp.Details.ID)
+	assert.True(t, dp.Details.IsMaster)
+	assert.Equal(t, "Boston Office", dp.Details.FailoverPoint)
+	assert.Equal(t, "SMB", dp.Details.ConnectionType)
+	assert.Equal(t, 445, dp.Details.SharePort)
+	assert.True(t, dp.Details.HTTPDownloadsEnabled)
+	assert.Equal(t, "https", dp.Details.Protocol)
+}
+
+func TestCreateDistributionPoint(t *testing.T) {
+	testServer := distributionPointResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateDistributionPoint(&jamf.DistributionPoint{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new distribution point")
+
+	dp, err := j.CreateDistributionPoint(&jamf.DistributionPoint{
+		Name:              "Boston Office",
+		IPAddress:         "bos-fs01.example.com",
+		ConnectionType:    "AFP",
+		ShareName:         "JamfShare",
+		ReadWriteUsername: "casperadmin",
+		ReadWritePassword: "secret",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Boston Office", dp.Name)
+	assert.Equal(t, "AFP", dp.ConnectionType)
+	assert.Equal(t, "casperadmin", dp.ReadWriteUsername)
+}
+
+func TestUpdateDistributionPoint(t *testing.T) {
+	testServer := distributionPointResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	dp, err := j.UpdateDistributionPoint(1, &jamf.DistributionPoint{
+		FailoverPointURL:     "https://failover.example.com/CasperShare",
+		HTTPDownloadsEnabled: true,
+		Protocol:             "https",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://failover.example.com/CasperShare", dp.FailoverPointURL)
+	assert.True(t, dp.HTTPDownloadsEnabled)
+}
+
+func TestDeleteDistributionPoint(t *testing.T) {
+	testServer := distributionPointResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteDistributionPoint(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.ID)
+}
+
+func TestValidateDistributionPoint(t *testing.T) {
+	err := jamf.ValidateDistributionPoint(&jamf.DistributionPoint{ConnectionType: "NFS"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "NFS is not a valid distribution point connection type must be of type [ AFP, SMB ]", err.Error())
+
+	err = jamf.ValidateDistributionPoint(&jamf.DistributionPoint{Protocol: "ftp"})
+	assert.NotNil(t, err)
+	assert.Equal(t, "ftp is not a valid distribution point protocol must be of type [ http, https ]", err.Error())
+
+	err = jamf.ValidateDistributionPoint(&jamf.DistributionPoint{UsernamePasswordRequired: true})
+	assert.NotNil(t, err)
+	assert.Equal(t, "http username is required when distribution point username and password are required", err.Error())
+
+	assert.Nil(t, jamf.ValidateDistributionPoint(&jamf.DistributionPoint{ConnectionType: "smb", Protocol: "HTTPS"}))
+}
@@ -5,13 +5,13 @@ package classic
 
 // DockItem represents a dock item configured in Jamf typically part of a policy
 type DockItem struct {
-	Size    int              `json:"size"`
-	Details *DockItemDetails `json:"dock_item"`
+	Size    int              `json:"size" xml:"size,omitempty"`
+	Details *DockItemDetails `json:"dock_item" xml:"dock_item,omitempty"`
 }
 
 // DockItemDetails holds the details for a configured dock item
 type DockItemDetails struct {
-	ID     int    `json:"id,omitempty"`
-	Name   string `json:"name"`
-	Action string `json:"action"`
+	ID     int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name   string `json:"name" xml:"name,omitempty"`
+	Action string `json:"action" xml:"action"`
 }
@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+)
+
+// Columns available to export a ComputerList
+var (
+	ComputerListColumnID = export.Column[BasicComputerInfo]{Header: "ID", Value: func(c BasicComputerInfo) string {
+		return strconv.Itoa(c.ID)
+	}}
+	ComputerListColumnName = export.Column[BasicComputerInfo]{Header: "Name", Value: func(c BasicComputerInfo) string {
+		return c.Name
+	}}
+	ComputerListColumnSerialNumber = export.Column[BasicComputerInfo]{Header: "Serial Number", Value: func(c BasicComputerInfo) string {
+		return c.SerialNumber
+	}}
+	ComputerListColumnUDID = export.Column[BasicComputerInfo]{Header: "UDID", Value: func(c BasicComputerInfo) string {
+		return c.UDID
+	}}
+	ComputerListColumnMACAddress = export.Column[BasicComputerInfo]{Header: "MAC Address", Value: func(c BasicComputerInfo) string {
+		return c.MACAddress
+	}}
+	ComputerListColumnReportDate = export.Column[BasicComputerInfo]{Header: "Report Date", Value: func(c BasicComputerInfo) string {
+		return c.ReportDate.String()
+	}}
+)
+
+// DefaultComputerListColumns are the columns exported when none are given to ComputerList.Export
+var DefaultComputerListColumns = []export.Column[BasicComputerInfo]{
+	ComputerListColumnID,
+	ComputerListColumnName,
+	ComputerListColumnSerialNumber,
+	ComputerListColumnUDID,
+}
+
+// Export writes the computers to w as CSV or NDJSON, DefaultComputerListColumns are used without columns
+func (l ComputerList) Export(w io.Writer, format export.Format, columns ...export.Column[BasicComputerInfo]) error {
+	if len(columns) == 0 {
+		columns = DefaultComputerListColumns
+	}
+	return export.Write(w, format, l, columns)
+}
+
+// Columns available to export a PolicyList
+var (
+	PolicyListColumnID = export.Column[BasicPolicyInformation]{Header: "ID", Value: func(p BasicPolicyInformation) string {
+		return strconv.Itoa(p.ID)
+	}}
+	PolicyListColumnName = export.Column[BasicPolicyInformation]{Header: "Name", Value: func(p BasicPolicyInformation) string {
+		return p.Name
+	}}
+)
+
+// DefaultPolicyListColumns are the columns exported when none are given to PolicyList.Export
+var DefaultPolicyListColumns = []export.Column[BasicPolicyInformation]{PolicyListColumnID, PolicyListColumnName}
+
+// Export writes the policies to w as CSV or NDJSON, DefaultPolicyListColumns are used without columns
+func (l PolicyList) Export(w io.Writer, format export.Format, columns ...export.Column[BasicPolicyInformation]) error {
+	if len(columns) == 0 {
+		columns = DefaultPolicyListColumns
+	}
+	return export.Write(w, format, l, columns)
+}
+
+// Columns available to export an AdvancedSearchResultList, display fields are exported with
+// AdvancedSearchResultFieldColumn
+var (
+	AdvancedSearchResultColumnID = export.Column[AdvancedSearchResult]{Header: "ID", Value: func(r AdvancedSearchResult) string {
+		return strconv.Itoa(r.ID)
+	}}
+	AdvancedSearchResultColumnName = export.Column[AdvancedSearchResult]{Header: "Name", Value: func(r AdvancedSearchResult) string {
+		return r.Name
+	}}
+	AdvancedSearchResultColumnUDID = export.Column[AdvancedSearchResult]{Header: "UDID", Value: func(r AdvancedSearchResult) string {
+		return r.UDID
+	}}
+)
+
+// AdvancedSearchResultFieldColumn returns the column holding a display field given its Jamf element
+// name, e.g. "Computer_Name", the header is the display field name, e.g. "Computer Name"
+func AdvancedSearchResultFieldColumn(field string) export.Column[AdvancedSearchResult] {
+	return export.Column[AdvancedSearchResult]{Header: strings.ReplaceAll(field, "_", " "), Value: func(r AdvancedSearchResult) string {
+		return r.Fields[field]
+	}}
+}
+
+// Fields returns the Jamf element names of the display fields found in the results, sorted by name
+func (l AdvancedSearchResultList) Fields() []string {
+	seen := map[string]bool{}
+	fields := []string{}
+	for _, r := range l {
+		for field := range r.Fields {
+			if !seen[field] {
+				seen[field] = true
+				fields = append(fields, field)
+			}
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Export writes the search results to w as CSV or NDJSON, without columns the ID and name are
+// exported followed by every display field returned
+func (l AdvancedSearchResultList) Export(w io.Writer, format export.Format, columns ...export.Column[AdvancedSearchResult]) error {
+	if len(columns) == 0 {
+		columns = []export.Column[AdvancedSearchResult]{AdvancedSearchResultColumnID, AdvancedSearchResultColumnName}
+		for _, field := range l.Fields() {
+			columns = append(columns, AdvancedSearchResultFieldColumn(field))
+		}
+	}
+	return export.Write(w, format, l, columns)
+}
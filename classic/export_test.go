@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"bytes"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportComputerList(t *testing.T) {
+	testServer := computerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	computers, err := j.Computers()
+	assert.Nil(t, err)
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, computers[:1].Export(out, export.CSV, jamf.ComputerListColumnID, jamf.ComputerListColumnName))
+	assert.Equal(t, "ID,Name\n3,Test MacBook #3\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, computers.Export(out, export.NDJSON))
+	assert.Equal(t, 6, bytes.Count(out.Bytes(), []byte("\n")))
+	assert.Contains(t, out.String(), `{"ID":"3","Name":"Test MacBook #3","Serial Number":`)
+}
+
+func TestExportPolicyList(t *testing.T) {
+	policies := jamf.PolicyList{{ID: 72, Name: "Test Policy"}, {ID: 73, Name: "Install, then restart"}}
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, policies.Export(out, export.CSV))
+	assert.Equal(t, "ID,Name\n72,Test Policy\n73,\"Install, then restart\"\n", out.String())
+}
+
+func TestExportAdvancedSearchResultList(t *testing.T) {
+	results := jamf.AdvancedSearchResultList{
+		{ID: 82, Name: "Test Machine", Fields: map[string]string{"Serial_Number": "C02ABC", "Operating_System_Version": "14.6"}},
+		{ID: 83, Name: "Other Machine", Fields: map[string]string{"Serial_Number": "C02DEF"}},
+	}
+	assert.Equal(t, []string{"Operating_System_Version", "Serial_Number"}, results.Fields())
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, results.Export(out, export.CSV))
+	assert.Equal(t, "ID,Name,Operating System Version,Serial Number\n82,Test Machine,14.6,C02ABC\n83,Other Machine,,C02DEF\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, results.Export(out, export.NDJSON, jamf.AdvancedSearchResultColumnName, jamf.AdvancedSearchResultFieldColumn("Serial_Number")))
+	assert.Equal(t, "{\"Name\":\"Test Machine\",\"Serial Number\":\"C02ABC\"}\n{\"Name\":\"Other Machine\",\"Serial Number\":\"C02DEF\"}\n", out.String())
+}
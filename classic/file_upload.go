@@ -0,0 +1,85 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// Resources which accept file uploads through the fileuploads endpoint
+const (
+	FileUploadResourceComputers                    = "computers"
+	FileUploadResourceMobileDevices                = "mobiledevices"
+	FileUploadResourceEnrollmentProfiles           = "enrollmentprofiles"
+	FileUploadResourcePeripherals                  = "peripherals"
+	FileUploadResourcePolicies                     = "policies"
+	FileUploadResourceEbooks                       = "ebooks"
+	FileUploadResourceMobileDeviceApplicationIcon  = "mobiledeviceapplicationsicon"
+	FileUploadResourceMobileDeviceApplicationIPA   = "mobiledeviceapplicationsipa"
+	FileUploadResourceDiskEncryptionConfigurations = "diskencryptionconfigurations"
+	FileUploadResourcePrinters                     = "printers"
+	FileUploadResourceMacApplicationIcon           = "macapplicationsicon"
+)
+
+// Identifier types accepted by the fileuploads endpoint
+const (
+	FileUploadIDTypeID   = "id"
+	FileUploadIDTypeName = "name"
+)
+
+// UploadFile uploads the contents of file to the given resource, e.g. a Self Service icon for a policy
+// or an attachment for a computer. The file is streamed to Jamf rather than buffered in memory. If file
+// implements Name() (as *os.File does) its base name is sent as the file name, otherwise "upload" is used.
+func (j *Client) UploadFile(ctx context.Context, resource string, idType string, id interface{}, file io.Reader) error {
+	if resource == "" {
+		return fmt.Errorf("a resource is required to upload a file")
+	}
+	if idType != FileUploadIDTypeID && idType != FileUploadIDTypeName {
+		return fmt.Errorf("invalid id type %q for file upload, please use %q or %q", idType, FileUploadIDTypeID, FileUploadIDTypeName)
+	}
+	if file == nil {
+		return fmt.Errorf("a file is required to upload to %s/%s/%v", resource, idType, id)
+	}
+
+	ep := fmt.Sprintf("%s/%s/%s/%s/%s", j.Endpoint, fileUploadsContext, resource, idType, url.PathEscape(fmt.Sprint(id)))
+
+	filename := "upload"
+	if named, ok := file.(interface{ Name() string }); ok {
+		filename = filepath.Base(named.Name())
+	}
+
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+	go func() {
+		part, err := form.CreateFormFile("name", filename)
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, "POST", ep, pr)
+	if err != nil {
+		pr.Close()
+		return errors.Wrapf(err, "error building JAMF file upload request for %s (%s)", filename, ep)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	if err := j.makeAPIrequest(req, nil); err != nil {
+		pr.Close()
+		return errors.Wrapf(err, "unable to upload %s to %s", filename, ep)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var FILE_UPLOAD_API_BASE_ENDPOINT = "/JSSResource/fileuploads"
+
+func fileUploadResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.EscapedPath() {
+		case fmt.Sprintf("%s/policies/id/12", FILE_UPLOAD_API_BASE_ENDPOINT), fmt.Sprintf("%s/computers/name/Admin%sMac", FILE_UPLOAD_API_BASE_ENDPOINT, "%20"):
+			assert.Equal(t, "POST", r.Method)
+			file, header, err := r.FormFile("name")
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			defer file.Close()
+			data, err := io.ReadAll(file)
+			assert.Nil(t, err)
+			w.Header().Set("Content-Type", "text/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, "<upload><filename>%s</filename><contents>%s</contents></upload>", header.Filename, data)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestUploadFile(t *testing.T) {
+	testServer := fileUploadResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	err = j.UploadFile(context.Background(), jamf.FileUploadResourcePolicies, jamf.FileUploadIDTypeID, 12, strings.NewReader("icon-bytes"))
+	assert.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	assert.Nil(t, os.WriteFile(path, []byte("pdf-bytes"), 0o600))
+	file, err := os.Open(path)
+	assert.Nil(t, err)
+	defer file.Close()
+	err = j.UploadFile(context.Background(), jamf.FileUploadResourceComputers, jamf.FileUploadIDTypeName, "Admin Mac", file)
+	assert.Nil(t, err)
+
+	err = j.UploadFile(context.Background(), jamf.FileUploadResourcePolicies, jamf.FileUploadIDTypeID, 99, strings.NewReader("icon-bytes"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to upload upload")
+}
+
+func TestUploadFileValidation(t *testing.T) {
+	j, err := jamf.NewClient("https://example.jamfcloud.com", "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	err = j.UploadFile(context.Background(), "", jamf.FileUploadIDTypeID, 1, strings.NewReader("x"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "resource is required")
+
+	err = j.UploadFile(context.Background(), jamf.FileUploadResourcePolicies, "serial", 1, strings.NewReader("x"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "invalid id type")
+
+	err = j.UploadFile(context.Background(), jamf.FileUploadResourcePolicies, jamf.FileUploadIDTypeID, 1, nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "file is required")
+}
@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// GSXConnection returns the Apple GSX connection settings configured in Jamf
+func (j *Client) GSXConnection() (*GSXConnection, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, gsxConnectionContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF GSX connection query request")
+	}
+
+	res := GSXConnectionDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query GSX connection from %s", ep)
+	}
+	return res.Details, nil
+}
+
+// UpdateGSXConnection will update the Apple GSX connection settings configured in Jamf
+func (j *Client) UpdateGSXConnection(content *GSXConnection) (*GSXConnection, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, gsxConnectionContext)
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for GSX connection: (%s)", ep)
+	}
+
+	if err := ValidateGSXConnection(content); err != nil {
+		return nil, errors.Wrap(err, "GSX connection validation failed")
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF update payload for GSX connection")
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for GSX connection (%s)", ep)
+	}
+
+	res := GSXConnection{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for GSX connection (%s)", ep)
+	}
+
+	return &res, nil
+}
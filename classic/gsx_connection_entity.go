@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+)
+
+// GSXConnectionDetails holds the Apple GSX connection settings configured in Jamf
+type GSXConnectionDetails struct {
+	Details *GSXConnection `json:"gsx_connection"`
+}
+
+// GSXConnection represents the Apple Global Service Exchange (GSX) integration settings in Jamf
+type GSXConnection struct {
+	XMLName       xml.Name `json:"-" xml:"gsx_connection,omitempty"`
+	Enabled       bool     `json:"enabled" xml:"enabled"`
+	Username      string   `json:"username,omitempty" xml:"username,omitempty"`
+	AccountNumber int      `json:"account_number,omitempty" xml:"account_number,omitempty"`
+	Region        string   `json:"region,omitempty" xml:"region,omitempty"`
+	URI           string   `json:"uri,omitempty" xml:"uri,omitempty"`
+}
+
+// ValidateGSXConnection will validate that an enabled GSX connection has the settings required to connect
+func ValidateGSXConnection(gsx *GSXConnection) error {
+	if !gsx.Enabled {
+		return nil
+	}
+
+	if gsx.Username == "" || gsx.AccountNumber == 0 {
+		return fmt.Errorf("username and account number are required for an enabled GSX connection")
+	}
+
+	if gsx.URI != "" {
+		u, err := url.Parse(gsx.URI)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%s is not a valid GSX connection URI must be an absolute https URL", gsx.URI)
+		}
+	}
+
+	return nil
+}
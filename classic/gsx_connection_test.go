@@ -0,0 +1,103 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var GSX_CONNECTION_API_BASE_ENDPOINT = "/JSSResource/gsxconnection"
+
+func gsxConnectionResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case GSX_CONNECTION_API_BASE_ENDPOINT:
+			switch r.Method {
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				gsxContents := &jamf.GSXConnection{}
+				err = xml.Unmarshal(data, gsxContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				gsxData, err := json.MarshalIndent(gsxContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(gsxData))
+			default:
+				fmt.Fprint(w, `{
+					"gsx_connection": {
+						"enabled": true,
+						"username": "gsx.api@example.com",
+						"account_number": 123456,
+						"region": "Americas",
+						"uri": "https://partner-connect.apple.com"
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryGSXConnection(t *testing.T) {
+	testServer := gsxConnectionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	gsx, err := j.GSXConnection()
+	assert.Nil(t, err)
+	assert.True(t, gsx.Enabled)
+	assert.Equal(t, 123456, gsx.AccountNumber)
+	assert.Equal(t, "Americas", gsx.Region)
+	assert.Equal(t, "https://partner-connect.apple.com", gsx.URI)
+}
+
+func TestUpdateGSXConnection(t *testing.T) {
+	testServer := gsxConnectionResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.UpdateGSXConnection(&jamf.GSXConnection{Enabled: true})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "username and account number are required for an enabled GSX connection")
+
+	_, err = j.UpdateGSXConnection(&jamf.GSXConnection{Enabled: true, Username: "gsx", AccountNumber: 1, URI: "http://insecure.example.com"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "http://insecure.example.com is not a valid GSX connection URI")
+
+	gsx, err := j.UpdateGSXConnection(&jamf.GSXConnection{
+		Enabled:       true,
+		Username:      "new.gsx.api@example.com",
+		AccountNumber: 654321,
+		URI:           "https://partner-connect.apple.com",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "new.gsx.api@example.com", gsx.Username)
+	assert.Equal(t, 654321, gsx.AccountNumber)
+
+	gsx, err = j.UpdateGSXConnection(&jamf.GSXConnection{Enabled: false})
+	assert.Nil(t, err)
+	assert.False(t, gsx.Enabled)
+}
@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// HealthcareListeners returns all healthcare listeners
+func (j *Client) HealthcareListeners() ([]BasicHealthcareListener, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, healthcareListenersContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF healthcare listeners query request")
+	}
+
+	res := &HealthcareListeners{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query healthcare listeners from %s", ep)
+	}
+	return res.List, nil
+}
+
+// HealthcareListenerDetails returns the details for a specific healthcare listener given its ID or Name
+func (j *Client) HealthcareListenerDetails(identifier interface{}) (*HealthcareListenerDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, healthcareListenersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for healthcare listener: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for healthcare listener: %v", identifier)
+	}
+
+	res := HealthcareListenerDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query healthcare listener with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateHealthcareListener will update a healthcare listener in Jamf by either ID or Name
+func (j *Client) UpdateHealthcareListener(identifier interface{}, content *HealthcareListener) (*HealthcareListener, error) {
+	ep, err := EndpointBuilder(j.Endpoint, healthcareListenersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for healthcare listener: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for healthcare listener: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for healthcare listener: %v (%s)", identifier, ep)
+	}
+
+	res := HealthcareListener{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for healthcare listener: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateHealthcareListenerByName will update a healthcare listener in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateHealthcareListenerByName(name string, content *HealthcareListener) (*HealthcareListener, error) {
+	return j.UpdateHealthcareListener(name, content)
+}
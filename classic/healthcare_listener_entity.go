@@ -0,0 +1,70 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// HealthcareListeners represents a list of Jamf Healthcare Listener instances
+type HealthcareListeners struct {
+	List  []BasicHealthcareListener `json:"healthcare_listeners" xml:"healthcare_listeners>healthcare_listener,omitempty"`
+	Count int                       `json:"-" xml:"size"`
+}
+
+// BasicHealthcareListener holds the basic information for a Healthcare Listener in Jamf
+type BasicHealthcareListener struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// HealthcareListenerDetails holds the details for a single Healthcare Listener
+type HealthcareListenerDetails struct {
+	Details *HealthcareListener `json:"healthcare_listener"`
+}
+
+// HealthcareListener represents a Jamf Healthcare Listener which receives ADT messages from an EMR system
+type HealthcareListener struct {
+	XMLName        xml.Name                      `json:"-" xml:"healthcare_listener,omitempty"`
+	ID             int                           `json:"id,omitempty" xml:"id,omitempty"`
+	Name           string                        `json:"name" xml:"name,omitempty"`
+	Port           int                           `json:"port,omitempty" xml:"port,omitempty"`
+	MDMCommandType string                        `json:"mdm_command_type,omitempty" xml:"mdm_command_type,omitempty"`
+	IPAddresses    []string                      `json:"ip_addresses,omitempty" xml:"ip_addresses>ip_address,omitempty"`
+	Rules          []BasicHealthcareListenerRule `json:"healthcare_listener_rules,omitempty" xml:"-"`
+}
+
+// HealthcareListenerRules represents a list of Jamf Healthcare Listener rules
+type HealthcareListenerRules struct {
+	List  []BasicHealthcareListenerRule `json:"healthcare_listener_rules" xml:"healthcare_listener_rules>healthcare_listener_rule,omitempty"`
+	Count int                           `json:"-" xml:"size"`
+}
+
+// BasicHealthcareListenerRule holds the basic information for a Healthcare Listener rule in Jamf
+type BasicHealthcareListenerRule struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// HealthcareListenerRuleDetails holds the details for a single Healthcare Listener rule
+type HealthcareListenerRuleDetails struct {
+	Details *HealthcareListenerRule `json:"healthcare_listener_rule"`
+}
+
+// HealthcareListenerRule represents a rule mapping ADT messages received by a Healthcare Listener to MDM commands
+type HealthcareListenerRule struct {
+	XMLName                  xml.Name                  `json:"-" xml:"healthcare_listener_rule,omitempty"`
+	ID                       int                       `json:"id,omitempty" xml:"id,omitempty"`
+	Name                     string                    `json:"name" xml:"name,omitempty"`
+	Enabled                  bool                      `json:"enabled" xml:"enabled"`
+	OperatingSystem          *HealthcareListenerRuleOS `json:"operating_system,omitempty" xml:"operating_system,omitempty"`
+	ADTMessage               string                    `json:"adt_message,omitempty" xml:"adt_message,omitempty"`
+	MDMCommand               string                    `json:"mdm_command,omitempty" xml:"mdm_command,omitempty"`
+	MDMCommandAdditionalData string                    `json:"mdm_command_additional_data,omitempty" xml:"mdm_command_additional_data,omitempty"`
+	MobileDeviceGroup        *ClassMobileDeviceGroup   `json:"mobile_device_group,omitempty" xml:"mobile_device_group,omitempty"`
+	Notification             bool                      `json:"notification" xml:"notification"`
+}
+
+// HealthcareListenerRuleOS holds the operating system range a Healthcare Listener rule applies to
+type HealthcareListenerRuleOS struct {
+	Minimum string `json:"minimum,omitempty" xml:"minimum,omitempty"`
+	Maximum string `json:"maximum,omitempty" xml:"maximum,omitempty"`
+}
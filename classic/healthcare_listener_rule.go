@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// HealthcareListenerRules returns all healthcare listener rules
+func (j *Client) HealthcareListenerRules() ([]BasicHealthcareListenerRule, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, healthcareListenerRulesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF healthcare listener rules query request")
+	}
+
+	res := &HealthcareListenerRules{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query healthcare listener rules from %s", ep)
+	}
+	return res.List, nil
+}
+
+// HealthcareListenerRuleDetails returns the details for a specific healthcare listener rule given its ID or Name
+func (j *Client) HealthcareListenerRuleDetails(identifier interface{}) (*HealthcareListenerRuleDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, healthcareListenerRulesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for healthcare listener rule: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for healthcare listener rule: %v", identifier)
+	}
+
+	res := HealthcareListenerRuleDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query healthcare listener rule with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateHealthcareListenerRule will create a new healthcare listener rule in Jamf
+func (j *Client) CreateHealthcareListenerRule(content *HealthcareListenerRule) (*HealthcareListenerRule, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, healthcareListenerRulesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new healthcare listener rule")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for healthcare listener rule: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new healthcare listener rule"), "unable to process JAMF creation request for healthcare listener rule: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for healthcare listener rule: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for healthcare listener rule: %v (%s)", content.Name, ep)
+	}
+
+	res := HealthcareListenerRule{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for healthcare listener rule %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateHealthcareListenerRule will update a healthcare listener rule in Jamf by either ID or Name
+func (j *Client) UpdateHealthcareListenerRule(identifier interface{}, content *HealthcareListenerRule) (*HealthcareListenerRule, error) {
+	ep, err := EndpointBuilder(j.Endpoint, healthcareListenerRulesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for healthcare listener rule: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for healthcare listener rule: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for healthcare listener rule: %v (%s)", identifier, ep)
+	}
+
+	res := HealthcareListenerRule{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for healthcare listener rule: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateHealthcareListenerRuleByName will update a healthcare listener rule in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateHealthcareListenerRuleByName(name string, content *HealthcareListenerRule) (*HealthcareListenerRule, error) {
+	return j.UpdateHealthcareListenerRule(name, content)
+}
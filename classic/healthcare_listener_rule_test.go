@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var HEALTHCARE_LISTENER_RULE_API_BASE_ENDPOINT = "/JSSResource/healthcarelistenerrule"
+
+func healthcareListenerRuleResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case HEALTHCARE_LISTENER_RULE_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"healthcare_listener_rules": [
+					{
+							"id": 1,
+							"name": "Admit"
+					},
+					{
+							"id": 4,
+							"name": "Discharge"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/4", HEALTHCARE_LISTENER_RULE_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", HEALTHCARE_LISTENER_RULE_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				ruleContents := &jamf.HealthcareListenerRule{}
+				err = xml.Unmarshal(data, ruleContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				ruleData, err := json.MarshalIndent(ruleContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(ruleData))
+			default:
+				mockHealthcareListenerRule := &jamf.HealthcareListenerRuleDetails{
+					Details: &jamf.HealthcareListenerRule{
+						ID:         4,
+						Name:       "Discharge",
+						Enabled:    true,
+						ADTMessage: "A03",
+						MDMCommand: "EraseDevice",
+						OperatingSystem: &jamf.HealthcareListenerRuleOS{
+							Minimum: "14.0",
+						},
+					},
+				}
+				ruleData, err := json.MarshalIndent(mockHealthcareListenerRule, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(ruleData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllHealthcareListenerRules(t *testing.T) {
+	testServer := healthcareListenerRuleResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	rules, err := j.HealthcareListenerRules()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rules))
+	assert.Equal(t, 4, rules[1].ID)
+	assert.Equal(t, "Discharge", rules[1].Name)
+}
+
+func TestQuerySpecificHealthcareListenerRule(t *testing.T) {
+	testServer := healthcareListenerRuleResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	rule, err := j.HealthcareListenerRuleDetails(4)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, rule.Details.ID)
+	assert.Equal(t, "Discharge", rule.Details.Name)
+	assert.True(t, rule.Details.Enabled)
+	assert.Equal(t, "A03", rule.Details.ADTMessage)
+	assert.Equal(t, "EraseDevice", rule.Details.MDMCommand)
+	assert.Equal(t, "14.0", rule.Details.OperatingSystem.Minimum)
+}
+
+func TestCreateHealthcareListenerRule(t *testing.T) {
+	testServer := healthcareListenerRuleResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateHealthcareListenerRule(&jamf.HealthcareListenerRule{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required")
+
+	rule, err := j.CreateHealthcareListenerRule(&jamf.HealthcareListenerRule{
+		Name:       "Transfer",
+		Enabled:    true,
+		ADTMessage: "A02",
+		MDMCommand: "ClearPasscode",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Transfer", rule.Name)
+	assert.True(t, rule.Enabled)
+	assert.Equal(t, "A02", rule.ADTMessage)
+}
+
+func TestUpdateHealthcareListenerRule(t *testing.T) {
+	testServer := healthcareListenerRuleResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	rule, err := j.UpdateHealthcareListenerRule(4, &jamf.HealthcareListenerRule{
+		Name:    "Discharge",
+		Enabled: false,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Discharge", rule.Name)
+	assert.False(t, rule.Enabled)
+}
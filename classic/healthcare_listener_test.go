@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var HEALTHCARE_LISTENER_API_BASE_ENDPOINT = "/JSSResource/healthcarelistener"
+
+func healthcareListenerResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case HEALTHCARE_LISTENER_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"healthcare_listeners": [
+					{
+							"id": 1,
+							"name": "Main Campus"
+					},
+					{
+							"id": 2,
+							"name": "North Campus"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", HEALTHCARE_LISTENER_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				listenerContents := &jamf.HealthcareListener{}
+				err = xml.Unmarshal(data, listenerContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				listenerData, err := json.MarshalIndent(listenerContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(listenerData))
+			default:
+				mockHealthcareListener := &jamf.HealthcareListenerDetails{
+					Details: &jamf.HealthcareListener{
+						ID:             2,
+						Name:           "North Campus",
+						Port:           8080,
+						MDMCommandType: "INFORMATION",
+						IPAddresses:    []string{"10.0.0.5"},
+						Rules:          []jamf.BasicHealthcareListenerRule{{ID: 4, Name: "Discharge"}},
+					},
+				}
+				listenerData, err := json.MarshalIndent(mockHealthcareListener, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(listenerData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllHealthcareListeners(t *testing.T) {
+	testServer := healthcareListenerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	listeners, err := j.HealthcareListeners()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(listeners))
+	assert.Equal(t, 2, listeners[1].ID)
+	assert.Equal(t, "North Campus", listeners[1].Name)
+}
+
+func TestQuerySpecificHealthcareListener(t *testing.T) {
+	testServer := healthcareListenerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	listener, err := j.HealthcareListenerDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, listener.Details.ID)
+	assert.Equal(t, "North Campus", listener.Details.Name)
+	assert.Equal(t, 8080, listener.Details.Port)
+	assert.Equal(t, []string{"10.0.0.5"}, listener.Details.IPAddresses)
+	assert.Equal(t, 1, len(listener.Details.Rules))
+	assert.Equal(t, "Discharge", listener.Details.Rules[0].Name)
+}
+
+func TestUpdateHealthcareListener(t *testing.T) {
+	testServer := healthcareListenerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	listener, err := j.UpdateHealthcareListener(2, &jamf.HealthcareListener{
+		Name:        "North Campus",
+		Port:        9090,
+		IPAddresses: []string{"10.0.0.5", "10.0.0.6"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "North Campus", listener.Name)
+	assert.Equal(t, 9090, listener.Port)
+	assert.Equal(t, []string{"10.0.0.5", "10.0.0.6"}, listener.IPAddresses)
+}
@@ -0,0 +1,45 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// InfrastructureManagers returns all infrastructure managers
+func (j *Client) InfrastructureManagers() ([]BasicInfrastructureManager, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, infrastructureManagersContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF infrastructure managers query request")
+	}
+
+	res := &InfrastructureManagers{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query infrastructure managers from %s", ep)
+	}
+	return res.List, nil
+}
+
+// InfrastructureManagerDetails returns the details for a specific infrastructure manager given its ID or Name
+func (j *Client) InfrastructureManagerDetails(identifier interface{}) (*InfrastructureManagerDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, infrastructureManagersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for infrastructure manager: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for infrastructure manager: %v", identifier)
+	}
+
+	res := InfrastructureManagerDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query infrastructure manager with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,35 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// InfrastructureManagers represents a list of Jamf Infrastructure Manager instances
+type InfrastructureManagers struct {
+	List  []BasicInfrastructureManager `json:"infrastructure_managers" xml:"infrastructure_managers>infrastructure_manager,omitempty"`
+	Count int                          `json:"-" xml:"size"`
+}
+
+// BasicInfrastructureManager holds the basic information for an Infrastructure Manager instance in Jamf
+type BasicInfrastructureManager struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// InfrastructureManagerDetails holds the details for a single Infrastructure Manager instance
+type InfrastructureManagerDetails struct {
+	Details *InfrastructureManager `json:"infrastructure_manager"`
+}
+
+// InfrastructureManager represents an Infrastructure Manager instance registered with Jamf, typically
+// hosting the LDAP proxy
+type InfrastructureManager struct {
+	XMLName                   xml.Name  `json:"-" xml:"infrastructure_manager,omitempty"`
+	ID                        int       `json:"id,omitempty" xml:"id,omitempty"`
+	Name                      string    `json:"name" xml:"name,omitempty"`
+	Hostname                  string    `json:"hostname,omitempty" xml:"hostname,omitempty"`
+	RecurringCheckInFrequency int       `json:"recurring_check_in_frequency,omitempty" xml:"recurring_check_in_frequency,omitempty"`
+	LastCheckIn               JamfTime  `json:"last_check_in,omitempty" xml:"last_check_in,omitempty"`
+	LastCheckInEpoch          EpochTime `json:"last_check_in_epoch,omitempty" xml:"last_check_in_epoch,omitempty"`
+	LastCheckInUTC            JamfTime  `json:"last_check_in_utc,omitempty" xml:"last_check_in_utc,omitempty"`
+}
@@ -0,0 +1,89 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var INFRASTRUCTURE_MANAGER_API_BASE_ENDPOINT = "/JSSResource/infrastructuremanager"
+
+func infrastructureManagerResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case INFRASTRUCTURE_MANAGER_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"infrastructure_managers": [
+					{
+							"id": 1,
+							"name": "LDAP Proxy East"
+					},
+					{
+							"id": 3,
+							"name": "LDAP Proxy West"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/3", INFRASTRUCTURE_MANAGER_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/LDAP%sProxy%sWest", INFRASTRUCTURE_MANAGER_API_BASE_ENDPOINT, "%20", "%20"):
+			switch r.Method {
+			default:
+				mockInfrastructureManager := &jamf.InfrastructureManagerDetails{
+					Details: &jamf.InfrastructureManager{
+						ID:                        3,
+						Name:                      "LDAP Proxy West",
+						Hostname:                  "jim-west.example.com",
+						RecurringCheckInFrequency: 30,
+						LastCheckInEpoch:          jamf.NewEpochTime(time.UnixMilli(1665000000000)),
+					},
+				}
+				managerData, err := json.MarshalIndent(mockInfrastructureManager, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(managerData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllInfrastructureManagers(t *testing.T) {
+	testServer := infrastructureManagerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	managers, err := j.InfrastructureManagers()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(managers))
+	assert.Equal(t, 3, managers[1].ID)
+	assert.Equal(t, "LDAP Proxy West", managers[1].Name)
+}
+
+func TestQuerySpecificInfrastructureManager(t *testing.T) {
+	testServer := infrastructureManagerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	manager, err := j.InfrastructureManagerDetails("LDAP Proxy West")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, manager.Details.ID)
+	assert.Equal(t, "LDAP Proxy West", manager.Details.Name)
+	assert.Equal(t, "jim-west.example.com", manager.Details.Hostname)
+	assert.Equal(t, 30, manager.Details.RecurringCheckInFrequency)
+	assert.Equal(t, int64(1665000000000), manager.Details.LastCheckInEpoch.Millis())
+	assert.Equal(t, 2022, manager.Details.LastCheckInEpoch.Year())
+}
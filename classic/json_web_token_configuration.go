@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// JSONWebTokenConfigurations returns all JSON web token configurations
+func (j *Client) JSONWebTokenConfigurations() ([]BasicJSONWebTokenConfiguration, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, jsonWebTokenConfigurationsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF JSON web token configurations query request")
+	}
+
+	res := &JSONWebTokenConfigurations{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query JSON web token configurations from %s", ep)
+	}
+	return res.List, nil
+}
+
+// JSONWebTokenConfigurationDetails returns the details for a specific JSON web token configuration given its ID
+func (j *Client) JSONWebTokenConfigurationDetails(identifier interface{}) (*JSONWebTokenConfigurationDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, jsonWebTokenConfigurationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for JSON web token configuration: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for JSON web token configuration: %v", identifier)
+	}
+
+	res := JSONWebTokenConfigurationDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query JSON web token configuration with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateJSONWebTokenConfiguration will create a new JSON web token configuration in Jamf
+func (j *Client) CreateJSONWebTokenConfiguration(content *JSONWebTokenConfiguration) (*JSONWebTokenConfiguration, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, jsonWebTokenConfigurationsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new JSON web token configuration")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for JSON web token configuration: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new JSON web token configuration"), "unable to process JAMF creation request for JSON web token configuration: (%s)", ep)
+	}
+
+	if content.EncryptionKey == "" {
+		return nil, errors.Wrapf(fmt.Errorf("encryption key required for new JSON web token configuration"), "unable to process JAMF creation request for JSON web token configuration: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for JSON web token configuration: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for JSON web token configuration: %v (%s)", content.Name, ep)
+	}
+
+	res := JSONWebTokenConfiguration{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for JSON web token configuration %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateJSONWebTokenConfiguration will update a JSON web token configuration in Jamf by ID
+func (j *Client) UpdateJSONWebTokenConfiguration(identifier interface{}, content *JSONWebTokenConfiguration) (*JSONWebTokenConfiguration, error) {
+	ep, err := EndpointBuilder(j.Endpoint, jsonWebTokenConfigurationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for JSON web token configuration: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for JSON web token configuration: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for JSON web token configuration: %v (%s)", identifier, ep)
+	}
+
+	res := JSONWebTokenConfiguration{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for JSON web token configuration: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteJSONWebTokenConfiguration will delete a JSON web token configuration by ID
+func (j *Client) DeleteJSONWebTokenConfiguration(identifier interface{}) (*JSONWebTokenConfiguration, error) {
+	ep, err := EndpointBuilder(j.Endpoint, jsonWebTokenConfigurationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for JSON web token configuration: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for JSON web token configuration %v", identifier)
+	}
+
+	res := JSONWebTokenConfiguration{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for JSON web token configuration %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
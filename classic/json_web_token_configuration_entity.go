@@ -0,0 +1,35 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// JSONWebTokenConfigurations represents a list of JSON web token configurations in Jamf
+type JSONWebTokenConfigurations struct {
+	List  []BasicJSONWebTokenConfiguration `json:"json_web_token_configurations" xml:"json_web_token_configurations>json_web_token_configuration,omitempty"`
+	Count int                              `json:"-" xml:"size"`
+}
+
+// BasicJSONWebTokenConfiguration holds the basic information for a JSON web token configuration in Jamf
+type BasicJSONWebTokenConfiguration struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// JSONWebTokenConfigurationDetails holds the details for a single JSON web token configuration
+type JSONWebTokenConfigurationDetails struct {
+	Details *JSONWebTokenConfiguration `json:"json_web_token_configuration"`
+}
+
+// JSONWebTokenConfiguration represents the signing configuration used to issue JSON web tokens
+// to apps through managed app configuration
+type JSONWebTokenConfiguration struct {
+	XMLName          xml.Name `json:"-" xml:"json_web_token_configuration,omitempty"`
+	ID               int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name             string   `json:"name" xml:"name,omitempty"`
+	Disabled         bool     `json:"disabled" xml:"disabled"`
+	EncryptionKey    string   `json:"encryption_key,omitempty" xml:"encryption_key,omitempty"`
+	TokenExpiry      int      `json:"token_expiry,omitempty" xml:"token_expiry,omitempty"`
+	EncryptionKeyID  string   `json:"encryption_key_id,omitempty" xml:"encryption_key_id,omitempty"`
+	SigningAlgorithm string   `json:"signing_algorithm,omitempty" xml:"signing_algorithm,omitempty"`
+}
@@ -0,0 +1,161 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var JSON_WEB_TOKEN_CONFIGURATION_API_BASE_ENDPOINT = "/JSSResource/jsonwebtokenconfigurations"
+
+func jsonWebTokenConfigurationResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case JSON_WEB_TOKEN_CONFIGURATION_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"json_web_token_configurations": [
+					{
+							"id": 1,
+							"name": "Outlook"
+					},
+					{
+							"id": 2,
+							"name": "Okta Verify"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", JSON_WEB_TOKEN_CONFIGURATION_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", JSON_WEB_TOKEN_CONFIGURATION_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Okta%sVerify", JSON_WEB_TOKEN_CONFIGURATION_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				configContents := &jamf.JSONWebTokenConfiguration{}
+				err = xml.Unmarshal(data, configContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				configData, err := json.MarshalIndent(configContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(configData))
+			default:
+				mockJSONWebTokenConfiguration := &jamf.JSONWebTokenConfigurationDetails{
+					Details: &jamf.JSONWebTokenConfiguration{
+						ID:          2,
+						Name:        "Okta Verify",
+						Disabled:    false,
+						TokenExpiry: 60,
+					},
+				}
+
+				var (
+					configData []byte
+					err        error
+				)
+
+				if r.Method == "DELETE" {
+					configData, err = json.MarshalIndent(mockJSONWebTokenConfiguration.Details, "", "    ")
+				} else {
+					configData, err = json.MarshalIndent(mockJSONWebTokenConfiguration, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(configData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllJSONWebTokenConfigurations(t *testing.T) {
+	testServer := jsonWebTokenConfigurationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	configs, err := j.JSONWebTokenConfigurations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(configs))
+	assert.Equal(t, 2, configs[1].ID)
+	assert.Equal(t, "Okta Verify", configs[1].Name)
+}
+
+func TestQuerySpecificJSONWebTokenConfiguration(t *testing.T) {
+	testServer := jsonWebTokenConfigurationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	config, err := j.JSONWebTokenConfigurationDetails("Okta Verify")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, config.Details.ID)
+	assert.Equal(t, "Okta Verify", config.Details.Name)
+	assert.False(t, config.Details.Disabled)
+	assert.Equal(t, 60, config.Details.TokenExpiry)
+}
+
+func TestCreateJSONWebTokenConfiguration(t *testing.T) {
+	testServer := jsonWebTokenConfigurationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateJSONWebTokenConfiguration(&jamf.JSONWebTokenConfiguration{Name: "Outlook"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "encryption key required")
+
+	config, err := j.CreateJSONWebTokenConfiguration(&jamf.JSONWebTokenConfiguration{
+		Name:          "Intune",
+		EncryptionKey: "c2VjcmV0LWtleQ==",
+		TokenExpiry:   30,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Intune", config.Name)
+	assert.Equal(t, "c2VjcmV0LWtleQ==", config.EncryptionKey)
+	assert.Equal(t, 30, config.TokenExpiry)
+}
+
+func TestUpdateJSONWebTokenConfiguration(t *testing.T) {
+	testServer := jsonWebTokenConfigurationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	config, err := j.UpdateJSONWebTokenConfiguration(2, &jamf.JSONWebTokenConfiguration{
+		Name:          "Okta Verify",
+		EncryptionKey: "cm90YXRlZC1rZXk=",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Okta Verify", config.Name)
+	assert.Equal(t, "cm90YXRlZC1rZXk=", config.EncryptionKey)
+}
+
+func TestDeleteJSONWebTokenConfiguration(t *testing.T) {
+	testServer := jsonWebTokenConfigurationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteJSONWebTokenConfiguration(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.ID)
+}
@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// LicensedSoftwareTitles returns all licensed software titles
+func (j *Client) LicensedSoftwareTitles() ([]BasicLicensedSoftware, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, licensedSoftwareContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF licensed software titles query request")
+	}
+
+	res := &LicensedSoftwareTitles{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query licensed software titles from %s", ep)
+	}
+	return res.List, nil
+}
+
+// LicensedSoftwareDetails returns the details for a specific licensed software title given its ID or Name
+func (j *Client) LicensedSoftwareDetails(identifier interface{}) (*LicensedSoftwareDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, licensedSoftwareContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for licensed software title: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for licensed software title: %v", identifier)
+	}
+
+	res := LicensedSoftwareDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query licensed software title with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// LicensedSoftwareUsage returns which computers consume licenses for a specific licensed software title
+// given its ID or Name
+func (j *Client) LicensedSoftwareUsage(identifier interface{}) (*LicensedSoftwareUsage, error) {
+	res, err := j.LicensedSoftwareDetails(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if res.Details == nil {
+		return nil, fmt.Errorf("no details returned for licensed software title: %v", identifier)
+	}
+	return res.Details.Usage(), nil
+}
+
+// LicensedSoftwareReport returns the license usage for every licensed software title in Jamf,
+// suitable for generating true-up reports
+func (j *Client) LicensedSoftwareReport() ([]LicensedSoftwareUsage, error) {
+	titles, err := j.LicensedSoftwareTitles()
+	if err != nil {
+		return nil, err
+	}
+
+	report := make([]LicensedSoftwareUsage, 0, len(titles))
+	for _, title := range titles {
+		usage, err := j.LicensedSoftwareUsage(title.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to build licensed software report for %s", title.Name)
+		}
+		report = append(report, *usage)
+	}
+	return report, nil
+}
@@ -0,0 +1,99 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// LicensedSoftwareTitles represents a list of licensed software titles in Jamf
+type LicensedSoftwareTitles struct {
+	List  []BasicLicensedSoftware `json:"licensed_software" xml:"licensed_software>licensed_software,omitempty"`
+	Count int                     `json:"-" xml:"size"`
+}
+
+// BasicLicensedSoftware holds the basic information for a licensed software title in Jamf
+type BasicLicensedSoftware struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// LicensedSoftwareDetails holds the details for a single licensed software title
+type LicensedSoftwareDetails struct {
+	Details *LicensedSoftware `json:"licensed_software"`
+}
+
+// LicensedSoftware represents a licensed software title tracked by Jamf along with the
+// licenses owned and the computers using it
+type LicensedSoftware struct {
+	XMLName   xml.Name                   `json:"-" xml:"licensed_software,omitempty"`
+	General   *LicensedSoftwareGeneral   `json:"general,omitempty" xml:"general,omitempty"`
+	Licenses  []SoftwareLicense          `json:"licenses,omitempty" xml:"licenses>license,omitempty"`
+	Computers []LicensedSoftwareComputer `json:"computers,omitempty" xml:"computers>computer,omitempty"`
+}
+
+// LicensedSoftwareGeneral holds the general information for a licensed software title
+type LicensedSoftwareGeneral struct {
+	ID                                 int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name                               string `json:"name" xml:"name,omitempty"`
+	Publisher                          string `json:"publisher,omitempty" xml:"publisher,omitempty"`
+	Platform                           string `json:"platform,omitempty" xml:"platform,omitempty"`
+	SendEmailOnViolation               bool   `json:"send_email_on_violation" xml:"send_email_on_violation"`
+	RemoveTitlesFromInventoryReports   bool   `json:"remove_titles_from_inventory_reports" xml:"remove_titles_from_inventory_reports"`
+	ExcludeTitlesPurchasedFromAppStore bool   `json:"exclude_titles_purchased_from_app_store" xml:"exclude_titles_purchased_from_app_store"`
+	Notes                              string `json:"notes,omitempty" xml:"notes,omitempty"`
+	Site                               *Site  `json:"site,omitempty" xml:"site,omitempty"`
+}
+
+// SoftwareLicense holds a license, or volume of licenses, owned for a licensed software title
+type SoftwareLicense struct {
+	Size             int    `json:"size,omitempty" xml:"size,omitempty"`
+	SerialNumber1    string `json:"serial_number_1,omitempty" xml:"serial_number_1,omitempty"`
+	SerialNumber2    string `json:"serial_number_2,omitempty" xml:"serial_number_2,omitempty"`
+	OrganizationName string `json:"organization_name,omitempty" xml:"organization_name,omitempty"`
+	RegisteredTo     string `json:"registered_to,omitempty" xml:"registered_to,omitempty"`
+	LicenseType      string `json:"license_type,omitempty" xml:"license_type,omitempty"`
+	LicenseCount     int    `json:"license_count" xml:"license_count"`
+	Notes            string `json:"notes,omitempty" xml:"notes,omitempty"`
+}
+
+// LicensedSoftwareComputer holds a computer which has a licensed software title installed
+type LicensedSoftwareComputer struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// LicensedSoftwareUsage summarizes how many licenses are owned for a title and which computers consume them
+type LicensedSoftwareUsage struct {
+	ID        int                        `json:"id"`
+	Name      string                     `json:"name"`
+	Publisher string                     `json:"publisher,omitempty"`
+	Licensed  int                        `json:"licensed"`
+	InUse     int                        `json:"in_use"`
+	Computers []LicensedSoftwareComputer `json:"computers,omitempty"`
+}
+
+// Usage totals the licenses owned for the title against the computers it is installed on
+func (l *LicensedSoftware) Usage() *LicensedSoftwareUsage {
+	usage := &LicensedSoftwareUsage{
+		InUse:     len(l.Computers),
+		Computers: l.Computers,
+	}
+	if l.General != nil {
+		usage.ID = l.General.ID
+		usage.Name = l.General.Name
+		usage.Publisher = l.General.Publisher
+	}
+	for _, license := range l.Licenses {
+		usage.Licensed += license.LicenseCount
+	}
+	return usage
+}
+
+// Available returns the number of unused licenses, a negative value indicates the title is over-deployed
+func (u *LicensedSoftwareUsage) Available() int {
+	return u.Licensed - u.InUse
+}
+
+// Compliant reports whether the title is installed on no more computers than it is licensed for
+func (u *LicensedSoftwareUsage) Compliant() bool {
+	return u.Available() >= 0
+}
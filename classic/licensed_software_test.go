@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var LICENSED_SOFTWARE_API_BASE_ENDPOINT = "/JSSResource/licensedsoftware"
+
+func licensedSoftwareResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case LICENSED_SOFTWARE_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"licensed_software": [
+					{
+							"id": 1,
+							"name": "Microsoft Office"
+					},
+					{
+							"id": 2,
+							"name": "Adobe Photoshop"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", LICENSED_SOFTWARE_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/2", LICENSED_SOFTWARE_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Adobe%sPhotoshop", LICENSED_SOFTWARE_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			default:
+				mockLicensedSoftware := &jamf.LicensedSoftwareDetails{
+					Details: &jamf.LicensedSoftware{
+						General: &jamf.LicensedSoftwareGeneral{
+							ID:        2,
+							Name:      "Adobe Photoshop",
+							Publisher: "Adobe",
+						},
+						Licenses: []jamf.SoftwareLicense{
+							{LicenseType: "Standard", LicenseCount: 1},
+							{LicenseType: "Volume", LicenseCount: 2},
+						},
+						Computers: []jamf.LicensedSoftwareComputer{
+							{ID: 7, Name: "Design-01"},
+							{ID: 8, Name: "Design-02"},
+						},
+					},
+				}
+				softwareData, err := json.MarshalIndent(mockLicensedSoftware, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(softwareData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllLicensedSoftwareTitles(t *testing.T) {
+	testServer := licensedSoftwareResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	softwares, err := j.LicensedSoftwareTitles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(softwares))
+	assert.Equal(t, 2, softwares[1].ID)
+	assert.Equal(t, "Adobe Photoshop", softwares[1].Name)
+}
+
+func TestQuerySpecificLicensedSoftware(t *testing.T) {
+	testServer := licensedSoftwareResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	software, err := j.LicensedSoftwareDetails("Adobe Photoshop")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, software.Details.General.ID)
+	assert.Equal(t, "Adobe Photoshop", software.Details.General.Name)
+	assert.Equal(t, 2, len(software.Details.Licenses))
+	assert.Equal(t, "Design-02", software.Details.Computers[1].Name)
+}
+
+func TestLicensedSoftwareUsage(t *testing.T) {
+	testServer := licensedSoftwareResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	usage, err := j.LicensedSoftwareUsage(2)
+	assert.Nil(t, err)
+	assert.Equal(t, "Adobe Photoshop", usage.Name)
+	assert.Equal(t, 3, usage.Licensed)
+	assert.Equal(t, 2, usage.InUse)
+	assert.Equal(t, 1, usage.Available())
+	assert.True(t, usage.Compliant())
+}
+
+func TestLicensedSoftwareReport(t *testing.T) {
+	testServer := licensedSoftwareResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	report, err := j.LicensedSoftwareReport()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(report))
+	assert.Equal(t, 2, report[0].InUse)
+}
+
+func TestLicensedSoftwareUsageOverage(t *testing.T) {
+	software := &jamf.LicensedSoftware{
+		General:   &jamf.LicensedSoftwareGeneral{ID: 4, Name: "Final Cut Pro"},
+		Licenses:  []jamf.SoftwareLicense{{LicenseCount: 1}},
+		Computers: []jamf.LicensedSoftwareComputer{{ID: 1}, {ID: 2}},
+	}
+	usage := software.Usage()
+	assert.Equal(t, -1, usage.Available())
+	assert.False(t, usage.Compliant())
+}
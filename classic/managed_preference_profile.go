@@ -0,0 +1,138 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ManagedPreferenceProfiles returns all managed preference profiles
+func (j *Client) ManagedPreferenceProfiles() ([]BasicManagedPreferenceProfile, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, managedPreferenceProfilesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF managed preference profiles query request")
+	}
+
+	res := &ManagedPreferenceProfiles{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed preference profiles from %s", ep)
+	}
+	return res.List, nil
+}
+
+// ManagedPreferenceProfileDetails returns the details for a specific managed preference profile given its ID or Name
+func (j *Client) ManagedPreferenceProfileDetails(identifier interface{}) (*ManagedPreferenceProfileDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, managedPreferenceProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for managed preference profile: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for managed preference profile: %v", identifier)
+	}
+
+	res := ManagedPreferenceProfileDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed preference profile with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateManagedPreferenceProfile will create a new managed preference profile in Jamf
+func (j *Client) CreateManagedPreferenceProfile(content *ManagedPreferenceProfile) (*ManagedPreferenceProfile, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, managedPreferenceProfilesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new managed preference profile")
+	}
+
+	if content == nil || content.General == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for managed preference profile: (%s)", ep)
+	}
+
+	if content.General.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new managed preference profile"), "unable to process JAMF creation request for managed preference profile: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for managed preference profile: %v", content.General.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for managed preference profile: %v (%s)", content.General.Name, ep)
+	}
+
+	res := ManagedPreferenceProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for managed preference profile %v on %s", content.General.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateManagedPreferenceProfile will update a managed preference profile in Jamf by either ID or Name
+func (j *Client) UpdateManagedPreferenceProfile(identifier interface{}, content *ManagedPreferenceProfile) (*ManagedPreferenceProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, managedPreferenceProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for managed preference profile: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for managed preference profile: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for managed preference profile: %v (%s)", identifier, ep)
+	}
+
+	res := ManagedPreferenceProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for managed preference profile: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateManagedPreferenceProfileByName will update a managed preference profile in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateManagedPreferenceProfileByName(name string, content *ManagedPreferenceProfile) (*ManagedPreferenceProfile, error) {
+	return j.UpdateManagedPreferenceProfile(name, content)
+}
+
+// DeleteManagedPreferenceProfile will delete a managed preference profile by either ID or Name
+func (j *Client) DeleteManagedPreferenceProfile(identifier interface{}) (*ManagedPreferenceProfile, error) {
+	ep, err := EndpointBuilder(j.Endpoint, managedPreferenceProfilesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for managed preference profile: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for managed preference profile %v", identifier)
+	}
+
+	res := ManagedPreferenceProfile{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for managed preference profile %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteManagedPreferenceProfileByName will delete a managed preference profile by name, which is escaped in the request path
+func (j *Client) DeleteManagedPreferenceProfileByName(name string) (*ManagedPreferenceProfile, error) {
+	return j.DeleteManagedPreferenceProfile(name)
+}
@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// ManagedPreferenceProfiles represents a list of managed preference profiles in Jamf
+type ManagedPreferenceProfiles struct {
+	List  []BasicManagedPreferenceProfile `json:"managed_preference_profiles" xml:"managed_preference_profiles>managed_preference_profile,omitempty"`
+	Count int                             `json:"-" xml:"size"`
+}
+
+// BasicManagedPreferenceProfile holds the basic information for a managed preference profile in Jamf
+type BasicManagedPreferenceProfile struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// ManagedPreferenceProfileDetails holds the details for a single managed preference profile
+type ManagedPreferenceProfileDetails struct {
+	Details *ManagedPreferenceProfile `json:"managed_preference_profile"`
+}
+
+// ManagedPreferenceProfile represents an individual MCX managed preference profile in Jamf
+type ManagedPreferenceProfile struct {
+	XMLName xml.Name                         `json:"-" xml:"managed_preference_profile,omitempty"`
+	General *ManagedPreferenceProfileGeneral `json:"general" xml:"general,omitempty"`
+	Scope   *Scope                           `json:"scope,omitempty" xml:"scope,omitempty"`
+	Plist   string                           `json:"plist,omitempty" xml:"plist,omitempty"`
+}
+
+// ManagedPreferenceProfileGeneral holds the general settings of a managed preference profile
+type ManagedPreferenceProfileGeneral struct {
+	ID      int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string `json:"name" xml:"name,omitempty"`
+	Enabled bool   `json:"enabled" xml:"enabled"`
+	Site    *Site  `json:"site,omitempty" xml:"site,omitempty"`
+}
@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var MANAGED_PREF_PROFILES_API_BASE_ENDPOINT = "/JSSResource/managedpreferenceprofiles"
+
+func managedPreferenceProfileResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case MANAGED_PREF_PROFILES_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"managed_preference_profiles": [
+					{
+							"id": 1,
+							"name": "Login Window"
+					},
+					{
+							"id": 2,
+							"name": "Energy Saver"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", MANAGED_PREF_PROFILES_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", MANAGED_PREF_PROFILES_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Login%sWindow", MANAGED_PREF_PROFILES_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				profileContents := &jamf.ManagedPreferenceProfile{}
+				err = xml.Unmarshal(data, profileContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				profileData, err := json.MarshalIndent(profileContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(profileData))
+			default:
+				mockProfile := &jamf.ManagedPreferenceProfileDetails{
+					Details: &jamf.ManagedPreferenceProfile{
+						General: &jamf.ManagedPreferenceProfileGeneral{
+							ID:      1,
+							Name:    "Login Window",
+							Enabled: true,
+						},
+						Scope: &jamf.Scope{
+							AllComputers: true,
+						},
+						Plist: "<plist version=\"1.0\"><dict/></plist>",
+					},
+				}
+
+				var (
+					profileData []byte
+					err         error
+				)
+
+				if r.Method == "DELETE" {
+					profileData, err = json.MarshalIndent(mockProfile.Details, "", "    ")
+				} else {
+					profileData, err = json.MarshalIndent(mockProfile, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(profileData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllManagedPreferenceProfiles(t *testing.T) {
+	testServer := managedPreferenceProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	profiles, err := j.ManagedPreferenceProfiles()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(profiles))
+	assert.Equal(t, 2, profiles[1].ID)
+	assert.Equal(t, "Energy Saver", profiles[1].Name)
+}
+
+func TestQuerySpecificManagedPreferenceProfile(t *testing.T) {
+	testServer := managedPreferenceProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	for _, identifier := range []interface{}{1, "Login Window"} {
+		profile, err := j.ManagedPreferenceProfileDetails(identifier)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, profile.Details.General.ID)
+		assert.Equal(t, "Login Window", profile.Details.General.Name)
+		assert.True(t, profile.Details.General.Enabled)
+		assert.True(t, profile.Details.Scope.AllComputers)
+		assert.Contains(t, profile.Details.Plist, "<plist")
+	}
+}
+
+func TestCreateManagedPreferenceProfile(t *testing.T) {
+	testServer := managedPreferenceProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateManagedPreferenceProfile(&jamf.ManagedPreferenceProfile{General: &jamf.ManagedPreferenceProfileGeneral{}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new managed preference profile")
+
+	profile, err := j.CreateManagedPreferenceProfile(&jamf.ManagedPreferenceProfile{
+		General: &jamf.ManagedPreferenceProfileGeneral{
+			Name:    "Dock",
+			Enabled: true,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Dock", profile.General.Name)
+	assert.True(t, profile.General.Enabled)
+}
+
+func TestUpdateManagedPreferenceProfile(t *testing.T) {
+	testServer := managedPreferenceProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	profile, err := j.UpdateManagedPreferenceProfile(1, &jamf.ManagedPreferenceProfile{
+		General: &jamf.ManagedPreferenceProfileGeneral{
+			Enabled: false,
+		},
+	})
+	assert.Nil(t, err)
+	assert.False(t, profile.General.Enabled)
+}
+
+func TestDeleteManagedPreferenceProfile(t *testing.T) {
+	testServer := managedPreferenceProfileResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteManagedPreferenceProfile(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.General.ID)
+}
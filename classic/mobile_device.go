@@ -0,0 +1,32 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// MatchMobileDevices returns the mobile devices whose name, serial number, MAC address, username or other
+// identifying information matches term, "*" matches any characters as in the search of the Jamf Pro interface
+func (j *Client) MatchMobileDevices(term string) ([]BasicMobileDeviceInfo, error) {
+	return j.matchMobileDevices(context.Background(), term)
+}
+
+func (j *Client) matchMobileDevices(ctx context.Context, term string) ([]BasicMobileDeviceInfo, error) {
+	ep := fmt.Sprintf("%s/%s/match/%s", j.Endpoint, mobileDevicesContext, url.PathEscape(term))
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF mobile device match request for: %s", term)
+	}
+
+	res := &MobileDeviceMatches{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile devices matching %s from %s", term, ep)
+	}
+	return res.List, nil
+}
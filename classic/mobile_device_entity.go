@@ -10,6 +10,11 @@ type MobileDevices struct {
 	Count int            `json:"-" xml:"size"`
 }
 
+// MobileDeviceMatches represents the list of mobile devices matching a search in Jamf
+type MobileDeviceMatches struct {
+	List []BasicMobileDeviceInfo `json:"mobile_devices"`
+}
+
 // BasicComputerInfo represents the information returned in a list of all computers from Jamf
 type BasicMobileDeviceInfo struct {
 	GeneralDeviceInformation
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchMobileDevices(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/JSSResource/mobiledevices/match/%2AiPad%2A", r.RequestURI)
+		fmt.Fprint(w, `{"mobile_devices": [{"id": 7, "name": "Zoom Room iPad", "serial_number": "DMPZ1234", "username": "rooms"}]}`)
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	devices, err := j.MatchMobileDevices("*iPad*")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(devices))
+	assert.Equal(t, 7, devices[0].ID)
+	assert.Equal(t, "DMPZ1234", devices[0].SerialNumber)
+	assert.Equal(t, "rooms", devices[0].Username)
+}
@@ -0,0 +1,138 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// NetbootServers returns all NetBoot servers
+func (j *Client) NetbootServers() ([]BasicNetbootServer, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, netbootServersContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF NetBoot servers query request")
+	}
+
+	res := &NetbootServers{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query NetBoot servers from %s", ep)
+	}
+	return res.List, nil
+}
+
+// NetbootServerDetails returns the details for a specific NetBoot server given its ID or Name
+func (j *Client) NetbootServerDetails(identifier interface{}) (*NetbootServerDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, netbootServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for NetBoot server: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for NetBoot server: %v", identifier)
+	}
+
+	res := NetbootServerDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query NetBoot server with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateNetbootServer will create a new NetBoot server in Jamf
+func (j *Client) CreateNetbootServer(content *NetbootServer) (*NetbootServer, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, netbootServersContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new NetBoot server")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for NetBoot server: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new NetBoot server"), "unable to process JAMF creation request for NetBoot server: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for NetBoot server: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for NetBoot server: %v (%s)", content.Name, ep)
+	}
+
+	res := NetbootServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for NetBoot server %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateNetbootServer will update a NetBoot server in Jamf by either ID or Name
+func (j *Client) UpdateNetbootServer(identifier interface{}, content *NetbootServer) (*NetbootServer, error) {
+	ep, err := EndpointBuilder(j.Endpoint, netbootServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for NetBoot server: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for NetBoot server: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for NetBoot server: %v (%s)", identifier, ep)
+	}
+
+	res := NetbootServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for NetBoot server: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateNetbootServerByName will update a NetBoot server in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateNetbootServerByName(name string, content *NetbootServer) (*NetbootServer, error) {
+	return j.UpdateNetbootServer(name, content)
+}
+
+// DeleteNetbootServer will delete a NetBoot server by either ID or Name
+func (j *Client) DeleteNetbootServer(identifier interface{}) (*NetbootServer, error) {
+	ep, err := EndpointBuilder(j.Endpoint, netbootServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for NetBoot server: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for NetBoot server %v", identifier)
+	}
+
+	res := NetbootServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for NetBoot server %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteNetbootServerByName will delete a NetBoot server by name, which is escaped in the request path
+func (j *Client) DeleteNetbootServerByName(name string) (*NetbootServer, error) {
+	return j.DeleteNetbootServer(name)
+}
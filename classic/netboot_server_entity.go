@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// NetbootServers represents a list of NetBoot servers in Jamf
+type NetbootServers struct {
+	List  []BasicNetbootServer `json:"netboot_servers" xml:"netboot_servers>netboot_server,omitempty"`
+	Count int                  `json:"-" xml:"size"`
+}
+
+// BasicNetbootServer holds the basic information for a NetBoot server in Jamf
+type BasicNetbootServer struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// NetbootServerDetails holds the details for a single NetBoot server
+type NetbootServerDetails struct {
+	Details *NetbootServer `json:"netboot_server"`
+}
+
+// NetbootServer represents a NetBoot/NetInstall server configured in Jamf
+type NetbootServer struct {
+	XMLName              xml.Name `json:"-" xml:"netboot_server,omitempty"`
+	ID                   int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name                 string   `json:"name" xml:"name,omitempty"`
+	IPAddress            string   `json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	DefaultImage         bool     `json:"default_image" xml:"default_image"`
+	SpecificArchitecture string   `json:"specific_architecture,omitempty" xml:"specific_architecture,omitempty"`
+	TargetPlatform       string   `json:"target_platform,omitempty" xml:"target_platform,omitempty"`
+	SharePoint           string   `json:"share_point,omitempty" xml:"share_point,omitempty"`
+	Set                  string   `json:"set,omitempty" xml:"set,omitempty"`
+	Image                string   `json:"image,omitempty" xml:"image,omitempty"`
+	Protocol             string   `json:"protocol,omitempty" xml:"protocol,omitempty"`
+	ConfigureManually    bool     `json:"configure_manually" xml:"configure_manually"`
+	BootArgs             string   `json:"boot_args,omitempty" xml:"boot_args,omitempty"`
+	BootFile             string   `json:"boot_file,omitempty" xml:"boot_file,omitempty"`
+	BootDevice           string   `json:"boot_device,omitempty" xml:"boot_device,omitempty"`
+}
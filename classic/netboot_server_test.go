@@ -0,0 +1,165 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var NETBOOT_SERVERS_API_BASE_ENDPOINT = "/JSSResource/netbootservers"
+
+func netbootServerResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case NETBOOT_SERVERS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"netboot_servers": [
+					{
+							"id": 1,
+							"name": "Imaging Lab"
+					},
+					{
+							"id": 2,
+							"name": "NetInstall 10.13"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", NETBOOT_SERVERS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", NETBOOT_SERVERS_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				netbootContents := &jamf.NetbootServer{}
+				err = xml.Unmarshal(data, netbootContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				netbootData, err := json.MarshalIndent(netbootContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(netbootData))
+			default:
+				mockNetbootServer := &jamf.NetbootServerDetails{
+					Details: &jamf.NetbootServer{
+						ID:           1,
+						Name:         "Imaging Lab",
+						IPAddress:    "10.1.1.5",
+						DefaultImage: true,
+						SharePoint:   "NetBootSP0",
+						Set:          "NetInstall.nbi",
+						Image:        "NetInstall.dmg",
+						Protocol:     "nfs",
+					},
+				}
+
+				var (
+					netbootData []byte
+					err         error
+				)
+
+				if r.Method == "DELETE" {
+					netbootData, err = json.MarshalIndent(mockNetbootServer.Details, "", "    ")
+				} else {
+					netbootData, err = json.MarshalIndent(mockNetbootServer, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(netbootData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllNetbootServers(t *testing.T) {
+	testServer := netbootServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	netboots, err := j.NetbootServers()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(netboots))
+	assert.Equal(t, 2, netboots[1].ID)
+	assert.Equal(t, "NetInstall 10.13", netboots[1].Name)
+}
+
+func TestQuerySpecificNetbootServer(t *testing.T) {
+	testServer := netbootServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	netboot, err := j.NetbootServerDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "Imaging Lab", netboot.Details.Name)
+	assert.True(t, netboot.Details.DefaultImage)
+	assert.Equal(t, "NetInstall.nbi", netboot.Details.Set)
+	assert.Equal(t, "nfs", netboot.Details.Protocol)
+}
+
+func TestCreateNetbootServer(t *testing.T) {
+	testServer := netbootServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateNetbootServer(&jamf.NetbootServer{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new NetBoot server")
+
+	netboot, err := j.CreateNetbootServer(&jamf.NetbootServer{
+		Name:              "NetInstall 10.13",
+		IPAddress:         "10.1.1.6",
+		ConfigureManually: true,
+		BootArgs:          "-v",
+		BootFile:          "booter",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "NetInstall 10.13", netboot.Name)
+	assert.True(t, netboot.ConfigureManually)
+	assert.Equal(t, "booter", netboot.BootFile)
+}
+
+func TestUpdateNetbootServer(t *testing.T) {
+	testServer := netbootServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	netboot, err := j.UpdateNetbootServer(1, &jamf.NetbootServer{
+		Protocol: "http",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "http", netboot.Protocol)
+}
+
+func TestDeleteNetbootServer(t *testing.T) {
+	testServer := netbootServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteNetbootServer(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.ID)
+}
@@ -16,7 +16,7 @@ type Package struct {
 	ID            int      `json:"id,omitempty" xml:"id,omitempty"`
 	Name          string   `json:"name" xml:"name,omitempty"`
 	Action        string   `json:"action" xml:"action,omitempty"`
-	FUT           bool     `json:"fut" xml:"fut,omitempty"`
-	FEU           bool     `json:"feu" xml:"feu,omitempty"`
-	UpdateAutorun bool     `json:"update_autorun" xml:"update_autorun,omitempty"`
+	FUT           bool     `json:"fut" xml:"fut"`
+	FEU           bool     `json:"feu" xml:"feu"`
+	UpdateAutorun bool     `json:"update_autorun" xml:"update_autorun"`
 }
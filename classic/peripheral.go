@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Peripherals returns all peripherals
+func (j *Client) Peripherals() ([]BasicPeripheral, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, peripheralsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF peripherals query request")
+	}
+
+	res := &Peripherals{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query peripherals from %s", ep)
+	}
+	return res.List, nil
+}
+
+// PeripheralDetails returns the details for a specific peripheral given its ID
+func (j *Client) PeripheralDetails(identifier interface{}) (*PeripheralDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for peripheral: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral: %v", identifier)
+	}
+
+	res := PeripheralDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query peripheral with ID %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreatePeripheral will create a new peripheral in Jamf
+func (j *Client) CreatePeripheral(content *Peripheral) (*Peripheral, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, peripheralsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new peripheral")
+	}
+
+	if content == nil || content.General == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for peripheral: (%s)", ep)
+	}
+
+	if content.General.Type == "" {
+		return nil, errors.Wrapf(fmt.Errorf("type required for new peripheral"), "unable to process JAMF creation request for peripheral: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for peripheral: %v", content.General.Type)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for peripheral: %v (%s)", content.General.Type, ep)
+	}
+
+	res := Peripheral{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for peripheral %v on %s", content.General.Type, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdatePeripheral will update a peripheral in Jamf by ID
+func (j *Client) UpdatePeripheral(identifier interface{}, content *Peripheral) (*Peripheral, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for peripheral: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for peripheral: %v (%s)", identifier, ep)
+	}
+
+	res := Peripheral{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for peripheral: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeletePeripheral will delete a peripheral by ID
+func (j *Client) DeletePeripheral(identifier interface{}) (*Peripheral, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for peripheral %v", identifier)
+	}
+
+	res := Peripheral{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for peripheral %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
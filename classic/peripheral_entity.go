@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// Peripherals represents a list of peripherals in Jamf
+type Peripherals struct {
+	List  []BasicPeripheral `json:"peripherals" xml:"peripherals>peripheral,omitempty"`
+	Count int               `json:"-" xml:"size"`
+}
+
+// BasicPeripheral holds the basic information for a peripheral in Jamf
+type BasicPeripheral struct {
+	ID       int    `json:"id,omitempty" xml:"id,omitempty"`
+	Type     string `json:"type,omitempty" xml:"type,omitempty"`
+	BarCode1 string `json:"bar_code_1,omitempty" xml:"bar_code_1,omitempty"`
+	BarCode2 string `json:"bar_code_2,omitempty" xml:"bar_code_2,omitempty"`
+}
+
+// PeripheralDetails holds the details for a single peripheral
+type PeripheralDetails struct {
+	Details *Peripheral `json:"peripheral"`
+}
+
+// Peripheral represents a legacy peripheral (monitor, dock, etc.) tracked in Jamf inventory
+type Peripheral struct {
+	XMLName     xml.Name               `json:"-" xml:"peripheral,omitempty"`
+	General     *PeripheralGeneral     `json:"general,omitempty" xml:"general,omitempty"`
+	Location    *LocationInformation   `json:"location,omitempty" xml:"location,omitempty"`
+	Purchasing  *PeripheralPurchasing  `json:"purchasing,omitempty" xml:"purchasing,omitempty"`
+	Attachments []PeripheralAttachment `json:"attachments,omitempty" xml:"-"`
+}
+
+// PeripheralGeneral holds the general information for a peripheral
+type PeripheralGeneral struct {
+	ID         int               `json:"id,omitempty" xml:"id,omitempty"`
+	Type       string            `json:"type" xml:"type,omitempty"`
+	BarCode1   string            `json:"bar_code_1,omitempty" xml:"bar_code_1,omitempty"`
+	BarCode2   string            `json:"bar_code_2,omitempty" xml:"bar_code_2,omitempty"`
+	ComputerID int               `json:"computer_id,omitempty" xml:"computer_id,omitempty"`
+	Fields     []PeripheralField `json:"fields,omitempty" xml:"fields>field,omitempty"`
+}
+
+// PeripheralField holds the value of a single custom field defined by the peripheral type
+type PeripheralField struct {
+	Name  string `json:"name" xml:"name"`
+	Value string `json:"value" xml:"value"`
+}
+
+// PeripheralPurchasing holds the purchasing information for a peripheral
+type PeripheralPurchasing struct {
+	IsPurchased       bool     `json:"is_purchased" xml:"is_purchased"`
+	IsLeased          bool     `json:"is_leased" xml:"is_leased"`
+	PONumber          string   `json:"po_number,omitempty" xml:"po_number,omitempty"`
+	Vendor            string   `json:"vendor,omitempty" xml:"vendor,omitempty"`
+	ApplecareID       string   `json:"applecare_id,omitempty" xml:"applecare_id,omitempty"`
+	PurchasePrice     string   `json:"purchase_price,omitempty" xml:"purchase_price,omitempty"`
+	PurchasingAccount string   `json:"purchasing_account,omitempty" xml:"purchasing_account,omitempty"`
+	PODate            JamfTime `json:"po_date,omitempty" xml:"po_date,omitempty"`
+	WarrantyExpires   JamfTime `json:"warranty_expires,omitempty" xml:"warranty_expires,omitempty"`
+	LeaseExpires      JamfTime `json:"lease_expires,omitempty" xml:"lease_expires,omitempty"`
+	LifeExpectancy    int      `json:"life_expectancy,omitempty" xml:"life_expectancy,omitempty"`
+	PurchasingContact string   `json:"purchasing_contact,omitempty" xml:"purchasing_contact,omitempty"`
+}
+
+// PeripheralAttachment holds the information for a file attached to a peripheral
+type PeripheralAttachment struct {
+	ID       int    `json:"id,omitempty" xml:"id,omitempty"`
+	Filename string `json:"filename,omitempty" xml:"filename,omitempty"`
+	URI      string `json:"uri,omitempty" xml:"uri,omitempty"`
+}
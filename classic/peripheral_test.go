@@ -0,0 +1,177 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var PERIPHERAL_API_BASE_ENDPOINT = "/JSSResource/peripherals"
+
+func peripheralResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case PERIPHERAL_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"peripherals": [
+					{
+							"id": 1,
+							"type": "Monitor"
+					},
+					{
+							"id": 2,
+							"type": "Dock"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", PERIPHERAL_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", PERIPHERAL_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				peripheralContents := &jamf.Peripheral{}
+				err = xml.Unmarshal(data, peripheralContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				peripheralData, err := json.MarshalIndent(peripheralContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(peripheralData))
+			default:
+				mockPeripheral := &jamf.PeripheralDetails{
+					Details: &jamf.Peripheral{
+						General: &jamf.PeripheralGeneral{
+							ID:         2,
+							Type:       "Dock",
+							BarCode1:   "DK-1002",
+							ComputerID: 14,
+							Fields: []jamf.PeripheralField{
+								{Name: "Model", Value: "TB4"},
+							},
+						},
+						Location: &jamf.LocationInformation{
+							Username: "jdoe",
+						},
+					},
+				}
+
+				var (
+					peripheralData []byte
+					err            error
+				)
+
+				if r.Method == "DELETE" {
+					peripheralData, err = json.MarshalIndent(mockPeripheral.Details, "", "    ")
+				} else {
+					peripheralData, err = json.MarshalIndent(mockPeripheral, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(peripheralData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllPeripherals(t *testing.T) {
+	testServer := peripheralResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripherals, err := j.Peripherals()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(peripherals))
+	assert.Equal(t, 2, peripherals[1].ID)
+	assert.Equal(t, "Dock", peripherals[1].Type)
+}
+
+func TestQuerySpecificPeripheral(t *testing.T) {
+	testServer := peripheralResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripheral, err := j.PeripheralDetails(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, peripheral.Details.General.ID)
+	assert.Equal(t, "Dock", peripheral.Details.General.Type)
+	assert.Equal(t, "DK-1002", peripheral.Details.General.BarCode1)
+	assert.Equal(t, 14, peripheral.Details.General.ComputerID)
+	assert.Equal(t, "TB4", peripheral.Details.General.Fields[0].Value)
+	assert.Equal(t, "jdoe", peripheral.Details.Location.Username)
+}
+
+func TestCreatePeripheral(t *testing.T) {
+	testServer := peripheralResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreatePeripheral(&jamf.Peripheral{General: &jamf.PeripheralGeneral{BarCode1: "MN-2001"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "type required")
+
+	peripheral, err := j.CreatePeripheral(&jamf.Peripheral{
+		General: &jamf.PeripheralGeneral{
+			Type:     "Monitor",
+			BarCode1: "MN-2001",
+			Fields: []jamf.PeripheralField{
+				{Name: "Size", Value: "27"},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Monitor", peripheral.General.Type)
+	assert.Equal(t, "MN-2001", peripheral.General.BarCode1)
+	assert.Equal(t, "27", peripheral.General.Fields[0].Value)
+}
+
+func TestUpdatePeripheral(t *testing.T) {
+	testServer := peripheralResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripheral, err := j.UpdatePeripheral(2, &jamf.Peripheral{
+		General: &jamf.PeripheralGeneral{
+			Type:       "Dock",
+			ComputerID: 22,
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Dock", peripheral.General.Type)
+	assert.Equal(t, 22, peripheral.General.ComputerID)
+}
+
+func TestDeletePeripheral(t *testing.T) {
+	testServer := peripheralResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeletePeripheral(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.General.ID)
+}
@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// PeripheralTypes returns all peripheral types
+func (j *Client) PeripheralTypes() ([]BasicPeripheralType, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, peripheralTypesContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF peripheral types query request")
+	}
+
+	res := &PeripheralTypes{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query peripheral types from %s", ep)
+	}
+	return res.List, nil
+}
+
+// PeripheralTypeDetails returns the details for a specific peripheral type given its ID
+func (j *Client) PeripheralTypeDetails(identifier interface{}) (*PeripheralTypeDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralTypesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for peripheral type: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral type: %v", identifier)
+	}
+
+	res := PeripheralTypeDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query peripheral type with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreatePeripheralType will create a new peripheral type in Jamf
+func (j *Client) CreatePeripheralType(content *PeripheralType) (*PeripheralType, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, peripheralTypesContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new peripheral type")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for peripheral type: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new peripheral type"), "unable to process JAMF creation request for peripheral type: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for peripheral type: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for peripheral type: %v (%s)", content.Name, ep)
+	}
+
+	res := PeripheralType{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for peripheral type %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdatePeripheralType will update a peripheral type in Jamf by ID
+func (j *Client) UpdatePeripheralType(identifier interface{}, content *PeripheralType) (*PeripheralType, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralTypesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral type: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for peripheral type: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for peripheral type: %v (%s)", identifier, ep)
+	}
+
+	res := PeripheralType{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for peripheral type: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeletePeripheralType will delete a peripheral type by ID
+func (j *Client) DeletePeripheralType(identifier interface{}) (*PeripheralType, error) {
+	ep, err := EndpointBuilder(j.Endpoint, peripheralTypesContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for peripheral type: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for peripheral type %v", identifier)
+	}
+
+	res := PeripheralType{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for peripheral type %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// Peripheral field types supported by Jamf
+const (
+	PeripheralFieldTypeText = "text"
+	PeripheralFieldTypeMenu = "menu"
+)
+
+// PeripheralTypes represents a list of peripheral types in Jamf
+type PeripheralTypes struct {
+	List  []BasicPeripheralType `json:"peripheral_types" xml:"peripheral_types>peripheral_type,omitempty"`
+	Count int                   `json:"-" xml:"size"`
+}
+
+// BasicPeripheralType holds the basic information for a peripheral type in Jamf
+type BasicPeripheralType struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// PeripheralTypeDetails holds the details for a single peripheral type
+type PeripheralTypeDetails struct {
+	Details *PeripheralType `json:"peripheral_type"`
+}
+
+// PeripheralType represents a category of peripheral and the custom fields collected for it
+type PeripheralType struct {
+	XMLName xml.Name              `json:"-" xml:"peripheral_type,omitempty"`
+	ID      int                   `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string                `json:"name" xml:"name,omitempty"`
+	Fields  []PeripheralTypeField `json:"fields,omitempty" xml:"fields>field,omitempty"`
+}
+
+// PeripheralTypeField holds the definition of a custom field for a peripheral type
+type PeripheralTypeField struct {
+	Order   int      `json:"order" xml:"order"`
+	Label   string   `json:"label" xml:"label"`
+	Type    string   `json:"type" xml:"type"`
+	Choices []string `json:"choices,omitempty" xml:"choices>choice,omitempty"`
+}
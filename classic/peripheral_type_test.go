@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var PERIPHERAL_TYPE_API_BASE_ENDPOINT = "/JSSResource/peripheraltypes"
+
+func peripheralTypeResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case PERIPHERAL_TYPE_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"peripheral_types": [
+					{
+							"id": 1,
+							"name": "Monitor"
+					},
+					{
+							"id": 2,
+							"name": "Docking Station"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/2", PERIPHERAL_TYPE_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", PERIPHERAL_TYPE_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Docking%sStation", PERIPHERAL_TYPE_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				peripheralTypeContents := &jamf.PeripheralType{}
+				err = xml.Unmarshal(data, peripheralTypeContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				peripheralTypeData, err := json.MarshalIndent(peripheralTypeContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(peripheralTypeData))
+			default:
+				mockPeripheralType := &jamf.PeripheralTypeDetails{
+					Details: &jamf.PeripheralType{
+						ID:   2,
+						Name: "Docking Station",
+						Fields: []jamf.PeripheralTypeField{
+							{Order: 1, Label: "Model", Type: jamf.PeripheralFieldTypeMenu, Choices: []string{"TB3", "TB4"}},
+						},
+					},
+				}
+
+				var (
+					peripheralTypeData []byte
+					err                error
+				)
+
+				if r.Method == "DELETE" {
+					peripheralTypeData, err = json.MarshalIndent(mockPeripheralType.Details, "", "    ")
+				} else {
+					peripheralTypeData, err = json.MarshalIndent(mockPeripheralType, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(peripheralTypeData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllPeripheralTypes(t *testing.T) {
+	testServer := peripheralTypeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripheralTypes, err := j.PeripheralTypes()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(peripheralTypes))
+	assert.Equal(t, 2, peripheralTypes[1].ID)
+	assert.Equal(t, "Docking Station", peripheralTypes[1].Name)
+}
+
+func TestQuerySpecificPeripheralType(t *testing.T) {
+	testServer := peripheralTypeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripheralType, err := j.PeripheralTypeDetails("Docking Station")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, peripheralType.Details.ID)
+	assert.Equal(t, "Docking Station", peripheralType.Details.Name)
+	assert.Equal(t, jamf.PeripheralFieldTypeMenu, peripheralType.Details.Fields[0].Type)
+	assert.Equal(t, []string{"TB3", "TB4"}, peripheralType.Details.Fields[0].Choices)
+}
+
+func TestCreatePeripheralType(t *testing.T) {
+	testServer := peripheralTypeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreatePeripheralType(&jamf.PeripheralType{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required")
+
+	peripheralType, err := j.CreatePeripheralType(&jamf.PeripheralType{
+		Name: "Projector",
+		Fields: []jamf.PeripheralTypeField{
+			{Order: 1, Label: "Lumens", Type: jamf.PeripheralFieldTypeText},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Projector", peripheralType.Name)
+	assert.Equal(t, "Lumens", peripheralType.Fields[0].Label)
+}
+
+func TestUpdatePeripheralType(t *testing.T) {
+	testServer := peripheralTypeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	peripheralType, err := j.UpdatePeripheralType(2, &jamf.PeripheralType{
+		Name: "Dock",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Dock", peripheralType.Name)
+}
+
+func TestDeletePeripheralType(t *testing.T) {
+	testServer := peripheralTypeResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeletePeripheralType(2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, removed.ID)
+}
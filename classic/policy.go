@@ -8,15 +8,20 @@ import (
 	"context"
 	"encoding/xml"
 	"fmt"
+	"iter"
 	"net/http"
 
 	"github.com/pkg/errors"
 )
 
 // Policies returns a list of policies available in the jamf client
-func (j *Client) Policies() ([]BasicPolicyInformation, error) {
+func (j *Client) Policies() (PolicyList, error) {
+	return j.policies(context.Background())
+}
+
+func (j *Client) policies(ctx context.Context) (PolicyList, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, policiesContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building Jamf policies query request")
 	}
@@ -46,6 +51,12 @@ func (j *Client) PolicyDetails(identifier interface{}) (*Policy, error) {
 	return &res, nil
 }
 
+// IterPolicies yields the details of every policy, requesting them one at a time as they are consumed.
+// Canceling ctx ends the iteration.
+func (j *Client) IterPolicies(ctx context.Context) iter.Seq2[*Policy, error] {
+	return iterDetails(ctx, j.Policies, func(p BasicPolicyInformation) int { return p.ID }, j.PolicyDetails)
+}
+
 // UpdatePolicy will update a policy in Jamf by either ID or Name
 func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*PolicyContents, error) {
 	ep, err := EndpointBuilder(j.Endpoint, policiesContext, identifier)
@@ -53,6 +64,16 @@ func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*
 		return nil, errors.Wrapf(err, "error building JAMF query request for policy: %v", identifier)
 	}
 
+	if j.Resolver != nil {
+		if err := j.Resolver.ResolvePolicy(policy); err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve the references of policy: %v", identifier)
+		}
+	}
+
+	if err := ValidatePolicy(policy); err != nil {
+		return nil, errors.Wrapf(err, "policy validation failed: %v", identifier)
+	}
+
 	if len(policy.Scripts) > 0 {
 		policy.ScriptCount = len(policy.Scripts)
 		// Priority is required so we will default to After
@@ -82,6 +103,11 @@ func (j *Client) UpdatePolicy(identifier interface{}, policy *PolicyContents) (*
 	return &res, nil
 }
 
+// UpdatePolicyByName will update a policy in Jamf by name, which is escaped in the request path
+func (j *Client) UpdatePolicyByName(name string, policy *PolicyContents) (*PolicyContents, error) {
+	return j.UpdatePolicy(name, policy)
+}
+
 // CreatePolicy will create a policy in Jamf
 func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error) {
 	// -1 denotes the next available ID
@@ -94,6 +120,16 @@ func (j *Client) CreatePolicy(content *PolicyContents) (*PolicyContents, error)
 		return nil, errors.Wrapf(fmt.Errorf("name required for new policy"), "unable to process JAMF creation request for policy: (%s)", ep)
 	}
 
+	if j.Resolver != nil {
+		if err := j.Resolver.ResolvePolicy(content); err != nil {
+			return nil, errors.Wrapf(err, "unable to resolve the references of policy: %v", content.General.Name)
+		}
+	}
+
+	if err := ValidatePolicy(content); err != nil {
+		return nil, errors.Wrapf(err, "policy validation failed: %v", content.General.Name)
+	}
+
 	if len(content.Scripts) > 0 {
 		content.ScriptCount = len(content.Scripts)
 		// Priority is required so we will default to After
@@ -142,3 +178,8 @@ func (j *Client) DeletePolicy(identifier interface{}) (*PolicyGeneral, error) {
 
 	return &res, nil
 }
+
+// DeletePolicyByName will delete a policy by name, which is escaped in the request path
+func (j *Client) DeletePolicyByName(name string) (*PolicyGeneral, error) {
+	return j.DeletePolicy(name)
+}
@@ -3,13 +3,19 @@
 
 package classic
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"fmt"
+)
 
 // Policies holds all policies in the configured Jamf environment
 type Policies struct {
 	List []BasicPolicyInformation `json:"policies"`
 }
 
+// PolicyList is the list of policies returned by Policies, see Export
+type PolicyList []BasicPolicyInformation
+
 // BasicPolicyInformation holds the basic information for all policies in Jamf
 type BasicPolicyInformation struct {
 	XMLName xml.Name `json:"-" xml:"policy,omitempty"`
@@ -46,22 +52,22 @@ type PolicyGeneral struct {
 	XMLName                   xml.Name                  `json:"-" xml:"general,omitempty"`
 	ID                        int                       `json:"id,omitempty" xml:"id,omitempty"`
 	Name                      string                    `json:"name" xml:"name,omitempty"`
-	Enabled                   bool                      `json:"enabled" xml:"enabled,omitempty"`
-	Trigger                   string                    `json:"trigger" xml:"trigger,omitempty"`
-	TriggerCheckIn            bool                      `json:"trigger_checkin" xml:"trigger_checkin,omitempty"`
-	TriggerEnrollmentComplete bool                      `json:"trigger_enrollment_comlete" xml:"trigger_enrollment_complete,omitempty"`
-	TriggerLogin              bool                      `json:"trigger_login" xml:"trigger_login,omitempty"`
-	TriggerLogout             bool                      `json:"trigger_logout" xml:"trigger_logout,omitempty"`
-	TriggerNetworkStateChange bool                      `json:"trigger_network_state_changed" xml:"trigger_network_state_changed,omitempty"`
-	TriggerStartup            bool                      `json:"trigger_startup" xml:"trigger_startup,omitempty"`
+	Enabled                   bool                      `json:"enabled" xml:"enabled"`
+	Trigger                   PolicyTrigger             `json:"trigger" xml:"trigger,omitempty"`
+	TriggerCheckIn            bool                      `json:"trigger_checkin" xml:"trigger_checkin"`
+	TriggerEnrollmentComplete bool                      `json:"trigger_enrollment_comlete" xml:"trigger_enrollment_complete"`
+	TriggerLogin              bool                      `json:"trigger_login" xml:"trigger_login"`
+	TriggerLogout             bool                      `json:"trigger_logout" xml:"trigger_logout"`
+	TriggerNetworkStateChange bool                      `json:"trigger_network_state_changed" xml:"trigger_network_state_changed"`
+	TriggerStartup            bool                      `json:"trigger_startup" xml:"trigger_startup"`
 	TriggerOther              string                    `json:"trigger_other" xml:"trigger_other,omitempty"`
-	Frequency                 string                    `json:"frequency" xml:"frequency,omitempty"`
-	RetryEvent                string                    `json:"retry_event" xml:"retry_event,omitempty"`
+	Frequency                 PolicyFrequency           `json:"frequency" xml:"frequency,omitempty"`
+	RetryEvent                PolicyRetryEvent          `json:"retry_event" xml:"retry_event,omitempty"`
 	RetryAttempts             int                       `json:"retry_attempts" xml:"retry_attempts,omitempty"`
-	NotifyOnFailedRetry       bool                      `json:"notify_on_each_failed_retry" xml:"notify_on_each_failed_retry,omitempty"`
-	LocationUserOnly          bool                      `json:"location_user_only" xml:"location_user_only,omitempty"`
+	NotifyOnFailedRetry       bool                      `json:"notify_on_each_failed_retry" xml:"notify_on_each_failed_retry"`
+	LocationUserOnly          bool                      `json:"location_user_only" xml:"location_user_only"`
 	TargetDrive               string                    `json:"target_drive" xml:"target_drive,omitempty"`
-	Offline                   bool                      `json:"offline" xml:"offline,omitempty"`
+	Offline                   bool                      `json:"offline" xml:"offline"`
 	NetworkRequirements       string                    `json:"network_requirements" xml:"network_requirements,omitempty"`
 	Category                  *PolicyCategory           `json:"category" xml:"category,omitempty"`
 	DateTimeLimitations       *PolicyDateLimitations    `json:"date_time_limitations" xml:"date_time_limitations,omitempty"`
@@ -100,7 +106,7 @@ type PolicyScriptAssignment struct {
 // PolicyNetworkLimitations holds the network limitations associated with a policy
 type PolicyNetworkLimitations struct {
 	MinimumNetworkConnection string   `json:"minimum_network_connection" xml:"minimum_network_connection,omitempty"`
-	AnyIPAddress             bool     `json:"any_ip_address" xml:"any_ip_address,omitempty"`
+	AnyIPAddress             bool     `json:"any_ip_address" xml:"any_ip_address"`
 	NetworkSegments          []string `json:"network_segments" xml:"network_segments,omitempty"`
 }
 
@@ -108,19 +114,19 @@ type PolicyNetworkLimitations struct {
 type PolicyOverrides struct {
 	TargetDrive       string `json:"target_drive" xml:"target_drive,omitempty"`
 	DistributionPoint string `json:"distribution_point" xml:"distribution_point,omitempty"`
-	ForceAFPSMB       bool   `json:"force_afp_smb" xml:"force_afp_smb,omitempty"`
+	ForceAFPSMB       bool   `json:"force_afp_smb" xml:"force_afp_smb"`
 	SUS               string `json:"sus" xml:"sus,omitempty"`
 	NetbootServer     string `json:"netboot_server" xml:"netboot_server,omitempty"`
 }
 
 // PolicyDateLimitations holds the date/time related config for the policy
 type PolicyDateLimitations struct {
-	ActivationDate      string `json:"activation_date" xml:"activation_date,omitempty"`
-	ActivationDateEPOCH int    `json:"activation_date_epoch" xml:"activation_date_epoch,omitempty"`
-	ActivationDateUTC   string `json:"activation_date_utc" xml:"activation_date_utc,omitempty"`
-	ExpirationDate      string `json:"expiration_date" xml:"expiration_date,omitempty"`
-	ExpirationDateEPOCH int    `json:"expiration_date_epoch" xml:"expiration_date_epoch,omitempty"`
-	ExpirationDateUTC   string `json:"expiration_date_utc" xml:"expiration_date_utc,omitempty"`
+	ActivationDate      JamfTime  `json:"activation_date" xml:"activation_date,omitempty"`
+	ActivationDateEPOCH EpochTime `json:"activation_date_epoch" xml:"activation_date_epoch,omitempty"`
+	ActivationDateUTC   JamfTime  `json:"activation_date_utc" xml:"activation_date_utc,omitempty"`
+	ExpirationDate      JamfTime  `json:"expiration_date" xml:"expiration_date,omitempty"`
+	ExpirationDateEPOCH EpochTime `json:"expiration_date_epoch" xml:"expiration_date_epoch,omitempty"`
+	ExpirationDateUTC   JamfTime  `json:"expiration_date_utc" xml:"expiration_date_utc,omitempty"`
 	NoExecuteOn         struct {
 		Day string `json:"day,omitempty" xml:"day,omitempty"`
 	} `json:"no_execute_on" xml:"no_execute_on,omitempty"`
@@ -128,66 +134,136 @@ type PolicyDateLimitations struct {
 	NoExecuteEnd   string `json:"no_execute_end" xml:"no_execute_end,omitempty"`
 }
 
-// PolicyAccountMaintenance holds information about account changes controlled by this policy
+// PolicyAccountMaintenance holds information about account changes controlled by this policy, the
+// directory bindings and firmware password are only read and never sent to Jamf
 type PolicyAccountMaintenance struct {
-	Account                 []*Account         `json:"accounts"`
-	DirectoryBindings       interface{}        `json:"directory_bindings"`
-	ManagementAccount       *ManagementAccount `json:"management_account"`
-	OpenFirmwareEFIPassword interface{}        `json:"open_firmware_efi_password"`
+	Account                 []*Account         `json:"accounts" xml:"accounts,omitempty"`
+	DirectoryBindings       interface{}        `json:"directory_bindings" xml:"-"`
+	ManagementAccount       *ManagementAccount `json:"management_account" xml:"management_account,omitempty"`
+	OpenFirmwareEFIPassword interface{}        `json:"open_firmware_efi_password" xml:"-"`
 }
 
 // PolicyRebootSettings stores information about how this policy handles reboots
 type PolicyRebootSettings struct {
-	Message                     string `json:"message"`
-	StartupDisk                 string `json:"startup_disk"`
-	SpecifyStartup              string `json:"specify_startup"`
-	NoUserLoggedIn              string `json:"no_user_logged_in"`
-	UserLoggedIn                string `json:"user_logged_in"`
-	MinutesUntilReboot          int    `json:"minutes_until_reboot"`
-	StartRebootTimerImmediately bool   `json:"start_reboot_timer_immediately"`
-	FileVaultReboot             bool   `json:"file_value_2_reboot"`
+	Message                     string          `json:"message" xml:"message"`
+	StartupDisk                 string          `json:"startup_disk" xml:"startup_disk,omitempty"`
+	SpecifyStartup              string          `json:"specify_startup" xml:"specify_startup,omitempty"`
+	NoUserLoggedIn              RestartBehavior `json:"no_user_logged_in" xml:"no_user_logged_in,omitempty"`
+	UserLoggedIn                RestartBehavior `json:"user_logged_in" xml:"user_logged_in,omitempty"`
+	MinutesUntilReboot          int             `json:"minutes_until_reboot" xml:"minutes_until_reboot"`
+	StartRebootTimerImmediately bool            `json:"start_reboot_timer_immediately" xml:"start_reboot_timer_immediately"`
+	FileVaultReboot             bool            `json:"file_value_2_reboot" xml:"file_vault_2_reboot"`
 }
 
 // PolicyMaintenance defines how jamf handles this policy long term
 type PolicyMaintenance struct {
-	Recon                    bool `json:"recon"`
-	ResetName                bool `json:"reset_name"`
-	InstallAllCachedPackages bool `json:"install_all_cached_packages"`
-	Heal                     bool `json:"heal"`
-	PreBindings              bool `json:"prebindings"`
-	Permissons               bool `json:"permissions"`
-	ByHost                   bool `json:"byhost"`
-	SystemCache              bool `json:"system_cache"`
-	UserCache                bool `json:"user_cache"`
-	Verify                   bool `json:"verify"`
+	Recon                    bool `json:"recon" xml:"recon"`
+	ResetName                bool `json:"reset_name" xml:"reset_name"`
+	InstallAllCachedPackages bool `json:"install_all_cached_packages" xml:"install_all_cached_packages"`
+	Heal                     bool `json:"heal" xml:"heal"`
+	PreBindings              bool `json:"prebindings" xml:"prebindings"`
+	Permissons               bool `json:"permissions" xml:"permissions"`
+	ByHost                   bool `json:"byhost" xml:"byhost"`
+	SystemCache              bool `json:"system_cache" xml:"system_cache"`
+	UserCache                bool `json:"user_cache" xml:"user_cache"`
+	Verify                   bool `json:"verify" xml:"verify"`
 }
 
 // PolicyFileProcesses holds information about the files processed when this policy is executed
 type PolicyFileProcesses struct {
-	SearchPatch      string `json:"search_by_path"`
-	DeleteFile       bool   `json:"delete_file"`
-	LocateFile       string `json:"locate_file"`
-	UpdateLocateDB   bool   `json:"update_locate_database"`
-	SpotlightSearch  string `json:"spotlight_search"`
-	SearchFroProcess string `json:"search_for_process"`
-	KillProcess      bool   `json:"kill_process"`
-	RunCommand       string `json:"run_command"`
+	SearchPatch      string `json:"search_by_path" xml:"search_by_path"`
+	DeleteFile       bool   `json:"delete_file" xml:"delete_file"`
+	LocateFile       string `json:"locate_file" xml:"locate_file"`
+	UpdateLocateDB   bool   `json:"update_locate_database" xml:"update_locate_database"`
+	SpotlightSearch  string `json:"spotlight_search" xml:"spotlight_search"`
+	SearchFroProcess string `json:"search_for_process" xml:"search_for_process"`
+	KillProcess      bool   `json:"kill_process" xml:"kill_process"`
+	RunCommand       string `json:"run_command" xml:"run_command"`
 }
 
 // PolicyUserInteraction holds the settings associated with user interaction when the policy runs
 type PolicyUserInteraction struct {
-	MessageStart           string `json:"message_start"`
-	MessageFinish          string `json:"message_finish"`
-	AllowUserDefer         bool   `json:"allow_user_to_defer"`
-	AllowUserDeferUntilUTC string `json:"allow_deferral_until_utc"`
-	AllowUSerDeferMinutes  int    `json:"allow_deferral_minutes"`
+	MessageStart           string   `json:"message_start" xml:"message_start"`
+	MessageFinish          string   `json:"message_finish" xml:"message_finish"`
+	AllowUserDefer         bool     `json:"allow_user_to_defer" xml:"allow_users_to_defer"`
+	AllowUserDeferUntilUTC JamfTime `json:"allow_deferral_until_utc" xml:"allow_deferral_until_utc,omitempty"`
+	AllowUSerDeferMinutes  int      `json:"allow_deferral_minutes" xml:"allow_deferral_minutes"`
 }
 
 // PolicyDiskEncryption holds information about disk encryption settings when executed
 type PolicyDiskEncryption struct {
-	Action                       string `json:"action"`
-	DiskEncryptionConfigID       int    `json:"disk_encryption_configuration_id"`
-	AuthRestart                  bool   `json:"auth_restart"`
-	RemediateKeyType             string `json:"remediate_key_type"`
-	RemediateDiskEncryptConfigID int    `json:"remediate_disk_encryption_configuration_id"`
+	Action                       string `json:"action" xml:"action"`
+	DiskEncryptionConfigID       int    `json:"disk_encryption_configuration_id" xml:"disk_encryption_configuration_id,omitempty"`
+	AuthRestart                  bool   `json:"auth_restart" xml:"auth_restart"`
+	RemediateKeyType             string `json:"remediate_key_type" xml:"remediate_key_type,omitempty"`
+	RemediateDiskEncryptConfigID int    `json:"remediate_disk_encryption_configuration_id" xml:"remediate_disk_encryption_configuration_id,omitempty"`
+}
+
+// ValidatePolicy will validate that the settings of a policy are consistent before it is sent to Jamf,
+// which otherwise rejects inconsistent policies with a 409 Conflict that does not say what is wrong.
+// Only the sections that are set are validated so that partial updates are accepted.
+func ValidatePolicy(policy *PolicyContents) error {
+	if general := policy.General; general != nil {
+		for _, option := range []interface{ Validate() error }{general.Trigger, general.Frequency, general.RetryEvent} {
+			if err := option.Validate(); err != nil {
+				return err
+			}
+		}
+
+		if general.RetryAttempts > 0 && (general.RetryEvent == "" || general.RetryEvent == RetryEventNone) {
+			return fmt.Errorf("a retry event is required to retry the policy %d times", general.RetryAttempts)
+		}
+
+		if general.RetryEvent != "" && general.RetryEvent != RetryEventNone &&
+			general.Frequency != "" && general.Frequency != FrequencyOncePerComputer {
+			return fmt.Errorf("policies can only be retried with a frequency of %q not %q", FrequencyOncePerComputer, general.Frequency)
+		}
+
+		if general.Category != nil && general.Category.ID == 0 && general.Category.Name == "" {
+			return fmt.Errorf("policy category requires either an ID or a name")
+		}
+
+		if general.Site != nil && general.Site.ID == 0 && general.Site.Name == "" {
+			return fmt.Errorf("policy site requires either an ID or a name")
+		}
+
+		if limits := general.DateTimeLimitations; limits != nil && !limits.ActivationDateUTC.IsZero() &&
+			!limits.ExpirationDateUTC.IsZero() && !limits.ExpirationDateUTC.After(limits.ActivationDateUTC.Time) {
+			return fmt.Errorf("policy expiration date %s must be after its activation date %s", limits.ExpirationDateUTC, limits.ActivationDateUTC)
+		}
+	}
+
+	for i, s := range policy.Scripts {
+		if s.ID == 0 && s.Name == "" {
+			return fmt.Errorf("script %d of the policy requires either an ID or a name", i+1)
+		}
+		if s.Priority != "" && s.Priority != "Before" && s.Priority != "After" {
+			return fmt.Errorf("%q is not a valid script priority must be either Before or After", s.Priority)
+		}
+	}
+
+	if policy.PackageConfiguration != nil {
+		for i, p := range policy.PackageConfiguration.List {
+			if p.ID == 0 && p.Name == "" {
+				return fmt.Errorf("package %d of the policy requires either an ID or a name", i+1)
+			}
+		}
+	}
+
+	if reboot := policy.RebootSettings; reboot != nil {
+		if err := reboot.NoUserLoggedIn.Validate(); err != nil {
+			return err
+		}
+		if err := reboot.UserLoggedIn.Validate(); err != nil {
+			return err
+		}
+		if reboot.NoUserLoggedIn == RestartAfterDelay {
+			return fmt.Errorf("restart behavior %q is only available when a user is logged in", RestartAfterDelay)
+		}
+		if reboot.MinutesUntilReboot < 0 {
+			return fmt.Errorf("%d is not a valid number of minutes until reboot", reboot.MinutesUntilReboot)
+		}
+	}
+
+	return nil
 }
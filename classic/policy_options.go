@@ -0,0 +1,116 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyFrequency is how often a policy runs on a computer
+type PolicyFrequency string
+
+// Policy frequencies
+const (
+	FrequencyOncePerComputer        PolicyFrequency = "Once per computer"
+	FrequencyOncePerUserPerComputer PolicyFrequency = "Once per user per computer"
+	FrequencyOncePerUser            PolicyFrequency = "Once per user"
+	FrequencyOnceEveryDay           PolicyFrequency = "Once every day"
+	FrequencyOnceEveryWeek          PolicyFrequency = "Once every week"
+	FrequencyOnceEveryMonth         PolicyFrequency = "Once every month"
+	FrequencyOngoing                PolicyFrequency = "Ongoing"
+)
+
+// PolicyTrigger is the kind of trigger of a policy, the events it runs on are set with the Trigger fields
+// of PolicyGeneral
+type PolicyTrigger string
+
+// Policy triggers
+const (
+	TriggerEvent         PolicyTrigger = "EVENT"
+	TriggerUserInitiated PolicyTrigger = "USER_INITIATED"
+)
+
+// PolicyRetryEvent is when a policy which failed is run again
+type PolicyRetryEvent string
+
+// Policy retry events
+const (
+	RetryEventNone    PolicyRetryEvent = "none"
+	RetryEventTrigger PolicyRetryEvent = "trigger"
+	RetryEventCheckin PolicyRetryEvent = "check-in"
+)
+
+// RestartBehavior is whether a computer restarts once a policy ran, depending on whether a user is logged in
+type RestartBehavior string
+
+// Restart behaviors, RestartAfterDelay is only available when a user is logged in and restarts the computer
+// once the MinutesUntilReboot of PolicyRebootSettings elapsed
+const (
+	RestartIfRequired  RestartBehavior = "Restart if a package or update requires it"
+	RestartImmediately RestartBehavior = "Restart immediately"
+	RestartAfterDelay  RestartBehavior = "Restart"
+	DoNotRestart       RestartBehavior = "Do not restart"
+)
+
+var (
+	policyFrequencies = []PolicyFrequency{FrequencyOncePerComputer, FrequencyOncePerUserPerComputer, FrequencyOncePerUser,
+		FrequencyOnceEveryDay, FrequencyOnceEveryWeek, FrequencyOnceEveryMonth, FrequencyOngoing}
+	policyTriggers    = []PolicyTrigger{TriggerEvent, TriggerUserInitiated}
+	policyRetryEvents = []PolicyRetryEvent{RetryEventNone, RetryEventTrigger, RetryEventCheckin}
+	restartBehaviors  = []RestartBehavior{RestartIfRequired, RestartImmediately, RestartAfterDelay, DoNotRestart}
+)
+
+// Validate returns an error when the frequency is not one supported by Jamf, the empty frequency is valid
+func (f PolicyFrequency) Validate() error {
+	return validateOption("policy frequency", f, policyFrequencies)
+}
+
+// MarshalText validates the frequency so that typos are not sent to Jamf
+func (f PolicyFrequency) MarshalText() ([]byte, error) {
+	return []byte(f), f.Validate()
+}
+
+// Validate returns an error when the trigger is not one supported by Jamf, the empty trigger is valid
+func (t PolicyTrigger) Validate() error {
+	return validateOption("policy trigger", t, policyTriggers)
+}
+
+// MarshalText validates the trigger so that typos are not sent to Jamf
+func (t PolicyTrigger) MarshalText() ([]byte, error) {
+	return []byte(t), t.Validate()
+}
+
+// Validate returns an error when the retry event is not one supported by Jamf, the empty retry event is valid
+func (e PolicyRetryEvent) Validate() error {
+	return validateOption("policy retry event", e, policyRetryEvents)
+}
+
+// MarshalText validates the retry event so that typos are not sent to Jamf
+func (e PolicyRetryEvent) MarshalText() ([]byte, error) {
+	return []byte(e), e.Validate()
+}
+
+// Validate returns an error when the restart behavior is not one supported by Jamf, the empty behavior is valid
+func (b RestartBehavior) Validate() error {
+	return validateOption("restart behavior", b, restartBehaviors)
+}
+
+// MarshalText validates the restart behavior so that typos are not sent to Jamf
+func (b RestartBehavior) MarshalText() ([]byte, error) {
+	return []byte(b), b.Validate()
+}
+
+func validateOption[T ~string](kind string, value T, valid []T) error {
+	if value == "" {
+		return nil
+	}
+	names := make([]string, len(valid))
+	for i, v := range valid {
+		if v == value {
+			return nil
+		}
+		names[i] = fmt.Sprintf("%q", v)
+	}
+	return fmt.Errorf("%q is not a valid %s, expected one of %s", value, kind, strings.Join(names, ", "))
+}
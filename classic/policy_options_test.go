@@ -0,0 +1,50 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyOptionsMarshal(t *testing.T) {
+	policy := &jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{
+			Name:       "Install Chrome",
+			Trigger:    jamf.TriggerEvent,
+			Frequency:  jamf.FrequencyOncePerComputer,
+			RetryEvent: jamf.RetryEventCheckin,
+		},
+		RebootSettings: &jamf.PolicyRebootSettings{NoUserLoggedIn: jamf.RestartIfRequired, UserLoggedIn: jamf.DoNotRestart},
+	}
+	data, err := xml.Marshal(policy)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<trigger>EVENT</trigger>")
+	assert.Contains(t, string(data), "<frequency>Once per computer</frequency><retry_event>check-in</retry_event>")
+
+	decoded := &jamf.PolicyContents{}
+	assert.Nil(t, xml.Unmarshal(data, decoded))
+	assert.Equal(t, jamf.FrequencyOncePerComputer, decoded.General.Frequency)
+
+	policy.General.Frequency = "Once per comptuer"
+	_, err = xml.Marshal(policy)
+	assert.Contains(t, err.Error(), `"Once per comptuer" is not a valid policy frequency, expected one of "Once per computer", "Once per user per computer"`)
+	_, err = json.Marshal(policy)
+	assert.NotNil(t, err)
+
+	policy.General.Frequency = jamf.FrequencyOngoing
+	policy.RebootSettings.UserLoggedIn = "Reboot"
+	_, err = json.Marshal(policy)
+	assert.Contains(t, err.Error(), `"Reboot" is not a valid restart behavior`)
+}
+
+func TestPolicyOptionsValidate(t *testing.T) {
+	assert.Nil(t, jamf.PolicyFrequency("").Validate())
+	assert.Nil(t, jamf.TriggerUserInitiated.Validate())
+	assert.NotNil(t, jamf.PolicyTrigger("CHECKIN").Validate())
+	assert.NotNil(t, jamf.PolicyRetryEvent("always").Validate())
+}
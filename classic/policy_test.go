@@ -166,6 +166,18 @@ func TestUpdatePolicy(t *testing.T) {
 	assert.Equal(t, "My Name", policy.Scripts[0].Parameter4)
 }
 
+func TestUpdatePolicyByName(t *testing.T) {
+	testServer := policiesResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	policy, err := j.UpdatePolicyByName("Test Policy", &jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "Test Policy", Enabled: true}})
+	assert.Nil(t, err)
+	assert.True(t, policy.General.Enabled)
+}
+
 func TestCreatePolicy(t *testing.T) {
 	testServer := policiesResponseMocks(t)
 	defer testServer.Close()
@@ -218,7 +230,7 @@ func TestCreatePolicy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotNil(t, policy)
 	assert.Equal(t, "Test Policy", policy.General.Name)
-	assert.Equal(t, "Once per computer", policy.General.Frequency)
+	assert.Equal(t, jamf.FrequencyOncePerComputer, policy.General.Frequency)
 	assert.Equal(t, "Software - Security", policy.General.Category.Name)
 	assert.Equal(t, 2, len(policy.Scope.Computers))
 	assert.Equal(t, "TEST-BOX", policy.Scope.Computers[0].GeneralInformation.Name)
@@ -240,3 +252,35 @@ func DeletePolicy(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 72, removed.ID)
 }
+
+func TestValidatePolicy(t *testing.T) {
+	err := jamf.ValidatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{RetryAttempts: 3}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "a retry event is required to retry the policy 3 times", err.Error())
+
+	err = jamf.ValidatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{RetryEvent: jamf.RetryEventCheckin, Frequency: jamf.FrequencyOngoing}})
+	assert.NotNil(t, err)
+	assert.Equal(t, `policies can only be retried with a frequency of "Once per computer" not "Ongoing"`, err.Error())
+
+	err = jamf.ValidatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{Category: &jamf.PolicyCategory{}}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "policy category requires either an ID or a name", err.Error())
+
+	err = jamf.ValidatePolicy(&jamf.PolicyContents{Scripts: []*jamf.PolicyScriptAssignment{{ID: 1}, {Priority: "After"}}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "script 2 of the policy requires either an ID or a name", err.Error())
+
+	err = jamf.ValidatePolicy(&jamf.PolicyContents{RebootSettings: &jamf.PolicyRebootSettings{NoUserLoggedIn: jamf.RestartAfterDelay}})
+	assert.NotNil(t, err)
+	assert.Equal(t, `restart behavior "Restart" is only available when a user is logged in`, err.Error())
+
+	assert.Nil(t, jamf.ValidatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{
+		RetryEvent: jamf.RetryEventTrigger, RetryAttempts: 2, Frequency: jamf.FrequencyOncePerComputer,
+	}}))
+
+	j, err := jamf.NewClient("http://localhost", "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	_, err = j.CreatePolicy(&jamf.PolicyContents{General: &jamf.PolicyGeneral{Name: "Retry", RetryAttempts: 1}})
+	assert.NotNil(t, err)
+	assert.Equal(t, "policy validation failed: Retry: a retry event is required to retry the policy 1 times", err.Error())
+}
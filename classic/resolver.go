@@ -0,0 +1,200 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Reference resources whose IDs a Resolver looks up by name
+const (
+	ReferenceCategories     = "categories"
+	ReferenceComputerGroups = "computergroups"
+	ReferencePackages       = "packages"
+	ReferenceScripts        = "scripts"
+	ReferenceSites          = "sites"
+)
+
+// Resolver looks up the IDs of reference objects such as categories, scripts, packages and computer
+// groups given their name and caches them. Setting Client.Resolver fills the missing IDs of the
+// references of policies before they are created or updated.
+type Resolver struct {
+	client *Client
+	ttl    time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*referenceCache
+}
+
+type referenceCache struct {
+	ids     map[string]int
+	fetched time.Time
+}
+
+// NewResolver returns a Resolver using j to list reference objects, cached names are listed again
+// once older than ttl, a zero ttl caches them until Invalidate is called. A name missing from the
+// cache is always listed again in case the object was created since.
+func NewResolver(j *Client, ttl time.Duration) *Resolver {
+	return &Resolver{client: j, ttl: ttl, cache: map[string]*referenceCache{}}
+}
+
+// ID returns the ID of the object of a reference resource, e.g. ReferenceScripts, given its name
+func (r *Resolver) ID(resource string, name string) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cache, ok := r.cache[resource]
+	if ok && r.ttl > 0 && time.Since(cache.fetched) > r.ttl {
+		ok = false
+	}
+	if ok {
+		if id, found := cache.ids[name]; found {
+			return id, nil
+		}
+	}
+
+	cache, err := r.fetch(resource)
+	if err != nil {
+		return 0, err
+	}
+	r.cache[resource] = cache
+	if id, found := cache.ids[name]; found {
+		return id, nil
+	}
+	return 0, fmt.Errorf("no %s named %q", resource, name)
+}
+
+// CategoryID returns the ID of a category given its name
+func (r *Resolver) CategoryID(name string) (int, error) {
+	return r.ID(ReferenceCategories, name)
+}
+
+// ComputerGroupID returns the ID of a smart or static computer group given its name
+func (r *Resolver) ComputerGroupID(name string) (int, error) {
+	return r.ID(ReferenceComputerGroups, name)
+}
+
+// PackageID returns the ID of a package given its name
+func (r *Resolver) PackageID(name string) (int, error) {
+	return r.ID(ReferencePackages, name)
+}
+
+// ScriptID returns the ID of a script given its name
+func (r *Resolver) ScriptID(name string) (int, error) {
+	return r.ID(ReferenceScripts, name)
+}
+
+// SiteID returns the ID of a site given its name
+func (r *Resolver) SiteID(name string) (int, error) {
+	return r.ID(ReferenceSites, name)
+}
+
+// Invalidate drops the cached names of the given reference resources, or of every resource without any
+func (r *Resolver) Invalidate(resources ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(resources) == 0 {
+		r.cache = map[string]*referenceCache{}
+		return
+	}
+	for _, resource := range resources {
+		delete(r.cache, resource)
+	}
+}
+
+// ResolvePolicy fills the IDs of the category, site, scripts, packages and computer groups referenced
+// by name only in a policy
+func (r *Resolver) ResolvePolicy(content *PolicyContents) error {
+	if content == nil {
+		return nil
+	}
+	if content.General != nil {
+		if c := content.General.Category; c != nil {
+			if err := r.resolve(ReferenceCategories, c.Name, &c.ID); err != nil {
+				return err
+			}
+		}
+		if s := content.General.Site; s != nil {
+			if err := r.resolve(ReferenceSites, s.Name, &s.ID); err != nil {
+				return err
+			}
+		}
+	}
+	for _, s := range content.Scripts {
+		if err := r.resolve(ReferenceScripts, s.Name, &s.ID); err != nil {
+			return err
+		}
+	}
+	if content.PackageConfiguration != nil {
+		for _, p := range content.PackageConfiguration.List {
+			if err := r.resolve(ReferencePackages, p.Name, &p.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return r.ResolveScope(content.Scope)
+}
+
+// ResolveScope fills the IDs of the computer groups targeted or excluded by name only in a scope
+func (r *Resolver) ResolveScope(scope *Scope) error {
+	if scope == nil {
+		return nil
+	}
+	groups := scope.ComputerGroups
+	if scope.Exclusions != nil {
+		groups = append(groups[:len(groups):len(groups)], scope.Exclusions.ComputerGroups...)
+	}
+	for _, g := range groups {
+		if err := r.resolve(ReferenceComputerGroups, g.Name, &g.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Resolver) resolve(resource string, name string, id *int) error {
+	if *id != 0 || name == "" {
+		return nil
+	}
+	resolved, err := r.ID(resource, name)
+	if err != nil {
+		return err
+	}
+	*id = resolved
+	return nil
+}
+
+func (r *Resolver) fetch(resource string) (*referenceCache, error) {
+	ep := fmt.Sprintf("%s/%s", r.client.Endpoint, resource)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF %s query request", resource)
+	}
+
+	// lists are returned in an object with a single key named after the resource, e.g. computer_groups
+	res := map[string]json.RawMessage{}
+	if err := r.client.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query %s from %s", resource, ep)
+	}
+	cache := &referenceCache{ids: map[string]int{}, fetched: time.Now()}
+	for _, data := range res {
+		list := []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}{}
+		if err := json.Unmarshal(data, &list); err != nil {
+			continue
+		}
+		for _, item := range list {
+			cache.ids[item.Name] = item.ID
+		}
+	}
+	return cache, nil
+}
@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func resolverResponseMocks(t *testing.T, calls map[string]int, created *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path]++
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/JSSResource/categories":
+			fmt.Fprint(w, `{"categories": [{"id": 2, "name": "Browsers"}, {"id": 3, "name": "Maintenance"}]}`)
+		case "/JSSResource/scripts":
+			if calls[r.URL.Path] > 1 {
+				fmt.Fprint(w, `{"scripts": [{"id": 7, "name": "Install"}, {"id": 8, "name": "Clean Up"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"scripts": [{"id": 7, "name": "Install"}]}`)
+		case "/JSSResource/packages":
+			fmt.Fprint(w, `{"packages": [{"id": 11, "name": "GoogleChrome.pkg"}]}`)
+		case "/JSSResource/computergroups":
+			fmt.Fprint(w, `{"computer_groups": [{"id": 1, "name": "All Managed Clients", "is_smart": true}, {"id": 4, "name": "Pilot", "is_smart": false}]}`)
+		case "/JSSResource/policies/id/-1":
+			data, _ := io.ReadAll(r.Body)
+			*created = string(data)
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `<policy><id>12</id></policy>`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestResolver(t *testing.T) {
+	calls := map[string]int{}
+	testServer := resolverResponseMocks(t, calls, new(string))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	r := jamf.NewResolver(j, 0)
+	id, err := r.CategoryID("Maintenance")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, id)
+	id, err = r.CategoryID("Browsers")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, id)
+	assert.Equal(t, 1, calls["/JSSResource/categories"])
+
+	// names missing from the cache are listed again in case they were created since
+	id, err = r.ScriptID("Install")
+	assert.Nil(t, err)
+	assert.Equal(t, 7, id)
+	id, err = r.ScriptID("Clean Up")
+	assert.Nil(t, err)
+	assert.Equal(t, 8, id)
+	assert.Equal(t, 2, calls["/JSSResource/scripts"])
+
+	_, err = r.ComputerGroupID("Canary")
+	assert.Contains(t, err.Error(), `no computergroups named "Canary"`)
+
+	r.Invalidate(jamf.ReferenceCategories)
+	_, err = r.CategoryID("Browsers")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls["/JSSResource/categories"])
+}
+
+func TestCreatePolicyWithResolver(t *testing.T) {
+	created := ""
+	testServer := resolverResponseMocks(t, map[string]int{}, &created)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	j.Resolver = jamf.NewResolver(j, 0)
+
+	policy := &jamf.PolicyContents{
+		General:              &jamf.PolicyGeneral{Name: "Install Chrome", Category: &jamf.PolicyCategory{Name: "Browsers"}},
+		Scripts:              []*jamf.PolicyScriptAssignment{{Name: "Install"}, {ID: 99, Name: "Renamed"}},
+		PackageConfiguration: &jamf.Packages{List: []*jamf.Package{{Name: "GoogleChrome.pkg", Action: "Install"}}},
+		Scope:                &jamf.Scope{ComputerGroups: []*jamf.ComputerGroup{{Name: "All Managed Clients"}}},
+	}
+	policy.Scope.Exclude().AddComputerGroup(0, "Pilot")
+
+	_, err = j.CreatePolicy(policy)
+	assert.Nil(t, err)
+	assert.Contains(t, created, "<category><id>2</id><name>Browsers</name></category>")
+	assert.Contains(t, created, "<script><id>7</id><name>Install</name>")
+	assert.Contains(t, created, "<script><id>99</id><name>Renamed</name>")
+	assert.Contains(t, created, "<package><id>11</id><name>GoogleChrome.pkg</name>")
+	assert.Contains(t, created, "<computer_group><id>1</id><name>All Managed Clients</name>")
+	assert.Contains(t, created, "<computer_group><id>4</id><name>Pilot</name>")
+
+	policy.Scripts = []*jamf.PolicyScriptAssignment{{Name: "Missing"}}
+	_, err = j.CreatePolicy(policy)
+	assert.Contains(t, err.Error(), `unable to resolve the references of policy: Install Chrome: no scripts named "Missing"`)
+}
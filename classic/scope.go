@@ -0,0 +1,310 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+// Limit returns the limitations of the scope, creating them when the scope has none yet
+func (s *Scope) Limit() *Limitations {
+	if s.Limitations == nil {
+		s.Limitations = &Limitations{}
+	}
+	return s.Limitations
+}
+
+// Exclude returns the exclusions of the scope, creating them when the scope has none yet
+func (s *Scope) Exclude() *Exclusions {
+	if s.Exclusions == nil {
+		s.Exclusions = &Exclusions{}
+	}
+	return s.Exclusions
+}
+
+// AddComputer adds a computer to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddComputer(id int, name string) {
+	s.Computers = addScopeEntry(s.Computers, &BasicComputerInfo{GeneralInformation: GeneralInformation{ID: id, Name: name}}, func(x *BasicComputerInfo) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveComputer removes a computer from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveComputer(id int, name string) bool {
+	var removed bool
+	s.Computers, removed = removeScopeEntry(s.Computers, id, name, func(x *BasicComputerInfo) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddComputerGroup adds a computer group to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddComputerGroup(id int, name string) {
+	s.ComputerGroups = addScopeEntry(s.ComputerGroups, &ComputerGroup{ID: id, Name: name}, func(x *ComputerGroup) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveComputerGroup removes a computer group from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveComputerGroup(id int, name string) bool {
+	var removed bool
+	s.ComputerGroups, removed = removeScopeEntry(s.ComputerGroups, id, name, func(x *ComputerGroup) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddMobileDevice adds a mobile device to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddMobileDevice(id int, name string) {
+	s.MobileDevices = addScopeEntry(s.MobileDevices, &BasicMobileDeviceInfo{GeneralDeviceInformation: GeneralDeviceInformation{ID: id, Name: name}}, func(x *BasicMobileDeviceInfo) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveMobileDevice removes a mobile device from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveMobileDevice(id int, name string) bool {
+	var removed bool
+	s.MobileDevices, removed = removeScopeEntry(s.MobileDevices, id, name, func(x *BasicMobileDeviceInfo) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddMobileDeviceGroup adds a mobile device group to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddMobileDeviceGroup(id int, name string) {
+	s.MobileDeviceGroups = addScopeEntry(s.MobileDeviceGroups, &MobileDeviceGroup{ID: id, Name: name}, func(x *MobileDeviceGroup) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveMobileDeviceGroup removes a mobile device group from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveMobileDeviceGroup(id int, name string) bool {
+	var removed bool
+	s.MobileDeviceGroups, removed = removeScopeEntry(s.MobileDeviceGroups, id, name, func(x *MobileDeviceGroup) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddBuilding adds a building to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddBuilding(id int, name string) {
+	s.Buildings = addScopeEntry(s.Buildings, &Building{ID: id, Name: name}, func(x *Building) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveBuilding removes a building from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveBuilding(id int, name string) bool {
+	var removed bool
+	s.Buildings, removed = removeScopeEntry(s.Buildings, id, name, func(x *Building) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddDepartment adds a department to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddDepartment(id int, name string) {
+	s.Departments = addScopeEntry(s.Departments, &Department{ID: id, Name: name}, func(x *Department) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveDepartment removes a department from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveDepartment(id int, name string) bool {
+	var removed bool
+	s.Departments, removed = removeScopeEntry(s.Departments, id, name, func(x *Department) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddJSSUser adds a JSS user to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddJSSUser(id int, name string) {
+	s.JSSUsers = addScopeEntry(s.JSSUsers, &User{ID: id, Name: name}, func(x *User) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveJSSUser removes a JSS user from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveJSSUser(id int, name string) bool {
+	var removed bool
+	s.JSSUsers, removed = removeScopeEntry(s.JSSUsers, id, name, func(x *User) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddJSSUserGroup adds a JSS user group to the scope targets given its ID or name, nothing is added if it is already there
+func (s *Scope) AddJSSUserGroup(id int, name string) {
+	s.JSSUserGroups = addScopeEntry(s.JSSUserGroups, &UserGroupDetails{ID: id, Name: name}, func(x *UserGroupDetails) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveJSSUserGroup removes a JSS user group from the scope targets by ID, or by name when id is 0, and returns whether it was found
+func (s *Scope) RemoveJSSUserGroup(id int, name string) bool {
+	var removed bool
+	s.JSSUserGroups, removed = removeScopeEntry(s.JSSUserGroups, id, name, func(x *UserGroupDetails) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddUser adds an user to the scope limitations given its ID or name, nothing is added if it is already there
+func (l *Limitations) AddUser(id int, name string) {
+	l.Users = addScopeEntry(l.Users, &User{ID: id, Name: name}, func(x *User) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveUser removes an user from the scope limitations by ID, or by name when id is 0, and returns whether it was found
+func (l *Limitations) RemoveUser(id int, name string) bool {
+	var removed bool
+	l.Users, removed = removeScopeEntry(l.Users, id, name, func(x *User) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddNetworkSegment adds a network segment to the scope limitations given its ID or name, nothing is added if it is already there
+func (l *Limitations) AddNetworkSegment(id int, name string) {
+	l.NetworkSegments = addScopeEntry(l.NetworkSegments, &NetworkSegment{ID: id, Name: name}, func(x *NetworkSegment) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveNetworkSegment removes a network segment from the scope limitations by ID, or by name when id is 0, and returns whether it was found
+func (l *Limitations) RemoveNetworkSegment(id int, name string) bool {
+	var removed bool
+	l.NetworkSegments, removed = removeScopeEntry(l.NetworkSegments, id, name, func(x *NetworkSegment) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddIBeacon adds an iBeacon to the scope limitations given its ID or name, nothing is added if it is already there
+func (l *Limitations) AddIBeacon(id int, name string) {
+	l.IBeacons = addScopeEntry(l.IBeacons, &IBeacon{ID: id, Name: name}, func(x *IBeacon) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveIBeacon removes an iBeacon from the scope limitations by ID, or by name when id is 0, and returns whether it was found
+func (l *Limitations) RemoveIBeacon(id int, name string) bool {
+	var removed bool
+	l.IBeacons, removed = removeScopeEntry(l.IBeacons, id, name, func(x *IBeacon) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddComputer adds a computer to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddComputer(id int, name string) {
+	e.Computers = addScopeEntry(e.Computers, &BasicComputerInfo{GeneralInformation: GeneralInformation{ID: id, Name: name}}, func(x *BasicComputerInfo) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveComputer removes a computer from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveComputer(id int, name string) bool {
+	var removed bool
+	e.Computers, removed = removeScopeEntry(e.Computers, id, name, func(x *BasicComputerInfo) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddComputerGroup adds a computer group to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddComputerGroup(id int, name string) {
+	e.ComputerGroups = addScopeEntry(e.ComputerGroups, &ComputerGroup{ID: id, Name: name}, func(x *ComputerGroup) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveComputerGroup removes a computer group from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveComputerGroup(id int, name string) bool {
+	var removed bool
+	e.ComputerGroups, removed = removeScopeEntry(e.ComputerGroups, id, name, func(x *ComputerGroup) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddMobileDevice adds a mobile device to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddMobileDevice(id int, name string) {
+	e.MobileDevices = addScopeEntry(e.MobileDevices, &BasicMobileDeviceInfo{GeneralDeviceInformation: GeneralDeviceInformation{ID: id, Name: name}}, func(x *BasicMobileDeviceInfo) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveMobileDevice removes a mobile device from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveMobileDevice(id int, name string) bool {
+	var removed bool
+	e.MobileDevices, removed = removeScopeEntry(e.MobileDevices, id, name, func(x *BasicMobileDeviceInfo) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddMobileDeviceGroup adds a mobile device group to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddMobileDeviceGroup(id int, name string) {
+	e.MobileDeviceGroups = addScopeEntry(e.MobileDeviceGroups, &MobileDeviceGroup{ID: id, Name: name}, func(x *MobileDeviceGroup) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveMobileDeviceGroup removes a mobile device group from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveMobileDeviceGroup(id int, name string) bool {
+	var removed bool
+	e.MobileDeviceGroups, removed = removeScopeEntry(e.MobileDeviceGroups, id, name, func(x *MobileDeviceGroup) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddBuilding adds a building to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddBuilding(id int, name string) {
+	e.Buildings = addScopeEntry(e.Buildings, &Building{ID: id, Name: name}, func(x *Building) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveBuilding removes a building from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveBuilding(id int, name string) bool {
+	var removed bool
+	e.Buildings, removed = removeScopeEntry(e.Buildings, id, name, func(x *Building) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddDepartment adds a department to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddDepartment(id int, name string) {
+	e.Departments = addScopeEntry(e.Departments, &Department{ID: id, Name: name}, func(x *Department) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveDepartment removes a department from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveDepartment(id int, name string) bool {
+	var removed bool
+	e.Departments, removed = removeScopeEntry(e.Departments, id, name, func(x *Department) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddUser adds an user to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddUser(id int, name string) {
+	e.Users = addScopeEntry(e.Users, &User{ID: id, Name: name}, func(x *User) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveUser removes an user from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveUser(id int, name string) bool {
+	var removed bool
+	e.Users, removed = removeScopeEntry(e.Users, id, name, func(x *User) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddJSSUser adds a JSS user to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddJSSUser(id int, name string) {
+	e.JSSUsers = addScopeEntry(e.JSSUsers, &User{ID: id, Name: name}, func(x *User) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveJSSUser removes a JSS user from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveJSSUser(id int, name string) bool {
+	var removed bool
+	e.JSSUsers, removed = removeScopeEntry(e.JSSUsers, id, name, func(x *User) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddJSSUserGroup adds a JSS user group to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddJSSUserGroup(id int, name string) {
+	e.JSSUserGroups = addScopeEntry(e.JSSUserGroups, &UserGroupDetails{ID: id, Name: name}, func(x *UserGroupDetails) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveJSSUserGroup removes a JSS user group from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveJSSUserGroup(id int, name string) bool {
+	var removed bool
+	e.JSSUserGroups, removed = removeScopeEntry(e.JSSUserGroups, id, name, func(x *UserGroupDetails) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddNetworkSegment adds a network segment to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddNetworkSegment(id int, name string) {
+	e.NetworkSegments = addScopeEntry(e.NetworkSegments, &NetworkSegment{ID: id, Name: name}, func(x *NetworkSegment) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveNetworkSegment removes a network segment from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveNetworkSegment(id int, name string) bool {
+	var removed bool
+	e.NetworkSegments, removed = removeScopeEntry(e.NetworkSegments, id, name, func(x *NetworkSegment) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+// AddIBeacon adds an iBeacon to the scope exclusions given its ID or name, nothing is added if it is already there
+func (e *Exclusions) AddIBeacon(id int, name string) {
+	e.IBeacons = addScopeEntry(e.IBeacons, &IBeacon{ID: id, Name: name}, func(x *IBeacon) (int, string) { return x.ID, x.Name })
+}
+
+// RemoveIBeacon removes an iBeacon from the scope exclusions by ID, or by name when id is 0, and returns whether it was found
+func (e *Exclusions) RemoveIBeacon(id int, name string) bool {
+	var removed bool
+	e.IBeacons, removed = removeScopeEntry(e.IBeacons, id, name, func(x *IBeacon) (int, string) { return x.ID, x.Name })
+	return removed
+}
+
+func scopeEntryMatches(entryID int, entryName string, id int, name string) bool {
+	if id != 0 {
+		return entryID == id
+	}
+	return entryName == name
+}
+
+func addScopeEntry[T any](entries []*T, entry *T, key func(*T) (int, string)) []*T {
+	id, name := key(entry)
+	for _, existing := range entries {
+		if existingID, existingName := key(existing); scopeEntryMatches(existingID, existingName, id, name) {
+			return entries
+		}
+	}
+	return append(entries, entry)
+}
+
+func removeScopeEntry[T any](entries []*T, id int, name string, key func(*T) (int, string)) ([]*T, bool) {
+	kept := make([]*T, 0, len(entries))
+	for _, existing := range entries {
+		if existingID, existingName := key(existing); !scopeEntryMatches(existingID, existingName, id, name) {
+			kept = append(kept, existing)
+		}
+	}
+	return kept, len(kept) != len(entries)
+}
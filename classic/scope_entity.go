@@ -3,39 +3,56 @@
 
 package classic
 
-// Scope represents the scope of a related Jamf configuration setting or Policy
+import "encoding/xml"
+
+// Scope represents the scope of a related Jamf configuration setting or Policy, computer resources
+// such as policies use the computer targets while mobile device resources use the mobile device and
+// JSS user targets
 type Scope struct {
-	AllComputers   bool                  `json:"all_computers" xml:"all_computers,omitempty"`
-	Computers      []*BasicComputerInfo  `json:"computers" xml:"computers>computer,omitempty"`
-	ComputerGroups []*ComputerGroup      `json:"computer_groups" xml:"computer_groups>computer_group,omitempty"`
-	Buildings      []*Building           `json:"buildings" xml:"buildings,omitempty"`
-	Departments    []*Department         `json:"departments" xml:"departments,omitempty"`
-	LimitToUsers   *UserGroupLimitations `json:"limit_to_users" xml:"limit_to_users,omitempty"`
-	Limitations    *Limitations          `json:"limitations" xml:"limitations,omitempty"`
-	Exclusions     *Exclusions           `json:"exclusions" xml:"exclusions,omitempty"`
+	AllComputers       bool                     `json:"all_computers" xml:"all_computers"`
+	Computers          []*BasicComputerInfo     `json:"computers" xml:"computers>computer,omitempty"`
+	ComputerGroups     []*ComputerGroup         `json:"computer_groups" xml:"computer_groups>computer_group,omitempty"`
+	AllMobileDevices   bool                     `json:"all_mobile_devices,omitempty" xml:"all_mobile_devices"`
+	MobileDevices      []*BasicMobileDeviceInfo `json:"mobile_devices,omitempty" xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups []*MobileDeviceGroup     `json:"mobile_device_groups,omitempty" xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          []*Building              `json:"buildings" xml:"buildings>building,omitempty"`
+	Departments        []*Department            `json:"departments" xml:"departments>department,omitempty"`
+	AllJSSUsers        bool                     `json:"all_jss_users,omitempty" xml:"all_jss_users"`
+	JSSUsers           []*User                  `json:"jss_users,omitempty" xml:"jss_users>user,omitempty"`
+	JSSUserGroups      []*UserGroupDetails      `json:"jss_user_groups,omitempty" xml:"jss_user_groups>user_group,omitempty"`
+	LimitToUsers       *UserGroupLimitations    `json:"limit_to_users" xml:"limit_to_users,omitempty"`
+	Limitations        *Limitations             `json:"limitations" xml:"limitations,omitempty"`
+	Exclusions         *Exclusions              `json:"exclusions" xml:"exclusions,omitempty"`
+}
+
+// MobileDeviceGroup represents a smart or static mobile device group that a setting can be scoped to
+type MobileDeviceGroup struct {
+	ID      int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name    string `json:"name" xml:"name"`
+	IsSmart bool   `json:"is_smart,omitempty" xml:"is_smart,omitempty"`
 }
 
 // Building represents a building configured in Jamf that a setting can be scoped to
 type Building struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
 }
 
 // Department represents a department configured in Jamf that a setting can be scoped to
 type Department struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
 }
 
 // User represents a user configured in Jamf that a setting can be scoped to
 type User struct {
-	ID   int    `json:"id,omitempty"`
-	Name string `json:"name"`
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
 }
 
 // UserGroupLimitations represents the user groups to limit a scope to
 type UserGroupLimitations struct {
-	UserGroups []*UserGroup `json:"user_groups"`
+	UserGroups []*UserGroup `json:"user_groups" xml:"user_groups>user_group,omitempty"`
 }
 
 // UserGroup represents a user group configured in Jamf that a setting can be scoped to
@@ -44,36 +61,67 @@ type UserGroup struct {
 	Info *UserGroupDetails `json:"user_group"`
 }
 
+// MarshalXML writes the user group details directly in the user group element as the Classic API expects
+func (g *UserGroup) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	info := g.Info
+	if info == nil {
+		info = &UserGroupDetails{}
+	}
+	return e.EncodeElement(info, start)
+}
+
+// UnmarshalXML reads the user group details held directly in the user group element
+func (g *UserGroup) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	info := &UserGroupDetails{}
+	if err := d.DecodeElement(info, &start); err != nil {
+		return err
+	}
+	g.Info = info
+	return nil
+}
+
 // UserGroupDetails holds the specific details of a user group
 type UserGroupDetails struct {
-	ID             int    `json:"id,omitempty"`
-	Name           string `json:"name"`
-	IsSmart        bool   `json:"is_smart"`
-	NotifyOnChange bool   `json:"is_notify_on_change"`
+	ID             int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name           string `json:"name" xml:"name"`
+	IsSmart        bool   `json:"is_smart" xml:"is_smart,omitempty"`
+	NotifyOnChange bool   `json:"is_notify_on_change" xml:"is_notify_on_change,omitempty"`
 }
 
 // NetworkSegment represents a network segment configured in Jamf that a setting can be scoped to
 type NetworkSegment struct {
-	ID              int    `json:"id,omitempty"`
-	Name            string `json:"name"`
-	StartingAddress string `json:"starting_address"`
-	EndingAddress   string `json:"ending_address"`
+	ID              int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name            string `json:"name" xml:"name"`
+	StartingAddress string `json:"starting_address" xml:"starting_address,omitempty"`
+	EndingAddress   string `json:"ending_address" xml:"ending_address,omitempty"`
+}
+
+// IBeacon represents an iBeacon region configured in Jamf that a setting can be limited to
+type IBeacon struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name"`
 }
 
 // Limitations represents any limitations related to the specific scope
 type Limitations struct {
-	Users           []*User           `json:"users,omitempty"`
-	UserGroups      []*UserGroup      `json:"user_groups,omitempty"`
-	NetworkSegments []*NetworkSegment `json:"network_segments"`
+	Users           []*User           `json:"users,omitempty" xml:"users>user,omitempty"`
+	UserGroups      []*UserGroup      `json:"user_groups,omitempty" xml:"user_groups>user_group,omitempty"`
+	NetworkSegments []*NetworkSegment `json:"network_segments" xml:"network_segments>network_segment,omitempty"`
+	IBeacons        []*IBeacon        `json:"ibeacons,omitempty" xml:"ibeacons>ibeacon,omitempty"`
 }
 
 // Exclusions represents any exclusions applied to the scoping of the Jamf setting in context
 type Exclusions struct {
-	Computers       []*BasicComputerInfo `json:"computers"`
-	ComputerGroups  []*ComputerGroup     `json:"computer_groups"`
-	Buildings       []*Building          `json:"buildings"`
-	Departments     []*Department        `json:"departments"`
-	Users           []*User              `json:"users"`
-	UserGroups      []*UserGroup         `json:"user_groups"`
-	NetworkSegments []*NetworkSegment    `json:"network_segments"`
+	Computers          []*BasicComputerInfo     `json:"computers" xml:"computers>computer,omitempty"`
+	ComputerGroups     []*ComputerGroup         `json:"computer_groups" xml:"computer_groups>computer_group,omitempty"`
+	MobileDevices      []*BasicMobileDeviceInfo `json:"mobile_devices,omitempty" xml:"mobile_devices>mobile_device,omitempty"`
+	MobileDeviceGroups []*MobileDeviceGroup     `json:"mobile_device_groups,omitempty" xml:"mobile_device_groups>mobile_device_group,omitempty"`
+	Buildings          []*Building              `json:"buildings" xml:"buildings>building,omitempty"`
+	Departments        []*Department            `json:"departments" xml:"departments>department,omitempty"`
+	Users              []*User                  `json:"users" xml:"users>user,omitempty"`
+	UserGroups         []*UserGroup             `json:"user_groups" xml:"user_groups>user_group,omitempty"`
+	JSSUsers           []*User                  `json:"jss_users,omitempty" xml:"jss_users>user,omitempty"`
+	JSSUserGroups      []*UserGroupDetails      `json:"jss_user_groups,omitempty" xml:"jss_user_groups>user_group,omitempty"`
+	NetworkSegments    []*NetworkSegment        `json:"network_segments" xml:"network_segments>network_segment,omitempty"`
+	IBeacons           []*IBeacon               `json:"ibeacons,omitempty" xml:"ibeacons>ibeacon,omitempty"`
 }
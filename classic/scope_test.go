@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScopeTargets(t *testing.T) {
+	scope := &jamf.Scope{}
+	scope.AddComputer(1, "TEST-BOX")
+	scope.AddComputer(1, "TEST-BOX")
+	scope.AddComputer(0, "TestMachine")
+	scope.AddComputerGroup(4, "All Managed Clients")
+	scope.AddBuilding(0, "HQ")
+	assert.Len(t, scope.Computers, 2)
+	assert.Equal(t, "TEST-BOX", scope.Computers[0].Name)
+
+	assert.True(t, scope.RemoveComputer(0, "TestMachine"))
+	assert.False(t, scope.RemoveComputer(0, "TestMachine"))
+	assert.True(t, scope.RemoveComputer(1, ""))
+	assert.Empty(t, scope.Computers)
+
+	assert.Nil(t, scope.Exclusions)
+	scope.Exclude().AddComputerGroup(7, "Loaners")
+	scope.Exclude().AddUser(0, "jdoe")
+	scope.Limit().AddNetworkSegment(2, "Office")
+	assert.Len(t, scope.Exclusions.ComputerGroups, 1)
+	assert.Equal(t, "jdoe", scope.Exclusions.Users[0].Name)
+	assert.Equal(t, 2, scope.Limitations.NetworkSegments[0].ID)
+}
+
+func TestScopeXML(t *testing.T) {
+	scope := &jamf.Scope{
+		LimitToUsers: &jamf.UserGroupLimitations{
+			UserGroups: []*jamf.UserGroup{{Info: &jamf.UserGroupDetails{Name: "Staff"}}},
+		},
+	}
+	scope.AddComputerGroup(4, "All Managed Clients")
+	scope.AddMobileDevice(9, "Cart iPad")
+	scope.AddDepartment(0, "Engineering")
+	scope.Exclude().AddJSSUserGroup(3, "Contractors")
+
+	data, err := xml.Marshal(scope)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<computer_groups><computer_group><id>4</id><name>All Managed Clients</name></computer_group></computer_groups>")
+	assert.Contains(t, string(data), "<mobile_devices><mobile_device><id>9</id><name>Cart iPad</name></mobile_device></mobile_devices>")
+	assert.Contains(t, string(data), "<departments><department><name>Engineering</name></department></departments>")
+	assert.Contains(t, string(data), "<limit_to_users><user_groups><user_group><name>Staff</name></user_group></user_groups></limit_to_users>")
+	assert.Contains(t, string(data), "<jss_user_groups><user_group><id>3</id><name>Contractors</name></user_group></jss_user_groups>")
+
+	decoded := &jamf.Scope{}
+	assert.Nil(t, xml.Unmarshal(data, decoded))
+	assert.Equal(t, "All Managed Clients", decoded.ComputerGroups[0].Name)
+	assert.Equal(t, 9, decoded.MobileDevices[0].ID)
+	assert.Equal(t, "Staff", decoded.LimitToUsers.UserGroups[0].Info.Name)
+	assert.Equal(t, "Contractors", decoded.Exclusions.JSSUserGroups[0].Name)
+}
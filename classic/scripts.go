@@ -15,8 +15,12 @@ import (
 
 // Scripts returns a list of scripts available in the jamf client
 func (j *Client) Scripts() ([]BasicScriptInfo, error) {
+	return j.scripts(context.Background())
+}
+
+func (j *Client) scripts(ctx context.Context) ([]BasicScriptInfo, error) {
 	ep := fmt.Sprintf("%s/%s", j.Endpoint, scriptsContext)
-	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error building JAMF scripts query request")
 	}
@@ -59,6 +63,10 @@ func (j *Client) UpdateScript(identifier interface{}, script *ScriptContents) (*
 		return nil, errors.Wrapf(err, "error building JAMF query request for script: %v", identifier)
 	}
 
+	if err := ValidateScript(script); err != nil {
+		return nil, errors.Wrapf(err, "script validation failed: %v", identifier)
+	}
+
 	// TODO: Fix hack
 	// handle empty parameters since they can come in as
 	// map[string]interface{} which can not be handled by xml/encoding
@@ -86,6 +94,11 @@ func (j *Client) UpdateScript(identifier interface{}, script *ScriptContents) (*
 	return &res, nil
 }
 
+// UpdateScriptByName will update a script in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateScriptByName(name string, script *ScriptContents) (*ScriptContents, error) {
+	return j.UpdateScript(name, script)
+}
+
 // CreateScript will create a script in Jamf
 func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error) {
 	// -1 denotes the next available ID
@@ -102,6 +115,10 @@ func (j *Client) CreateScript(content *ScriptContents) (*ScriptContents, error)
 		return nil, errors.Wrapf(fmt.Errorf("script contents required"), "unable to process JAMF creation request for script: (%s)", ep)
 	}
 
+	if err := ValidateScript(content); err != nil {
+		return nil, errors.Wrapf(err, "script validation failed: %v", content.Name)
+	}
+
 	if content.Filename == "" {
 		content.Filename = content.Name
 	}
@@ -144,3 +161,8 @@ func (j *Client) DeleteScript(identifier interface{}) (*ScriptContents, error) {
 
 	return &res, nil
 }
+
+// DeleteScriptByName will delete a script by name, which is escaped in the request path
+func (j *Client) DeleteScriptByName(name string) (*ScriptContents, error) {
+	return j.DeleteScript(name)
+}
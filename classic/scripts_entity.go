@@ -3,7 +3,10 @@
 
 package classic
 
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"fmt"
+)
 
 // Scripts holds a list of all the scripts available in Jamf
 type Scripts struct {
@@ -48,3 +51,12 @@ type ParametersList struct {
 	Parameter10 string `json:"parameter10" xml:"parameter10"`
 	Parameter11 string `json:"parameter11" xml:"parameter11"`
 }
+
+// ValidateScript will validate that the settings of a script are consistent before it is sent to Jamf
+func ValidateScript(script *ScriptContents) error {
+	if script.Priority != "" && script.Priority != "Before" && script.Priority != "After" && script.Priority != "At Reboot" {
+		return fmt.Errorf("%q is not a valid script priority must be Before, After or At Reboot", script.Priority)
+	}
+
+	return nil
+}
@@ -209,3 +209,11 @@ func TestDeleteScript(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, 33, removed.ID)
 }
+
+func TestValidateScript(t *testing.T) {
+	err := jamf.ValidateScript(&jamf.ScriptContents{Priority: "Later"})
+	assert.NotNil(t, err)
+	assert.Equal(t, `"Later" is not a valid script priority must be Before, After or At Reboot`, err.Error())
+
+	assert.Nil(t, jamf.ValidateScript(&jamf.ScriptContents{Priority: "At Reboot"}))
+}
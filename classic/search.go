@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Types of the results returned by SearchAll
+const (
+	SearchResultComputer     = "computer"
+	SearchResultMobileDevice = "mobile_device"
+	SearchResultUser         = "user"
+	SearchResultPolicy       = "policy"
+	SearchResultScript       = "script"
+)
+
+// SearchResult is an object found by SearchAll, Type is one of the SearchResult constants
+type SearchResult struct {
+	Type string `json:"type"`
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// SearchAll searches computers, mobile devices, users, policies and scripts for query at once and returns
+// the objects found, grouped by type in that order. Computers and mobile devices are matched by Jamf on
+// any identifying information, users, policies and scripts on their name ignoring case. "*" matches any
+// characters, a query without it matches anywhere in the name or information. The requests are bound to
+// ctx, canceling it aborts them all.
+func (j *Client) SearchAll(ctx context.Context, query string) ([]SearchResult, error) {
+	if strings.Trim(query, "*") == "" {
+		return nil, errors.New("unable to search Jamf, the query is empty")
+	}
+	term := query
+	if !strings.Contains(term, "*") {
+		term = "*" + term + "*"
+	}
+
+	searches := []func() ([]SearchResult, error){
+		func() ([]SearchResult, error) {
+			computers, err := j.matchComputers(ctx, term)
+			results := make([]SearchResult, 0, len(computers))
+			for _, c := range computers {
+				results = append(results, SearchResult{Type: SearchResultComputer, ID: c.ID, Name: c.Name})
+			}
+			return results, err
+		},
+		func() ([]SearchResult, error) {
+			devices, err := j.matchMobileDevices(ctx, term)
+			results := make([]SearchResult, 0, len(devices))
+			for _, d := range devices {
+				results = append(results, SearchResult{Type: SearchResultMobileDevice, ID: d.ID, Name: d.Name})
+			}
+			return results, err
+		},
+		func() ([]SearchResult, error) {
+			users, err := j.users(ctx)
+			var results []SearchResult
+			for _, u := range users {
+				if matchName(u.Name, term) {
+					results = append(results, SearchResult{Type: SearchResultUser, ID: u.ID, Name: u.Name})
+				}
+			}
+			return results, err
+		},
+		func() ([]SearchResult, error) {
+			policies, err := j.policies(ctx)
+			var results []SearchResult
+			for _, p := range policies {
+				if matchName(p.Name, term) {
+					results = append(results, SearchResult{Type: SearchResultPolicy, ID: p.ID, Name: p.Name})
+				}
+			}
+			return results, err
+		},
+		func() ([]SearchResult, error) {
+			scripts, err := j.scripts(ctx)
+			var results []SearchResult
+			for _, s := range scripts {
+				if matchName(s.Name, term) {
+					results = append(results, SearchResult{Type: SearchResultScript, ID: s.ID, Name: s.Name})
+				}
+			}
+			return results, err
+		},
+	}
+
+	found := make([][]SearchResult, len(searches))
+	errs := make([]error, len(searches))
+	wg := sync.WaitGroup{}
+	for i, search := range searches {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			found[i], errs[i] = search()
+		}()
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, errors.Wrapf(err, "unable to search Jamf for %s", query)
+	}
+
+	var results []SearchResult
+	for i := range searches {
+		if errs[i] != nil {
+			return nil, errors.Wrapf(errs[i], "unable to search Jamf for %s", query)
+		}
+		results = append(results, found[i]...)
+	}
+	return results, nil
+}
+
+// matchName reports whether name matches pattern ignoring case, "*" in pattern matching any characters
+func matchName(name string, pattern string) bool {
+	name, pattern = strings.ToLower(name), strings.ToLower(pattern)
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return name == pattern
+	}
+	if !strings.HasPrefix(name, parts[0]) {
+		return false
+	}
+	name = name[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(name, part)
+		if i < 0 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+	return strings.HasSuffix(name, parts[len(parts)-1])
+}
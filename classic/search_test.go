@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func searchResponseMocks(t *testing.T, failing string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == failing {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		switch r.URL.Path {
+		case "/JSSResource/computers/match/*Zoom*":
+			fmt.Fprint(w, `{"computers": [{"id": 3, "name": "Zoom Room MacBook", "serial_number": "C02ZOOM"}]}`)
+		case "/JSSResource/mobiledevices/match/*Zoom*":
+			fmt.Fprint(w, `{"mobile_devices": [{"id": 7, "name": "Zoom Room iPad", "username": "rooms"}]}`)
+		case "/JSSResource/users":
+			fmt.Fprint(w, `{"users": [{"id": 1, "name": "zoomadmin"}, {"id": 2, "name": "jdoe"}]}`)
+		case "/JSSResource/policies":
+			fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Install Zoom"}, {"id": 11, "name": "Install Chrome"}]}`)
+		case "/JSSResource/scripts":
+			fmt.Fprint(w, `{"scripts": [{"id": 33, "name": "Zoom Script 2"}, {"id": 52, "name": "Admin to Standard"}]}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestSearchAll(t *testing.T) {
+	testServer := searchResponseMocks(t, "")
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	results, err := j.SearchAll(context.Background(), "Zoom")
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.SearchResult{
+		{Type: jamf.SearchResultComputer, ID: 3, Name: "Zoom Room MacBook"},
+		{Type: jamf.SearchResultMobileDevice, ID: 7, Name: "Zoom Room iPad"},
+		{Type: jamf.SearchResultUser, ID: 1, Name: "zoomadmin"},
+		{Type: jamf.SearchResultPolicy, ID: 10, Name: "Install Zoom"},
+		{Type: jamf.SearchResultScript, ID: 33, Name: "Zoom Script 2"},
+	}, results)
+}
+
+func TestSearchAllWildcards(t *testing.T) {
+	var terms []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/JSSResource/computers/match/install*", "/JSSResource/mobiledevices/match/install*":
+			terms = append(terms, r.URL.Path)
+			fmt.Fprint(w, `{}`)
+		case "/JSSResource/users":
+			fmt.Fprint(w, `{"users": []}`)
+		case "/JSSResource/policies":
+			fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Install Zoom"}, {"id": 12, "name": "Reinstall Zoom"}]}`)
+		case "/JSSResource/scripts":
+			fmt.Fprint(w, `{"scripts": []}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	results, err := j.SearchAll(context.Background(), "install*")
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.SearchResult{{Type: jamf.SearchResultPolicy, ID: 10, Name: "Install Zoom"}}, results)
+
+	results, err = j.SearchAll(context.Background(), "*")
+	assert.NotNil(t, err)
+	assert.Nil(t, results)
+}
+
+func TestSearchAllError(t *testing.T) {
+	testServer := searchResponseMocks(t, "/JSSResource/policies")
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	results, err := j.SearchAll(context.Background(), "Zoom")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unable to search Jamf for Zoom")
+	assert.Contains(t, err.Error(), "unable to query available policies")
+	assert.Nil(t, results)
+}
+
+func TestSearchAllCanceled(t *testing.T) {
+	release := make(chan struct{})
+	arrived := make(chan struct{}, 5)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived <- struct{}{}
+		<-release
+	}))
+	defer testServer.Close()
+	defer close(release)
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = j.SearchAll(ctx, "Zoom")
+	assert.True(t, errors.Is(err, context.Canceled))
+
+	// canceling ctx aborts the requests in flight, SearchAll returns once they all stopped
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		for i := 0; i < 5; i++ {
+			<-arrived
+		}
+		cancel()
+	}()
+	_, err = j.SearchAll(ctx, "Zoom")
+	assert.True(t, errors.Is(err, context.Canceled))
+}
@@ -0,0 +1,222 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+)
+
+// Section is a top level element of a Classic API resource. Jamf replaces every section sent in a PUT
+// request, so updates of a resource which was queried should only include the sections being changed.
+type Section string
+
+// Sections of the resources having a Sections method
+const (
+	SectionGeneral               Section = "general"
+	SectionScope                 Section = "scope"
+	SectionSelfService           Section = "self_service"
+	SectionPackages              Section = "package_configuration"
+	SectionScripts               Section = "scripts"
+	SectionPrinters              Section = "printers"
+	SectionDockItems             Section = "dock_items"
+	SectionAccountMaintenance    Section = "account_maintenance"
+	SectionReboot                Section = "reboot"
+	SectionMaintenance           Section = "maintenance"
+	SectionFilesProcesses        Section = "files_processes"
+	SectionUserInteraction       Section = "user_interaction"
+	SectionDiskEncryption        Section = "disk_encryption"
+	SectionLocation              Section = "location"
+	SectionExtensionAttributes   Section = "extension_attributes"
+	SectionConfigurationProfiles Section = "configuration_profiles"
+	SectionPlist                 Section = "plist"
+	SectionPurchasing            Section = "purchasing"
+	SectionApps                  Section = "apps"
+	SectionIBooks                Section = "ibooks"
+	SectionLicenses              Section = "licenses"
+	SectionComputers             Section = "computers"
+)
+
+// Sections returns a copy of the policy holding only the given sections, e.g.
+// policy.Sections(SectionScope) to update the scope of a queried policy without resending the rest of it
+func (p *PolicyContents) Sections(sections ...Section) *PolicyContents {
+	res := *p
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the computer holding only the given sections, e.g.
+// computer.Sections(SectionExtensionAttributes) to only update its extension attributes
+func (c *ComputerDetails) Sections(sections ...Section) *ComputerDetails {
+	res := *c
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the managed preference profile holding only the given sections, e.g.
+// profile.Sections(SectionScope) to only update its scope
+func (p *ManagedPreferenceProfile) Sections(sections ...Section) *ManagedPreferenceProfile {
+	res := *p
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the peripheral holding only the given sections, e.g.
+// peripheral.Sections(SectionLocation) to only update its location
+func (p *Peripheral) Sections(sections ...Section) *Peripheral {
+	res := *p
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the licensed software holding only the given sections, e.g.
+// software.Sections(SectionLicenses) to only update its licenses
+func (s *LicensedSoftware) Sections(sections ...Section) *LicensedSoftware {
+	res := *s
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the VPP assignment holding only the given sections, e.g.
+// assignment.Sections(SectionScope) to only update its scope
+func (a *VPPAssignment) Sections(sections ...Section) *VPPAssignment {
+	res := *a
+	keepSections(&res, sections)
+	return &res
+}
+
+// Sections returns a copy of the VPP invitation holding only the given sections, e.g.
+// invitation.Sections(SectionScope) to only update its scope
+func (i *VPPInvitation) Sections(sections ...Section) *VPPInvitation {
+	res := *i
+	keepSections(&res, sections)
+	return &res
+}
+
+type computerExtensionAttributes struct {
+	List []ExtensionAttributes `xml:"extension_attribute"`
+}
+
+type computerConfigProfiles struct {
+	List []ConfigProfile `xml:"configuration_profile"`
+}
+
+// MarshalXML omits the empty sections of the computer so that updating some of them does not send the
+// others, Jamf would otherwise remove e.g. the extension attribute values of the computer
+func (c ComputerDetails) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type details ComputerDetails
+	payload := struct {
+		details
+		General             *GeneralInformation          `xml:"general,omitempty"`
+		UserLocation        *LocationInformation         `xml:"location,omitempty"`
+		ExtensionAttributes *computerExtensionAttributes `xml:"extension_attributes,omitempty"`
+		ConfigProfiles      *computerConfigProfiles      `xml:"configuration_profiles,omitempty"`
+	}{details: details(c)}
+	if c.General != (GeneralInformation{}) {
+		payload.General = &c.General
+	}
+	if c.UserLocation != (LocationInformation{}) {
+		payload.UserLocation = &c.UserLocation
+	}
+	if len(c.ExtensionAttributes) > 0 {
+		payload.ExtensionAttributes = &computerExtensionAttributes{List: c.ExtensionAttributes}
+	}
+	if len(c.ConfigProfiles) > 0 {
+		payload.ConfigProfiles = &computerConfigProfiles{List: c.ConfigProfiles}
+	}
+	start.Name = xml.Name{Local: "computer"}
+	return e.EncodeElement(payload, start)
+}
+
+type policyScripts struct {
+	Size    int                       `xml:"size"`
+	Scripts []*PolicyScriptAssignment `xml:"script"`
+}
+
+// MarshalXML omits the scripts section when the policy has no scripts, otherwise an empty scripts element
+// would be sent and remove the scripts of the policy
+func (p PolicyContents) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type contents PolicyContents
+	payload := struct {
+		contents
+		Scripts *policyScripts `xml:"scripts,omitempty"`
+	}{contents: contents(p)}
+	if len(p.Scripts) > 0 {
+		payload.Scripts = &policyScripts{Size: len(p.Scripts), Scripts: p.Scripts}
+	}
+	start.Name = xml.Name{Local: "policy"}
+	return e.EncodeElement(payload, start)
+}
+
+type vppIBooks struct {
+	List []VPPContent `xml:"ibook"`
+}
+
+type vppApps struct {
+	List []VPPContent `xml:"app"`
+}
+
+// MarshalXML omits the ibooks and apps sections when they are empty, otherwise updating the scope of an
+// assignment would remove its content
+func (a VPPAssignment) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type assignment VPPAssignment
+	payload := struct {
+		assignment
+		IBooks *vppIBooks `xml:"ibooks,omitempty"`
+		Apps   *vppApps   `xml:"apps,omitempty"`
+	}{assignment: assignment(a)}
+	if len(a.IBooks) > 0 {
+		payload.IBooks = &vppIBooks{List: a.IBooks}
+	}
+	if len(a.Apps) > 0 {
+		payload.Apps = &vppApps{List: a.Apps}
+	}
+	start.Name = xml.Name{Local: "vpp_assignment"}
+	return e.EncodeElement(payload, start)
+}
+
+type softwareLicenses struct {
+	List []SoftwareLicense `xml:"license"`
+}
+
+type licensedSoftwareComputers struct {
+	List []LicensedSoftwareComputer `xml:"computer"`
+}
+
+// MarshalXML omits the licenses and computers sections when they are empty, otherwise updating the
+// general section of a licensed software title would remove its licenses
+func (s LicensedSoftware) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type software LicensedSoftware
+	payload := struct {
+		software
+		Licenses  *softwareLicenses          `xml:"licenses,omitempty"`
+		Computers *licensedSoftwareComputers `xml:"computers,omitempty"`
+	}{software: software(s)}
+	if len(s.Licenses) > 0 {
+		payload.Licenses = &softwareLicenses{List: s.Licenses}
+	}
+	if len(s.Computers) > 0 {
+		payload.Computers = &licensedSoftwareComputers{List: s.Computers}
+	}
+	start.Name = xml.Name{Local: "licensed_software"}
+	return e.EncodeElement(payload, start)
+}
+
+// keepSections zeroes the fields of the struct v points to which are sections not listed, the
+// XMLName and the ID of the resource are always kept
+func keepSections(v interface{}, sections []Section) {
+	keep := make(map[string]bool, len(sections))
+	for _, s := range sections {
+		keep[string(s)] = true
+	}
+	value := reflect.ValueOf(v).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		name := strings.Split(strings.Split(field.Tag.Get("xml"), ",")[0], ">")[0]
+		if field.Name == "XMLName" || name == "id" || name == "" || name == "-" || keep[name] {
+			continue
+		}
+		value.Field(i).Set(reflect.Zero(field.Type))
+	}
+}
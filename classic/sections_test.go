@@ -0,0 +1,138 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicySections(t *testing.T) {
+	policy := &jamf.PolicyContents{
+		General:        &jamf.PolicyGeneral{ID: 7, Name: "Install Chrome", Enabled: true},
+		Scope:          &jamf.Scope{AllComputers: true},
+		Maintenance:    &jamf.PolicyMaintenance{Recon: true},
+		RebootSettings: &jamf.PolicyRebootSettings{UserLoggedIn: jamf.DoNotRestart, NoUserLoggedIn: jamf.DoNotRestart},
+	}
+
+	scope := policy.Sections(jamf.SectionScope)
+	assert.NotNil(t, policy.General)
+	assert.Nil(t, scope.General)
+	assert.Nil(t, scope.Maintenance)
+	payload, err := xml.Marshal(scope)
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<policy><scope><all_computers>true</all_computers>")
+	assert.NotContains(t, string(payload), "<scripts>")
+
+	payload, err = xml.Marshal(policy.Sections(jamf.SectionMaintenance, jamf.SectionReboot))
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<maintenance><recon>true</recon><reset_name>false</reset_name>")
+	assert.Contains(t, string(payload), "<reboot><message></message><no_user_logged_in>Do not restart</no_user_logged_in><user_logged_in>Do not restart</user_logged_in>")
+	assert.NotContains(t, string(payload), "<general>")
+
+	policy.Scripts = []*jamf.PolicyScriptAssignment{{ID: 4, Priority: "After"}}
+	payload, err = xml.Marshal(policy.Sections(jamf.SectionScripts))
+	assert.Nil(t, err)
+	assert.Equal(t, "<policy><scripts><size>1</size><script><id>4</id><priority>After</priority></script></scripts></policy>", string(payload))
+}
+
+func TestPolicySectionsDisable(t *testing.T) {
+	policy := &jamf.PolicyContents{
+		General: &jamf.PolicyGeneral{ID: 7, Name: "Install Chrome", Enabled: false, Trigger: jamf.TriggerEvent},
+		Scope:   &jamf.Scope{AllComputers: false},
+	}
+
+	payload, err := xml.Marshal(policy.Sections(jamf.SectionGeneral))
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<enabled>false</enabled>")
+	assert.Contains(t, string(payload), "<trigger_checkin>false</trigger_checkin>")
+	assert.Contains(t, string(payload), "<offline>false</offline>")
+	assert.NotContains(t, string(payload), "<scope>")
+
+	payload, err = xml.Marshal(policy.Sections(jamf.SectionScope))
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<scope><all_computers>false</all_computers>")
+}
+
+func TestResourceSections(t *testing.T) {
+	scope := &jamf.Scope{}
+	scope.AddJSSUserGroup(3, "New Hires")
+	assignment := &jamf.VPPAssignment{
+		General: &jamf.VPPAssignmentGeneral{ID: 3, Name: "Math Apps"},
+		Apps:    []jamf.VPPContent{{AdamID: 361309726, Name: "Pages"}},
+		Scope:   scope,
+	}
+	payload, err := xml.Marshal(assignment.Sections(jamf.SectionScope))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "<general>")
+	assert.NotContains(t, string(payload), "<apps>")
+	assert.Contains(t, string(payload), "<jss_user_groups><user_group><id>3</id><name>New Hires</name>")
+	payload, err = xml.Marshal(assignment.Sections(jamf.SectionApps))
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<apps><app><adam_id>361309726</adam_id><name>Pages</name></app></apps>")
+	assert.NotContains(t, string(payload), "<ibooks>")
+
+	profile := &jamf.ManagedPreferenceProfile{
+		General: &jamf.ManagedPreferenceProfileGeneral{ID: 20, Name: "Dock"},
+		Scope:   &jamf.Scope{AllComputers: true},
+		Plist:   "<plist/>",
+	}
+	payload, err = xml.Marshal(profile.Sections(jamf.SectionScope))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "plist")
+	assert.Contains(t, string(payload), "<all_computers>true</all_computers>")
+
+	peripheral := &jamf.Peripheral{
+		General:    &jamf.PeripheralGeneral{ID: 4},
+		Purchasing: &jamf.PeripheralPurchasing{PONumber: "PO-1"},
+	}
+	assert.Nil(t, peripheral.Sections(jamf.SectionGeneral).Purchasing)
+	assert.Nil(t, (&jamf.VPPInvitation{Scope: scope}).Sections(jamf.SectionGeneral).Scope)
+	software := &jamf.LicensedSoftware{
+		General:  &jamf.LicensedSoftwareGeneral{ID: 5, Name: "Office"},
+		Licenses: []jamf.SoftwareLicense{{SerialNumber1: "ABC-123"}},
+	}
+	payload, err = xml.Marshal(software.Sections(jamf.SectionGeneral))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "<licenses>")
+	assert.NotContains(t, string(payload), "<computers>")
+	payload, err = xml.Marshal(software.Sections(jamf.SectionLicenses))
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<licenses><license><serial_number_1>ABC-123</serial_number_1>")
+}
+
+func TestComputerSections(t *testing.T) {
+	computer := &jamf.ComputerDetails{
+		ID:                  12,
+		General:             jamf.GeneralInformation{Name: "MacBook Pro", ReportDate: jamf.NewJamfTime(time.Date(2024, 10, 1, 9, 30, 0, 0, time.UTC), jamf.JamfDateTimeLayout)},
+		UserLocation:        jamf.LocationInformation{Username: "jdoe"},
+		ExtensionAttributes: []jamf.ExtensionAttributes{{ID: 3, Name: "Owner", Value: "IT"}},
+	}
+
+	payload, err := xml.Marshal(computer.Sections(jamf.SectionExtensionAttributes))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(payload), "<general>")
+	assert.NotContains(t, string(payload), "<location>")
+	assert.Equal(t, "<computer><id>12</id><extension_attributes><extension_attribute><id>3</id><name>Owner</name><value>IT</value></extension_attribute></extension_attributes></computer>", string(payload))
+
+	payload, err = xml.Marshal(computer.Sections(jamf.SectionLocation))
+	assert.Nil(t, err)
+	assert.Equal(t, "<computer><id>12</id><location><username>jdoe</username></location></computer>", string(payload))
+}
+
+func TestSelfServiceCategoryXML(t *testing.T) {
+	category := &jamf.SelfServiceCategory{}
+	category.Category.Name = "Browsers"
+	category.Category.DisplayIn = true
+	payload, err := xml.Marshal(&jamf.SelfService{Categories: []*jamf.SelfServiceCategory{category}})
+	assert.Nil(t, err)
+	assert.Contains(t, string(payload), "<self_service_categories><category><name>Browsers</name><display_in>true</display_in><feature_in>false</feature_in></category></self_service_categories>")
+
+	decoded := &jamf.SelfService{}
+	assert.Nil(t, xml.Unmarshal(payload, decoded))
+	assert.Equal(t, "Browsers", decoded.Categories[0].Category.Name)
+}
@@ -3,35 +3,47 @@
 
 package classic
 
+import "encoding/xml"
+
 // SelfService represents a self service configuration in Jamf i.e policy self service config
 type SelfService struct {
-	Enabled              bool                   `json:"user_for_self_service"`
-	DisplayName          string                 `json:"self_service_display_name"`
-	InstallBtnText       string                 `json:"install_button_text"`
-	ReInstallBtnText     string                 `json:"reinstall_button_text"`
-	Description          string                 `json:"self_service_description"`
-	ForceDescriptionView bool                   `json:"force_users_to_view_description"`
-	Icon                 *SelfServiceIcon       `json:"self_service_icon"`
-	MainPageFeature      bool                   `json:"feature_on_main_page"`
-	Categories           []*SelfServiceCategory `json:"self_service_categories"`
-	Notification         string                 `json:"notification"`
-	NotificationSubject  string                 `json:"notification_subject"`
-	NotificationMessage  string                 `json:"notification_message"`
+	Enabled              bool                   `json:"user_for_self_service" xml:"use_for_self_service"`
+	DisplayName          string                 `json:"self_service_display_name" xml:"self_service_display_name,omitempty"`
+	InstallBtnText       string                 `json:"install_button_text" xml:"install_button_text,omitempty"`
+	ReInstallBtnText     string                 `json:"reinstall_button_text" xml:"reinstall_button_text,omitempty"`
+	Description          string                 `json:"self_service_description" xml:"self_service_description,omitempty"`
+	ForceDescriptionView bool                   `json:"force_users_to_view_description" xml:"force_users_to_view_description"`
+	Icon                 *SelfServiceIcon       `json:"self_service_icon" xml:"self_service_icon,omitempty"`
+	MainPageFeature      bool                   `json:"feature_on_main_page" xml:"feature_on_main_page"`
+	Categories           []*SelfServiceCategory `json:"self_service_categories" xml:"self_service_categories>category,omitempty"`
+	Notification         string                 `json:"notification" xml:"notification,omitempty"`
+	NotificationSubject  string                 `json:"notification_subject" xml:"notification_subject,omitempty"`
+	NotificationMessage  string                 `json:"notification_message" xml:"notification_message,omitempty"`
 }
 
 // SelfServiceIcon holds the config for a self service icon associated with a policy
 type SelfServiceIcon struct {
-	ID       int    `json:"id,omitempty"`
-	Filename string `json:"filename"`
-	URI      string `json:"uri"`
+	ID       int    `json:"id,omitempty" xml:"id,omitempty"`
+	Filename string `json:"filename" xml:"filename,omitempty"`
+	URI      string `json:"uri" xml:"uri,omitempty"`
 }
 
 // SelfServiceCategory holds the category associated with a policy
 type SelfServiceCategory struct {
 	Category struct {
-		ID        int    `json:"id,omitempty"`
-		Name      string `json:"name"`
-		DisplayIn bool   `json:"display_in"`
-		FeatureIn bool   `json:"feature_in"`
+		ID        int    `json:"id,omitempty" xml:"id,omitempty"`
+		Name      string `json:"name" xml:"name,omitempty"`
+		DisplayIn bool   `json:"display_in" xml:"display_in"`
+		FeatureIn bool   `json:"feature_in" xml:"feature_in"`
 	} `json:"category"`
 }
+
+// MarshalXML encodes the category as a single category element, as Jamf expects in self_service_categories
+func (c SelfServiceCategory) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(c.Category, start)
+}
+
+// UnmarshalXML decodes a category element of self_service_categories
+func (c *SelfServiceCategory) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return d.DecodeElement(&c.Category, &start)
+}
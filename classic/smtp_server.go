@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// SMTPServer returns the SMTP server settings configured in Jamf
+func (j *Client) SMTPServer() (*SMTPServer, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, smtpServerContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF SMTP server query request")
+	}
+
+	res := SMTPServerDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query SMTP server from %s", ep)
+	}
+	return res.Details, nil
+}
+
+// UpdateSMTPServer will update the SMTP server settings configured in Jamf
+func (j *Client) UpdateSMTPServer(content *SMTPServer) (*SMTPServer, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, smtpServerContext)
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for SMTP server: (%s)", ep)
+	}
+
+	if err := ValidateSMTPServer(content); err != nil {
+		return nil, errors.Wrap(err, "SMTP server validation failed")
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF update payload for SMTP server")
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for SMTP server (%s)", ep)
+	}
+
+	res := SMTPServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for SMTP server (%s)", ep)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/xml"
+	"fmt"
+)
+
+// SMTPServerDetails holds the SMTP server settings configured in Jamf
+type SMTPServerDetails struct {
+	Details *SMTPServer `json:"smtp_server"`
+}
+
+// SMTPServer represents the mail relay Jamf uses to send email notifications
+type SMTPServer struct {
+	XMLName               xml.Name `json:"-" xml:"smtp_server,omitempty"`
+	Enabled               bool     `json:"enabled" xml:"enabled"`
+	Host                  string   `json:"host,omitempty" xml:"host,omitempty"`
+	Port                  int      `json:"port,omitempty" xml:"port,omitempty"`
+	Timeout               int      `json:"timeout,omitempty" xml:"timeout,omitempty"`
+	AuthorizationRequired bool     `json:"authorization_required" xml:"authorization_required"`
+	Username              string   `json:"username,omitempty" xml:"username,omitempty"`
+	Password              string   `json:"password,omitempty" xml:"password,omitempty"`
+	SSL                   bool     `json:"ssl" xml:"ssl"`
+	TLS                   bool     `json:"tls" xml:"tls"`
+	SendFromName          string   `json:"send_from_name,omitempty" xml:"send_from_name,omitempty"`
+	SendFromEmail         string   `json:"send_from_email,omitempty" xml:"send_from_email,omitempty"`
+}
+
+// ValidateSMTPServer will validate that the SMTP server settings are consistent before they are sent to Jamf
+func ValidateSMTPServer(smtp *SMTPServer) error {
+	if smtp.Port < 0 || smtp.Port > 65535 {
+		return fmt.Errorf("%d is not a valid SMTP server port must be between 1 and 65535", smtp.Port)
+	}
+
+	if smtp.SSL && smtp.TLS {
+		return fmt.Errorf("SMTP server encryption must be either SSL or TLS not both")
+	}
+
+	if !smtp.Enabled {
+		return nil
+	}
+
+	if smtp.Host == "" {
+		return fmt.Errorf("host is required for an enabled SMTP server")
+	}
+
+	if smtp.AuthorizationRequired && smtp.Username == "" {
+		return fmt.Errorf("username is required when SMTP server authorization is required")
+	}
+
+	return nil
+}
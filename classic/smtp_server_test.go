@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var SMTP_SERVER_API_BASE_ENDPOINT = "/JSSResource/smtpserver"
+
+func smtpServerResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case SMTP_SERVER_API_BASE_ENDPOINT:
+			switch r.Method {
+			case "PUT":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				smtpContents := &jamf.SMTPServer{}
+				err = xml.Unmarshal(data, smtpContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				smtpData, err := json.MarshalIndent(smtpContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(smtpData))
+			default:
+				fmt.Fprint(w, `{
+					"smtp_server": {
+						"enabled": true,
+						"host": "smtp.example.com",
+						"port": 587,
+						"timeout": 5,
+						"authorization_required": true,
+						"username": "jamf-relay",
+						"password": "********",
+						"ssl": false,
+						"tls": true,
+						"send_from_name": "Jamf Pro Server",
+						"send_from_email": "jamf@example.com"
+					}
+				}`)
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQuerySMTPServer(t *testing.T) {
+	testServer := smtpServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	smtp, err := j.SMTPServer()
+	assert.Nil(t, err)
+	assert.True(t, smtp.Enabled)
+	assert.Equal(t, "smtp.example.com", smtp.Host)
+	assert.Equal(t, 587, smtp.Port)
+	assert.True(t, smtp.TLS)
+	assert.Equal(t, "jamf@example.com", smtp.SendFromEmail)
+}
+
+func TestUpdateSMTPServer(t *testing.T) {
+	testServer := smtpServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	smtp, err := j.UpdateSMTPServer(&jamf.SMTPServer{
+		Enabled:       true,
+		Host:          "relay.example.com",
+		Port:          465,
+		SSL:           true,
+		SendFromEmail: "no-reply@example.com",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "relay.example.com", smtp.Host)
+	assert.Equal(t, 465, smtp.Port)
+	assert.True(t, smtp.SSL)
+	assert.False(t, smtp.TLS)
+}
+
+func TestValidateSMTPServer(t *testing.T) {
+	err := jamf.ValidateSMTPServer(&jamf.SMTPServer{Port: 70000})
+	assert.NotNil(t, err)
+	assert.Equal(t, "70000 is not a valid SMTP server port must be between 1 and 65535", err.Error())
+
+	err = jamf.ValidateSMTPServer(&jamf.SMTPServer{SSL: true, TLS: true})
+	assert.NotNil(t, err)
+	assert.Equal(t, "SMTP server encryption must be either SSL or TLS not both", err.Error())
+
+	err = jamf.ValidateSMTPServer(&jamf.SMTPServer{Enabled: true})
+	assert.NotNil(t, err)
+	assert.Equal(t, "host is required for an enabled SMTP server", err.Error())
+
+	err = jamf.ValidateSMTPServer(&jamf.SMTPServer{Enabled: true, Host: "smtp.example.com", AuthorizationRequired: true})
+	assert.NotNil(t, err)
+	assert.Equal(t, "username is required when SMTP server authorization is required", err.Error())
+
+	assert.Nil(t, jamf.ValidateSMTPServer(&jamf.SMTPServer{Enabled: false}))
+}
@@ -0,0 +1,142 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// SoftwareUpdateServers returns all software update servers
+func (j *Client) SoftwareUpdateServers() ([]BasicSoftwareUpdateServer, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, softwareUpdateServersContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF software update servers query request")
+	}
+
+	res := &SoftwareUpdateServers{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query software update servers from %s", ep)
+	}
+	return res.List, nil
+}
+
+// SoftwareUpdateServerDetails returns the details for a specific software update server given its ID or Name
+func (j *Client) SoftwareUpdateServerDetails(identifier interface{}) (*SoftwareUpdateServerDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, softwareUpdateServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for software update server: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for software update server: %v", identifier)
+	}
+
+	res := SoftwareUpdateServerDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query software update server with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateSoftwareUpdateServer will create a new software update server in Jamf
+func (j *Client) CreateSoftwareUpdateServer(content *SoftwareUpdateServer) (*SoftwareUpdateServer, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, softwareUpdateServersContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new software update server")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for software update server: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new software update server"), "unable to process JAMF creation request for software update server: (%s)", ep)
+	}
+
+	if content.IPAddress == "" {
+		return nil, errors.Wrapf(fmt.Errorf("ip address required for new software update server"), "unable to process JAMF creation request for software update server: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for software update server: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for software update server: %v (%s)", content.Name, ep)
+	}
+
+	res := SoftwareUpdateServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for software update server %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateSoftwareUpdateServer will update a software update server in Jamf by either ID or Name
+func (j *Client) UpdateSoftwareUpdateServer(identifier interface{}, content *SoftwareUpdateServer) (*SoftwareUpdateServer, error) {
+	ep, err := EndpointBuilder(j.Endpoint, softwareUpdateServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for software update server: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for software update server: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for software update server: %v (%s)", identifier, ep)
+	}
+
+	res := SoftwareUpdateServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for software update server: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateSoftwareUpdateServerByName will update a software update server in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateSoftwareUpdateServerByName(name string, content *SoftwareUpdateServer) (*SoftwareUpdateServer, error) {
+	return j.UpdateSoftwareUpdateServer(name, content)
+}
+
+// DeleteSoftwareUpdateServer will delete a software update server by either ID or Name
+func (j *Client) DeleteSoftwareUpdateServer(identifier interface{}) (*SoftwareUpdateServer, error) {
+	ep, err := EndpointBuilder(j.Endpoint, softwareUpdateServersContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for software update server: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for software update server %v", identifier)
+	}
+
+	res := SoftwareUpdateServer{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for software update server %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteSoftwareUpdateServerByName will delete a software update server by name, which is escaped in the request path
+func (j *Client) DeleteSoftwareUpdateServerByName(name string) (*SoftwareUpdateServer, error) {
+	return j.DeleteSoftwareUpdateServer(name)
+}
@@ -0,0 +1,32 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// SoftwareUpdateServers represents a list of internal software update servers in Jamf
+type SoftwareUpdateServers struct {
+	List  []BasicSoftwareUpdateServer `json:"software_update_servers" xml:"software_update_servers>software_update_server,omitempty"`
+	Count int                         `json:"-" xml:"size"`
+}
+
+// BasicSoftwareUpdateServer holds the basic information for a software update server in Jamf
+type BasicSoftwareUpdateServer struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// SoftwareUpdateServerDetails holds the details for a single software update server
+type SoftwareUpdateServerDetails struct {
+	Details *SoftwareUpdateServer `json:"software_update_server"`
+}
+
+// SoftwareUpdateServer represents an internal software update server (SUS) configured in Jamf
+type SoftwareUpdateServer struct {
+	XMLName       xml.Name `json:"-" xml:"software_update_server,omitempty"`
+	ID            int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name          string   `json:"name" xml:"name,omitempty"`
+	IPAddress     string   `json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	Port          int      `json:"port,omitempty" xml:"port,omitempty"`
+	SetSystemWide bool     `json:"set_system_wide" xml:"set_system_wide"`
+}
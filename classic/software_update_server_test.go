@@ -0,0 +1,162 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var SOFTWARE_UPDATE_SERVERS_API_BASE_ENDPOINT = "/JSSResource/softwareupdateservers"
+
+func softwareUpdateServerResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case SOFTWARE_UPDATE_SERVERS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"software_update_servers": [
+					{
+							"id": 1,
+							"name": "Main SUS"
+					},
+					{
+							"id": 2,
+							"name": "Lab SUS"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", SOFTWARE_UPDATE_SERVERS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", SOFTWARE_UPDATE_SERVERS_API_BASE_ENDPOINT), fmt.Sprintf("%s/name/Main%sSUS", SOFTWARE_UPDATE_SERVERS_API_BASE_ENDPOINT, "%20"):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				susContents := &jamf.SoftwareUpdateServer{}
+				err = xml.Unmarshal(data, susContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				susData, err := json.MarshalIndent(susContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(susData))
+			default:
+				mockSoftwareUpdateServer := &jamf.SoftwareUpdateServerDetails{
+					Details: &jamf.SoftwareUpdateServer{
+						ID:            1,
+						Name:          "Main SUS",
+						IPAddress:     "sus.example.com",
+						Port:          8088,
+						SetSystemWide: true,
+					},
+				}
+
+				var (
+					susData []byte
+					err     error
+				)
+
+				if r.Method == "DELETE" {
+					susData, err = json.MarshalIndent(mockSoftwareUpdateServer.Details, "", "    ")
+				} else {
+					susData, err = json.MarshalIndent(mockSoftwareUpdateServer, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(susData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllSoftwareUpdateServers(t *testing.T) {
+	testServer := softwareUpdateServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	suss, err := j.SoftwareUpdateServers()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(suss))
+	assert.Equal(t, 2, suss[1].ID)
+	assert.Equal(t, "Lab SUS", suss[1].Name)
+}
+
+func TestQuerySpecificSoftwareUpdateServer(t *testing.T) {
+	testServer := softwareUpdateServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	sus, err := j.SoftwareUpdateServerDetails("Main SUS")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, sus.Details.ID)
+	assert.Equal(t, "sus.example.com", sus.Details.IPAddress)
+	assert.Equal(t, 8088, sus.Details.Port)
+	assert.True(t, sus.Details.SetSystemWide)
+}
+
+func TestCreateSoftwareUpdateServer(t *testing.T) {
+	testServer := softwareUpdateServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateSoftwareUpdateServer(&jamf.SoftwareUpdateServer{Name: "Lab SUS"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "ip address required for new software update server")
+
+	sus, err := j.CreateSoftwareUpdateServer(&jamf.SoftwareUpdateServer{
+		Name:      "Lab SUS",
+		IPAddress: "10.0.0.20",
+		Port:      8088,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Lab SUS", sus.Name)
+	assert.Equal(t, "10.0.0.20", sus.IPAddress)
+	assert.Equal(t, 8088, sus.Port)
+}
+
+func TestUpdateSoftwareUpdateServer(t *testing.T) {
+	testServer := softwareUpdateServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	sus, err := j.UpdateSoftwareUpdateServer(1, &jamf.SoftwareUpdateServer{
+		Port:          80,
+		SetSystemWide: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 80, sus.Port)
+	assert.True(t, sus.SetSystemWide)
+}
+
+func TestDeleteSoftwareUpdateServer(t *testing.T) {
+	testServer := softwareUpdateServerResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteSoftwareUpdateServer(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.ID)
+}
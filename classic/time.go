@@ -0,0 +1,165 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Layouts of the dates returned by the Classic API
+const (
+	// JamfTimeLayout is the layout of the _utc dates, e.g. 2024-10-01T09:30:00.000+0000
+	JamfTimeLayout = "2006-01-02T15:04:05.000-0700"
+	// JamfDateTimeLayout is the layout of the dates without a time zone, e.g. report_date or activation_date
+	JamfDateTimeLayout = "2006-01-02 15:04:05"
+	// JamfDateLayout is the layout of the dates without a time, e.g. the purchasing dates of peripherals
+	JamfDateLayout = "2006-01-02"
+)
+
+// jamfTimeLayouts are the layouts JamfTime accepts, Jamf formats dates differently across resources
+var jamfTimeLayouts = []string{
+	JamfTimeLayout,
+	"2006-01-02T15:04:05-0700",
+	time.RFC3339Nano,
+	JamfDateTimeLayout,
+	"2006-01-02T15:04:05",
+	JamfDateLayout,
+}
+
+// EpochTime is a date the Classic API represents as milliseconds since the Unix epoch, such as the
+// _epoch fields. Jamf reports unset dates as 0 which is the zero EpochTime.
+type EpochTime struct {
+	time.Time
+}
+
+// NewEpochTime returns the EpochTime for t, truncated to the millisecond
+func NewEpochTime(t time.Time) EpochTime {
+	return EpochTime{Time: t.Truncate(time.Millisecond)}
+}
+
+// Millis returns the number of milliseconds since the Unix epoch, 0 for the zero EpochTime
+func (t EpochTime) Millis() int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
+
+func (t *EpochTime) parse(value string) error {
+	value = strings.Trim(strings.TrimSpace(value), `"`)
+	if value == "" || value == "0" || value == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid epoch time %q: %v", value, err)
+	}
+	t.Time = time.UnixMilli(millis).UTC()
+	return nil
+}
+
+// MarshalJSON encodes the time as a number of milliseconds
+func (t EpochTime) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.FormatInt(t.Millis(), 10)), nil
+}
+
+// UnmarshalJSON decodes a number of milliseconds, which Jamf sometimes sends as a string
+func (t *EpochTime) UnmarshalJSON(data []byte) error {
+	return t.parse(string(data))
+}
+
+// MarshalXML encodes the time as a number of milliseconds, the element is omitted for the zero EpochTime
+func (t EpochTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if t.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(t.Millis(), start)
+}
+
+// UnmarshalXML decodes a number of milliseconds
+func (t *EpochTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	return t.parse(value)
+}
+
+// JamfTime is a date the Classic API represents as a string such as the _utc fields, which are formatted
+// with JamfTimeLayout, or the dates formatted like 2024-10-01 09:30:00. Empty strings are the zero JamfTime.
+// A decoded JamfTime is encoded again in the layout it was read with, so objects sent back to Jamf keep
+// the format of each field.
+type JamfTime struct {
+	time.Time
+
+	layout string
+}
+
+// NewJamfTime returns the JamfTime for t encoded with layout, e.g. JamfDateTimeLayout, or with
+// JamfTimeLayout when layout is empty
+func NewJamfTime(t time.Time, layout string) JamfTime {
+	return JamfTime{Time: t, layout: layout}
+}
+
+func (t *JamfTime) parse(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		t.Time, t.layout = time.Time{}, ""
+		return nil
+	}
+	for _, layout := range jamfTimeLayouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			t.Time, t.layout = parsed, layout
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid Jamf time %q", value)
+}
+
+// String formats the time in the layout it was decoded or created with, the zero JamfTime is empty
+func (t JamfTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	if t.layout != "" {
+		return t.Format(t.layout)
+	}
+	return t.Format(JamfTimeLayout)
+}
+
+// MarshalJSON encodes the time as String does, the zero JamfTime is an empty string
+func (t JamfTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON decodes a date in any of the formats used by Jamf
+func (t *JamfTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("invalid Jamf time %s: %v", data, err)
+	}
+	return t.parse(value)
+}
+
+// MarshalXML encodes the time as String does, the element is omitted for the zero JamfTime
+func (t JamfTime) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if t.IsZero() {
+		return nil
+	}
+	return e.EncodeElement(t.String(), start)
+}
+
+// UnmarshalXML decodes a date in any of the formats used by Jamf
+func (t *JamfTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value string
+	if err := d.DecodeElement(&value, &start); err != nil {
+		return err
+	}
+	return t.parse(value)
+}
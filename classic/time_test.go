@@ -0,0 +1,71 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyDateLimitationsTimes(t *testing.T) {
+	limitations := &jamf.PolicyDateLimitations{}
+	err := json.Unmarshal([]byte(`{
+		"activation_date": "2024-10-01 09:30:00",
+		"activation_date_epoch": 1727775000000,
+		"activation_date_utc": "2024-10-01T09:30:00.000+0000",
+		"expiration_date": "",
+		"expiration_date_epoch": 0,
+		"expiration_date_utc": ""
+	}`), limitations)
+	assert.Nil(t, err)
+	activation := time.Date(2024, 10, 1, 9, 30, 0, 0, time.UTC)
+	assert.True(t, activation.Equal(limitations.ActivationDateEPOCH.Time))
+	assert.True(t, activation.Equal(limitations.ActivationDateUTC.Time))
+	assert.True(t, activation.Equal(limitations.ActivationDate.Time))
+	assert.True(t, limitations.ExpirationDate.IsZero())
+	assert.True(t, limitations.ExpirationDateEPOCH.IsZero())
+	assert.True(t, limitations.ExpirationDateUTC.IsZero())
+
+	data, err := xml.Marshal(limitations)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<activation_date_epoch>1727775000000</activation_date_epoch>")
+	assert.Contains(t, string(data), "<activation_date>2024-10-01 09:30:00</activation_date>")
+	assert.Contains(t, string(data), "<activation_date_utc>2024-10-01T09:30:00.000+0000</activation_date_utc>")
+	assert.NotContains(t, string(data), "expiration_date")
+
+	decoded := &jamf.PolicyDateLimitations{}
+	assert.Nil(t, xml.Unmarshal(data, decoded))
+	assert.True(t, activation.Equal(decoded.ActivationDateUTC.Time))
+
+	data, err = json.Marshal(limitations)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"activation_date_epoch":1727775000000,"activation_date_utc":"2024-10-01T09:30:00.000+0000"`)
+	assert.Contains(t, string(data), `"expiration_date_epoch":0,"expiration_date_utc":""`)
+}
+
+func TestJamfTimeLayouts(t *testing.T) {
+	for _, value := range []string{`"2024-10-01T09:30:00Z"`, `"2024-10-01T09:30:00.000+0000"`, `"2024-10-01 09:30:00"`} {
+		parsed := jamf.JamfTime{}
+		assert.Nil(t, json.Unmarshal([]byte(value), &parsed), value)
+		assert.Equal(t, 9, parsed.Hour())
+	}
+
+	epoch := jamf.EpochTime{}
+	assert.Nil(t, json.Unmarshal([]byte(`"1727775000000"`), &epoch))
+	assert.Equal(t, int64(1727775000000), epoch.Millis())
+
+	date := jamf.NewJamfTime(time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), jamf.JamfDateLayout)
+	assert.Equal(t, "2024-10-01", date.String())
+	assert.Equal(t, "2024-10-01T00:00:00.000+0000", jamf.NewJamfTime(date.Time, "").String())
+	data, err := xml.Marshal(&jamf.PeripheralPurchasing{PODate: date})
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<po_date>2024-10-01</po_date>")
+
+	assert.NotNil(t, json.Unmarshal([]byte(`"yesterday"`), &jamf.JamfTime{}))
+	assert.NotNil(t, json.Unmarshal([]byte(`"soon"`), &epoch))
+}
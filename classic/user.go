@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// Users returns the ID and name of every user in Jamf
+func (j *Client) Users() ([]User, error) {
+	return j.users(context.Background())
+}
+
+func (j *Client) users(ctx context.Context) ([]User, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, usersContext)
+	req, err := http.NewRequestWithContext(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF users query request")
+	}
+
+	res := &Users{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query users from %s", ep)
+	}
+	return res.List, nil
+}
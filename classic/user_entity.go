@@ -0,0 +1,8 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+// Users represents a list of users in Jamf
+type Users struct {
+	List []User `json:"users" xml:"users>user,omitempty"`
+}
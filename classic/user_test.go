@@ -0,0 +1,28 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryAllUsers(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/JSSResource/users", r.RequestURI)
+		fmt.Fprint(w, `{"users": [{"id": 1, "name": "zoomadmin"}, {"id": 2, "name": "jdoe"}]}`)
+	}))
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	users, err := j.Users()
+	assert.Nil(t, err)
+	assert.Equal(t, []jamf.User{{ID: 1, Name: "zoomadmin"}, {ID: 2, Name: "jdoe"}}, users)
+}
@@ -5,8 +5,11 @@ package classic
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"net/url"
 )
 
 // JSONPrettyPrint can be used to pretty print JSON API responses
@@ -19,16 +22,41 @@ func JSONPrettyPrint(input []byte) string {
 	return out.String()
 }
 
-// EndpointBuilder can be utilized to query a specific API context via either name or ID
+// EndpointBuilder can be utilized to query a specific API context via either name or ID, names are
+// escaped so that they can contain spaces, slashes and other special characters
 func EndpointBuilder(endpoint string, context string, identifier interface{}) (string, error) {
 	var ep string
-	switch identifier.(type) {
+	switch id := identifier.(type) {
 	case string:
-		ep = fmt.Sprintf("%s/%s/name/%s", endpoint, context, identifier)
+		ep = fmt.Sprintf("%s/%s/name/%s", endpoint, context, url.PathEscape(id))
 	case int:
-		ep = fmt.Sprintf("%s/%s/id/%d", endpoint, context, identifier)
+		ep = fmt.Sprintf("%s/%s/id/%d", endpoint, context, id)
 	default:
 		return "", fmt.Errorf("invalid identifier of type (%v) passed for %s/%s please use name (string) or id (int)", fmt.Sprintf("%T", identifier), endpoint, context)
 	}
 	return ep, nil
 }
+
+// iterDetails lists the IDs of a resource and yields the details of each of them, which are only requested
+// once the previous details have been consumed. An error listing the resource ends the iteration while an
+// error requesting details is yielded and the iteration goes on unless the caller stops it.
+func iterDetails[S ~[]T, T any, D any](ctx context.Context, list func() (S, error), id func(T) int, details func(interface{}) (D, error)) iter.Seq2[D, error] {
+	return func(yield func(D, error) bool) {
+		var zero D
+		items, err := list()
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		for _, item := range items {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			res, err := details(id(item))
+			if !yield(res, err) {
+				return
+			}
+		}
+	}
+}
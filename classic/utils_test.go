@@ -36,3 +36,9 @@ func TestEndpointBuilderInvalid(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "invalid identifier of type (float64) passed for https://mock.test.com/tests please use name (string) or id (int)", err.Error())
 }
+
+func TestEndpointBuilderEscapedName(t *testing.T) {
+	result, err := jamf.EndpointBuilder(testDomain, testContext, "Wi-Fi / VPN #2?")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://mock.test.com/tests/name/Wi-Fi%20%2F%20VPN%20%232%3F", result)
+}
@@ -0,0 +1,153 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// VPPAccounts returns all VPP accounts
+func (j *Client) VPPAccounts() ([]BasicVPPAccount, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, vppAccountsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF VPP accounts query request")
+	}
+
+	res := &VPPAccounts{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP accounts from %s", ep)
+	}
+	return res.List, nil
+}
+
+// VPPAccountDetails returns the details for a specific VPP account given its ID or Name
+func (j *Client) VPPAccountDetails(identifier interface{}) (*VPPAccountDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAccountsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for VPP account: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP account: %v", identifier)
+	}
+
+	res := VPPAccountDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP account with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateVPPAccount will create a new VPP account in Jamf
+func (j *Client) CreateVPPAccount(content *VPPAccount) (*VPPAccount, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, vppAccountsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new VPP account")
+	}
+
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for VPP account: (%s)", ep)
+	}
+
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new VPP account"), "unable to process JAMF creation request for VPP account: (%s)", ep)
+	}
+
+	if content.ServiceToken == "" {
+		return nil, errors.Wrapf(fmt.Errorf("service token required for new VPP account"), "unable to process JAMF creation request for VPP account: (%s)", ep)
+	}
+
+	if _, err := ParseVPPServiceToken(content.ServiceToken); err != nil {
+		return nil, errors.Wrapf(err, "invalid service token for VPP account: %v", content.Name)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for VPP account: %v", content.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for VPP account: %v (%s)", content.Name, ep)
+	}
+
+	res := VPPAccount{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for VPP account %v on %s", content.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateVPPAccount will update a VPP account in Jamf by either ID or Name
+func (j *Client) UpdateVPPAccount(identifier interface{}, content *VPPAccount) (*VPPAccount, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAccountsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP account: %v", identifier)
+	}
+
+	// the service token is only sent when it is being rotated
+	if content != nil && content.ServiceToken != "" {
+		if _, err := ParseVPPServiceToken(content.ServiceToken); err != nil {
+			return nil, errors.Wrapf(err, "invalid service token for VPP account: %v", identifier)
+		}
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for VPP account: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for VPP account: %v (%s)", identifier, ep)
+	}
+
+	res := VPPAccount{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for VPP account: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateVPPAccountByName will update a VPP account in Jamf by name, which is escaped in the request path
+func (j *Client) UpdateVPPAccountByName(name string, content *VPPAccount) (*VPPAccount, error) {
+	return j.UpdateVPPAccount(name, content)
+}
+
+// DeleteVPPAccount will delete a VPP account by either ID or Name
+func (j *Client) DeleteVPPAccount(identifier interface{}) (*VPPAccount, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAccountsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP account: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for VPP account %v", identifier)
+	}
+
+	res := VPPAccount{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for VPP account %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteVPPAccountByName will delete a VPP account by name, which is escaped in the request path
+func (j *Client) DeleteVPPAccountByName(name string) (*VPPAccount, error) {
+	return j.DeleteVPPAccount(name)
+}
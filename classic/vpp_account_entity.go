@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// VPPAccounts represents a list of volume purchasing accounts in Jamf
+type VPPAccounts struct {
+	List  []BasicVPPAccount `json:"vpp_accounts" xml:"vpp_accounts>vpp_account,omitempty"`
+	Count int               `json:"-" xml:"size"`
+}
+
+// BasicVPPAccount holds the basic information for a volume purchasing account in Jamf
+type BasicVPPAccount struct {
+	ID   int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name string `json:"name" xml:"name,omitempty"`
+}
+
+// VPPAccountDetails holds the details for a single volume purchasing account
+type VPPAccountDetails struct {
+	Details *VPPAccount `json:"vpp_account"`
+}
+
+// VPPAccount represents a volume purchasing (Apps and Books) account in Jamf
+type VPPAccount struct {
+	XMLName                       xml.Name `json:"-" xml:"vpp_account,omitempty"`
+	ID                            int      `json:"id,omitempty" xml:"id,omitempty"`
+	Name                          string   `json:"name" xml:"name,omitempty"`
+	Contact                       string   `json:"contact,omitempty" xml:"contact,omitempty"`
+	ServiceToken                  string   `json:"service_token,omitempty" xml:"service_token,omitempty"`
+	AccountName                   string   `json:"account_name,omitempty" xml:"account_name,omitempty"`
+	ExpirationDate                JamfTime `json:"expiration_date,omitempty" xml:"expiration_date,omitempty"`
+	LocationName                  string   `json:"location_name,omitempty" xml:"location_name,omitempty"`
+	Country                       string   `json:"country,omitempty" xml:"country,omitempty"`
+	AppleID                       string   `json:"apple_id,omitempty" xml:"apple_id,omitempty"`
+	Site                          *Site    `json:"site,omitempty" xml:"site,omitempty"`
+	PopulateCatalogFromVPPContent bool     `json:"populate_catalog_from_vpp_content" xml:"populate_catalog_from_vpp_content"`
+	NotifyDisassociation          bool     `json:"notify_disassociation" xml:"notify_disassociation"`
+	AutoRegisterManagedUsers      bool     `json:"auto_register_managed_users" xml:"auto_register_managed_users"`
+	AutoUpdateVPPContent          bool     `json:"auto_update_vpp_content" xml:"auto_update_vpp_content"`
+}
+
+// VPPServiceToken holds the decoded contents of a service token (.vpptoken) downloaded from Apple
+type VPPServiceToken struct {
+	Token          string `json:"token"`
+	ExpirationDate string `json:"expDate"`
+	OrgName        string `json:"orgName"`
+}
+
+// ParseVPPServiceToken decodes the base64 contents of a service token file so its
+// organization and expiration can be inspected before it is uploaded to Jamf
+func ParseVPPServiceToken(serviceToken string) (*VPPServiceToken, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(serviceToken))
+	if err != nil {
+		return nil, fmt.Errorf("service token is not valid base64: %s", err.Error())
+	}
+
+	token := &VPPServiceToken{}
+	if err := json.Unmarshal(decoded, token); err != nil {
+		return nil, fmt.Errorf("service token contents are not valid JSON: %s", err.Error())
+	}
+
+	if token.Token == "" {
+		return nil, fmt.Errorf("service token is missing the token value")
+	}
+	return token, nil
+}
+
+// Expires returns the expiration time of the service token
+func (t *VPPServiceToken) Expires() (time.Time, error) {
+	// Apple formats the expiration date as 2006-01-02T15:04:05-0700
+	return time.Parse("2006-01-02T15:04:05-0700", t.ExpirationDate)
+}
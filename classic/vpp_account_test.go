@@ -0,0 +1,175 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var VPP_ACCOUNTS_API_BASE_ENDPOINT = "/JSSResource/vppaccounts"
+
+var testServiceToken = "eyJ0b2tlbiI6ImFiYzEyMyIsImV4cERhdGUiOiIyMDI3LTAzLTAxVDEwOjAwOjAwLTA4MDAiLCJvcmdOYW1lIjoiRXhhbXBsZSBTY2hvb2wgRGlzdHJpY3QifQ=="
+
+func vppAccountResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case VPP_ACCOUNTS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"vpp_accounts": [
+					{
+							"id": 1,
+							"name": "District Apps"
+					},
+					{
+							"id": 2,
+							"name": "Staff Books"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/1", VPP_ACCOUNTS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", VPP_ACCOUNTS_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				accountContents := &jamf.VPPAccount{}
+				err = xml.Unmarshal(data, accountContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				accountData, err := json.MarshalIndent(accountContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(accountData))
+			default:
+				mockAccount := &jamf.VPPAccountDetails{
+					Details: &jamf.VPPAccount{
+						ID:             1,
+						Name:           "District Apps",
+						Contact:        "IT Department",
+						AccountName:    "Example School District",
+						ExpirationDate: jamf.NewJamfTime(time.Date(2027, 3, 1, 10, 0, 0, 0, time.FixedZone("", -8*3600)), "2006-01-02T15:04:05-0700"),
+						Country:        "US",
+						AppleID:        "vpp@example.com",
+					},
+				}
+
+				var (
+					accountData []byte
+					err         error
+				)
+
+				if r.Method == "DELETE" {
+					accountData, err = json.MarshalIndent(mockAccount.Details, "", "    ")
+				} else {
+					accountData, err = json.MarshalIndent(mockAccount, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(accountData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllVPPAccounts(t *testing.T) {
+	testServer := vppAccountResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	accounts, err := j.VPPAccounts()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(accounts))
+	assert.Equal(t, "Staff Books", accounts[1].Name)
+}
+
+func TestQuerySpecificVPPAccount(t *testing.T) {
+	testServer := vppAccountResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	account, err := j.VPPAccountDetails(1)
+	assert.Nil(t, err)
+	assert.Equal(t, "District Apps", account.Details.Name)
+	assert.Equal(t, "US", account.Details.Country)
+	assert.Equal(t, "vpp@example.com", account.Details.AppleID)
+	assert.Equal(t, "2027-03-01T10:00:00-0800", account.Details.ExpirationDate.String())
+}
+
+func TestCreateVPPAccount(t *testing.T) {
+	testServer := vppAccountResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateVPPAccount(&jamf.VPPAccount{Name: "No Token"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service token required for new VPP account")
+
+	_, err = j.CreateVPPAccount(&jamf.VPPAccount{Name: "Bad Token", ServiceToken: "not-a-token"})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "service token is not valid base64")
+
+	account, err := j.CreateVPPAccount(&jamf.VPPAccount{
+		Name:                     "District Apps",
+		ServiceToken:             testServiceToken,
+		AutoRegisterManagedUsers: true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "District Apps", account.Name)
+	assert.Equal(t, testServiceToken, account.ServiceToken)
+	assert.True(t, account.AutoRegisterManagedUsers)
+}
+
+func TestUpdateAndDeleteVPPAccount(t *testing.T) {
+	testServer := vppAccountResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	account, err := j.UpdateVPPAccount(1, &jamf.VPPAccount{ServiceToken: testServiceToken})
+	assert.Nil(t, err)
+	assert.Equal(t, testServiceToken, account.ServiceToken)
+
+	removed, err := j.DeleteVPPAccount(1)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, removed.ID)
+}
+
+func TestParseVPPServiceToken(t *testing.T) {
+	token, err := jamf.ParseVPPServiceToken(testServiceToken)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc123", token.Token)
+	assert.Equal(t, "Example School District", token.OrgName)
+
+	expires, err := token.Expires()
+	assert.Nil(t, err)
+	assert.Equal(t, 2027, expires.Year())
+
+	_, err = jamf.ParseVPPServiceToken("e30=")
+	assert.NotNil(t, err)
+	assert.Equal(t, "service token is missing the token value", err.Error())
+}
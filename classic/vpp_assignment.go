@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// VPPAssignments returns all VPP assignments
+func (j *Client) VPPAssignments() ([]BasicVPPAssignment, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, vppAssignmentsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF VPP assignments query request")
+	}
+
+	res := &VPPAssignments{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP assignments from %s", ep)
+	}
+	return res.List, nil
+}
+
+// VPPAssignmentDetails returns the details for a specific VPP assignment given its ID
+func (j *Client) VPPAssignmentDetails(identifier interface{}) (*VPPAssignmentDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAssignmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for VPP assignment: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP assignment: %v", identifier)
+	}
+
+	res := VPPAssignmentDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP assignment with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateVPPAssignment will create a new VPP assignment in Jamf
+func (j *Client) CreateVPPAssignment(content *VPPAssignment) (*VPPAssignment, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, vppAssignmentsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new VPP assignment")
+	}
+
+	if content == nil || content.General == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for VPP assignment: (%s)", ep)
+	}
+
+	if content.General.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new VPP assignment"), "unable to process JAMF creation request for VPP assignment: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for VPP assignment: %v", content.General.Name)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for VPP assignment: %v (%s)", content.General.Name, ep)
+	}
+
+	res := VPPAssignment{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for VPP assignment %v on %s", content.General.Name, ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateVPPAssignment will update a VPP assignment in Jamf by ID
+func (j *Client) UpdateVPPAssignment(identifier interface{}, content *VPPAssignment) (*VPPAssignment, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAssignmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP assignment: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for VPP assignment: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for VPP assignment: %v (%s)", identifier, ep)
+	}
+
+	res := VPPAssignment{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for VPP assignment: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteVPPAssignment will delete a VPP assignment by ID
+func (j *Client) DeleteVPPAssignment(identifier interface{}) (*VPPAssignment, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppAssignmentsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP assignment: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for VPP assignment %v", identifier)
+	}
+
+	res := VPPAssignment{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for VPP assignment %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
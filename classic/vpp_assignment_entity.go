@@ -0,0 +1,46 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// VPPAssignments represents a list of volume purchasing assignments in Jamf
+type VPPAssignments struct {
+	List  []BasicVPPAssignment `json:"vpp_assignments" xml:"vpp_assignments>vpp_assignment,omitempty"`
+	Count int                  `json:"-" xml:"size"`
+}
+
+// BasicVPPAssignment holds the basic information for a volume purchasing assignment in Jamf
+type BasicVPPAssignment struct {
+	ID                int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name              string `json:"name" xml:"name,omitempty"`
+	VPPAdminAccountID int    `json:"vpp_admin_account_id,omitempty" xml:"vpp_admin_account_id,omitempty"`
+}
+
+// VPPAssignmentDetails holds the details for a single volume purchasing assignment
+type VPPAssignmentDetails struct {
+	Details *VPPAssignment `json:"vpp_assignment"`
+}
+
+// VPPAssignment represents a user based app and ebook license assignment in Jamf
+type VPPAssignment struct {
+	XMLName xml.Name              `json:"-" xml:"vpp_assignment,omitempty"`
+	General *VPPAssignmentGeneral `json:"general" xml:"general,omitempty"`
+	IBooks  []VPPContent          `json:"ibooks,omitempty" xml:"ibooks>ibook,omitempty"`
+	Apps    []VPPContent          `json:"apps,omitempty" xml:"apps>app,omitempty"`
+	Scope   *Scope                `json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// VPPAssignmentGeneral holds the general settings of a volume purchasing assignment
+type VPPAssignmentGeneral struct {
+	ID                int    `json:"id,omitempty" xml:"id,omitempty"`
+	Name              string `json:"name" xml:"name,omitempty"`
+	VPPAdminAccountID int    `json:"vpp_admin_account_id,omitempty" xml:"vpp_admin_account_id,omitempty"`
+	Site              *Site  `json:"site,omitempty" xml:"site,omitempty"`
+}
+
+// VPPContent represents an app or ebook purchased through volume purchasing
+type VPPContent struct {
+	AdamID int    `json:"adam_id,omitempty" xml:"adam_id,omitempty"`
+	Name   string `json:"name,omitempty" xml:"name,omitempty"`
+}
@@ -0,0 +1,178 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var VPP_ASSIGNMENTS_API_BASE_ENDPOINT = "/JSSResource/vppassignments"
+
+func vppAssignmentResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case VPP_ASSIGNMENTS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"vpp_assignments": [
+					{
+							"id": 3,
+							"name": "Math Apps"
+					},
+					{
+							"id": 4,
+							"name": "Required Reading"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/3", VPP_ASSIGNMENTS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", VPP_ASSIGNMENTS_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				assignmentContents := &jamf.VPPAssignment{}
+				err = xml.Unmarshal(data, assignmentContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				assignmentData, err := json.MarshalIndent(assignmentContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(assignmentData))
+			default:
+				mockVPPAssignment := &jamf.VPPAssignmentDetails{
+					Details: &jamf.VPPAssignment{
+						General: &jamf.VPPAssignmentGeneral{
+							ID:                3,
+							Name:              "Math Apps",
+							VPPAdminAccountID: 1,
+						},
+						Apps: []jamf.VPPContent{
+							{AdamID: 361309726, Name: "Pages"},
+						},
+						Scope: &jamf.Scope{
+							JSSUserGroups: []*jamf.UserGroupDetails{
+								{ID: 7, Name: "Math Teachers"},
+							},
+						},
+					},
+				}
+
+				var (
+					assignmentData []byte
+					err            error
+				)
+
+				if r.Method == "DELETE" {
+					assignmentData, err = json.MarshalIndent(mockVPPAssignment.Details, "", "    ")
+				} else {
+					assignmentData, err = json.MarshalIndent(mockVPPAssignment, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(assignmentData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllVPPAssignments(t *testing.T) {
+	testServer := vppAssignmentResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	assignments, err := j.VPPAssignments()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(assignments))
+	assert.Equal(t, 4, assignments[1].ID)
+	assert.Equal(t, "Required Reading", assignments[1].Name)
+}
+
+func TestQuerySpecificVPPAssignment(t *testing.T) {
+	testServer := vppAssignmentResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	assignment, err := j.VPPAssignmentDetails(3)
+	assert.Nil(t, err)
+	assert.Equal(t, "Math Apps", assignment.Details.General.Name)
+	assert.Equal(t, 1, assignment.Details.General.VPPAdminAccountID)
+	assert.Equal(t, 361309726, assignment.Details.Apps[0].AdamID)
+	assert.Equal(t, "Math Teachers", assignment.Details.Scope.JSSUserGroups[0].Name)
+}
+
+func TestCreateVPPAssignment(t *testing.T) {
+	testServer := vppAssignmentResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateVPPAssignment(&jamf.VPPAssignment{General: &jamf.VPPAssignmentGeneral{}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required for new VPP assignment")
+
+	scope := &jamf.Scope{AllJSSUsers: true}
+	scope.Exclude().AddJSSUser(0, "jdoe")
+	assignment, err := j.CreateVPPAssignment(&jamf.VPPAssignment{
+		General: &jamf.VPPAssignmentGeneral{
+			Name:              "Required Reading",
+			VPPAdminAccountID: 2,
+		},
+		IBooks: []jamf.VPPContent{
+			{AdamID: 1234567, Name: "Example Book"},
+		},
+		Scope: scope,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Required Reading", assignment.General.Name)
+	assert.Equal(t, 1234567, assignment.IBooks[0].AdamID)
+	assert.True(t, assignment.Scope.AllJSSUsers)
+	assert.Equal(t, "jdoe", assignment.Scope.Exclusions.JSSUsers[0].Name)
+}
+
+func TestUpdateVPPAssignment(t *testing.T) {
+	testServer := vppAssignmentResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	assignment, err := j.UpdateVPPAssignment(3, &jamf.VPPAssignment{
+		Scope: &jamf.Scope{
+			JSSUsers: []*jamf.User{{ID: 12}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 12, assignment.Scope.JSSUsers[0].ID)
+}
+
+func TestDeleteVPPAssignment(t *testing.T) {
+	testServer := vppAssignmentResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteVPPAssignment(3)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, removed.General.ID)
+}
@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// VPPInvitations returns all VPP invitations
+func (j *Client) VPPInvitations() ([]BasicVPPInvitation, error) {
+	ep := fmt.Sprintf("%s/%s", j.Endpoint, vppInvitationsContext)
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error building JAMF VPP invitations query request")
+	}
+
+	res := &VPPInvitations{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP invitations from %s", ep)
+	}
+	return res.List, nil
+}
+
+// VPPInvitationDetails returns the details for a specific VPP invitation given its ID
+func (j *Client) VPPInvitationDetails(identifier interface{}) (*VPPInvitationDetails, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppInvitationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query endpoint for VPP invitation: %v", identifier)
+	}
+	req, err := http.NewRequestWithContext(context.Background(), "GET", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP invitation: %v", identifier)
+	}
+
+	res := VPPInvitationDetails{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query VPP invitation with ID/name %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// CreateVPPInvitation will create a new VPP invitation in Jamf
+func (j *Client) CreateVPPInvitation(content *VPPInvitation) (*VPPInvitation, error) {
+	// -1 denotes the next available ID
+	ep, err := EndpointBuilder(j.Endpoint, vppInvitationsContext, -1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for new VPP invitation")
+	}
+
+	if content == nil || content.General == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for VPP invitation: (%s)", ep)
+	}
+
+	if content.General.VPPAccount == nil || (content.General.VPPAccount.ID == 0 && content.General.VPPAccount.Name == "") {
+		return nil, errors.Wrapf(fmt.Errorf("VPP account required for new VPP invitation"), "unable to process JAMF creation request for VPP invitation: (%s)", ep)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation payload for VPP invitation: (%s)", ep)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "POST", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF creation request for VPP invitation: (%s)", ep)
+	}
+
+	res := VPPInvitation{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for VPP invitation on %s", ep)
+	}
+
+	return &res, nil
+}
+
+// UpdateVPPInvitation will update a VPP invitation in Jamf by ID
+func (j *Client) UpdateVPPInvitation(identifier interface{}, content *VPPInvitation) (*VPPInvitation, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppInvitationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP invitation: %v", identifier)
+	}
+
+	bodyContent, err := xml.Marshal(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update payload for VPP invitation: %v", identifier)
+	}
+
+	body := bytes.NewReader(bodyContent)
+	req, err := http.NewRequestWithContext(context.Background(), "PUT", ep, body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF update request for VPP invitation: %v (%s)", identifier, ep)
+	}
+
+	res := VPPInvitation{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for VPP invitation: %v (%s)", identifier, ep)
+	}
+
+	return &res, nil
+}
+
+// DeleteVPPInvitation will delete a VPP invitation by ID
+func (j *Client) DeleteVPPInvitation(identifier interface{}) (*VPPInvitation, error) {
+	ep, err := EndpointBuilder(j.Endpoint, vppInvitationsContext, identifier)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF query request for VPP invitation: %v", identifier)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), "DELETE", ep, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF deletion request for VPP invitation %v", identifier)
+	}
+
+	res := VPPInvitation{}
+	if err := j.makeAPIrequest(req, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF deletion request for VPP invitation %v from %s", identifier, ep)
+	}
+
+	return &res, nil
+}
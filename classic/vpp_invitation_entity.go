@@ -0,0 +1,57 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic
+
+import "encoding/xml"
+
+// VPP invitation types supported by Jamf
+const (
+	VPPInvitationTypeEmail        = "email"
+	VPPInvitationTypeNotification = "notification"
+)
+
+// VPPInvitations represents a list of volume purchasing invitations in Jamf
+type VPPInvitations struct {
+	List  []BasicVPPInvitation `json:"vpp_invitations" xml:"vpp_invitations>vpp_invitation,omitempty"`
+	Count int                  `json:"-" xml:"size"`
+}
+
+// BasicVPPInvitation holds the basic information for a volume purchasing invitation in Jamf
+type BasicVPPInvitation struct {
+	ID               int    `json:"id,omitempty" xml:"id,omitempty"`
+	InvitationType   string `json:"invitation_type,omitempty" xml:"invitation_type,omitempty"`
+	InvitationStatus string `json:"invitation_status,omitempty" xml:"invitation_status,omitempty"`
+}
+
+// VPPInvitationDetails holds the details for a single volume purchasing invitation
+type VPPInvitationDetails struct {
+	Details *VPPInvitation `json:"vpp_invitation"`
+}
+
+// VPPInvitation represents an invitation for users to join volume purchasing in Jamf
+type VPPInvitation struct {
+	XMLName          xml.Name              `json:"-" xml:"vpp_invitation,omitempty"`
+	General          *VPPInvitationGeneral `json:"general" xml:"general,omitempty"`
+	Scope            *Scope                `json:"scope,omitempty" xml:"scope,omitempty"`
+	InvitationUsages []VPPInvitationUsage  `json:"invitation_usages,omitempty" xml:"-"`
+}
+
+// VPPInvitationGeneral holds the general settings of a volume purchasing invitation
+type VPPInvitationGeneral struct {
+	ID                  int              `json:"id,omitempty" xml:"id,omitempty"`
+	InvitationType      string           `json:"invitation_type,omitempty" xml:"invitation_type,omitempty"`
+	VPPAccount          *BasicVPPAccount `json:"vpp_account,omitempty" xml:"vpp_account,omitempty"`
+	InvitationStatus    string           `json:"invitation_status,omitempty" xml:"invitation_status,omitempty"`
+	ExpirationDate      JamfTime         `json:"expiration_date,omitempty" xml:"expiration_date,omitempty"`
+	ExpirationDateUTC   JamfTime         `json:"expiration_date_utc,omitempty" xml:"expiration_date_utc,omitempty"`
+	ExpirationDateEPOCH EpochTime        `json:"expiration_date_epoch,omitempty" xml:"expiration_date_epoch,omitempty"`
+	Message             string           `json:"message,omitempty" xml:"message,omitempty"`
+	ReminderFrequency   int              `json:"reminder_frequency,omitempty" xml:"reminder_frequency,omitempty"`
+	Site                *Site            `json:"site,omitempty" xml:"site,omitempty"`
+}
+
+// VPPInvitationUsage holds the status of an invitation sent to a specific user
+type VPPInvitationUsage struct {
+	Username string `json:"username,omitempty" xml:"username,omitempty"`
+	Status   string `json:"status,omitempty" xml:"status,omitempty"`
+}
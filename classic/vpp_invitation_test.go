@@ -0,0 +1,177 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package classic_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jamf "github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/stretchr/testify/assert"
+)
+
+var VPP_INVITATIONS_API_BASE_ENDPOINT = "/JSSResource/vppinvitations"
+
+func vppInvitationResponseMocks(t *testing.T) *httptest.Server {
+	var resp string
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.RequestURI {
+		case VPP_INVITATIONS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{
+				"vpp_invitations": [
+					{
+							"id": 5,
+							"invitation_type": "email",
+							"invitation_status": "Sent"
+					},
+					{
+							"id": 6,
+							"invitation_type": "notification",
+							"invitation_status": "Sent"
+					}]
+			}`)
+		case fmt.Sprintf("%s/id/5", VPP_INVITATIONS_API_BASE_ENDPOINT), fmt.Sprintf("%s/id/-1", VPP_INVITATIONS_API_BASE_ENDPOINT):
+			switch r.Method {
+			case "PUT", "POST":
+				data, err := io.ReadAll(r.Body)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				invitationContents := &jamf.VPPInvitation{}
+				err = xml.Unmarshal(data, invitationContents)
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				invitationData, err := json.MarshalIndent(invitationContents, "", "    ")
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+				fmt.Fprint(w, string(invitationData))
+			default:
+				mockVPPInvitation := &jamf.VPPInvitationDetails{
+					Details: &jamf.VPPInvitation{
+						General: &jamf.VPPInvitationGeneral{
+							ID:             5,
+							InvitationType: jamf.VPPInvitationTypeEmail,
+							VPPAccount:     &jamf.BasicVPPAccount{ID: 1, Name: "District Apps"},
+							Message:        "Join our volume purchasing program",
+						},
+						Scope: &jamf.Scope{
+							AllJSSUsers: true,
+						},
+						InvitationUsages: []jamf.VPPInvitationUsage{
+							{Username: "jappleseed", Status: "Registered"},
+						},
+					},
+				}
+
+				var (
+					invitationData []byte
+					err            error
+				)
+
+				if r.Method == "DELETE" {
+					invitationData, err = json.MarshalIndent(mockVPPInvitation.Details, "", "    ")
+				} else {
+					invitationData, err = json.MarshalIndent(mockVPPInvitation, "", "    ")
+				}
+				if err != nil {
+					fmt.Fprint(w, err.Error())
+				}
+
+				fmt.Fprint(w, string(invitationData))
+			}
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		_, err := w.Write([]byte(resp))
+		assert.Nil(t, err)
+	}))
+}
+
+func TestQueryAllVPPInvitations(t *testing.T) {
+	testServer := vppInvitationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	invitations, err := j.VPPInvitations()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(invitations))
+	assert.Equal(t, 6, invitations[1].ID)
+	assert.Equal(t, jamf.VPPInvitationTypeNotification, invitations[1].InvitationType)
+}
+
+func TestQuerySpecificVPPInvitation(t *testing.T) {
+	testServer := vppInvitationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	invitation, err := j.VPPInvitationDetails(5)
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.VPPInvitationTypeEmail, invitation.Details.General.InvitationType)
+	assert.Equal(t, "District Apps", invitation.Details.General.VPPAccount.Name)
+	assert.True(t, invitation.Details.Scope.AllJSSUsers)
+	assert.Equal(t, "Registered", invitation.Details.InvitationUsages[0].Status)
+}
+
+func TestCreateVPPInvitation(t *testing.T) {
+	testServer := vppInvitationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+
+	_, err = j.CreateVPPInvitation(&jamf.VPPInvitation{General: &jamf.VPPInvitationGeneral{}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "VPP account required for new VPP invitation")
+
+	invitation, err := j.CreateVPPInvitation(&jamf.VPPInvitation{
+		General: &jamf.VPPInvitationGeneral{
+			InvitationType:    jamf.VPPInvitationTypeNotification,
+			VPPAccount:        &jamf.BasicVPPAccount{ID: 1},
+			ReminderFrequency: 7,
+		},
+		Scope: &jamf.Scope{
+			JSSUserGroups: []*jamf.UserGroupDetails{{ID: 3, Name: "New Hires"}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, jamf.VPPInvitationTypeNotification, invitation.General.InvitationType)
+	assert.Equal(t, 1, invitation.General.VPPAccount.ID)
+	assert.Equal(t, 7, invitation.General.ReminderFrequency)
+	assert.Equal(t, "New Hires", invitation.Scope.JSSUserGroups[0].Name)
+}
+
+func TestUpdateVPPInvitation(t *testing.T) {
+	testServer := vppInvitationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	invitation, err := j.UpdateVPPInvitation(5, &jamf.VPPInvitation{
+		General: &jamf.VPPInvitationGeneral{
+			Message: "Reminder: join volume purchasing",
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Reminder: join volume purchasing", invitation.General.Message)
+}
+
+func TestDeleteVPPInvitation(t *testing.T) {
+	testServer := vppInvitationResponseMocks(t)
+	defer testServer.Close()
+	j, err := jamf.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	j.Token = &testToken
+	assert.Nil(t, err)
+	removed, err := j.DeleteVPPInvitation(5)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, removed.General.ID)
+}
@@ -0,0 +1,133 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// computerColumns are the columns shown when listing computers
+var computerColumns = []pro.ComputerInventoryColumn{
+	pro.ComputerInventoryColumnID,
+	pro.ComputerInventoryColumnName,
+	pro.ComputerInventoryColumnSerialNumber,
+	pro.ComputerInventoryColumnModel,
+	pro.ComputerInventoryColumnOSVersion,
+	pro.ComputerInventoryColumnUsername,
+	pro.ComputerInventoryColumnLastContactTime,
+}
+
+func computerSections(columns []pro.ComputerInventoryColumn) []pro.ComputerInventorySection {
+	seen := map[pro.ComputerInventorySection]bool{}
+	sections := []pro.ComputerInventorySection{}
+	for _, c := range columns {
+		if !seen[c.Section] {
+			seen[c.Section] = true
+			sections = append(sections, c.Section)
+		}
+	}
+	return sections
+}
+
+func computerRows(computers []pro.ComputerInventory) ([]string, [][]string) {
+	header := make([]string, len(computerColumns))
+	for i, c := range computerColumns {
+		header[i] = c.Header
+	}
+	rows := make([][]string, len(computers))
+	for i := range computers {
+		rows[i] = make([]string, len(computerColumns))
+		for j, c := range computerColumns {
+			rows[i][j] = c.Value(computers[i])
+		}
+	}
+	return header, rows
+}
+
+func listComputers(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("computers list")
+	filter := fs.String("filter", "", `RSQL filter, e.g. general.name=="Mac*"`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	computers, err := a.pro.AllComputersInventory(ctx, &pro.ListOptions{Filter: *filter}, computerSections(computerColumns)...)
+	if err != nil {
+		return err
+	}
+	header, rows := computerRows(computers)
+	return a.out.print(computers, header, rows)
+}
+
+func getComputer(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("computers get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a computer ID"); err != nil {
+		return err
+	}
+
+	computer, err := a.pro.ComputerInventoryDetails(ctx, fs.Arg(0), computerSections(computerColumns)...)
+	if err != nil {
+		return err
+	}
+	pairs := []string{}
+	for _, c := range computerColumns {
+		pairs = append(pairs, c.Header, c.Value(*computer))
+	}
+	return a.out.fields(computer, pairs...)
+}
+
+func deleteComputer(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("computers delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a computer ID"); err != nil {
+		return err
+	}
+
+	if err := a.pro.DeleteComputer(ctx, fs.Arg(0)); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "deleted computer %s\n", fs.Arg(0))
+	return nil
+}
+
+func exportComputers(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("computers export")
+	filter := fs.String("filter", "", `RSQL filter, e.g. general.name=="Mac*"`)
+	file := fs.String("file", "", "file to write the report to, standard output by default")
+	format := fs.String("format", "csv", "format of the report, csv or ndjson")
+	var attributes stringsFlag
+	fs.Var(&attributes, "attribute", "extension attribute to add as a column, may be repeated")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	reportFormat, err := export.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	columns := append([]pro.ComputerInventoryColumn{}, computerColumns...)
+	for _, name := range attributes {
+		columns = append(columns, pro.ComputerInventoryExtensionAttributeColumn(name))
+	}
+
+	w := a.stdout
+	if *file != "" {
+		f, err := os.Create(*file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return a.pro.ExportComputersInventory(ctx, &pro.ListOptions{Filter: *filter}, w, reportFormat, columns...)
+}
@@ -0,0 +1,158 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/diff"
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// diffTarget holds the flags of a diff verb selecting the server the second object is read from
+type diffTarget struct {
+	url      *string
+	username *string
+	password *string
+}
+
+func addDiffTarget(fs *flag.FlagSet) *diffTarget {
+	return &diffTarget{
+		url:      fs.String("target", "", "server to compare with, e.g. production, the objects are compared on the same server by default"),
+		username: fs.String("target-username", os.Getenv("JAMF_TARGET_USERNAME"), "API username of the target server, the global username by default"),
+		password: fs.String("target-password", os.Getenv("JAMF_TARGET_PASSWORD"), "API password of the target server, the global password by default"),
+	}
+}
+
+// clients returns the clients of the server holding the second object and the identifiers of the
+// objects compared, the second identifier defaults to the first when comparing with a target server
+func (d *diffTarget) clients(a *app, fs *flag.FlagSet) (*classic.Client, *pro.Client, string, string, error) {
+	if *d.url == "" {
+		if fs.NArg() != 2 {
+			return nil, nil, "", "", fmt.Errorf("expected the two objects to compare, or -target and the object to compare")
+		}
+		return a.classic, a.pro, fs.Arg(0), fs.Arg(1), nil
+	}
+	if fs.NArg() < 1 || fs.NArg() > 2 {
+		return nil, nil, "", "", fmt.Errorf("expected the object to compare and optionally its name or ID on the target server")
+	}
+	second := fs.Arg(0)
+	if fs.NArg() == 2 {
+		second = fs.Arg(1)
+	}
+
+	username, password := *d.username, *d.password
+	if username == "" {
+		username = a.classic.Username
+	}
+	if password == "" {
+		password = a.classic.Password
+	}
+	c, err := classic.NewClient(*d.url, username, password, nil)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	p, err := pro.NewClient(*d.url, username, password, nil)
+	if err != nil {
+		return nil, nil, "", "", err
+	}
+	return c, p, fs.Arg(0), second, nil
+}
+
+// printChanges prints the differences found, it returns an error when there are any so that drift
+// can be detected from the exit code
+func printChanges(a *app, changes []diff.Change) error {
+	rows := make([][]string, len(changes))
+	for i, c := range changes {
+		rows[i] = []string{c.Path, diff.FormatValue(c.A), diff.FormatValue(c.B)}
+	}
+	if err := a.out.print(changes, []string{"PATH", "A", "B"}, rows); err != nil {
+		return err
+	}
+	if len(changes) > 0 {
+		return fmt.Errorf("%d differences found", len(changes))
+	}
+	return nil
+}
+
+func diffPolicies(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("policies diff")
+	target := addDiffTarget(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	other, _, first, second, err := target.clients(a, fs)
+	if err != nil {
+		return err
+	}
+
+	policyA, err := a.classic.PolicyDetails(classicIdentifier(first))
+	if err != nil {
+		return err
+	}
+	policyB, err := other.PolicyDetails(classicIdentifier(second))
+	if err != nil {
+		return err
+	}
+	return printChanges(a, diff.Diff(policyA.Content, policyB.Content))
+}
+
+func diffProfiles(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("profiles diff")
+	target := addDiffTarget(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	other, _, first, second, err := target.clients(a, fs)
+	if err != nil {
+		return err
+	}
+
+	profileA, err := a.classic.ManagedPreferenceProfileDetails(classicIdentifier(first))
+	if err != nil {
+		return err
+	}
+	profileB, err := other.ManagedPreferenceProfileDetails(classicIdentifier(second))
+	if err != nil {
+		return err
+	}
+	return printChanges(a, diff.Diff(profileA.Details, profileB.Details))
+}
+
+func diffGroups(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("groups diff")
+	target := addDiffTarget(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	_, other, first, second, err := target.clients(a, fs)
+	if err != nil {
+		return err
+	}
+
+	groupA, err := smartGroupDetails(ctx, a.pro, first)
+	if err != nil {
+		return err
+	}
+	groupB, err := smartGroupDetails(ctx, other, second)
+	if err != nil {
+		return err
+	}
+	return printChanges(a, diff.Diff(groupA, groupB))
+}
+
+// smartGroupDetails returns a smart computer group given its ID or name
+func smartGroupDetails(ctx context.Context, p *pro.Client, identifier string) (*pro.SmartComputerGroup, error) {
+	group, err := computerGroup(ctx, p, identifier)
+	if err != nil {
+		return nil, err
+	}
+	if !group.SmartGroup {
+		return nil, fmt.Errorf("computer group %s is not a smart group", identifier)
+	}
+	return p.SmartComputerGroupDetails(ctx, group.ID)
+}
@@ -0,0 +1,133 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// computerGroup looks up a computer group by ID or name to tell smart and static groups apart
+func computerGroup(ctx context.Context, p *pro.Client, identifier string) (*pro.ComputerGroup, error) {
+	groups, err := p.ComputerGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range groups {
+		if groups[i].ID == identifier {
+			return &groups[i], nil
+		}
+	}
+	for i := range groups {
+		if groups[i].Name == identifier {
+			return &groups[i], nil
+		}
+	}
+	return nil, fmt.Errorf("computer group %s not found", identifier)
+}
+
+func groupKind(g pro.ComputerGroup) string {
+	if g.SmartGroup {
+		return "smart"
+	}
+	return "static"
+}
+
+func listGroups(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("groups list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	groups, err := a.pro.ComputerGroups(ctx)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(groups))
+	for i, g := range groups {
+		rows[i] = []string{g.ID, g.Name, groupKind(g)}
+	}
+	return a.out.print(groups, []string{"ID", "NAME", "TYPE"}, rows)
+}
+
+func groupMembers(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("groups members")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a computer group ID or name"); err != nil {
+		return err
+	}
+
+	group, err := computerGroup(ctx, a.pro, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var members pro.ComputerGroupMemberList
+	if group.SmartGroup {
+		members, err = a.pro.SmartComputerGroupMembers(ctx, group.ID)
+	} else {
+		members, err = a.pro.StaticComputerGroupMembers(ctx, group.ID)
+	}
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(members))
+	for i, id := range members {
+		rows[i] = []string{strconv.Itoa(id)}
+	}
+	return a.out.print(members, []string{"COMPUTER ID"}, rows)
+}
+
+func createGroup(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("groups create")
+	name := fs.String("name", "", "name of the computer group")
+	description := fs.String("description", "", "description of the computer group")
+	static := fs.Bool("static", false, "create a static group, the arguments are the IDs of its computers")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var created *pro.CreatedResource
+	var err error
+	if *static {
+		created, err = a.pro.CreateStaticComputerGroup(ctx, &pro.StaticComputerGroup{Name: *name, Description: *description, Assignments: fs.Args()})
+	} else {
+		if fs.NArg() > 0 {
+			return fmt.Errorf("computers can only be assigned to static groups")
+		}
+		created, err = a.pro.CreateSmartComputerGroup(ctx, &pro.SmartComputerGroup{Name: *name, Description: *description, Criteria: []pro.SmartComputerGroupCriterion{}})
+	}
+	if err != nil {
+		return err
+	}
+	return a.out.fields(created, "ID", created.ID, "Href", created.Href)
+}
+
+func deleteGroup(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("groups delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a computer group ID or name"); err != nil {
+		return err
+	}
+
+	group, err := computerGroup(ctx, a.pro, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if group.SmartGroup {
+		err = a.pro.DeleteSmartComputerGroup(ctx, group.ID)
+	} else {
+		err = a.pro.DeleteStaticComputerGroup(ctx, group.ID)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "deleted %s computer group %s\n", groupKind(*group), group.ID)
+	return nil
+}
@@ -0,0 +1,190 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Command jamfctl manages a Jamf Pro server from the command line using the classic and Jamf Pro
+// API clients of this module
+//
+//	jamfctl [global flags] <resource> <verb> [flags] [args]
+//
+// The server and credentials are read from the -url, -username and -password flags, which default
+// to the JAMF_URL, JAMF_USERNAME and JAMF_PASSWORD environment variables.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// version is set at build time with -ldflags "-X main.version=..."
+var version = "dev"
+
+// app holds what every command needs: the API clients and where to write
+type app struct {
+	classic *classic.Client
+	pro     *pro.Client
+	out     *printer
+	stdout  io.Writer
+	stderr  io.Writer
+}
+
+// command runs a verb of a resource with the arguments following it
+type command func(ctx context.Context, a *app, args []string) error
+
+// resources maps each resource to its verbs
+var resources = map[string]map[string]command{
+	"computers": {
+		"list":   listComputers,
+		"get":    getComputer,
+		"delete": deleteComputer,
+		"export": exportComputers,
+	},
+	"policies": {
+		"list":   listPolicies,
+		"get":    getPolicy,
+		"create": createPolicy,
+		"delete": deletePolicy,
+		"diff":   diffPolicies,
+	},
+	"profiles": {
+		"diff": diffProfiles,
+	},
+	"scripts": {
+		"list":   listScripts,
+		"get":    getScript,
+		"create": createScript,
+		"delete": deleteScript,
+	},
+	"groups": {
+		"list":    listGroups,
+		"members": groupMembers,
+		"create":  createGroup,
+		"delete":  deleteGroup,
+		"diff":    diffGroups,
+	},
+	"mdm": {
+		"send":   sendMDMCommand,
+		"status": mdmCommandStatus,
+	},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run parses the global flags, builds the clients and runs the requested command, it returns the
+// process exit code
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("jamfctl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	url := fs.String("url", os.Getenv("JAMF_URL"), "Jamf Pro server URL, e.g. https://example.jamfcloud.com")
+	username := fs.String("username", os.Getenv("JAMF_USERNAME"), "Jamf Pro API username")
+	password := fs.String("password", os.Getenv("JAMF_PASSWORD"), "Jamf Pro API password")
+	output := fs.String("o", formatTable, "output format: json, csv or table")
+	fs.Usage = func() { usage(fs) }
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	args = fs.Args()
+	if len(args) == 1 && args[0] == "version" {
+		fmt.Fprintln(stdout, version)
+		return 0
+	}
+	if len(args) < 2 {
+		fs.Usage()
+		return 2
+	}
+	verbs, ok := resources[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown resource %q\n", args[0])
+		fs.Usage()
+		return 2
+	}
+	cmd, ok := verbs[args[1]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown verb %q for %s, expected one of: %s\n", args[1], args[0], strings.Join(names(verbs), ", "))
+		return 2
+	}
+
+	out, err := newPrinter(stdout, *output)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	classicClient, err := classic.NewClient(*url, *username, *password, nil)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+	proClient, err := pro.NewClient(*url, *username, *password, nil)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 2
+	}
+
+	a := &app{classic: classicClient, pro: proClient, out: out, stdout: stdout, stderr: stderr}
+	if err := cmd(ctx, a, args[2:]); err != nil {
+		fmt.Fprintf(stderr, "jamfctl %s %s: %v\n", args[0], args[1], err)
+		return 1
+	}
+	return 0
+}
+
+func usage(fs *flag.FlagSet) {
+	w := fs.Output()
+	fmt.Fprintln(w, "Usage: jamfctl [global flags] <resource> <verb> [flags] [args]")
+	fmt.Fprintln(w, "\nResources and verbs:")
+	for _, resource := range names(resources) {
+		fmt.Fprintf(w, "  %-10s %s\n", resource, strings.Join(names(resources[resource]), ", "))
+	}
+	fmt.Fprintln(w, "  version")
+	fmt.Fprintln(w, "\nGlobal flags:")
+	fs.PrintDefaults()
+}
+
+// newFlagSet returns the flag set of a verb, errors are reported to the app's stderr
+func (a *app) newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+	return fs
+}
+
+// exactArgs checks that a verb received n positional arguments
+func exactArgs(fs *flag.FlagSet, n int, names string) error {
+	if fs.NArg() != n {
+		return fmt.Errorf("expected %s", names)
+	}
+	return nil
+}
+
+func names[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringsFlag collects the values of a flag that may be repeated
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
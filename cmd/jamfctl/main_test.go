@@ -0,0 +1,236 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// jamfMock serves the endpoints used by jamfctl and records the requests that change data
+type jamfMock struct {
+	requests []string
+	bodies   map[string]string
+}
+
+func (m *jamfMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	call := fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+	if r.Method != "GET" {
+		m.requests = append(m.requests, call)
+		data, _ := io.ReadAll(r.Body)
+		m.bodies[call] = string(data)
+	}
+	switch call {
+	case "POST /api/v1/auth/token":
+		fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+	case "GET /api/v1/computers-inventory":
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"id": "1", "general": {"name": "MAC-001", "lastContactTime": "2024-10-01T12:00:00Z"}, "hardware": {"serialNumber": "C02AAA", "model": "MacBook Pro"}, "operatingSystem": {"version": "14.6"}, "userAndLocation": {"username": "jdoe"}},
+			{"id": "2", "general": {"name": "MAC-002"}, "hardware": {"serialNumber": "C02BBB", "model": "Mac mini"}}
+		]}`)
+	case "GET /api/v1/computers-inventory/1":
+		fmt.Fprint(w, `{"id": "1", "general": {"name": "MAC-001"}, "hardware": {"serialNumber": "C02AAA"}}`)
+	case "DELETE /api/v1/computers-inventory/1":
+		w.WriteHeader(http.StatusNoContent)
+	case "GET /JSSResource/policies":
+		fmt.Fprint(w, `{"policies": [{"id": 4, "name": "Install Chrome"}]}`)
+	case "GET /JSSResource/policies/id/4", "GET /JSSResource/policies/name/Install Chrome":
+		fmt.Fprint(w, `{"policy": {"general": {"id": 4, "name": "Install Chrome", "enabled": true, "category": {"name": "Browsers"}}}}`)
+	case "GET /JSSResource/policies/id/5":
+		fmt.Fprint(w, `{"policy": {"general": {"id": 5, "name": "Install Chrome (staging)", "enabled": false, "category": {"name": "Browsers"}}}}`)
+	case "POST /JSSResource/policies/id/-1":
+		w.Header().Set("Content-Type", "application/xml")
+		fmt.Fprint(w, `<policy><id>5</id></policy>`)
+	case "DELETE /JSSResource/policies/id/4":
+		fmt.Fprint(w, `{"policy": {"id": 4}}`)
+	case "GET /api/v1/scripts":
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "3", "name": "Clean Up", "categoryName": "Maintenance"}]}`)
+	case "POST /api/v1/scripts":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "6", "href": "/api/v1/scripts/6"}`)
+	case "GET /api/v1/computer-groups":
+		fmt.Fprint(w, `[{"id": "1", "name": "All Managed Clients", "smartGroup": true}, {"id": "2", "name": "Pilot", "smartGroup": false}]`)
+	case "GET /api/v2/computer-groups/static-group-membership/2":
+		fmt.Fprint(w, `{"members": [11, 12]}`)
+	case "POST /api/v2/computer-groups/static-groups":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "7", "href": "/api/v2/computer-groups/static-groups/7"}`)
+	case "DELETE /api/v2/computer-groups/smart-groups/1":
+		w.WriteHeader(http.StatusNoContent)
+	case "POST /api/v2/mdm/commands":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `[{"id": "aaaa-bbbb", "href": "/api/v2/mdm/commands/aaaa-bbbb"}]`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"httpStatus": 404, "errors": [{"code": "NOT_FOUND", "description": "%s not found"}]}`, r.URL.Path)
+	}
+}
+
+// runCLI runs jamfctl against a mock server, arguments equal to serverURL are replaced by its URL
+func runCLI(t *testing.T, args ...string) (*jamfMock, int, string, string) {
+	mock := &jamfMock{bodies: map[string]string{}}
+	server := httptest.NewServer(mock)
+	defer server.Close()
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	for i := range args {
+		if args[i] == serverURL {
+			args[i] = server.URL
+		}
+	}
+	args = append([]string{"-url", server.URL, "-username", "api", "-password", "secret"}, args...)
+	code := run(context.Background(), args, stdout, stderr)
+	return mock, code, stdout.String(), stderr.String()
+}
+
+const serverURL = "<server-url>"
+
+func TestComputers(t *testing.T) {
+	_, code, stdout, _ := runCLI(t, "computers", "list")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "MAC-001")
+	assert.Contains(t, stdout, "C02BBB")
+	assert.Regexp(t, `ID\s+Name\s+Serial Number`, stdout)
+
+	_, code, stdout, _ = runCLI(t, "-o", "json", "computers", "get", "1")
+	assert.Equal(t, 0, code)
+	computer := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal([]byte(stdout), &computer))
+	assert.Equal(t, "1", computer["id"])
+
+	mock, code, _, stderr := runCLI(t, "computers", "delete", "1")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, []string{"POST /api/v1/auth/token", "DELETE /api/v1/computers-inventory/1"}, mock.requests)
+	assert.Contains(t, stderr, "deleted computer 1")
+
+	_, code, stdout, _ = runCLI(t, "computers", "export")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "ID,Name,Serial Number")
+	assert.Contains(t, stdout, "2,MAC-002,C02BBB,Mac mini")
+
+	_, code, stdout, _ = runCLI(t, "computers", "export", "-format", "ndjson")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, `{"ID":"2","Name":"MAC-002","Serial Number":"C02BBB","Model":"Mac mini"`)
+}
+
+func TestPolicies(t *testing.T) {
+	_, code, stdout, _ := runCLI(t, "-o", "csv", "policies", "list")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "ID,NAME\n4,Install Chrome\n", stdout)
+
+	_, code, stdout, _ = runCLI(t, "policies", "get", "Install Chrome")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `Category\s+Browsers`, stdout)
+
+	mock, code, _, _ := runCLI(t, "policies", "create", "-name", "Install Firefox", "-category", "Browsers", "-enabled")
+	assert.Equal(t, 0, code)
+	body := mock.bodies["POST /JSSResource/policies/id/-1"]
+	assert.Contains(t, body, "<name>Install Firefox</name>")
+	assert.Contains(t, body, "<enabled>true</enabled>")
+
+	_, code, _, stderr := runCLI(t, "policies", "create")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "name required for new policy")
+
+	mock, code, _, _ = runCLI(t, "policies", "delete", "4")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, mock.requests, "DELETE /JSSResource/policies/id/4")
+}
+
+func TestDiff(t *testing.T) {
+	_, code, stdout, stderr := runCLI(t, "-o", "csv", "policies", "diff", "4", "5")
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "PATH,A,B\ngeneral.enabled,true,false\ngeneral.name,\"\"\"Install Chrome\"\"\",\"\"\"Install Chrome (staging)\"\"\"\n", stdout)
+	assert.Contains(t, stderr, "2 differences found")
+
+	_, code, stdout, _ = runCLI(t, "policies", "diff", "-target", serverURL, "Install Chrome")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "PATH  A  B\n", stdout)
+
+	_, code, _, stderr = runCLI(t, "policies", "diff", "4")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "expected the two objects to compare")
+
+	_, code, _, stderr = runCLI(t, "groups", "diff", "Pilot", "All Managed Clients")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "computer group Pilot is not a smart group")
+}
+
+func TestScripts(t *testing.T) {
+	_, code, stdout, _ := runCLI(t, "scripts", "list")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `3\s+Clean Up\s+Maintenance`, stdout)
+
+	file := filepath.Join(t.TempDir(), "hello.sh")
+	assert.Nil(t, os.WriteFile(file, []byte("#!/bin/sh\necho hello\n"), 0o600))
+	mock, code, stdout, _ := runCLI(t, "-o", "json", "scripts", "create", "-name", "Hello", "-file", file)
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, `"id": "6"`)
+	assert.Contains(t, mock.bodies["POST /api/v1/scripts"], `"scriptContents":"#!/bin/sh\necho hello\n"`)
+
+	_, code, _, stderr := runCLI(t, "scripts", "create", "-name", "Hello")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "-name and -file are required")
+}
+
+func TestGroups(t *testing.T) {
+	_, code, stdout, _ := runCLI(t, "groups", "list")
+	assert.Equal(t, 0, code)
+	assert.Regexp(t, `2\s+Pilot\s+static`, stdout)
+
+	_, code, stdout, _ = runCLI(t, "-o", "csv", "groups", "members", "2")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "COMPUTER ID\n11\n12\n", stdout)
+
+	mock, code, _, _ := runCLI(t, "groups", "create", "-static", "-name", "Canary", "11", "12")
+	assert.Equal(t, 0, code)
+	assert.JSONEq(t, `{"name": "Canary", "assignments": ["11", "12"]}`, mock.bodies["POST /api/v2/computer-groups/static-groups"])
+
+	mock, code, _, _ = runCLI(t, "groups", "delete", "1")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, mock.requests, "DELETE /api/v2/computer-groups/smart-groups/1")
+
+	_, code, _, stderr := runCLI(t, "groups", "delete", "9")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "computer group 9 not found")
+}
+
+func TestMDM(t *testing.T) {
+	mock, code, stdout, _ := runCLI(t, "mdm", "send", "-notify", "restart", "mgmt-1")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, stdout, "aaaa-bbbb")
+	assert.Contains(t, mock.bodies["POST /api/v2/mdm/commands"], `"commandType":"RESTART_DEVICE"`)
+	assert.Contains(t, mock.bodies["POST /api/v2/mdm/commands"], `"notifyUser":true`)
+
+	_, code, _, stderr := runCLI(t, "mdm", "send", "self-destruct", "mgmt-1")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `unknown MDM command "self-destruct"`)
+}
+
+func TestUsage(t *testing.T) {
+	_, code, _, stderr := runCLI(t, "computers")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "Usage: jamfctl")
+
+	_, code, _, stderr = runCLI(t, "computers", "reboot")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "expected one of: delete, export, get, list")
+
+	_, code, _, stderr = runCLI(t, "-o", "yaml", "computers", "list")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown output format "yaml"`)
+
+	_, code, stdout, _ := runCLI(t, "version")
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "dev\n", stdout)
+}
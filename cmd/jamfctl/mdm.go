@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// mdmCommandNames lists the commands accepted by "mdm send"
+var mdmCommandNames = []string{"restart", "shutdown", "lock", "clear-passcode", "log-out", "enable-remote-desktop", "disable-remote-desktop"}
+
+func sendMDMCommand(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("mdm send")
+	message := fs.String("message", "", "message displayed on the locked device (lock)")
+	pin := fs.String("pin", "", "six digit PIN unlocking a computer (lock)")
+	notify := fs.Bool("notify", false, "notify the user before restarting (restart)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() < 2 {
+		return fmt.Errorf("expected a command and at least one management ID, commands: %s", strings.Join(mdmCommandNames, ", "))
+	}
+
+	var command pro.MDMCommand
+	switch fs.Arg(0) {
+	case "restart":
+		command = pro.RestartDeviceCommand{NotifyUser: *notify}
+	case "shutdown":
+		command = pro.ShutDownDeviceCommand{}
+	case "lock":
+		command = pro.DeviceLockCommand{Message: *message, PIN: *pin}
+	case "clear-passcode":
+		command = pro.ClearPasscodeCommand{}
+	case "log-out":
+		command = pro.LogOutUserCommand{}
+	case "enable-remote-desktop":
+		command = pro.EnableRemoteDesktopCommand{}
+	case "disable-remote-desktop":
+		command = pro.DisableRemoteDesktopCommand{}
+	default:
+		return fmt.Errorf("unknown MDM command %q, expected one of: %s", fs.Arg(0), strings.Join(mdmCommandNames, ", "))
+	}
+
+	queued, err := a.pro.SendMDMCommand(ctx, command, fs.Args()[1:]...)
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(queued))
+	for i, q := range queued {
+		rows[i] = []string{q.ID, q.Href}
+	}
+	return a.out.print(queued, []string{"COMMAND UUID", "HREF"}, rows)
+}
+
+func mdmCommandStatus(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("mdm status")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "an MDM command UUID"); err != nil {
+		return err
+	}
+
+	status, err := a.pro.MDMCommandDetails(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return a.out.fields(status,
+		"UUID", status.UUID,
+		"Type", status.CommandType,
+		"State", status.CommandState,
+		"Management ID", status.Client.ManagementID,
+		"Sent", status.DateSent,
+		"Completed", status.DateCompleted,
+	)
+}
@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+)
+
+// Output formats accepted by the -o flag
+const (
+	formatJSON  = "json"
+	formatCSV   = "csv"
+	formatTable = "table"
+)
+
+// printer writes command results in the format selected with -o
+type printer struct {
+	w      io.Writer
+	format string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case formatJSON, formatCSV, formatTable:
+		return &printer{w: w, format: format}, nil
+	}
+	return nil, fmt.Errorf("unknown output format %q, expected json, csv or table", format)
+}
+
+// print writes v as JSON, or the header and rows as CSV or an aligned table
+func (p *printer) print(v interface{}, header []string, rows [][]string) error {
+	switch p.format {
+	case formatJSON:
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		columns := make([]export.Column[[]string], len(header))
+		for i := range header {
+			columns[i] = export.Column[[]string]{Header: header[i], Value: func(row []string) string { return row[i] }}
+		}
+		return export.Write(p.w, export.CSV, rows, columns)
+	}
+	w := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// fields writes a single object as field/value rows, or as is with JSON
+func (p *printer) fields(v interface{}, pairs ...string) error {
+	rows := make([][]string, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		rows = append(rows, []string{pairs[i], pairs[i+1]})
+	}
+	return p.print(v, []string{"FIELD", "VALUE"}, rows)
+}
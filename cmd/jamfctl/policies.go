@@ -0,0 +1,108 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+)
+
+// classicIdentifier turns an argument into the identifier expected by the classic client, an ID
+// when the argument is numeric and a name otherwise
+func classicIdentifier(arg string) interface{} {
+	if id, err := strconv.Atoi(arg); err == nil {
+		return id
+	}
+	return arg
+}
+
+func listPolicies(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("policies list")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	policies, err := a.classic.Policies()
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(policies))
+	for i, p := range policies {
+		rows[i] = []string{strconv.Itoa(p.ID), p.Name}
+	}
+	return a.out.print(policies, []string{"ID", "NAME"}, rows)
+}
+
+func getPolicy(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("policies get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a policy ID or name"); err != nil {
+		return err
+	}
+
+	policy, err := a.classic.PolicyDetails(classicIdentifier(fs.Arg(0)))
+	if err != nil {
+		return err
+	}
+	if policy.Content == nil || policy.Content.General == nil {
+		return fmt.Errorf("policy %s has no general information", fs.Arg(0))
+	}
+	general := policy.Content.General
+	category := ""
+	if general.Category != nil {
+		category = general.Category.Name
+	}
+	return a.out.fields(policy.Content,
+		"ID", strconv.Itoa(general.ID),
+		"Name", general.Name,
+		"Enabled", strconv.FormatBool(general.Enabled),
+		"Category", category,
+		"Trigger", string(general.Trigger),
+		"Frequency", string(general.Frequency),
+	)
+}
+
+func createPolicy(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("policies create")
+	name := fs.String("name", "", "name of the policy")
+	category := fs.String("category", "", "name of the policy category")
+	enabled := fs.Bool("enabled", false, "enable the policy")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	content := &classic.PolicyContents{General: &classic.PolicyGeneral{Name: *name, Enabled: *enabled}}
+	if *category != "" {
+		content.General.Category = &classic.PolicyCategory{Name: *category}
+	}
+	created, err := a.classic.CreatePolicy(content)
+	if err != nil {
+		return err
+	}
+	id := 0
+	if created.General != nil {
+		id = created.General.ID
+	}
+	return a.out.fields(created, "ID", strconv.Itoa(id), "Name", *name)
+}
+
+func deletePolicy(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("policies delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a policy ID or name"); err != nil {
+		return err
+	}
+
+	if _, err := a.classic.DeletePolicy(classicIdentifier(fs.Arg(0))); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "deleted policy %s\n", fs.Arg(0))
+	return nil
+}
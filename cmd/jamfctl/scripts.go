@@ -0,0 +1,91 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+func listScripts(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("scripts list")
+	filter := fs.String("filter", "", `RSQL filter, e.g. name=="Install*"`)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	scripts, err := a.pro.AllScripts(ctx, &pro.ListOptions{Filter: *filter})
+	if err != nil {
+		return err
+	}
+	rows := make([][]string, len(scripts))
+	for i, s := range scripts {
+		rows[i] = []string{s.ID, s.Name, s.CategoryName}
+	}
+	return a.out.print(scripts, []string{"ID", "NAME", "CATEGORY"}, rows)
+}
+
+func getScript(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("scripts get")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a script ID"); err != nil {
+		return err
+	}
+
+	script, err := a.pro.ScriptDetails(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return a.out.fields(script,
+		"ID", script.ID,
+		"Name", script.Name,
+		"Category", script.CategoryName,
+		"Info", script.Info,
+		"Contents", script.ScriptContents,
+	)
+}
+
+func createScript(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("scripts create")
+	name := fs.String("name", "", "name of the script")
+	file := fs.String("file", "", "file holding the script contents")
+	category := fs.String("category", "", "ID of the script category")
+	info := fs.String("info", "", "information shown when the script is added to a policy")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *name == "" || *file == "" {
+		return fmt.Errorf("-name and -file are required")
+	}
+
+	contents, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	created, err := a.pro.CreateScript(ctx, &pro.Script{Name: *name, CategoryID: *category, Info: *info, ScriptContents: string(contents)})
+	if err != nil {
+		return err
+	}
+	return a.out.fields(created, "ID", created.ID, "Href", created.Href)
+}
+
+func deleteScript(ctx context.Context, a *app, args []string) error {
+	fs := a.newFlagSet("scripts delete")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := exactArgs(fs, 1, "a script ID"); err != nil {
+		return err
+	}
+
+	if err := a.pro.DeleteScript(ctx, fs.Arg(0)); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.stderr, "deleted script %s\n", fs.Arg(0))
+	return nil
+}
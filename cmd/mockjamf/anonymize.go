@@ -0,0 +1,238 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// minSubstringLength is the length from which a scrubbed value is also replaced inside other strings,
+// e.g. a username in a computer name, shorter values would replace unrelated text
+const minSubstringLength = 4
+
+// deviceResources are the resources whose items are devices, their names being hostnames
+var deviceResources = map[string]bool{
+	"computers":                  true,
+	"computers-inventory":        true,
+	"computers-inventory-detail": true,
+	"mobiledevices":              true,
+	"mobile-devices":             true,
+}
+
+// listKeys are the fields holding the items of a list response, e.g. results for the pro API
+var listKeys = map[string]bool{
+	"results":        true,
+	"computers":      true,
+	"mobile_devices": true,
+}
+
+// anonymizer scrubs serial numbers, usernames and hostnames from responses. Replacements are derived
+// from the value and the seed so a value is scrubbed the same way in every fixture and every recording.
+type anonymizer struct {
+	seed string
+
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func newAnonymizer(seed string) *anonymizer {
+	return &anonymizer{seed: seed, values: map[string]string{}}
+}
+
+// isDeviceResource reports whether rel, e.g. v1/computers-inventory/1, holds devices
+func isDeviceResource(rel string) bool {
+	for _, part := range strings.Split(rel, "/") {
+		if deviceResources[part] {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitive returns the kind of data held by a field given its name and the name of the object holding
+// it, an empty parent standing for the root object or a list item. It returns an empty string for
+// fields which are kept.
+func sensitive(key string, parent string, device bool) string {
+	switch strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key)) {
+	case "serialnumber", "serial":
+		return "serial"
+	case "username", "realname", "fullname", "emailaddress", "email", "useremail", "shortname":
+		return "user"
+	case "hostname", "localhostname", "computername", "devicename":
+		return "host"
+	case "name":
+		if device && (parent == "" || parent == "general") {
+			return "host"
+		}
+	}
+	return ""
+}
+
+// scrub returns the replacement of value, remembering it so it is also replaced inside other strings
+func (a *anonymizer) scrub(kind string, value string) string {
+	if value == "" {
+		return value
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if replacement, ok := a.values[value]; ok {
+		return replacement
+	}
+	sum := sha256.Sum256([]byte(a.seed + "\x00" + value))
+	digest := hex.EncodeToString(sum[:])
+	var replacement string
+	switch {
+	case kind == "serial":
+		replacement = "S" + strings.ToUpper(digest[:11])
+	case kind == "user" && strings.Contains(value, "@"):
+		replacement = "user-" + digest[:8] + "@example.com"
+	case kind == "user":
+		replacement = "user-" + digest[:8]
+	default:
+		replacement = "host-" + digest[:8]
+	}
+	a.values[value] = replacement
+	return replacement
+}
+
+// replace replaces the values scrubbed so far appearing inside s, longest first
+func (a *anonymizer) replace(s string) string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if replacement, ok := a.values[s]; ok {
+		return replacement
+	}
+	originals := make([]string, 0, len(a.values))
+	for original := range a.values {
+		if len(original) >= minSubstringLength && strings.Contains(s, original) {
+			originals = append(originals, original)
+		}
+	}
+	sort.Slice(originals, func(i, j int) bool { return len(originals[i]) > len(originals[j]) })
+	for _, original := range originals {
+		s = strings.ReplaceAll(s, original, a.values[original])
+	}
+	return s
+}
+
+// JSON scrubs a JSON document, the sensitive fields are scrubbed first so their values are replaced
+// wherever they appear in the document
+func (a *anonymizer) JSON(data []byte, device bool) ([]byte, error) {
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+	a.collectJSON(doc, "", device)
+	doc = a.replaceJSON(doc)
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+func (a *anonymizer) collectJSON(doc interface{}, parent string, device bool) {
+	switch value := doc.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			if s, ok := field.(string); ok {
+				if kind := sensitive(key, parent, device); kind != "" {
+					a.scrub(kind, s)
+				}
+				continue
+			}
+			a.collectJSON(field, key, device)
+		}
+	case []interface{}:
+		if listKeys[parent] {
+			parent = ""
+		}
+		for _, item := range value {
+			a.collectJSON(item, parent, device)
+		}
+	}
+}
+
+func (a *anonymizer) replaceJSON(doc interface{}) interface{} {
+	switch value := doc.(type) {
+	case map[string]interface{}:
+		for key, field := range value {
+			value[key] = a.replaceJSON(field)
+		}
+	case []interface{}:
+		for i, item := range value {
+			value[i] = a.replaceJSON(item)
+		}
+	case string:
+		return a.replace(value)
+	}
+	return doc
+}
+
+// XML scrubs an XML document the same way as JSON, the elements of the root element or of the items of
+// a root list element standing for root fields
+func (a *anonymizer) XML(data []byte, device bool) ([]byte, error) {
+	var stack []string
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			if len(stack) < 2 {
+				continue
+			}
+			parent := stack[len(stack)-2]
+			if len(stack) == 2 || (len(stack) == 3 && listKeys[stack[0]]) {
+				parent = ""
+			}
+			if kind := sensitive(stack[len(stack)-1], parent, device); kind != "" {
+				a.scrub(kind, string(t))
+			}
+		}
+	}
+
+	res := &bytes.Buffer{}
+	decoder = xml.NewDecoder(bytes.NewReader(data))
+	encoder := xml.NewEncoder(res)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			for i := range t.Attr {
+				t.Attr[i].Value = a.replace(t.Attr[i].Value)
+			}
+			token = t
+		case xml.CharData:
+			token = xml.CharData(a.replace(string(t)))
+		}
+		if err := encoder.EncodeToken(token); err != nil {
+			return nil, err
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+	return res.Bytes(), nil
+}
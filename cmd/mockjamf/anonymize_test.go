@@ -0,0 +1,80 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnonymizeJSON(t *testing.T) {
+	input := []byte(`{"totalCount": 1, "results": [{
+		"id": "7",
+		"general": {"name": "jdoe-mbp", "site": {"id": "1", "name": "Paris"}},
+		"hardware": {"serialNumber": "C02XYZ123ABC", "model": "MacBook Pro"},
+		"userAndLocation": {"username": "jdoe", "realname": "John Doe", "email": "jdoe@example.org"},
+		"extensionAttributes": [{"name": "Owner", "values": ["jdoe"]}],
+		"localUserAccounts": [{"username": "admin", "fullName": "Admin"}]
+	}]}`)
+
+	a := newAnonymizer("seed")
+	res, err := a.JSON(input, true)
+	assert.Nil(t, err)
+	out := string(res)
+	for _, secret := range []string{"jdoe", "John Doe", "C02XYZ123ABC", "admin"} {
+		assert.NotContains(t, out, secret)
+	}
+	for _, kept := range []string{"Paris", "MacBook Pro", `"Owner"`, `"totalCount": 1`} {
+		assert.Contains(t, out, kept)
+	}
+
+	page := struct {
+		Results []struct {
+			General  struct{ Name string }
+			Hardware struct{ SerialNumber string }
+		}
+	}{}
+	assert.Nil(t, json.Unmarshal(res, &page))
+	assert.Regexp(t, `^host-[0-9a-f]{8}$`, page.Results[0].General.Name)
+	assert.Regexp(t, `^S[0-9A-F]{11}$`, page.Results[0].Hardware.SerialNumber)
+
+	// Replacements only depend on the seed and the value
+	again, err := newAnonymizer("seed").JSON(input, true)
+	assert.Nil(t, err)
+	assert.Equal(t, out, string(again))
+	other, err := newAnonymizer("other").JSON(input, true)
+	assert.Nil(t, err)
+	assert.NotEqual(t, out, string(other))
+
+	// Names are only hostnames for devices
+	res, err = newAnonymizer("seed").JSON([]byte(`{"id": "1", "name": "Install Chrome"}`), false)
+	assert.Nil(t, err)
+	assert.Contains(t, string(res), "Install Chrome")
+}
+
+func TestAnonymizeXML(t *testing.T) {
+	input := []byte(`<?xml version="1.0" encoding="UTF-8"?><computer><general><id>7</id><name>jdoe-mbp</name>` +
+		`<serial_number>C02XYZ123ABC</serial_number><site><name>Paris</name></site></general>` +
+		`<location><username>jdoe</username><email_address>jdoe@example.org</email_address></location>` +
+		`<extension_attributes><extension_attribute><name>Owner</name><value>jdoe</value></extension_attribute></extension_attributes></computer>`)
+
+	a := newAnonymizer("seed")
+	res, err := a.XML(input, true)
+	assert.Nil(t, err)
+	out := string(res)
+	for _, secret := range []string{"jdoe", "C02XYZ123ABC"} {
+		assert.NotContains(t, out, secret)
+	}
+	assert.Contains(t, out, "<name>Paris</name>")
+	assert.Contains(t, out, "<name>Owner</name>")
+	assert.Contains(t, out, "<name>"+a.scrub("host", "jdoe-mbp")+"</name>")
+	assert.Contains(t, out, "<value>"+a.scrub("user", "jdoe")+"</value>")
+
+	// Same values are scrubbed the same way in both formats
+	res, err = a.JSON([]byte(`{"computers": [{"id": 7, "name": "jdoe-mbp", "serial_number": "C02XYZ123ABC"}]}`), true)
+	assert.Nil(t, err)
+	assert.Contains(t, string(res), a.scrub("serial", "C02XYZ123ABC"))
+	assert.Contains(t, string(res), a.scrub("host", "jdoe-mbp"))
+}
@@ -0,0 +1,226 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// matcher reports whether a decoded JSON item matches a filter
+type matcher func(item interface{}) bool
+
+// parseFilter parses the subset of RSQL used by the Jamf Pro API: comparisons with ==, !=, =gt=,
+// =ge=, =lt=, =le=, =in= and =out= combined with ; (and), , (or) and parentheses
+func parseFilter(expr string) (matcher, error) {
+	p := &filterParser{input: expr}
+	match, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.input) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.input[p.pos:], p.pos)
+	}
+	return match, nil
+}
+
+type filterParser struct {
+	input string
+	pos   int
+}
+
+func (p *filterParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *filterParser) or() (matcher, error) {
+	var terms []matcher
+	for {
+		term, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if p.peek() != ',' {
+			break
+		}
+		p.pos++
+	}
+	return func(item interface{}) bool {
+		for _, term := range terms {
+			if term(item) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func (p *filterParser) and() (matcher, error) {
+	var terms []matcher
+	for {
+		term, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+		if p.peek() != ';' {
+			break
+		}
+		p.pos++
+	}
+	return func(item interface{}) bool {
+		for _, term := range terms {
+			if !term(item) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func (p *filterParser) term() (matcher, error) {
+	if p.peek() == '(' {
+		p.pos++
+		match, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+		return match, nil
+	}
+	return p.comparison()
+}
+
+func (p *filterParser) comparison() (matcher, error) {
+	start := p.pos
+	for p.pos < len(p.input) && p.input[p.pos] != '=' && p.input[p.pos] != '!' {
+		p.pos++
+	}
+	field := strings.TrimSpace(p.input[start:p.pos])
+	if field == "" {
+		return nil, fmt.Errorf("missing field at position %d", start)
+	}
+
+	rest := p.input[p.pos:]
+	var op string
+	for _, candidate := range []string{"==", "!=", "=gt=", "=ge=", "=lt=", "=le=", "=in=", "=out="} {
+		if strings.HasPrefix(rest, candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return nil, fmt.Errorf("unknown operator for field %s at position %d", field, p.pos)
+	}
+	p.pos += len(op)
+
+	var values []string
+	if op == "=in=" || op == "=out=" {
+		if p.peek() != '(' {
+			return nil, fmt.Errorf("%s expects a list of values at position %d", op, p.pos)
+		}
+		p.pos++
+		for {
+			value, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+			if p.peek() != ',' {
+				break
+			}
+			p.pos++
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("missing closing parenthesis at position %d", p.pos)
+		}
+		p.pos++
+	} else {
+		value, err := p.value()
+		if err != nil {
+			return nil, err
+		}
+		values = []string{value}
+	}
+
+	return func(item interface{}) bool {
+		actual := lookup(item, field)
+		switch op {
+		case "==":
+			return wildcard(values[0], actual)
+		case "!=":
+			return !wildcard(values[0], actual)
+		case "=gt=":
+			return compare(actual, values[0]) > 0
+		case "=ge=":
+			return compare(actual, values[0]) >= 0
+		case "=lt=":
+			return compare(actual, values[0]) < 0
+		case "=le=":
+			return compare(actual, values[0]) <= 0
+		}
+		for _, value := range values {
+			if wildcard(value, actual) {
+				return op == "=in="
+			}
+		}
+		return op == "=out="
+	}, nil
+}
+
+// value parses a quoted or bare argument
+func (p *filterParser) value() (string, error) {
+	if quote := p.peek(); quote == '"' || quote == '\'' {
+		p.pos++
+		var value strings.Builder
+		for p.pos < len(p.input) {
+			c := p.input[p.pos]
+			p.pos++
+			switch {
+			case c == '\\' && p.pos < len(p.input):
+				value.WriteByte(p.input[p.pos])
+				p.pos++
+			case c == quote:
+				return value.String(), nil
+			default:
+				value.WriteByte(c)
+			}
+		}
+		return "", fmt.Errorf("unterminated string starting at position %d", p.pos)
+	}
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(";,()", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	if p.pos == start {
+		return "", fmt.Errorf("missing value at position %d", start)
+	}
+	return p.input[start:p.pos], nil
+}
+
+// wildcard matches value against a pattern where * matches any sequence of characters
+func wildcard(pattern string, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
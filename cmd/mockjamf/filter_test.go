@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	item := map[string]interface{}{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"id": "7", "general": {"name": "MAC-007", "site": {"id": "1"}, "reportDate": "2024-10-01T12:00:00Z"}}`), &item))
+
+	for expr, expected := range map[string]bool{
+		`id==7`:                            true,
+		`id!=7`:                            false,
+		`id=gt=10`:                         false,
+		`id=le=7`:                          true,
+		`general.name=="MAC-*"`:            true,
+		`general.name==*-008`:              false,
+		`general.site.id==1;id=in=(3,7)`:   true,
+		`general.site.id==2,id=out=(3,8)`:  true,
+		`(general.site.id==2,id==3);id==7`: false,
+		`general.reportDate=ge="2024-09-30T00:00Z"`: true,
+		`general.missing==""`:                       true,
+	} {
+		match, err := parseFilter(expr)
+		assert.Nil(t, err, expr)
+		assert.Equal(t, expected, match(item), expr)
+	}
+
+	for _, expr := range []string{`id`, `id=~7`, `(id==7`, `id==7)`, `id=in=7`, `name=="MAC`} {
+		_, err := parseFilter(expr)
+		assert.NotNil(t, err, expr)
+	}
+}
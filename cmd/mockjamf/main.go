@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Command mockjamf serves a fake Jamf Pro server from a directory of fixtures so that projects using
+// this module can run integration tests without a real Jamf Pro tenant
+//
+//	mockjamf [-addr :8080] [-fixtures ./fixtures] [-username user] [-password pass]
+//
+// Bearer tokens are issued by /api/v1/auth/token for the configured credentials and required by every
+// other endpoint. Fixtures are looked up by request path under the fixtures directory:
+//
+//   - classic/<path>.json or classic/<path>.xml serve GET /JSSResource/<path>, e.g.
+//     classic/computers/id/82.json for /JSSResource/computers/id/82. The JSON fixture is preferred, as
+//     the classic client does. Writes are accepted and echoed back but not persisted.
+//   - pro/<version>/<resource>.json serve /api/<version>/<resource>. A fixture holding a JSON array is
+//     a collection: it is paginated with the page and page-size parameters, sorted with sort, filtered
+//     with the ==, !=, =gt=, =ge=, =lt=, =le=, =in= and =out= RSQL operators and its items, identified
+//     by their id field, can be read, created, replaced, patched and deleted in memory. Any other fixture is served
+//     as is and can be replaced with PUT.
+//   - pro/<version>/<resource>/<id>.json serve a single item which is not part of a collection, e.g.
+//     pro/v1/computers-inventory-detail/1.json.
+//
+// With -record, requests are instead proxied to a real Jamf Pro server and the responses of successful
+// GET requests are written to the fixtures directory. Serial numbers, usernames and hostnames are
+// scrubbed deterministically, the same value always having the same replacement for a given -seed, so
+// the fixtures can be committed and reused by the mock server and unit tests.
+//
+//	mockjamf -record https://example.jamfcloud.com -fixtures ./fixtures
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"time"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run parses the flags and serves the fixtures until ctx is done, it returns the process exit code
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("mockjamf", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	addr := fs.String("addr", ":8080", "address to listen on")
+	fixtures := fs.String("fixtures", "fixtures", "directory holding the classic and pro fixtures")
+	username := fs.String("username", "mockjamf", "username accepted to issue tokens")
+	password := fs.String("password", "mockjamf", "password accepted to issue tokens")
+	ttl := fs.Duration("token-ttl", 30*time.Minute, "lifetime of the issued tokens")
+	record := fs.String("record", "", "URL of a Jamf Pro server whose responses are recorded as fixtures")
+	seed := fs.String("seed", "", "seed of the replacements of the values scrubbed when recording")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	var handler http.Handler
+	if *record != "" {
+		target, err := url.Parse(*record)
+		if err != nil || target.Scheme == "" || target.Host == "" {
+			fmt.Fprintf(stderr, "invalid Jamf Pro URL %q\n", *record)
+			return 2
+		}
+		if err := os.MkdirAll(*fixtures, 0o755); err != nil {
+			fmt.Fprintln(stderr, err)
+			return 1
+		}
+		handler = newRecorder(target, *fixtures, *seed)
+	} else {
+		if info, err := os.Stat(*fixtures); err != nil || !info.IsDir() {
+			fmt.Fprintf(stderr, "fixtures directory %q not found\n", *fixtures)
+			return 2
+		}
+		handler = newServer(*fixtures, *username, *password, *ttl)
+	}
+
+	listener, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	srv := &http.Server{Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdown)
+	}()
+	if *record != "" {
+		fmt.Fprintf(stdout, "recording %s to %s on http://%s\n", *record, *fixtures, listener.Addr())
+	} else {
+		fmt.Fprintf(stdout, "serving %s on http://%s\n", *fixtures, listener.Addr())
+	}
+	if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	return 0
+}
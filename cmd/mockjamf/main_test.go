@@ -0,0 +1,37 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	assert.Equal(t, 2, run(context.Background(), []string{"-fixtures", "missing"}, stdout, stderr))
+	assert.Contains(t, stderr.String(), `fixtures directory "missing" not found`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stdout.Reset()
+	assert.Equal(t, 0, run(ctx, []string{"-addr", "127.0.0.1:0", "-fixtures", "testdata"}, stdout, stderr))
+	assert.Contains(t, stdout.String(), "serving testdata on http://127.0.0.1:")
+
+	stderr.Reset()
+	assert.Equal(t, 2, run(context.Background(), []string{"-record", "example.jamfcloud.com"}, stdout, stderr))
+	assert.Contains(t, stderr.String(), "invalid Jamf Pro URL")
+
+	ctx, cancel = context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	stdout.Reset()
+	fixtures := filepath.Join(t.TempDir(), "fixtures")
+	assert.Equal(t, 0, run(ctx, []string{"-addr", "127.0.0.1:0", "-fixtures", fixtures, "-record", "https://example.jamfcloud.com"}, stdout, stderr))
+	assert.Contains(t, stdout.String(), "recording https://example.jamfcloud.com to "+fixtures)
+	assert.DirExists(t, fixtures)
+}
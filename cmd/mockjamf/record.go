@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// recorder proxies requests to a real Jamf Pro server and writes the anonymized responses of successful
+// GET requests as fixtures, the anonymized responses are also returned to the client so tests see the
+// same data when recording and when replaying the fixtures
+type recorder struct {
+	fixtures   string
+	anonymizer *anonymizer
+	proxy      *httputil.ReverseProxy
+
+	// mu guards the fixture files, pro pages are merged into the collection fixture
+	mu sync.Mutex
+}
+
+func newRecorder(target *url.URL, fixtures string, seed string) *recorder {
+	rec := &recorder{fixtures: fixtures, anonymizer: newAnonymizer(seed)}
+	rec.proxy = &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			// Responses are rewritten so they must not be compressed
+			r.Out.Header.Del("Accept-Encoding")
+		},
+		ModifyResponse: rec.record,
+	}
+	return rec
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rec.proxy.ServeHTTP(w, r)
+}
+
+// record anonymizes and writes the response to a fixture when it should be recorded
+func (rec *recorder) record(res *http.Response) error {
+	r := res.Request
+	if r.Method != http.MethodGet || res.StatusCode != http.StatusOK || strings.HasPrefix(r.URL.Path, "/api/v1/auth/") {
+		return nil
+	}
+	var api, rel string
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/JSSResource/"):
+		api, rel = "classic", strings.TrimPrefix(r.URL.Path, "/JSSResource/")
+	case strings.HasPrefix(r.URL.Path, "/api/"):
+		api, rel = "pro", strings.TrimPrefix(r.URL.Path, "/api/")
+	default:
+		return nil
+	}
+
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return err
+	}
+	ext := ".json"
+	if contentType := strings.Split(res.Header.Get("Content-Type"), ";")[0]; strings.HasSuffix(contentType, "/xml") {
+		ext = ".xml"
+		data, err = rec.anonymizer.XML(data, isDeviceResource(rel))
+	} else {
+		data, err = rec.anonymizer.JSON(data, isDeviceResource(rel))
+	}
+	if err != nil {
+		return err
+	}
+
+	file, ok := fixturePath(rec.fixtures, api, rel, ext)
+	if ok {
+		if err := rec.write(file, api, data); err != nil {
+			return err
+		}
+	}
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	res.ContentLength = int64(len(data))
+	res.Header.Set("Content-Length", strconv.Itoa(len(data)))
+	return nil
+}
+
+// write writes a fixture, the results of a pro page are merged by id into the collection fixture so
+// every page ends up in the same file
+func (rec *recorder) write(file string, api string, data []byte) error {
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	page := struct {
+		TotalCount *int            `json:"totalCount"`
+		Results    json.RawMessage `json:"results"`
+	}{}
+	if api != "pro" || json.Unmarshal(data, &page) != nil || page.TotalCount == nil || page.Results == nil {
+		return os.WriteFile(file, append(data, '\n'), 0o644)
+	}
+
+	var results []map[string]interface{}
+	if err := json.Unmarshal(page.Results, &results); err != nil {
+		return os.WriteFile(file, append(data, '\n'), 0o644)
+	}
+	var collection []map[string]interface{}
+	if existing, err := os.ReadFile(file); err == nil {
+		_ = json.Unmarshal(existing, &collection)
+	}
+	for _, item := range results {
+		replaced := false
+		for i, recorded := range collection {
+			if item["id"] != nil && recorded["id"] == item["id"] {
+				collection[i], replaced = item, true
+				break
+			}
+		}
+		if !replaced {
+			collection = append(collection, item)
+		}
+	}
+	merged, err := json.MarshalIndent(collection, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, append(merged, '\n'), 0o644)
+}
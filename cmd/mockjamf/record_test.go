@@ -0,0 +1,84 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// jamfServer stands for a real Jamf Pro server
+func jamfServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"token": "real-token", "expires": "2100-01-01T00:00:00Z"}`)
+		case "/api/v1/computers-inventory":
+			w.Header().Set("Content-Type", "application/json")
+			if page := r.URL.Query().Get("page"); page == "" || page == "0" {
+				fmt.Fprint(w, `{"totalCount": 2, "results": [{"id": "1", "general": {"name": "jdoe-mbp"}, "hardware": {"serialNumber": "C02AAA111AAA"}}]}`)
+			} else {
+				fmt.Fprint(w, `{"totalCount": 2, "results": [{"id": "2", "general": {"name": "asmith-mini"}, "hardware": {"serialNumber": "C02BBB222BBB"}}]}`)
+			}
+		case "/JSSResource/computers":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"computers": [{"id": 1, "name": "jdoe-mbp", "serial_number": "C02AAA111AAA"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRecord(t *testing.T) {
+	target, err := url.Parse(jamfServer(t).URL)
+	assert.Nil(t, err)
+	fixtures := t.TempDir()
+	rec := httptest.NewServer(newRecorder(target, fixtures, "seed"))
+	defer rec.Close()
+	ctx := context.Background()
+
+	j, err := pro.NewClient(rec.URL, "api", "secret", nil)
+	assert.Nil(t, err)
+	recorded, err := j.AllComputersInventory(ctx, &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, recorded, 2)
+	assert.Regexp(t, `^host-`, recorded[0].General.Name)
+
+	c, err := classic.NewClient(rec.URL, "api", "secret", nil)
+	assert.Nil(t, err)
+	computers, err := c.Computers()
+	assert.Nil(t, err)
+	assert.Equal(t, recorded[0].General.Name, computers[0].Name)
+
+	data, err := os.ReadFile(filepath.Join(fixtures, "pro", "v1", "computers-inventory.json"))
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "jdoe")
+	assert.NotContains(t, string(data), "C02BBB222BBB")
+	_, err = os.Stat(filepath.Join(fixtures, "classic", "computers.json"))
+	assert.Nil(t, err)
+	_, err = os.Stat(filepath.Join(fixtures, "pro", "v1", "auth"))
+	assert.True(t, os.IsNotExist(err))
+
+	// The recorded fixtures are served by the mock server
+	mock := httptest.NewServer(newServer(fixtures, "api", "secret", time.Hour))
+	defer mock.Close()
+	j, err = pro.NewClient(mock.URL, "api", "secret", nil)
+	assert.Nil(t, err)
+	replayed, err := j.AllComputersInventory(ctx, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, recorded, replayed)
+}
@@ -0,0 +1,390 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultPageSize = 100
+
+// server serves the fixtures of a directory, the pro fixtures are loaded on first use and then
+// modified in memory by write requests
+type server struct {
+	fixtures string
+	username string
+	password string
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	tokens    map[string]time.Time
+	documents map[string]interface{}
+}
+
+func newServer(fixtures string, username string, password string, ttl time.Duration) *server {
+	return &server{
+		fixtures:  fixtures,
+		username:  username,
+		password:  password,
+		ttl:       ttl,
+		tokens:    map[string]time.Time{},
+		documents: map[string]interface{}{},
+	}
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/api/v1/auth/token":
+		username, password, ok := r.BasicAuth()
+		if r.Method != http.MethodPost || !ok || username != s.username || password != s.password {
+			writeError(w, http.StatusUnauthorized, "INVALID_CREDENTIALS", "invalid username or password")
+			return
+		}
+		s.issueToken(w)
+		return
+	case "/api/v1/auth/keep-alive":
+		if token, ok := s.authorized(r); ok && r.Method == http.MethodPost {
+			delete(s.tokens, token)
+			s.issueToken(w)
+			return
+		}
+	case "/api/v1/auth/invalidate-token":
+		if token, ok := s.authorized(r); ok && r.Method == http.MethodPost {
+			delete(s.tokens, token)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+	default:
+		if _, ok := s.authorized(r); ok {
+			switch {
+			case strings.HasPrefix(r.URL.Path, "/JSSResource/"):
+				s.serveClassic(w, r, strings.TrimPrefix(r.URL.Path, "/JSSResource/"))
+			case strings.HasPrefix(r.URL.Path, "/api/"):
+				s.servePro(w, r, strings.TrimPrefix(r.URL.Path, "/api/"))
+			default:
+				http.NotFound(w, r)
+			}
+			return
+		}
+	}
+	writeError(w, http.StatusUnauthorized, "INVALID_TOKEN", "missing, invalid or expired bearer token")
+}
+
+func (s *server) issueToken(w http.ResponseWriter) {
+	raw := make([]byte, 16)
+	if _, err := rand.Read(raw); err != nil {
+		writeError(w, http.StatusInternalServerError, "TOKEN_ERROR", err.Error())
+		return
+	}
+	token := hex.EncodeToString(raw)
+	expires := time.Now().Add(s.ttl).UTC()
+	s.tokens[token] = expires
+	writeJSON(w, http.StatusOK, map[string]string{"token": token, "expires": expires.Format(time.RFC3339)})
+}
+
+// authorized returns the bearer token of r when it was issued and has not expired
+func (s *server) authorized(r *http.Request) (string, bool) {
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	expires, ok := s.tokens[token]
+	return token, ok && time.Now().Before(expires)
+}
+
+// fixturePath returns the path of the fixture for a request path, false when it escapes the directory
+func fixturePath(fixtures string, api string, rel string, ext string) (string, bool) {
+	rel = strings.Trim(rel, "/")
+	if rel == "" || path.Clean(rel) != rel || strings.HasPrefix(rel, "..") {
+		return "", false
+	}
+	return filepath.Join(fixtures, api, filepath.FromSlash(rel)+ext), true
+}
+
+func (s *server) serveClassic(w http.ResponseWriter, r *http.Request, rel string) {
+	switch r.Method {
+	case http.MethodGet, http.MethodDelete:
+		for _, format := range []struct{ ext, contentType string }{{".json", "application/json"}, {".xml", "application/xml"}} {
+			file, ok := fixturePath(s.fixtures, "classic", rel, format.ext)
+			if !ok {
+				break
+			}
+			if data, err := os.ReadFile(file); err == nil {
+				w.Header().Set("Content-Type", format.contentType)
+				_, _ = w.Write(data)
+				return
+			}
+		}
+		http.Error(w, "The server has not found anything matching the request URI", http.StatusNotFound)
+	case http.MethodPost, http.MethodPut:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = w.Write(body)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// load returns the pro fixture for rel, e.g. v1/buildings, decoding it on first use
+func (s *server) load(rel string) (interface{}, bool) {
+	if doc, ok := s.documents[rel]; ok {
+		return doc, true
+	}
+	file, ok := fixturePath(s.fixtures, "pro", rel, ".json")
+	if !ok {
+		return nil, false
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, false
+	}
+	var doc interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, false
+	}
+	s.documents[rel] = doc
+	return doc, true
+}
+
+func (s *server) servePro(w http.ResponseWriter, r *http.Request, rel string) {
+	rel = strings.Trim(rel, "/")
+	if doc, ok := s.load(rel); ok {
+		if items, ok := doc.([]interface{}); ok {
+			s.serveCollection(w, r, rel, items)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, doc)
+		case http.MethodPut:
+			body, ok := decodeBody(w, r)
+			if ok {
+				s.documents[rel] = body
+				writeJSON(w, http.StatusOK, body)
+			}
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method+" is not supported by "+r.URL.Path)
+		}
+		return
+	}
+
+	parent, id := path.Split(rel)
+	if doc, ok := s.load(strings.TrimSuffix(parent, "/")); ok {
+		if items, ok := doc.([]interface{}); ok {
+			s.serveItem(w, r, strings.TrimSuffix(parent, "/"), items, id)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "NOT_FOUND", "no fixture for "+r.URL.Path)
+}
+
+func (s *server) serveCollection(w http.ResponseWriter, r *http.Request, rel string, items []interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		query := r.URL.Query()
+		matches := items
+		if expr := query.Get("filter"); expr != "" {
+			match, err := parseFilter(expr)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "INVALID_RSQL_FILTER", err.Error())
+				return
+			}
+			matches = []interface{}{}
+			for _, item := range items {
+				if match(item) {
+					matches = append(matches, item)
+				}
+			}
+		}
+		if criteria := query.Get("sort"); criteria != "" {
+			matches = append([]interface{}{}, matches...)
+			sortItems(matches, strings.Split(criteria, ","))
+		}
+		page, _ := strconv.Atoi(query.Get("page"))
+		size, err := strconv.Atoi(query.Get("page-size"))
+		if err != nil || size <= 0 {
+			size = defaultPageSize
+		}
+		start, end := page*size, (page+1)*size
+		if start > len(matches) {
+			start = len(matches)
+		}
+		if end > len(matches) {
+			end = len(matches)
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"totalCount": len(matches), "results": matches[start:end]})
+	case http.MethodPost:
+		body, ok := decodeBody(w, r)
+		if !ok {
+			return
+		}
+		next := 1
+		for _, item := range items {
+			if id, err := strconv.Atoi(lookup(item, "id")); err == nil && id >= next {
+				next = id + 1
+			}
+		}
+		id := strconv.Itoa(next)
+		body["id"] = id
+		s.documents[rel] = append(items, body)
+		writeJSON(w, http.StatusCreated, map[string]string{"id": id, "href": path.Join(r.URL.Path, id)})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method+" is not supported by "+r.URL.Path)
+	}
+}
+
+func (s *server) serveItem(w http.ResponseWriter, r *http.Request, rel string, items []interface{}, id string) {
+	index := -1
+	for i, item := range items {
+		if lookup(item, "id") == id {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("no item with id %s in %s", id, rel))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, items[index])
+	case http.MethodPut, http.MethodPatch:
+		body, ok := decodeBody(w, r)
+		if !ok {
+			return
+		}
+		if r.Method == http.MethodPatch {
+			if current, ok := items[index].(map[string]interface{}); ok {
+				body = merge(current, body)
+			}
+		}
+		body["id"] = id
+		items[index] = body
+		writeJSON(w, http.StatusOK, body)
+	case http.MethodDelete:
+		s.documents[rel] = append(items[:index:index], items[index+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", r.Method+" is not supported by "+r.URL.Path)
+	}
+}
+
+// merge applies a JSON merge patch to a copy of current
+func merge(current map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(current))
+	for key, value := range current {
+		res[key] = value
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(res, key)
+			continue
+		}
+		nested, isMap := value.(map[string]interface{})
+		existing, wasMap := res[key].(map[string]interface{})
+		if isMap && wasMap {
+			value = merge(existing, nested)
+		}
+		res[key] = value
+	}
+	return res
+}
+
+// lookup returns the value of a dotted field of item, e.g. general.site.id, as a string
+func lookup(item interface{}, field string) string {
+	for _, key := range strings.Split(field, ".") {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		item = object[key]
+	}
+	switch value := item.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// sortItems sorts items by criteria in the form field:asc or field:desc
+func sortItems(items []interface{}, criteria []string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, criterion := range criteria {
+			field, order, _ := strings.Cut(criterion, ":")
+			c := compare(lookup(items[i], field), lookup(items[j], field))
+			if c != 0 {
+				return (c < 0) != (order == "desc")
+			}
+		}
+		return false
+	})
+}
+
+// compare compares two values numerically when they are both numbers, as strings otherwise
+func compare(a string, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA == nil && errB == nil && x < y:
+		return -1
+	case errA == nil && errB == nil && x > y:
+		return 1
+	case errA == nil && errB == nil:
+		return 0
+	}
+	return strings.Compare(a, b)
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	body := map[string]interface{}{}
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "INVALID_JSON", err.Error())
+		return nil, false
+	}
+	return body, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes an error formatted like those of the Jamf Pro API
+func writeError(w http.ResponseWriter, status int, code string, description string) {
+	writeJSON(w, status, map[string]interface{}{
+		"httpStatus": status,
+		"errors":     []map[string]string{{"code": code, "description": description}},
+	})
+}
@@ -0,0 +1,121 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/jamftest/golden"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(newServer("testdata", "mockjamf", "secret", time.Hour))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestAuthentication(t *testing.T) {
+	server := newTestServer(t)
+
+	res, err := http.Get(server.URL + "/api/v1/buildings")
+	assert.Nil(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, res.StatusCode)
+
+	j, err := pro.NewClient(server.URL, "mockjamf", "wrong", nil)
+	assert.Nil(t, err)
+	_, err = j.JamfProVersion(context.Background())
+	assert.NotNil(t, err)
+
+	j, err = pro.NewClient(server.URL, "mockjamf", "secret", nil)
+	assert.Nil(t, err)
+	version, err := j.JamfProVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "10.40.1-t1661970015", version.Version)
+	assert.NotEmpty(t, j.Token.Token)
+}
+
+func TestProCollections(t *testing.T) {
+	j, err := pro.NewClient(newTestServer(t).URL, "mockjamf", "secret", nil)
+	assert.Nil(t, err)
+	ctx := context.Background()
+
+	computers, err := j.AllComputersInventory(ctx, &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Len(t, computers, 3)
+
+	page, err := j.ComputersInventory(ctx, &pro.ListOptions{PageSize: 2, Page: 1, Sort: []string{"id:desc"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Len(t, page.Results, 1)
+	assert.Equal(t, "82", page.Results[0].ID)
+
+	computers, err = j.WithSite("1").AllComputersInventory(ctx, &pro.ListOptions{Filter: pro.F("general.name").EQ("*iMac").String()})
+	assert.Nil(t, err)
+	assert.Len(t, computers, 1)
+	assert.Equal(t, "84", computers[0].ID)
+
+	devices, err := j.AllMobileDevices(ctx, nil)
+	assert.Nil(t, err)
+	assert.Len(t, devices, 2)
+	assert.Equal(t, "Mock's iPad", devices[0].Name)
+
+	created, err := j.CreateBuilding(ctx, &pro.Building{Name: "Annex", City: "Paris"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	building, err := j.BuildingDetails(ctx, "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Paris", building.City)
+
+	building.City = "Lyon"
+	_, err = j.UpdateBuilding(ctx, "2", building)
+	assert.Nil(t, err)
+	building, err = j.BuildingDetails(ctx, "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Lyon", building.City)
+
+	assert.Nil(t, j.DeleteBuilding(ctx, "2"))
+	_, err = j.BuildingDetails(ctx, "2")
+	assert.True(t, pro.IsNotFound(err))
+
+	_, err = j.ComputersInventory(ctx, &pro.ListOptions{Filter: "general.name=bad=x"})
+	assert.NotNil(t, err)
+}
+
+func TestClassicFixtures(t *testing.T) {
+	j, err := classic.NewClient(newTestServer(t).URL, "mockjamf", "secret", nil)
+	assert.Nil(t, err)
+
+	computers, err := j.Computers()
+	assert.Nil(t, err)
+	assert.Len(t, computers, 2)
+	assert.Equal(t, "C07ZK1XBJYVX", computers[1].SerialNumber)
+
+	computer, err := j.ComputerDetails(82)
+	assert.Nil(t, err)
+	assert.Equal(t, "Mock's MacBook Pro", computer.Info.General.Name)
+	assert.Equal(t, "Engineering", computer.Info.UserLocation.Department)
+
+	updated, err := j.UpdateComputer(&classic.ComputerIdentifier{ID: "82"}, &classic.ComputerDetails{UserLocation: classic.LocationInformation{Building: "Annex"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "Annex", updated.UserLocation.Building)
+
+	_, err = j.ComputerDetails(99)
+	assert.NotNil(t, err)
+}
+
+func TestFixturesRoundTrip(t *testing.T) {
+	golden.AssertRoundTrip[[]pro.ComputerInventory](t, "testdata/pro/v1/computers-inventory.json")
+	golden.AssertRoundTrip[[]pro.MobileDevice](t, "testdata/pro/v2/mobile-devices.json")
+	golden.AssertRoundTrip[[]pro.Building](t, "testdata/pro/v1/buildings.json")
+	golden.AssertRoundTrip[classic.Computers](t, "testdata/classic/computers.json")
+	golden.AssertRoundTrip[classic.Computer](t, "testdata/classic/computers/id/82.json")
+}
@@ -0,0 +1,125 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package delta fetches the computers whose inventory was reported or which checked in since the
+// last synchronization, turning nightly full inventory syncs into cheap deltas. The checkpoint of
+// each synchronization is persisted through a pluggable Store.
+package delta
+
+import (
+	"context"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/pkg/errors"
+)
+
+// DefaultKey is the key under which the checkpoint of a Syncer is stored when it has none
+const DefaultKey = "computers"
+
+// Fields of the computer inventory compared with the checkpoint
+var (
+	FieldReportDate      = pro.F("general.reportDate")
+	FieldLastContactTime = pro.F("general.lastContactTime")
+)
+
+// Syncer fetches the computers changed since its last synchronization
+type Syncer struct {
+	// Client is used to query the computers inventory
+	Client *pro.Client
+	// Store persists the checkpoint between synchronizations
+	Store Store
+	// Key identifies the checkpoint in the store, DefaultKey when empty
+	Key string
+	// Sections of the inventory to fetch, GENERAL is always requested to compute the next checkpoint
+	Sections []pro.ComputerInventorySection
+	// Overlap is subtracted from the checkpoint when querying so that inventories submitted while
+	// the previous synchronization was running are not missed, computers may then be returned twice
+	Overlap time.Duration
+}
+
+// Result describes a synchronization
+type Result struct {
+	// Since is the checkpoint computers were compared with, zero for a full synchronization
+	Since time.Time
+	// Checkpoint is the latest report or contact time of the computers returned, it is stored once
+	// the computers are handled and is Since when no computer changed
+	Checkpoint time.Time
+	// Computers are the computers changed since the checkpoint
+	Computers []pro.ComputerInventory
+}
+
+// Full returns whether every computer was returned because there was no checkpoint yet
+func (r *Result) Full() bool {
+	return r.Since.IsZero()
+}
+
+// Changed returns the computers reported or seen since the stored checkpoint without storing a new one
+func (s *Syncer) Changed(ctx context.Context) (*Result, error) {
+	since, err := s.Store.Load(ctx, s.key())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to load the %s checkpoint", s.key())
+	}
+
+	opts := &pro.ListOptions{Sort: []string{"id:asc"}}
+	if !since.IsZero() {
+		opts.Filter = Filter(since.Add(-s.Overlap)).String()
+	}
+	computers, err := s.Client.AllComputersInventory(ctx, opts, s.sections()...)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &Result{Since: since, Checkpoint: since, Computers: computers}
+	for _, c := range computers {
+		if c.General == nil {
+			continue
+		}
+		for _, date := range []string{c.General.ReportDate, c.General.LastContactTime} {
+			if t, err := time.Parse(time.RFC3339, date); err == nil && t.After(res.Checkpoint) {
+				res.Checkpoint = t
+			}
+		}
+	}
+	return res, nil
+}
+
+// Sync calls handle with the computers changed since the stored checkpoint and stores the new
+// checkpoint once handle succeeds, so that computers are fetched again after a failure
+func (s *Syncer) Sync(ctx context.Context, handle func(context.Context, *Result) error) (*Result, error) {
+	res, err := s.Changed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := handle(ctx, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to handle the %d computers changed since %v", len(res.Computers), res.Since)
+	}
+	if res.Checkpoint.Equal(res.Since) {
+		return res, nil
+	}
+	if err := s.Store.Save(ctx, s.key(), res.Checkpoint); err != nil {
+		return nil, errors.Wrapf(err, "unable to save the %s checkpoint", s.key())
+	}
+	return res, nil
+}
+
+// Filter returns the RSQL filter matching computers whose inventory was reported or which checked in after since
+func Filter(since time.Time) pro.Filter {
+	ts := since.UTC().Format(time.RFC3339)
+	return FieldReportDate.GT(ts).Or(FieldLastContactTime.GT(ts))
+}
+
+func (s *Syncer) key() string {
+	if s.Key == "" {
+		return DefaultKey
+	}
+	return s.Key
+}
+
+func (s *Syncer) sections() []pro.ComputerInventorySection {
+	for _, section := range s.Sections {
+		if section == pro.ComputerInventorySectionGeneral {
+			return s.Sections
+		}
+	}
+	return append([]pro.ComputerInventorySection{pro.ComputerInventorySectionGeneral}, s.Sections...)
+}
@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package delta_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/delta"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func inventoryMocks(t *testing.T, filters *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+		case "/api/v1/computers-inventory":
+			assert.Equal(t, []string{"GENERAL", "HARDWARE"}, r.URL.Query()["section"])
+			filter := r.URL.Query().Get("filter")
+			*filters = append(*filters, filter)
+			if filter == "" {
+				fmt.Fprint(w, `{"totalCount": 2, "results": [
+					{"id": "1", "general": {"name": "lab-01", "reportDate": "2024-10-01T08:00:00.123Z", "lastContactTime": "2024-10-01T09:30:00Z"}},
+					{"id": "2", "general": {"name": "lab-02", "reportDate": "2024-09-30T22:00:00Z"}}
+				]}`)
+				return
+			}
+			fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestSync(t *testing.T) {
+	var filters []string
+	server := inventoryMocks(t, &filters)
+	defer server.Close()
+	p, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	store := delta.NewMemoryStore()
+	s := &delta.Syncer{Client: p, Store: store, Sections: []pro.ComputerInventorySection{pro.ComputerInventorySectionHardware}, Overlap: 5 * time.Minute}
+	handled := 0
+	res, err := s.Sync(context.Background(), func(ctx context.Context, res *delta.Result) error {
+		handled += len(res.Computers)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.True(t, res.Full())
+	assert.Equal(t, 2, handled)
+	checkpoint := time.Date(2024, 10, 1, 9, 30, 0, 0, time.UTC)
+	assert.True(t, checkpoint.Equal(res.Checkpoint))
+	stored, _ := store.Load(context.Background(), delta.DefaultKey)
+	assert.True(t, checkpoint.Equal(stored))
+
+	res, err = s.Sync(context.Background(), func(ctx context.Context, res *delta.Result) error { return nil })
+	assert.Nil(t, err)
+	assert.False(t, res.Full())
+	assert.Empty(t, res.Computers)
+	assert.True(t, checkpoint.Equal(res.Checkpoint))
+	assert.Equal(t, `general.reportDate=gt="2024-10-01T09:25:00Z",general.lastContactTime=gt="2024-10-01T09:25:00Z"`, filters[1])
+}
+
+func TestSyncFailure(t *testing.T) {
+	var filters []string
+	server := inventoryMocks(t, &filters)
+	defer server.Close()
+	p, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	store := delta.NewMemoryStore()
+	s := &delta.Syncer{Client: p, Store: store, Key: "lab", Sections: []pro.ComputerInventorySection{pro.ComputerInventorySectionGeneral, pro.ComputerInventorySectionHardware}}
+	_, err = s.Sync(context.Background(), func(ctx context.Context, res *delta.Result) error {
+		return fmt.Errorf("database unavailable")
+	})
+	assert.Contains(t, err.Error(), "unable to handle the 2 computers changed since")
+	stored, _ := store.Load(context.Background(), "lab")
+	assert.True(t, stored.IsZero())
+}
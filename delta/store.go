@@ -0,0 +1,29 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package delta
+
+import (
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/internal/checkpoint"
+)
+
+// Store persists the checkpoints of synchronizations, e.g. in a file, a database or a key-value store.
+// Load returns the zero time when no checkpoint is stored under a key.
+type Store = checkpoint.Store[time.Time]
+
+// MemoryStore keeps checkpoints in memory, for the lifetime of a long running process or tests
+type MemoryStore = checkpoint.MemoryStore[time.Time]
+
+// FileStore keeps checkpoints in a JSON file mapping keys to RFC 3339 timestamps
+type FileStore = checkpoint.FileStore[time.Time]
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return checkpoint.NewMemoryStore[time.Time]()
+}
+
+// NewFileStore returns a FileStore persisting checkpoints to path, which is created on the first save
+func NewFileStore(path string) *FileStore {
+	return checkpoint.NewFileStore[time.Time](path)
+}
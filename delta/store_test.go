@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package delta_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/delta"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store := delta.NewFileStore(path)
+
+	checkpoint, err := store.Load(context.Background(), "computers")
+	assert.Nil(t, err)
+	assert.True(t, checkpoint.IsZero())
+
+	now := time.Date(2024, 10, 1, 9, 30, 0, 0, time.UTC)
+	assert.Nil(t, store.Save(context.Background(), "computers", now))
+	assert.Nil(t, store.Save(context.Background(), "lab", now.Add(time.Hour)))
+
+	checkpoint, err = delta.NewFileStore(path).Load(context.Background(), "computers")
+	assert.Nil(t, err)
+	assert.True(t, now.Equal(checkpoint))
+}
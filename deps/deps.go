@@ -0,0 +1,375 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package deps finds the policies, profiles and prestages referencing a script, package, category or
+// group and returns them as a dependency graph, so shared objects can be checked before they are deleted
+package deps
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/DataDog/jamf-api-client-go/batch"
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/pkg/errors"
+)
+
+// Kinds of the objects of a graph
+const (
+	KindScripts                   = "scripts"
+	KindPackages                  = "packages"
+	KindCategories                = "categories"
+	KindComputerGroups            = "computer-groups"
+	KindMobileDeviceGroups        = "mobile-device-groups"
+	KindPolicies                  = "policies"
+	KindManagedPreferenceProfiles = "managed-preference-profiles"
+	KindComputerPrestages         = "computer-prestages"
+)
+
+// Criteria of smart groups whose value is the name of another group
+const (
+	computerGroupCriterion     = "Computer Group"
+	mobileDeviceGroupCriterion = "Mobile Device Group"
+)
+
+// Node identifies an object of a graph
+type Node struct {
+	Kind string `json:"kind"`
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+func (n Node) String() string {
+	if n.Name == "" {
+		return fmt.Sprintf("%s/%s", n.Kind, n.ID)
+	}
+	return fmt.Sprintf("%s/%s (%s)", n.Kind, n.ID, n.Name)
+}
+
+func (n Node) is(other Node) bool {
+	return n.Kind == other.Kind && n.ID == other.ID
+}
+
+// Edge records that From references To, Field is where From holds the reference, e.g.
+// "scope.exclusions.computer_groups"
+type Edge struct {
+	From  Node   `json:"from"`
+	To    Node   `json:"to"`
+	Field string `json:"field"`
+}
+
+// Graph holds the objects referencing Root, directly or through smart groups whose criteria reference
+// another group
+type Graph struct {
+	Root  Node   `json:"root"`
+	Edges []Edge `json:"edges"`
+}
+
+// InUse reports whether any object references Root
+func (g *Graph) InUse() bool {
+	return len(g.Edges) > 0
+}
+
+// Dependents returns every object referencing Root, directly or not, in the order they were found
+func (g *Graph) Dependents() []Node {
+	var nodes []Node
+	for _, e := range g.Edges {
+		if !contains(nodes, e.From) {
+			nodes = append(nodes, e.From)
+		}
+	}
+	return nodes
+}
+
+// Referencing returns the objects referencing n directly
+func (g *Graph) Referencing(n Node) []Node {
+	var nodes []Node
+	for _, e := range g.Edges {
+		if e.To.is(n) && !contains(nodes, e.From) {
+			nodes = append(nodes, e.From)
+		}
+	}
+	return nodes
+}
+
+func contains(nodes []Node, n Node) bool {
+	for _, node := range nodes {
+		if node.is(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// Analyzer crawls a Jamf Pro server for the objects referencing another, Classic and Pro must be set
+type Analyzer struct {
+	Classic *classic.Client
+	Pro     *pro.Client
+	// Concurrency is the maximum number of requests made at once, batch.DefaultConcurrency when zero
+	Concurrency int
+	// Limiter, when set, is waited on before each request
+	Limiter batch.Limiter
+}
+
+// Graph returns the objects referencing the object of kind with the given ID, kind is one of KindScripts,
+// KindPackages, KindCategories, KindComputerGroups or KindMobileDeviceGroups
+func (a *Analyzer) Graph(ctx context.Context, kind string, id string) (*Graph, error) {
+	inv := &inventory{}
+	var lists []batch.Operation
+	switch kind {
+	case KindScripts, KindCategories:
+		lists = append(lists, inv.listPolicies(a.Classic))
+	case KindPackages:
+		lists = append(lists, inv.listPolicies(a.Classic), inv.listComputerPrestages(a.Pro))
+	case KindComputerGroups:
+		lists = append(lists, inv.listPolicies(a.Classic), inv.listProfiles(a.Classic), inv.listComputerGroups(a.Pro))
+	case KindMobileDeviceGroups:
+		lists = append(lists, inv.listProfiles(a.Classic), inv.listMobileDeviceGroups(a.Pro))
+	default:
+		return nil, errors.Errorf("unable to find the objects referencing %s, unsupported kind", kind)
+	}
+
+	b := &batch.Batch{Concurrency: a.Concurrency, Limiter: a.Limiter}
+	if err := b.Run(ctx, lists).Err(); err != nil {
+		return nil, errors.Wrapf(err, "unable to list the objects referencing %s/%s", kind, id)
+	}
+	if err := b.Run(ctx, inv.details(a.Classic)).Err(); err != nil {
+		return nil, errors.Wrapf(err, "unable to query the objects referencing %s/%s", kind, id)
+	}
+
+	root := Node{Kind: kind, ID: id}
+	if kind == KindComputerGroups || kind == KindMobileDeviceGroups {
+		name, ok := inv.names[id]
+		if !ok {
+			return nil, errors.Errorf("unable to find the objects referencing %s/%s, the group does not exist", kind, id)
+		}
+		root.Name = name
+	}
+
+	graph := &Graph{Root: root}
+	queue := []Node{root}
+	seen := []Node{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, e := range inv.references(node) {
+			graph.Edges = append(graph.Edges, e)
+			if e.From.Kind == node.Kind && !contains(seen, e.From) {
+				seen = append(seen, e.From)
+				queue = append(queue, e.From)
+			}
+		}
+	}
+	return graph, nil
+}
+
+// inventory holds the objects which may reference the root of a graph
+type inventory struct {
+	policyList              classic.PolicyList
+	profileList             []classic.BasicManagedPreferenceProfile
+	policies                []*classic.PolicyContents
+	profiles                []*classic.ManagedPreferenceProfile
+	computerPrestages       []pro.ComputerPrestage
+	smartComputerGroups     []pro.SmartComputerGroup
+	smartMobileDeviceGroups []pro.SmartMobileDeviceGroup
+	// names of the computer or mobile device groups keyed by ID
+	names map[string]string
+}
+
+func (inv *inventory) listPolicies(c *classic.Client) batch.Operation {
+	return batch.Operation{Key: KindPolicies, Do: func(ctx context.Context) (err error) {
+		inv.policyList, err = c.Policies()
+		return err
+	}}
+}
+
+func (inv *inventory) listProfiles(c *classic.Client) batch.Operation {
+	return batch.Operation{Key: KindManagedPreferenceProfiles, Do: func(ctx context.Context) (err error) {
+		inv.profileList, err = c.ManagedPreferenceProfiles()
+		return err
+	}}
+}
+
+func (inv *inventory) listComputerPrestages(p *pro.Client) batch.Operation {
+	return batch.Operation{Key: KindComputerPrestages, Do: func(ctx context.Context) (err error) {
+		inv.computerPrestages, err = p.AllComputerPrestages(ctx, nil)
+		return err
+	}}
+}
+
+func (inv *inventory) listComputerGroups(p *pro.Client) batch.Operation {
+	return batch.Operation{Key: KindComputerGroups, Do: func(ctx context.Context) error {
+		groups, err := p.ComputerGroups(ctx)
+		if err != nil {
+			return err
+		}
+		inv.names = make(map[string]string, len(groups))
+		for _, g := range groups {
+			inv.names[g.ID] = g.Name
+		}
+		inv.smartComputerGroups, err = p.AllSmartComputerGroups(ctx, nil)
+		return err
+	}}
+}
+
+func (inv *inventory) listMobileDeviceGroups(p *pro.Client) batch.Operation {
+	return batch.Operation{Key: KindMobileDeviceGroups, Do: func(ctx context.Context) error {
+		groups, err := p.MobileDeviceGroups(ctx)
+		if err != nil {
+			return err
+		}
+		inv.names = make(map[string]string, len(groups))
+		for _, g := range groups {
+			inv.names[g.ID] = g.Name
+		}
+		inv.smartMobileDeviceGroups, err = p.AllSmartMobileDeviceGroups(ctx, nil)
+		return err
+	}}
+}
+
+// details returns the operations querying every listed policy and profile, the classic API only
+// returns their references in their details
+func (inv *inventory) details(c *classic.Client) []batch.Operation {
+	inv.policies = make([]*classic.PolicyContents, len(inv.policyList))
+	inv.profiles = make([]*classic.ManagedPreferenceProfile, len(inv.profileList))
+	ops := make([]batch.Operation, 0, len(inv.policyList)+len(inv.profileList))
+	for i, p := range inv.policyList {
+		ops = append(ops, batch.Operation{Key: fmt.Sprintf("%s/%d", KindPolicies, p.ID), Do: func(ctx context.Context) error {
+			res, err := c.PolicyDetails(p.ID)
+			if err != nil {
+				return err
+			}
+			inv.policies[i] = res.Content
+			return nil
+		}})
+	}
+	for i, p := range inv.profileList {
+		ops = append(ops, batch.Operation{Key: fmt.Sprintf("%s/%d", KindManagedPreferenceProfiles, p.ID), Do: func(ctx context.Context) error {
+			res, err := c.ManagedPreferenceProfileDetails(p.ID)
+			if err != nil {
+				return err
+			}
+			inv.profiles[i] = res.Details
+			return nil
+		}})
+	}
+	return ops
+}
+
+// references returns the edges from the objects referencing n directly
+func (inv *inventory) references(n Node) []Edge {
+	var edges []Edge
+	add := func(from Node, field string) {
+		edges = append(edges, Edge{From: from, To: n, Field: field})
+	}
+	for _, p := range inv.policies {
+		if p == nil || p.General == nil {
+			continue
+		}
+		from := Node{Kind: KindPolicies, ID: strconv.Itoa(p.General.ID), Name: p.General.Name}
+		switch n.Kind {
+		case KindScripts:
+			for _, s := range p.Scripts {
+				if s != nil && strconv.Itoa(s.ID) == n.ID {
+					add(from, "scripts")
+				}
+			}
+		case KindPackages:
+			if p.PackageConfiguration != nil {
+				for _, pkg := range p.PackageConfiguration.List {
+					if pkg != nil && strconv.Itoa(pkg.ID) == n.ID {
+						add(from, "package_configuration.packages")
+					}
+				}
+			}
+		case KindCategories:
+			if p.General.Category != nil && strconv.Itoa(p.General.Category.ID) == n.ID {
+				add(from, "general.category")
+			}
+		}
+		for _, field := range scopeReferences(p.Scope, n) {
+			add(from, field)
+		}
+	}
+	for _, p := range inv.profiles {
+		if p == nil || p.General == nil {
+			continue
+		}
+		from := Node{Kind: KindManagedPreferenceProfiles, ID: strconv.Itoa(p.General.ID), Name: p.General.Name}
+		for _, field := range scopeReferences(p.Scope, n) {
+			add(from, field)
+		}
+	}
+	if n.Kind == KindPackages {
+		for _, p := range inv.computerPrestages {
+			for _, id := range p.CustomPackageIDs {
+				if id == n.ID {
+					add(Node{Kind: KindComputerPrestages, ID: p.ID, Name: p.DisplayName}, "customPackageIds")
+				}
+			}
+		}
+	}
+	if n.Kind == KindComputerGroups {
+		for _, g := range inv.smartComputerGroups {
+			for _, c := range g.Criteria {
+				if c.Name == computerGroupCriterion && c.Value == n.Name {
+					add(Node{Kind: KindComputerGroups, ID: g.ID, Name: g.Name}, "criteria")
+				}
+			}
+		}
+	}
+	if n.Kind == KindMobileDeviceGroups {
+		for _, g := range inv.smartMobileDeviceGroups {
+			for _, c := range g.Criteria {
+				if c.Name == mobileDeviceGroupCriterion && c.Value == n.Name {
+					add(Node{Kind: KindMobileDeviceGroups, ID: g.ID, Name: g.Name}, "criteria")
+				}
+			}
+		}
+	}
+	return edges
+}
+
+// scopeReferences returns the fields of scope targeting or excluding the group n
+func scopeReferences(scope *classic.Scope, n Node) []string {
+	if scope == nil {
+		return nil
+	}
+	var fields []string
+	switch n.Kind {
+	case KindComputerGroups:
+		if hasComputerGroup(scope.ComputerGroups, n.ID) {
+			fields = append(fields, "scope.computer_groups")
+		}
+		if scope.Exclusions != nil && hasComputerGroup(scope.Exclusions.ComputerGroups, n.ID) {
+			fields = append(fields, "scope.exclusions.computer_groups")
+		}
+	case KindMobileDeviceGroups:
+		if hasMobileDeviceGroup(scope.MobileDeviceGroups, n.ID) {
+			fields = append(fields, "scope.mobile_device_groups")
+		}
+		if scope.Exclusions != nil && hasMobileDeviceGroup(scope.Exclusions.MobileDeviceGroups, n.ID) {
+			fields = append(fields, "scope.exclusions.mobile_device_groups")
+		}
+	}
+	return fields
+}
+
+func hasComputerGroup(groups []*classic.ComputerGroup, id string) bool {
+	for _, g := range groups {
+		if g != nil && strconv.Itoa(g.ID) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMobileDeviceGroup(groups []*classic.MobileDeviceGroup, id string) bool {
+	for _, g := range groups {
+		if g != nil && strconv.Itoa(g.ID) == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,194 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package deps_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/deps"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalyzer(t *testing.T, mux *http.ServeMux) (*deps.Analyzer, func()) {
+	mux.HandleFunc("/api/v1/auth/token", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+	})
+	server := httptest.NewServer(mux)
+	c, err := classic.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	p, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	return &deps.Analyzer{Classic: c, Pro: p}, server.Close
+}
+
+func policies(mux *http.ServeMux) {
+	mux.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Weekly Clean Up"}, {"id": 11, "name": "Install Tools"}, {"id": 12, "name": "Inventory"}]}`)
+	})
+	mux.HandleFunc("/JSSResource/policies/id/10", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policy": {
+			"general": {"id": 10, "name": "Weekly Clean Up", "category": {"id": 3, "name": "Maintenance"}},
+			"scope": {"computer_groups": [{"id": 8, "name": "All Managed Clients"}]},
+			"scripts": [{"id": 5, "name": "Clean Up"}]
+		}}`)
+	})
+	mux.HandleFunc("/JSSResource/policies/id/11", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policy": {
+			"general": {"id": 11, "name": "Install Tools", "category": {"id": 4, "name": "Tools"}},
+			"scope": {"all_computers": true, "exclusions": {"computer_groups": [{"id": 8, "name": "All Managed Clients"}]}},
+			"package_configuration": {"packages": [{"id": 6, "name": "Tools.pkg"}]}
+		}}`)
+	})
+	mux.HandleFunc("/JSSResource/policies/id/12", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policy": {"general": {"id": 12, "name": "Inventory", "category": {"id": 3, "name": "Maintenance"}}}}`)
+	})
+}
+
+func TestGraphComputerGroup(t *testing.T) {
+	mux := http.NewServeMux()
+	policies(mux)
+	mux.HandleFunc("/JSSResource/managedpreferenceprofiles", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"managed_preference_profiles": [{"id": 20, "name": "Dock"}]}`)
+	})
+	mux.HandleFunc("/JSSResource/managedpreferenceprofiles/id/20", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"managed_preference_profile": {
+			"general": {"id": 20, "name": "Dock"},
+			"scope": {"computer_groups": [{"id": 9, "name": "Managed Laptops"}]}
+		}}`)
+	})
+	mux.HandleFunc("/api/v1/computer-groups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "8", "name": "All Managed Clients", "smartGroup": true},
+			{"id": "9", "name": "Managed Laptops", "smartGroup": true},
+			{"id": "13", "name": "Lab", "smartGroup": false}]`)
+	})
+	mux.HandleFunc("/api/v2/computer-groups/smart-groups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"id": "8", "name": "All Managed Clients", "criteria": [{"name": "Managed", "searchType": "is", "value": "Managed"}]},
+			{"id": "9", "name": "Managed Laptops", "criteria": [
+				{"name": "Computer Group", "searchType": "member of", "value": "All Managed Clients"},
+				{"name": "Model", "andOr": "and", "searchType": "like", "value": "Book"}
+			]}
+		]}`)
+	})
+	a, closeServer := newAnalyzer(t, mux)
+	defer closeServer()
+
+	graph, err := a.Graph(context.Background(), deps.KindComputerGroups, "8")
+	assert.Nil(t, err)
+	root := deps.Node{Kind: deps.KindComputerGroups, ID: "8", Name: "All Managed Clients"}
+	laptops := deps.Node{Kind: deps.KindComputerGroups, ID: "9", Name: "Managed Laptops"}
+	assert.Equal(t, root, graph.Root)
+	assert.Equal(t, []deps.Edge{
+		{From: deps.Node{Kind: deps.KindPolicies, ID: "10", Name: "Weekly Clean Up"}, To: root, Field: "scope.computer_groups"},
+		{From: deps.Node{Kind: deps.KindPolicies, ID: "11", Name: "Install Tools"}, To: root, Field: "scope.exclusions.computer_groups"},
+		{From: laptops, To: root, Field: "criteria"},
+		{From: deps.Node{Kind: deps.KindManagedPreferenceProfiles, ID: "20", Name: "Dock"}, To: laptops, Field: "scope.computer_groups"},
+	}, graph.Edges)
+	assert.True(t, graph.InUse())
+	assert.Len(t, graph.Dependents(), 4)
+	assert.Equal(t, []deps.Node{{Kind: deps.KindManagedPreferenceProfiles, ID: "20", Name: "Dock"}}, graph.Referencing(laptops))
+
+	graph, err = a.Graph(context.Background(), deps.KindComputerGroups, "13")
+	assert.Nil(t, err)
+	assert.False(t, graph.InUse())
+	assert.Empty(t, graph.Dependents())
+
+	_, err = a.Graph(context.Background(), deps.KindComputerGroups, "99")
+	assert.Contains(t, err.Error(), "the group does not exist")
+}
+
+func TestGraphPackage(t *testing.T) {
+	mux := http.NewServeMux()
+	policies(mux)
+	mux.HandleFunc("/api/v3/computer-prestages", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"id": "1", "displayName": "Staff", "customPackageIds": ["6", "7"]},
+			{"id": "2", "displayName": "Lab", "customPackageIds": ["7"]}
+		]}`)
+	})
+	a, closeServer := newAnalyzer(t, mux)
+	defer closeServer()
+
+	graph, err := a.Graph(context.Background(), deps.KindPackages, "6")
+	assert.Nil(t, err)
+	assert.Equal(t, []deps.Node{
+		{Kind: deps.KindPolicies, ID: "11", Name: "Install Tools"},
+		{Kind: deps.KindComputerPrestages, ID: "1", Name: "Staff"},
+	}, graph.Dependents())
+	assert.Equal(t, "package_configuration.packages", graph.Edges[0].Field)
+	assert.Equal(t, "customPackageIds", graph.Edges[1].Field)
+}
+
+func TestGraphScriptAndCategory(t *testing.T) {
+	mux := http.NewServeMux()
+	policies(mux)
+	a, closeServer := newAnalyzer(t, mux)
+	defer closeServer()
+
+	graph, err := a.Graph(context.Background(), deps.KindScripts, "5")
+	assert.Nil(t, err)
+	assert.Equal(t, []deps.Node{{Kind: deps.KindPolicies, ID: "10", Name: "Weekly Clean Up"}}, graph.Dependents())
+	assert.Equal(t, "scripts", graph.Edges[0].Field)
+
+	graph, err = a.Graph(context.Background(), deps.KindCategories, "3")
+	assert.Nil(t, err)
+	assert.Equal(t, []deps.Node{
+		{Kind: deps.KindPolicies, ID: "10", Name: "Weekly Clean Up"},
+		{Kind: deps.KindPolicies, ID: "12", Name: "Inventory"},
+	}, graph.Dependents())
+}
+
+func TestGraphMobileDeviceGroup(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/JSSResource/managedpreferenceprofiles", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"managed_preference_profiles": []}`)
+	})
+	mux.HandleFunc("/api/v1/mobile-device-groups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": "2", "name": "iPads", "isSmartGroup": true}, {"id": "3", "name": "Shared iPads", "isSmartGroup": true}]`)
+	})
+	mux.HandleFunc("/api/v1/mobile-device-groups/smart-groups", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"groupId": "2", "groupName": "iPads", "criteria": [{"name": "Mobile Device Group", "searchType": "member of", "value": "Shared iPads"}]},
+			{"groupId": "3", "groupName": "Shared iPads", "criteria": [{"name": "Mobile Device Group", "searchType": "member of", "value": "iPads"}]}
+		]}`)
+	})
+	a, closeServer := newAnalyzer(t, mux)
+	defer closeServer()
+
+	graph, err := a.Graph(context.Background(), deps.KindMobileDeviceGroups, "2")
+	assert.Nil(t, err)
+	assert.Equal(t, []deps.Edge{
+		{From: deps.Node{Kind: deps.KindMobileDeviceGroups, ID: "3", Name: "Shared iPads"}, To: graph.Root, Field: "criteria"},
+		{From: graph.Root, To: deps.Node{Kind: deps.KindMobileDeviceGroups, ID: "3", Name: "Shared iPads"}, Field: "criteria"},
+	}, graph.Edges)
+}
+
+func TestGraphErrors(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/JSSResource/policies", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"policies": [{"id": 10, "name": "Weekly Clean Up"}]}`)
+	})
+	mux.HandleFunc("/JSSResource/policies/id/10", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	a, closeServer := newAnalyzer(t, mux)
+	defer closeServer()
+
+	_, err := a.Graph(context.Background(), deps.KindScripts, "5")
+	assert.Contains(t, err.Error(), "unable to query the objects referencing scripts/5")
+	assert.Contains(t, err.Error(), "policies/10")
+
+	_, err = a.Graph(context.Background(), deps.KindPolicies, "10")
+	assert.Contains(t, err.Error(), "unsupported kind")
+}
+
+func TestNodeString(t *testing.T) {
+	assert.Equal(t, "scripts/5", deps.Node{Kind: deps.KindScripts, ID: "5"}.String())
+	assert.Equal(t, "policies/10 (Weekly Clean Up)", deps.Node{Kind: deps.KindPolicies, ID: "10", Name: "Weekly Clean Up"}.String())
+}
@@ -0,0 +1,250 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package diff compares Jamf objects such as policies, profiles and smart groups field by field,
+// ignoring the fields managed by the server so that the same object can be compared across servers
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ServerManagedFields are the struct fields ignored by default, they hold IDs and timestamps set by
+// the server. Fields whose name ends with ID, such as CategoryID or SiteID, are ignored as well since
+// IDs differ between servers, the names of the objects referenced are compared instead.
+var ServerManagedFields = []string{
+	"XMLName",
+	"ID",
+	"Href",
+	"Count",
+	"MembershipCount",
+	"ScriptCount",
+	"DateCreated",
+	"DateModified",
+	"DateSent",
+	"DateCompleted",
+	"LastModified",
+	"LastUpdated",
+	"CreatedAt",
+	"UpdatedAt",
+}
+
+// Change is a field holding different values in the objects compared, a nil A or B means the field
+// is missing from that object, e.g. an extra element of a list
+type Change struct {
+	Path string
+	A    interface{}
+	B    interface{}
+}
+
+// String returns the change as "path: a => b"
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %s => %s", c.Path, FormatValue(c.A), FormatValue(c.B))
+}
+
+// Option configures a comparison
+type Option func(*differ)
+
+// Ignore ignores the fields with the given struct field names, e.g. "Description", or paths, e.g. "general.site"
+func Ignore(fields ...string) Option {
+	return func(d *differ) {
+		for _, f := range fields {
+			d.ignored[f] = true
+		}
+	}
+}
+
+// IncludeIDs compares IDs and the other server managed fields instead of ignoring them, which is
+// useful to compare objects from the same server
+func IncludeIDs() Option {
+	return func(d *differ) {
+		d.ids = true
+		for _, f := range ServerManagedFields {
+			delete(d.ignored, f)
+		}
+	}
+}
+
+// Diff compares a and b field by field and returns the fields that differ ordered by path. Fields
+// are named after their JSON tag when they have one. Nil pointers, slices and maps are considered
+// equal to empty ones.
+//
+//	for _, change := range diff.Diff(staging.Content, production.Content) {
+//		fmt.Println(change)
+//	}
+func Diff[T any](a T, b T, opts ...Option) []Change {
+	d := &differ{ignored: map[string]bool{}}
+	for _, f := range ServerManagedFields {
+		d.ignored[f] = true
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	d.compare("", reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem())
+	sort.SliceStable(d.changes, func(i, j int) bool { return d.changes[i].Path < d.changes[j].Path })
+	return d.changes
+}
+
+// Equal returns true when Diff finds no differences between a and b
+func Equal[T any](a T, b T, opts ...Option) bool {
+	return len(Diff(a, b, opts...)) == 0
+}
+
+type differ struct {
+	ignored map[string]bool
+	ids     bool
+	changes []Change
+}
+
+func (d *differ) compare(path string, a reflect.Value, b reflect.Value) {
+	a, b = indirect(a), indirect(b)
+	if !a.IsValid() && !b.IsValid() {
+		return
+	}
+	if !a.IsValid() {
+		a = reflect.Zero(b.Type())
+	}
+	if !b.IsValid() {
+		b = reflect.Zero(a.Type())
+	}
+	if a.Type() != b.Type() {
+		d.add(path, a, b)
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		if hasExportedFields(a.Type()) {
+			d.compareStruct(path, a, b)
+		} else if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			// values such as time.Time only have unexported fields
+			d.add(path, a, b)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < a.Len() || i < b.Len(); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				d.changes = append(d.changes, Change{Path: elemPath, B: b.Index(i).Interface()})
+			case i >= b.Len():
+				d.changes = append(d.changes, Change{Path: elemPath, A: a.Index(i).Interface()})
+			default:
+				d.compare(elemPath, a.Index(i), b.Index(i))
+			}
+		}
+	case reflect.Map:
+		keys := map[string]reflect.Value{}
+		for _, k := range append(a.MapKeys(), b.MapKeys()...) {
+			keys[fmt.Sprint(k.Interface())] = k
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			elemPath := fmt.Sprintf("%s[%s]", path, name)
+			av, bv := a.MapIndex(keys[name]), b.MapIndex(keys[name])
+			switch {
+			case !av.IsValid():
+				d.changes = append(d.changes, Change{Path: elemPath, B: bv.Interface()})
+			case !bv.IsValid():
+				d.changes = append(d.changes, Change{Path: elemPath, A: av.Interface()})
+			default:
+				d.compare(elemPath, av, bv)
+			}
+		}
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(path, a, b)
+		}
+	}
+}
+
+func (d *differ) compareStruct(path string, a reflect.Value, b reflect.Value) {
+	t := a.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct {
+			d.compare(path, a.Field(i), b.Field(i))
+			continue
+		}
+		name := fieldName(field)
+		if name == "" {
+			continue
+		}
+		fieldPath := name
+		if path != "" {
+			fieldPath = path + "." + name
+		}
+		if d.ignored[field.Name] || d.ignored[fieldPath] || (!d.ids && strings.HasSuffix(field.Name, "ID")) {
+			continue
+		}
+		d.compare(fieldPath, a.Field(i), b.Field(i))
+	}
+}
+
+func (d *differ) add(path string, a reflect.Value, b reflect.Value) {
+	d.changes = append(d.changes, Change{Path: path, A: a.Interface(), B: b.Interface()})
+}
+
+// indirect follows pointers and interfaces, returning an invalid value for nil
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			if v.Kind() == reflect.Ptr {
+				return reflect.Zero(v.Type().Elem())
+			}
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// fieldName returns the JSON name of a field, the field name without one and "" when it is not serialized
+func fieldName(field reflect.StructField) string {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// FormatValue formats a value of a Change, strings are quoted and missing values shown as <missing>
+func FormatValue(v interface{}) string {
+	if v == nil {
+		return "<missing>"
+	}
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		return fmt.Sprintf("%+v", rv.Elem().Interface())
+	}
+	return fmt.Sprintf("%+v", v)
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package diff_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/diff"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffPolicies(t *testing.T) {
+	staging := &classic.PolicyContents{
+		General: &classic.PolicyGeneral{ID: 4, Name: "Install Chrome", Enabled: true, Frequency: "Once per computer", Category: &classic.PolicyCategory{ID: 2, Name: "Browsers"}},
+		Scripts: []*classic.PolicyScriptAssignment{{ID: 7, Name: "Install", Priority: "After"}},
+	}
+	production := &classic.PolicyContents{
+		General: &classic.PolicyGeneral{ID: 93, Name: "Install Chrome", Enabled: false, Frequency: "Once per computer", Category: &classic.PolicyCategory{ID: 12, Name: "Browsers"}},
+		Scope:   &classic.Scope{},
+		Scripts: []*classic.PolicyScriptAssignment{{ID: 31, Name: "Install", Priority: "After"}, {ID: 32, Name: "Clean Up", Priority: "After"}},
+	}
+
+	changes := diff.Diff(staging, production)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "general.enabled", changes[0].Path)
+	assert.Equal(t, "general.enabled: true => false", changes[0].String())
+	assert.Equal(t, "scripts[1]", changes[1].Path)
+	assert.Nil(t, changes[1].A)
+	assert.Contains(t, changes[1].String(), `scripts[1]: <missing> => {ID:32 Name:Clean Up`)
+
+	changes = diff.Diff(staging, production, diff.IncludeIDs())
+	assert.Equal(t, []string{"general.category.id", "general.enabled", "general.id", "scripts[0].id", "scripts[1]"}, paths(changes))
+
+	changes = diff.Diff(staging, production, diff.Ignore("Enabled", "scripts"))
+	assert.Empty(t, changes)
+	assert.True(t, diff.Equal(staging, staging))
+}
+
+func TestDiffSmartGroups(t *testing.T) {
+	a := pro.SmartComputerGroup{ID: "1", Name: "Sonoma", MembershipCount: 20, Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "14."},
+	}}
+	b := pro.SmartComputerGroup{ID: "8", Name: "Sonoma", MembershipCount: 3, SiteID: "2", Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "14.6"},
+	}}
+
+	changes := diff.Diff(a, b)
+	assert.Equal(t, []diff.Change{{Path: "criteria[0].value", A: "14.", B: "14.6"}}, changes)
+	assert.Equal(t, `criteria[0].value: "14." => "14.6"`, changes[0].String())
+}
+
+func TestDiffValues(t *testing.T) {
+	type record struct {
+		Seen    time.Time
+		Fields  map[string]string
+		Extra   interface{}
+		private string
+	}
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	a := record{Seen: now, Fields: map[string]string{"a": "1", "b": "2"}, private: "x"}
+	b := record{Seen: now.Add(time.Hour), Fields: map[string]string{"a": "1", "c": "3"}, Extra: []string{}, private: "y"}
+
+	changes := diff.Diff(a, b)
+	assert.Equal(t, []string{"Fields[b]", "Fields[c]", "Seen"}, paths(changes))
+	assert.Equal(t, diff.Change{Path: "Fields[b]", A: "2"}, changes[0])
+
+	b.Extra = []string{"unexpected"}
+	assert.Equal(t, []diff.Change{{Path: "Extra[0]", B: "unexpected"}}, diff.Diff(record{}, record{Extra: b.Extra}))
+
+	assert.Empty(t, diff.Diff(record{Fields: map[string]string{}}, record{}))
+}
+
+func paths(changes []diff.Change) []string {
+	res := make([]string, len(changes))
+	for i, c := range changes {
+		res[i] = c.Path
+	}
+	return res
+}
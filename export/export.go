@@ -0,0 +1,170 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package export flattens lists returned by the Jamf clients into CSV or newline delimited JSON
+// reports made of the columns chosen by the caller
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// Format is the encoding of an export
+type Format int
+
+const (
+	// CSV writes a header row followed by one row per item
+	CSV Format = iota
+	// NDJSON writes one JSON object per line keyed by the column headers, in column order
+	NDJSON
+)
+
+// String returns the name of the format
+func (f Format) String() string {
+	switch f {
+	case CSV:
+		return "csv"
+	case NDJSON:
+		return "ndjson"
+	}
+	return fmt.Sprintf("Format(%d)", int(f))
+}
+
+// ParseFormat returns the format with the given name, "csv" or "ndjson"
+func ParseFormat(name string) (Format, error) {
+	switch name {
+	case "csv":
+		return CSV, nil
+	case "ndjson", "jsonl":
+		return NDJSON, nil
+	}
+	return 0, fmt.Errorf("unknown export format %q, expected csv or ndjson", name)
+}
+
+// Column is a column of an export, Value reads the column from an item
+type Column[T any] struct {
+	Header string
+	Value  func(item T) string
+}
+
+// Headers returns the header of each column
+func Headers[T any](columns []Column[T]) []string {
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = c.Header
+	}
+	return headers
+}
+
+// Write writes items to w in the given format with one field per column
+//
+//	columns := []export.Column[classic.BasicComputerInfo]{classic.ComputerListColumnName, classic.ComputerListColumnSerialNumber}
+//	err := export.Write(os.Stdout, export.CSV, computers, columns)
+func Write[T any](w io.Writer, format Format, items []T, columns []Column[T]) error {
+	ew, err := NewWriter(w, format, columns)
+	if err != nil {
+		return err
+	}
+	if err := ew.Write(items...); err != nil {
+		return err
+	}
+	return ew.Flush()
+}
+
+// Writer writes items in a format as they arrive, e.g. page by page, so large lists are never held in
+// memory. Flush must be called once every item is written.
+type Writer[T any] struct {
+	format  Format
+	columns []Column[T]
+	w       io.Writer
+	csv     *csv.Writer
+	headers [][]byte
+	line    bytes.Buffer
+}
+
+// NewWriter returns a Writer of items to w in the given format with one field per column, writing the
+// CSV header row right away
+func NewWriter[T any](w io.Writer, format Format, columns []Column[T]) (*Writer[T], error) {
+	if len(columns) == 0 {
+		return nil, errors.New("at least one column is required to export")
+	}
+	ew := &Writer[T]{format: format, columns: columns, w: w}
+	switch format {
+	case CSV:
+		ew.csv = csv.NewWriter(w)
+		if err := ew.csv.Write(Headers(columns)); err != nil {
+			return nil, errors.Wrap(err, "unable to write CSV header")
+		}
+	case NDJSON:
+		ew.headers = make([][]byte, len(columns))
+		for i, c := range columns {
+			data, err := json.Marshal(c.Header)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to encode column %s", c.Header)
+			}
+			ew.headers[i] = data
+		}
+	default:
+		return nil, fmt.Errorf("unsupported export format %v", format)
+	}
+	return ew, nil
+}
+
+// Write writes one row per item
+func (ew *Writer[T]) Write(items ...T) error {
+	if ew.format == CSV {
+		return ew.writeCSV(items)
+	}
+	return ew.writeNDJSON(items)
+}
+
+// Flush writes any buffered data to the underlying writer
+func (ew *Writer[T]) Flush() error {
+	if ew.csv == nil {
+		return nil
+	}
+	ew.csv.Flush()
+	return errors.Wrap(ew.csv.Error(), "unable to write CSV export")
+}
+
+func (ew *Writer[T]) writeCSV(items []T) error {
+	row := make([]string, len(ew.columns))
+	for _, item := range items {
+		for i, c := range ew.columns {
+			row[i] = c.Value(item)
+		}
+		if err := ew.csv.Write(row); err != nil {
+			return errors.Wrap(err, "unable to write CSV row")
+		}
+	}
+	return nil
+}
+
+func (ew *Writer[T]) writeNDJSON(items []T) error {
+	for _, item := range items {
+		ew.line.Reset()
+		ew.line.WriteByte('{')
+		for i, c := range ew.columns {
+			if i > 0 {
+				ew.line.WriteByte(',')
+			}
+			value, err := json.Marshal(c.Value(item))
+			if err != nil {
+				return errors.Wrapf(err, "unable to encode column %s", c.Header)
+			}
+			ew.line.Write(ew.headers[i])
+			ew.line.WriteByte(':')
+			ew.line.Write(value)
+		}
+		ew.line.WriteString("}\n")
+		if _, err := ew.w.Write(ew.line.Bytes()); err != nil {
+			return errors.Wrap(err, "unable to write NDJSON export")
+		}
+	}
+	return nil
+}
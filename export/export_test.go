@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package export_test
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/stretchr/testify/assert"
+)
+
+type device struct {
+	ID   int
+	Name string
+}
+
+var deviceColumns = []export.Column[device]{
+	{Header: "ID", Value: func(d device) string { return strconv.Itoa(d.ID) }},
+	{Header: "Device Name", Value: func(d device) string { return d.Name }},
+}
+
+var devices = []device{{1, "MAC-001"}, {2, `Jane's "Air", 13"`}}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, export.Write(&buf, export.CSV, devices, deviceColumns))
+	assert.Equal(t, "ID,Device Name\n1,MAC-001\n2,\"Jane's \"\"Air\"\", 13\"\"\"\n", buf.String())
+
+	buf.Reset()
+	assert.Nil(t, export.Write(&buf, export.CSV, []device{}, deviceColumns))
+	assert.Equal(t, "ID,Device Name\n", buf.String())
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Nil(t, export.Write(&buf, export.NDJSON, devices, deviceColumns))
+	assert.Equal(t, "{\"ID\":\"1\",\"Device Name\":\"MAC-001\"}\n{\"ID\":\"2\",\"Device Name\":\"Jane's \\\"Air\\\", 13\\\"\"}\n", buf.String())
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(&buf, export.CSV, deviceColumns)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Write(devices[0]))
+	assert.Nil(t, w.Write())
+	assert.Nil(t, w.Write(device{3, "MAC-003"}))
+	assert.Nil(t, w.Flush())
+	assert.Equal(t, "ID,Device Name\n1,MAC-001\n3,MAC-003\n", buf.String())
+
+	buf.Reset()
+	w, err = export.NewWriter(&buf, export.NDJSON, deviceColumns)
+	assert.Nil(t, err)
+	assert.Nil(t, w.Write(devices[0]))
+	assert.Equal(t, "{\"ID\":\"1\",\"Device Name\":\"MAC-001\"}\n", buf.String())
+	assert.Nil(t, w.Flush())
+}
+
+func TestWriteErrors(t *testing.T) {
+	var buf bytes.Buffer
+	err := export.Write(&buf, export.CSV, devices, nil)
+	assert.Contains(t, err.Error(), "at least one column is required")
+
+	err = export.Write(&buf, export.Format(7), devices, deviceColumns)
+	assert.Contains(t, err.Error(), "unsupported export format Format(7)")
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := export.ParseFormat("ndjson")
+	assert.Nil(t, err)
+	assert.Equal(t, export.NDJSON, format)
+	assert.Equal(t, "ndjson", format.String())
+
+	_, err = export.ParseFormat("xlsx")
+	assert.Contains(t, err.Error(), `unknown export format "xlsx"`)
+}
@@ -1,6 +1,6 @@
 module github.com/DataDog/jamf-api-client-go
 
-go 1.18
+go 1.23
 
 require (
 	github.com/pkg/errors v0.9.1
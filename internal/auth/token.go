@@ -0,0 +1,116 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package auth holds the bearer token machinery shared by the classic and pro clients. Both APIs
+// authenticate with a token issued by the Jamf Pro API's /api/v1/auth/token endpoint.
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RefreshWindow is how long before expiration a token is considered stale and re-requested
+const RefreshWindow = time.Minute * 5
+
+// Token represents the bearer token required for client authentication, it must not be copied once used
+type Token struct {
+	Token   string `json:"token"`
+	Expires string `json:"expires"`
+
+	// refreshing serializes the requests for a new token while mu only guards the fields, so the
+	// current token can be read while a new one is requested
+	refreshing sync.Mutex
+	mu         sync.RWMutex
+}
+
+// Request requests a new bearer token from the given Jamf Pro domain using basic authentication
+func Request(ctx context.Context, client *http.Client, domain string, username string, password string) (*Token, error) {
+	// Create endpoint for token request
+	endpoint := fmt.Sprintf("%s/api/v1/auth/token", domain)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating bearer token request")
+	}
+	req.SetBasicAuth(username, password)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+	}
+	defer res.Body.Close()
+
+	// If status code is not ok attempt to read the response in plain text
+	if res.StatusCode != 200 && res.StatusCode != 201 {
+		responseData, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "request error: %s. unable to retrieve plain text response: %s", res.Status, err.Error())
+		}
+		return nil, fmt.Errorf("request error: %s", string(responseData))
+	}
+
+	token := &Token{}
+	if err = json.NewDecoder(res.Body).Decode(token); err != nil {
+		return nil, errors.Wrapf(err, "response was successful but error occured decoding JSON token response")
+	}
+
+	return token, nil
+}
+
+// Refresh ensures token holds a bearer token which is valid for longer than RefreshWindow, requesting
+// a new one when it is missing or about to expire. The token is updated in place so it can be shared,
+// including by concurrent requests as long as it is read with Bearer.
+func Refresh(ctx context.Context, client *http.Client, domain string, username string, password string, token *Token) error {
+	if valid, err := token.valid(); valid || err != nil {
+		return err
+	}
+
+	token.refreshing.Lock()
+	defer token.refreshing.Unlock()
+	// another request may have refreshed the token while this one waited
+	if valid, err := token.valid(); valid || err != nil {
+		return err
+	}
+
+	fresh, err := Request(ctx, client, domain, username, password)
+	if err != nil {
+		return errors.Wrapf(err, "error requesting new bearer token")
+	}
+	token.mu.Lock()
+	token.Token, token.Expires = fresh.Token, fresh.Expires
+	token.mu.Unlock()
+
+	return nil
+}
+
+// valid reports whether token holds a bearer token which is valid for longer than RefreshWindow
+func (token *Token) valid() (bool, error) {
+	token.mu.RLock()
+	expires := token.Expires
+	token.mu.RUnlock()
+
+	// Check for the existance of a bearer token and, if we already have a token,
+	// check the expiration timestamp
+	if expires == "" {
+		return false, nil
+	}
+	tokenExpires, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return false, errors.Wrapf(err, "error parsing the bearer token expiration date: %s", expires)
+	}
+	return time.Until(tokenExpires) > RefreshWindow, nil
+}
+
+// Bearer returns the Authorization header value for token, safe to call while it is refreshed
+func Bearer(token *Token) string {
+	token.mu.RLock()
+	defer token.mu.RUnlock()
+	return fmt.Sprintf("Bearer %s", token.Token)
+}
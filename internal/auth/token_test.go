@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/internal/auth"
+	"github.com/stretchr/testify/assert"
+)
+
+func tokenResponseMocks(t *testing.T, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.URL.Path != "/api/v1/auth/token" || !ok || username != "fake-username" || password != "mock-password-cool" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		*requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"token": "token-%d", "expires": "%s"}`, *requests, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+}
+
+func TestRequest(t *testing.T) {
+	var requests int
+	testServer := tokenResponseMocks(t, &requests)
+	defer testServer.Close()
+
+	token, err := auth.Request(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool")
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", token.Token)
+	assert.NotEmpty(t, token.Expires)
+
+	_, err = auth.Request(context.Background(), testServer.Client(), testServer.URL, "fake-username", "wrong")
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unauthorized")
+}
+
+func TestRefresh(t *testing.T) {
+	var requests int
+	testServer := tokenResponseMocks(t, &requests)
+	defer testServer.Close()
+
+	// An empty token is always requested
+	token := &auth.Token{}
+	err := auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+	assert.Nil(t, err)
+	assert.Equal(t, "token-1", token.Token)
+
+	// A valid token is reused
+	err = auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, requests)
+
+	// A token inside the refresh window is replaced
+	token.Expires = time.Now().Add(time.Minute).Format(time.RFC3339)
+	err = auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+	assert.Nil(t, err)
+	assert.Equal(t, "token-2", token.Token)
+
+	token.Expires = "not-a-date"
+	err = auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "error parsing the bearer token expiration date")
+}
+
+func TestRefreshConcurrently(t *testing.T) {
+	var requests int
+	testServer := tokenResponseMocks(t, &requests)
+	defer testServer.Close()
+
+	// Concurrent requests sharing an empty token only request a single one
+	token := &auth.Token{}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+			assert.Nil(t, err)
+			assert.Equal(t, "Bearer token-1", auth.Bearer(token))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, requests)
+}
+
+func TestRefreshDoesNotBlockOtherTokens(t *testing.T) {
+	release := make(chan struct{})
+	slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprintf(w, `{"token": "slow", "expires": "%s"}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer slowServer.Close()
+	var requests int
+	testServer := tokenResponseMocks(t, &requests)
+	defer testServer.Close()
+
+	// A token being refreshed from an unresponsive server can still be read
+	slow := &auth.Token{Token: "current", Expires: time.Now().Add(time.Minute).UTC().Format(time.RFC3339)}
+	refreshed := make(chan error)
+	go func() {
+		refreshed <- auth.Refresh(context.Background(), slowServer.Client(), slowServer.URL, "fake-username", "mock-password-cool", slow)
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.Equal(t, "Bearer current", auth.Bearer(slow))
+		// the tokens of other clients are refreshed meanwhile
+		token := &auth.Token{}
+		err := auth.Refresh(context.Background(), testServer.Client(), testServer.URL, "fake-username", "mock-password-cool", token)
+		assert.Nil(t, err)
+		assert.Equal(t, "Bearer token-1", auth.Bearer(token))
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("reading and refreshing tokens waited for the refresh of another token")
+	}
+
+	close(release)
+	assert.Nil(t, <-refreshed)
+	assert.Equal(t, "Bearer slow", auth.Bearer(slow))
+}
@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package checkpoint holds the checkpoint stores shared by the delta and sync packages, which persist
+// the progress of a synchronization under a key, e.g. the time it last ran or the last device written.
+package checkpoint
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Store persists checkpoints, e.g. in a file, a database or a key-value store
+type Store[T any] interface {
+	// Load returns the checkpoint stored under key, or the zero value when there is none
+	Load(ctx context.Context, key string) (T, error)
+	// Save stores the checkpoint under key
+	Save(ctx context.Context, key string, checkpoint T) error
+}
+
+// MemoryStore keeps checkpoints in memory, for the lifetime of a long running process or tests
+type MemoryStore[T any] struct {
+	mu          sync.Mutex
+	checkpoints map[string]T
+}
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore[T any]() *MemoryStore[T] {
+	return &MemoryStore[T]{checkpoints: map[string]T{}}
+}
+
+// Load returns the checkpoint stored under key
+func (s *MemoryStore[T]) Load(ctx context.Context, key string) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkpoints[key], nil
+}
+
+// Save stores the checkpoint under key
+func (s *MemoryStore[T]) Save(ctx context.Context, key string, checkpoint T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints[key] = checkpoint
+	return nil
+}
+
+// FileStore keeps checkpoints in a JSON file mapping keys to their JSON encoding
+type FileStore[T any] struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// NewFileStore returns a FileStore persisting checkpoints to path, which is created on the first save
+func NewFileStore[T any](path string) *FileStore[T] {
+	return &FileStore[T]{Path: path}
+}
+
+// Load returns the checkpoint stored under key, the zero value when the file does not exist yet
+func (s *FileStore[T]) Load(ctx context.Context, key string) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return checkpoints[key], nil
+}
+
+// Save stores the checkpoint under key, replacing the file atomically
+func (s *FileStore[T]) Save(ctx context.Context, key string, checkpoint T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	checkpoints, err := s.read()
+	if err != nil {
+		return err
+	}
+	checkpoints[key] = checkpoint
+
+	data, err := json.MarshalIndent(checkpoints, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "unable to encode checkpoints")
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return errors.Wrapf(err, "unable to write checkpoints to %s", s.Path)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "unable to write checkpoints to %s", s.Path)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "unable to write checkpoints to %s", s.Path)
+	}
+	return errors.Wrapf(os.Rename(tmp.Name(), s.Path), "unable to write checkpoints to %s", s.Path)
+}
+
+func (s *FileStore[T]) read() (map[string]T, error) {
+	checkpoints := map[string]T{}
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read checkpoints from %s", s.Path)
+	}
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, errors.Wrapf(err, "unable to decode checkpoints from %s", s.Path)
+	}
+	return checkpoints, nil
+}
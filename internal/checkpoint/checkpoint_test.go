@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package checkpoint_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/internal/checkpoint"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	store := checkpoint.NewMemoryStore[string]()
+
+	id, err := store.Load(ctx, "inventory/computer")
+	assert.Nil(t, err)
+	assert.Equal(t, "", id)
+
+	assert.Nil(t, store.Save(ctx, "inventory/computer", "42"))
+	id, err = store.Load(ctx, "inventory/computer")
+	assert.Nil(t, err)
+	assert.Equal(t, "42", id)
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store := checkpoint.NewFileStore[time.Time](path)
+
+	last, err := store.Load(ctx, "computers")
+	assert.Nil(t, err)
+	assert.True(t, last.IsZero())
+
+	now := time.Date(2024, 10, 1, 9, 30, 0, 0, time.UTC)
+	assert.Nil(t, store.Save(ctx, "computers", now))
+	assert.Nil(t, store.Save(ctx, "lab", now.Add(time.Hour)))
+
+	last, err = checkpoint.NewFileStore[time.Time](path).Load(ctx, "computers")
+	assert.Nil(t, err)
+	assert.True(t, now.Equal(last))
+	data, _ := os.ReadFile(path)
+	assert.Contains(t, string(data), `"lab": "2024-10-01T10:30:00Z"`)
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	assert.Len(t, entries, 1)
+
+	assert.Nil(t, os.WriteFile(path, []byte("not json"), 0o600))
+	_, err = store.Load(ctx, "computers")
+	assert.Contains(t, err.Error(), "unable to decode checkpoints from")
+	assert.Contains(t, store.Save(ctx, "computers", now).Error(), "unable to decode checkpoints from")
+}
@@ -0,0 +1,179 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package fake generates realistic randomized Jamf objects, such as computers, mobile devices, policies
+// and groups, for load tests and property-based tests of code using this module. Values look like the
+// ones of a real fleet: serial numbers, UDIDs and MAC addresses have the right format and operating
+// system versions match their build numbers. A Faker created with a given seed always generates the
+// same objects.
+//
+//	f := fake.New(42)
+//	computers := fake.List(1000, f.ComputerInventory)
+package fake
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+)
+
+// serialAlphabet holds the characters used in Apple serial numbers, which exclude letters that could
+// be mistaken for digits
+const serialAlphabet = "0123456789CDFGHJKLMNPQRTVWXY"
+
+// Faker generates random objects, it is not safe for concurrent use
+type Faker struct {
+	// Now is the time dates are generated relative to, e.g. last contact times are within the week
+	// before Now
+	Now time.Time
+
+	rand   *rand.Rand
+	nextID int
+}
+
+// New returns a Faker whose random values are derived from seed
+func New(seed uint64) *Faker {
+	return &Faker{
+		Now:    time.Now().UTC().Truncate(time.Second),
+		rand:   rand.New(rand.NewPCG(seed, seed)),
+		nextID: 1,
+	}
+}
+
+// List calls generate n times and returns the generated objects
+func List[T any](n int, generate func() T) []T {
+	res := make([]T, n)
+	for i := range res {
+		res[i] = generate()
+	}
+	return res
+}
+
+// ID returns the next ID, IDs are sequential and shared by every kind of object generated
+func (f *Faker) ID() int {
+	id := f.nextID
+	f.nextID++
+	return id
+}
+
+func pick[T any](f *Faker, values []T) T {
+	return values[f.rand.IntN(len(values))]
+}
+
+func (f *Faker) chars(alphabet string, n int) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteByte(alphabet[f.rand.IntN(len(alphabet))])
+	}
+	return b.String()
+}
+
+// Serial returns a serial number in the randomized 10 character format used by Apple since 2021
+func (f *Faker) Serial() string {
+	return f.chars(serialAlphabet, 10)
+}
+
+// legacySerial returns a serial number in the 12 character format of older devices, starting with
+// the code of the manufacturing location
+func (f *Faker) legacySerial() string {
+	return pick(f, []string{"C02", "C07", "C1M", "FVF", "DMP", "F9F"}) + f.chars(serialAlphabet, 9)
+}
+
+// UDID returns the UDID of a computer, formatted as an upper case UUID
+func (f *Faker) UDID() string {
+	hex := f.chars("0123456789ABCDEF", 32)
+	return fmt.Sprintf("%s-%s-%s-%s-%s", hex[:8], hex[8:12], hex[12:16], hex[16:20], hex[20:])
+}
+
+// mobileUDID returns the UDID of a recent iOS device, made of its chip ID and ECID
+func (f *Faker) mobileUDID() string {
+	return pick(f, []string{"00008101", "00008110", "00008120", "00008030"}) + "-" + f.chars("0123456789ABCDEF", 16)
+}
+
+// UUID returns a random lower case UUID, such as a management ID
+func (f *Faker) UUID() string {
+	return strings.ToLower(f.UDID())
+}
+
+// MACAddress returns a MAC address using one of Apple's prefixes
+func (f *Faker) MACAddress() string {
+	prefix := pick(f, []string{"F0:18:98", "3C:22:FB", "A4:83:E7", "14:7D:DA", "BC:D0:74"})
+	hex := f.chars("0123456789ABCDEF", 6)
+	return fmt.Sprintf("%s:%s:%s:%s", prefix, hex[:2], hex[2:4], hex[4:])
+}
+
+// IPAddress returns a private IPv4 address
+func (f *Faker) IPAddress() string {
+	return fmt.Sprintf("10.%d.%d.%d", f.rand.IntN(256), f.rand.IntN(256), 1+f.rand.IntN(254))
+}
+
+// Person is a user of a device
+type Person struct {
+	Username string
+	RealName string
+	Email    string
+}
+
+var (
+	firstNames = []string{"Alex", "Camille", "Dana", "Jordan", "Kim", "Lee", "Morgan", "Noa", "Priya", "Sam", "Taylor", "Yuki"}
+	lastNames  = []string{"Baker", "Chen", "Diaz", "Dubois", "Garcia", "Ito", "Kowalski", "Martin", "Nguyen", "Okafor", "Patel", "Smith"}
+)
+
+// Person returns a random user, the username is made of their initial and last name
+func (f *Faker) Person() Person {
+	first, last := pick(f, firstNames), pick(f, lastNames)
+	username := strings.ToLower(first[:1] + last)
+	return Person{Username: username, RealName: first + " " + last, Email: username + "@example.com"}
+}
+
+// recent returns a time within the given duration before Now
+func (f *Faker) recent(within time.Duration) time.Time {
+	return f.Now.Add(-time.Duration(f.rand.Int64N(int64(within)))).Truncate(time.Second)
+}
+
+// osVersion is a release of macOS or iOS
+type osVersion struct {
+	Version string
+	Build   string
+}
+
+var (
+	macOSVersions = []osVersion{
+		{"13.6.9", "22G830"},
+		{"14.6.1", "23G93"},
+		{"14.7", "23H124"},
+		{"15.0.1", "24A348"},
+		{"15.1", "24B83"},
+	}
+	iOSVersions = []osVersion{
+		{"17.6.1", "21G93"},
+		{"17.7", "21H16"},
+		{"18.0.1", "22A3370"},
+		{"18.1", "22B83"},
+	}
+)
+
+// model is a hardware model along with its identifier
+type model struct {
+	Name         string
+	Identifier   string
+	AppleSilicon bool
+	Kind         string
+}
+
+var (
+	macModels = []model{
+		{"MacBook Pro (14-inch, 2023)", "Mac15,3", true, "mbp"},
+		{"MacBook Pro (16-inch, 2021)", "MacBookPro18,1", true, "mbp"},
+		{"MacBook Air (M2, 2022)", "Mac14,2", true, "mba"},
+		{"Mac mini (2023)", "Mac14,3", true, "mini"},
+		{"iMac (24-inch, 2023)", "Mac15,4", true, "imac"},
+		{"MacBook Pro (13-inch, 2020, Four Thunderbolt 3 ports)", "MacBookPro16,2", false, "mbp"},
+	}
+	mobileModels = []model{
+		{"iPhone 15", "iPhone15,4", true, "iphone"},
+		{"iPhone 14 Pro", "iPhone15,2", true, "iphone"},
+		{"iPad Air (5th generation)", "iPad13,16", true, "ipad"},
+		{"iPad (10th generation)", "iPad13,18", true, "ipad"},
+	}
+)
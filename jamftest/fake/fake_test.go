@@ -0,0 +1,41 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package fake_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/jamftest/fake"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValues(t *testing.T) {
+	f := fake.New(1)
+	for i := 0; i < 100; i++ {
+		assert.Regexp(t, `^[0-9CDFGHJKLMNPQRTVWXY]{10}$`, f.Serial())
+		assert.Regexp(t, `^[0-9A-F]{8}-[0-9A-F]{4}-[0-9A-F]{4}-[0-9A-F]{4}-[0-9A-F]{12}$`, f.UDID())
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, f.UUID())
+		assert.Regexp(t, `^([0-9A-F]{2}:){5}[0-9A-F]{2}$`, f.MACAddress())
+		assert.Regexp(t, `^10(\.\d{1,3}){3}$`, f.IPAddress())
+
+		person := f.Person()
+		assert.Regexp(t, `^[a-z]+$`, person.Username)
+		assert.Equal(t, person.Username+"@example.com", person.Email)
+	}
+
+	assert.Equal(t, 1, f.ID())
+	assert.Equal(t, 2, f.ID())
+}
+
+func TestDeterministic(t *testing.T) {
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	a, b := fake.New(42), fake.New(42)
+	a.Now, b.Now = now, now
+	assert.Equal(t, fake.List(10, a.ComputerInventory), fake.List(10, b.ComputerInventory))
+	assert.Equal(t, fake.List(10, a.Policy), fake.List(10, b.Policy))
+
+	c := fake.New(43)
+	c.Now = now
+	assert.NotEqual(t, fake.List(10, a.Computer), fake.List(10, c.Computer))
+}
@@ -0,0 +1,254 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/pro"
+)
+
+// device holds the identity shared by the classic and pro representations of a device
+type device struct {
+	id          int
+	name        string
+	serial      string
+	udid        string
+	mac         string
+	model       model
+	os          osVersion
+	user        Person
+	enrolled    time.Time
+	lastContact time.Time
+}
+
+func (f *Faker) mac() device {
+	m := pick(f, macModels)
+	d := device{id: f.ID(), model: m, os: pick(f, macOSVersions), udid: f.UDID(), mac: f.MACAddress(), user: f.Person()}
+	d.serial = f.Serial()
+	if !m.AppleSilicon {
+		d.serial = f.legacySerial()
+	}
+	d.name = fmt.Sprintf("%s-%s", d.user.Username, m.Kind)
+	d.lastContact = f.recent(7 * 24 * time.Hour)
+	d.enrolled = d.lastContact.Add(-time.Duration(1+f.rand.IntN(700)) * 24 * time.Hour)
+	return d
+}
+
+// Computer returns a computer as returned by the classic API
+func (f *Faker) Computer() *classic.Computer {
+	d := f.mac()
+	return &classic.Computer{Info: classic.ComputerDetails{
+		ID: d.id,
+		General: classic.GeneralInformation{
+			ID:           d.id,
+			Name:         d.name,
+			MACAddress:   d.mac,
+			SerialNumber: d.serial,
+			UDID:         d.udid,
+			JamfVersion:  "11.10.1-t1725374880",
+			Platform:     "Mac",
+			MDMCapable:   true,
+			ReportDate:   classic.NewJamfTime(d.lastContact.Truncate(time.Second), classic.JamfDateTimeLayout),
+		},
+		UserLocation: classic.LocationInformation{
+			Username:     d.user.Username,
+			RealName:     d.user.RealName,
+			EmailAddress: d.user.Email,
+			Department:   pick(f, departments),
+			Building:     pick(f, buildings),
+		},
+		Hardware: classic.HardwareInformation{
+			Make:             "Apple",
+			OSName:           "macOS",
+			OSVersion:        d.os.Version,
+			OSBuild:          d.os.Build,
+			SIPStatus:        "Enabled",
+			GatekeeperStatus: "App Store and identified developers",
+		},
+	}}
+}
+
+// ComputerInventory returns a computer as returned by the pro API with its general, hardware, operating
+// system and user and location sections
+func (f *Faker) ComputerInventory() *pro.ComputerInventory {
+	d := f.mac()
+	id := strconv.Itoa(d.id)
+	return &pro.ComputerInventory{
+		ID:   id,
+		UDID: d.udid,
+		General: &pro.ComputerInventoryGeneral{
+			Name:                                 d.name,
+			LastIPAddress:                        f.IPAddress(),
+			Platform:                             "Mac",
+			RemoteManagement:                     &pro.RemoteManagement{Managed: true, ManagementUsername: "jamfadmin"},
+			ReportDate:                           d.lastContact.Format(time.RFC3339),
+			LastContactTime:                      d.lastContact.Format(time.RFC3339),
+			LastEnrolledDate:                     d.enrolled.Format(time.RFC3339),
+			InitialEntryDate:                     d.enrolled.Format("2006-01-02"),
+			Site:                                 &pro.Site{ID: "-1", Name: "None"},
+			EnrolledViaAutomatedDeviceEnrollment: f.rand.IntN(4) > 0,
+			UserApprovedMDM:                      true,
+			ManagementID:                         f.UUID(),
+		},
+		Hardware: &pro.ComputerInventoryHardware{
+			Make:                  "Apple",
+			Model:                 d.model.Name,
+			ModelIdentifier:       d.model.Identifier,
+			SerialNumber:          d.serial,
+			ProcessorArchitecture: map[bool]string{true: "arm64", false: "x86_64"}[d.model.AppleSilicon],
+			MACAddress:            d.mac,
+			TotalRAMMegabytes:     pick(f, []int{8192, 16384, 32768}),
+			AppleSilicon:          d.model.AppleSilicon,
+		},
+		OperatingSystem: &pro.ComputerInventoryOperatingSystem{
+			Name:             "macOS",
+			Version:          d.os.Version,
+			Build:            d.os.Build,
+			FileVault2Status: pick(f, []string{"ALL_ENCRYPTED", "ALL_ENCRYPTED", "ALL_ENCRYPTED", "NOT_ENCRYPTED"}),
+		},
+		UserAndLocation: &pro.ComputerInventoryUserAndLocation{
+			Username: d.user.Username,
+			RealName: d.user.RealName,
+			Email:    d.user.Email,
+			Position: pick(f, positions),
+		},
+	}
+}
+
+// MobileDevice returns an iPhone or an iPad as listed by the pro API
+func (f *Faker) MobileDevice() *pro.MobileDevice {
+	m, user := pick(f, mobileModels), f.Person()
+	device := &pro.MobileDevice{
+		ID:              strconv.Itoa(f.ID()),
+		Name:            fmt.Sprintf("%s-%s", user.Username, m.Kind),
+		SerialNumber:    f.Serial(),
+		WifiMACAddress:  f.MACAddress(),
+		UDID:            f.mobileUDID(),
+		Model:           m.Name,
+		ModelIdentifier: m.Identifier,
+		Username:        user.Username,
+		Type:            "ios",
+		ManagementID:    f.UUID(),
+	}
+	if m.Kind == "iphone" {
+		device.PhoneNumber = fmt.Sprintf("+1 555 %03d %04d", f.rand.IntN(1000), f.rand.IntN(10000))
+	}
+	return device
+}
+
+var (
+	departments = []string{"Engineering", "Finance", "Legal", "Marketing", "Sales", "Support"}
+	buildings   = []string{"HQ", "Annex", "Paris", "Tokyo"}
+	positions   = []string{"Engineer", "Designer", "Account Executive", "Analyst", "Manager"}
+	categories  = []string{"Applications", "Browsers", "Maintenance", "Security", "Utilities"}
+	apps        = []string{"Google Chrome", "Firefox", "Slack", "Zoom", "Microsoft Office", "1Password", "Visual Studio Code"}
+)
+
+// Policy returns a valid classic policy installing or updating an application, triggered at check-in
+// or made available in Self Service
+func (f *Faker) Policy() *classic.Policy {
+	id := f.ID()
+	general := &classic.PolicyGeneral{
+		ID:        id,
+		Name:      fmt.Sprintf("%s %s %d", pick(f, []string{"Install", "Update", "Configure"}), pick(f, apps), id),
+		Enabled:   f.rand.IntN(10) > 0,
+		Frequency: pick(f, []classic.PolicyFrequency{classic.FrequencyOncePerComputer, classic.FrequencyOnceEveryDay, classic.FrequencyOngoing}),
+		Category:  &classic.PolicyCategory{Name: pick(f, categories)},
+	}
+	if f.rand.IntN(2) == 0 {
+		general.Trigger, general.TriggerCheckIn = classic.TriggerEvent, true
+	} else {
+		general.Trigger, general.Frequency = classic.TriggerUserInitiated, classic.FrequencyOngoing
+	}
+	if general.Frequency == classic.FrequencyOncePerComputer && f.rand.IntN(2) == 0 {
+		general.RetryEvent, general.RetryAttempts = classic.RetryEventCheckin, 1+f.rand.IntN(3)
+	}
+
+	policy := &classic.PolicyContents{General: general, Scope: &classic.Scope{AllComputers: true}}
+	if f.rand.IntN(2) == 0 {
+		policy.Scope = &classic.Scope{ComputerGroups: []*classic.ComputerGroup{{ID: f.ID(), Name: pick(f, departments) + " Macs", IsSmart: true}}}
+	}
+	for i := f.rand.IntN(3); i > 0; i-- {
+		policy.Scripts = append(policy.Scripts, &classic.PolicyScriptAssignment{
+			ID:       f.ID(),
+			Name:     pick(f, []string{"Clean Up.sh", "Set Dock.sh", "Rename Computer.sh", "Inventory.sh"}),
+			Priority: pick(f, []string{"Before", "After"}),
+		})
+	}
+	return &classic.Policy{Content: policy}
+}
+
+// criterion is a smart group criterion along with how to generate its value
+type criterion struct {
+	name       string
+	searchType string
+	value      func(f *Faker) string
+}
+
+var (
+	computerCriteria = []criterion{
+		{"Operating System Version", "greater than or equal", func(f *Faker) string { return pick(f, macOSVersions).Version }},
+		{"Model", "like", func(f *Faker) string { return pick(f, []string{"MacBook Pro", "MacBook Air", "Mac mini", "iMac"}) }},
+		{"Last Check-in", "less than x days ago", func(f *Faker) string { return strconv.Itoa(1 + f.rand.IntN(30)) }},
+		{"Department", "is", func(f *Faker) string { return pick(f, departments) }},
+		{"FileVault 2 Status", "is not", func(*Faker) string { return "Encrypted" }},
+	}
+	mobileDeviceCriteria = []criterion{
+		{"iOS Version", "greater than or equal", func(f *Faker) string { return pick(f, iOSVersions).Version }},
+		{"Model", "like", func(f *Faker) string { return pick(f, []string{"iPhone", "iPad"}) }},
+		{"Last Inventory Update", "less than x days ago", func(f *Faker) string { return strconv.Itoa(1 + f.rand.IntN(30)) }},
+		{"Supervised", "is", func(*Faker) string { return "Supervised" }},
+	}
+)
+
+// criteria returns between one and three criteria joined by and or or, the fields are those of
+// SmartComputerGroupCriterion which SmartMobileDeviceGroupCriterion shares
+func (f *Faker) criteria(from []criterion) []pro.SmartComputerGroupCriterion {
+	res := make([]pro.SmartComputerGroupCriterion, 1+f.rand.IntN(3))
+	for i := range res {
+		c := pick(f, from)
+		res[i] = pro.SmartComputerGroupCriterion{Name: c.name, Priority: i, AndOr: "and", SearchType: c.searchType, Value: c.value(f)}
+		if i > 0 && f.rand.IntN(3) == 0 {
+			res[i].AndOr = "or"
+		}
+	}
+	return res
+}
+
+// SmartComputerGroup returns a valid smart computer group
+func (f *Faker) SmartComputerGroup() *pro.SmartComputerGroup {
+	id := f.ID()
+	return &pro.SmartComputerGroup{
+		ID:       strconv.Itoa(id),
+		Name:     fmt.Sprintf("%s Macs %d", pick(f, departments), id),
+		Criteria: f.criteria(computerCriteria),
+	}
+}
+
+// StaticComputerGroup returns a static computer group with the given members
+func (f *Faker) StaticComputerGroup(computerIDs ...string) *pro.StaticComputerGroup {
+	id := f.ID()
+	return &pro.StaticComputerGroup{
+		ID:          strconv.Itoa(id),
+		Name:        fmt.Sprintf("Pilot %d", id),
+		Count:       len(computerIDs),
+		Assignments: computerIDs,
+	}
+}
+
+// SmartMobileDeviceGroup returns a valid smart mobile device group
+func (f *Faker) SmartMobileDeviceGroup() *pro.SmartMobileDeviceGroup {
+	id := f.ID()
+	group := &pro.SmartMobileDeviceGroup{
+		ID:   strconv.Itoa(id),
+		Name: fmt.Sprintf("%s Devices %d", pick(f, departments), id),
+	}
+	for _, c := range f.criteria(mobileDeviceCriteria) {
+		group.Criteria = append(group.Criteria, pro.SmartMobileDeviceGroupCriterion(c))
+	}
+	return group
+}
@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package fake_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/jamftest/fake"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputers(t *testing.T) {
+	f := fake.New(1)
+	for _, computer := range fake.List(100, f.ComputerInventory) {
+		assert.NotEmpty(t, computer.ID)
+		assert.True(t, strings.HasPrefix(computer.General.Name, computer.UserAndLocation.Username+"-"))
+		assert.Regexp(t, `^[0-9CDFGHJKLMNPQRTVWXY]{10,12}$`, computer.Hardware.SerialNumber)
+		assert.Equal(t, computer.Hardware.AppleSilicon, len(computer.Hardware.SerialNumber) == 10)
+		assert.Regexp(t, `^1[3-5]\.\d+(\.\d+)?$`, computer.OperatingSystem.Version)
+		assert.Regexp(t, `^2[2-4][A-Z]\d+$`, computer.OperatingSystem.Build)
+
+		contact, err := time.Parse(time.RFC3339, computer.General.LastContactTime)
+		assert.Nil(t, err)
+		assert.WithinDuration(t, f.Now, contact, 7*24*time.Hour)
+		enrolled, err := time.Parse(time.RFC3339, computer.General.LastEnrolledDate)
+		assert.Nil(t, err)
+		assert.True(t, enrolled.Before(contact))
+	}
+
+	computer := f.Computer()
+	assert.Equal(t, computer.Info.ID, computer.Info.General.ID)
+	assert.WithinDuration(t, f.Now, computer.Info.General.ReportDate.Time, 7*24*time.Hour)
+	data, err := xml.Marshal(&computer.Info)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), "<report_date>"+computer.Info.General.ReportDate.Format("2006-01-02 15:04:05")+"</report_date>")
+}
+
+func TestMobileDevices(t *testing.T) {
+	f := fake.New(1)
+	for _, device := range fake.List(100, f.MobileDevice) {
+		assert.Regexp(t, `^[0-9A-F]{8}-[0-9A-F]{16}$`, device.UDID)
+		assert.Regexp(t, `^(iPhone|iPad)\d+,\d+$`, device.ModelIdentifier)
+		assert.Equal(t, strings.HasPrefix(device.Model, "iPhone"), device.PhoneNumber != "")
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	f := fake.New(1)
+	for _, policy := range fake.List(100, f.Policy) {
+		assert.Nil(t, classic.ValidatePolicy(policy.Content))
+		_, err := xml.Marshal(policy.Content)
+		assert.Nil(t, err)
+		_, err = json.Marshal(policy)
+		assert.Nil(t, err)
+	}
+}
+
+func TestGroups(t *testing.T) {
+	f := fake.New(1)
+	for _, group := range fake.List(100, f.SmartComputerGroup) {
+		assert.Nil(t, pro.ValidateSmartComputerGroup(group))
+		assert.Equal(t, "and", group.Criteria[0].AndOr)
+	}
+	for _, group := range fake.List(100, f.SmartMobileDeviceGroup) {
+		assert.NotEmpty(t, group.Criteria)
+		assert.NotEmpty(t, group.Name)
+	}
+
+	group := f.StaticComputerGroup("1", "2")
+	assert.Equal(t, []string{"1", "2"}, group.Assignments)
+	assert.Equal(t, 2, group.Count)
+}
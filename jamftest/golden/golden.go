@@ -0,0 +1,350 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package golden validates code against recorded Jamf payloads, such as the fixtures written by
+// mockjamf -record, so that consumers can check their own types and handlers the way this module
+// checks its own. Fixtures are JSON or XML depending on their extension and are compared by value:
+// formatting, field order and whitespace are ignored.
+//
+//	func TestPolicyExtension(t *testing.T) {
+//		golden.AssertRoundTrip[MyPolicy](t, "testdata/policy.xml")
+//	}
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/diff"
+)
+
+// UpdateEnv is the environment variable which, when set to a non-empty value, makes Assert and
+// AssertResponse write the values they receive to the fixtures instead of comparing them
+const UpdateEnv = "JAMFTEST_UPDATE"
+
+// Option configures a comparison
+type Option func(*comparison)
+
+// Ignore ignores the fields at the given dotted paths, e.g. "general.report_date", in every element of
+// the lists along the path
+func Ignore(paths ...string) Option {
+	return func(c *comparison) {
+		c.ignored = append(c.ignored, paths...)
+	}
+}
+
+type comparison struct {
+	ignored []string
+}
+
+// Load returns the content of the fixture at path, failing the test when it cannot be read
+func Load(t testing.TB, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unable to load fixture: %v", err)
+	}
+	return data
+}
+
+// Decode decodes the fixture at path into a new T, failing the test when it cannot be decoded
+func Decode[T any](t testing.TB, path string) T {
+	t.Helper()
+	var v T
+	if err := unmarshal(path, Load(t, path), &v); err != nil {
+		t.Fatalf("unable to decode fixture %s into %T: %v", path, v, err)
+	}
+	return v
+}
+
+// Assert asserts that got holds the same value as the fixture at path, reporting every field which
+// differs. It writes got to the fixture instead when UpdateEnv is set.
+func Assert(t testing.TB, path string, got []byte, opts ...Option) bool {
+	t.Helper()
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("unable to update fixture: %v", err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatalf("unable to update fixture: %v", err)
+		}
+		return true
+	}
+
+	expected, err := parse(path, Load(t, path))
+	if err != nil {
+		t.Fatalf("unable to parse fixture %s: %v", path, err)
+	}
+	actual, err := parse(path, got)
+	if err != nil {
+		t.Errorf("unable to parse value compared to fixture %s: %v", path, err)
+		return false
+	}
+	return report(t, path, Compare(expected, actual, opts...))
+}
+
+// AssertResponse asserts that the body of res holds the same value as the fixture at path, see Assert.
+// The body is read and closed.
+func AssertResponse(t testing.TB, path string, res *http.Response, opts ...Option) bool {
+	t.Helper()
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Errorf("unable to read response compared to fixture %s: %v", path, err)
+		return false
+	}
+	return Assert(t, path, body, opts...)
+}
+
+// AssertRoundTrip decodes the fixture at path into a T and encodes it back, asserting that no field
+// of the fixture was lost or changed on the way. Fields of the payload that T does not model are
+// reported as missing, use Ignore for the ones which are deliberately left out.
+func AssertRoundTrip[T any](t testing.TB, path string, opts ...Option) bool {
+	t.Helper()
+	data := Load(t, path)
+	v := Decode[T](t, path)
+	encoded, err := marshal(path, v)
+	if err != nil {
+		t.Errorf("unable to encode %T decoded from fixture %s: %v", v, path, err)
+		return false
+	}
+
+	expected, err := parse(path, data)
+	if err != nil {
+		t.Fatalf("unable to parse fixture %s: %v", path, err)
+	}
+	actual, err := parse(path, encoded)
+	if err != nil {
+		t.Errorf("unable to parse %T encoded from fixture %s: %v", v, path, err)
+		return false
+	}
+	return report(t, path, Compare(expected, actual, opts...))
+}
+
+func report(t testing.TB, path string, changes []diff.Change) bool {
+	t.Helper()
+	if len(changes) == 0 {
+		return true
+	}
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = "\t" + change.String()
+	}
+	t.Errorf("value differs from fixture %s (fixture => value):\n%s", path, strings.Join(lines, "\n"))
+	return false
+}
+
+func isXML(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".xml")
+}
+
+func unmarshal(path string, data []byte, v interface{}) error {
+	if isXML(path) {
+		return xml.Unmarshal(data, v)
+	}
+	return json.Unmarshal(data, v)
+}
+
+func marshal(path string, v interface{}) ([]byte, error) {
+	if isXML(path) {
+		return xml.Marshal(v)
+	}
+	return json.Marshal(v)
+}
+
+// parse decodes a payload into generic values: maps, lists, strings, numbers and booleans. An XML
+// element holding other elements becomes a map of their names, repeated ones being gathered in a
+// list, and any other element becomes its text. Attributes are ignored.
+func parse(path string, data []byte) (interface{}, error) {
+	if !isXML(path) {
+		var v interface{}
+		err := json.Unmarshal(data, &v)
+		return v, err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	type element struct {
+		name     string
+		text     strings.Builder
+		children map[string]interface{}
+	}
+	var stack []*element
+	var root interface{}
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, &element{name: t.Name.Local})
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			var value interface{} = strings.TrimSpace(current.text.String())
+			if current.children != nil {
+				value = current.children
+			}
+			if len(stack) == 0 {
+				root = map[string]interface{}{current.name: value}
+				continue
+			}
+			parent := stack[len(stack)-1]
+			if parent.children == nil {
+				parent.children = map[string]interface{}{}
+			}
+			switch existing := parent.children[current.name].(type) {
+			case nil:
+				parent.children[current.name] = value
+			case []interface{}:
+				parent.children[current.name] = append(existing, value)
+			default:
+				parent.children[current.name] = []interface{}{existing, value}
+			}
+		}
+	}
+	if root == nil {
+		return nil, fmt.Errorf("no root element")
+	}
+	return root, nil
+}
+
+// Compare compares two generic values, such as decoded JSON, and returns the fields which differ
+// ordered by path. Missing fields, null values and zero values, such as empty strings, lists and
+// maps, are considered equal so that omitted fields do not show up as changes.
+func Compare(a interface{}, b interface{}, opts ...Option) []diff.Change {
+	c := &comparison{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	for _, path := range c.ignored {
+		a, b = remove(a, strings.Split(path, ".")), remove(b, strings.Split(path, "."))
+	}
+	var changes []diff.Change
+	compare("", a, b, &changes)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes
+}
+
+func compare(path string, a interface{}, b interface{}, changes *[]diff.Change) {
+	if isEmpty(a) && isEmpty(b) {
+		return
+	}
+	// A missing map or list is compared as an empty one so each of the fields of the other is reported
+	if a == nil {
+		a = emptyLike(b)
+	}
+	if b == nil {
+		b = emptyLike(a)
+	}
+	am, aIsMap := a.(map[string]interface{})
+	bm, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		keys := map[string]bool{}
+		for key := range am {
+			keys[key] = true
+		}
+		for key := range bm {
+			keys[key] = true
+		}
+		for key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			compare(fieldPath, am[key], bm[key], changes)
+		}
+		return
+	}
+	al, aIsList := a.([]interface{})
+	bl, bIsList := b.([]interface{})
+	if aIsList && bIsList {
+		for i := 0; i < len(al) || i < len(bl); i++ {
+			var av, bv interface{}
+			if i < len(al) {
+				av = al[i]
+			}
+			if i < len(bl) {
+				bv = bl[i]
+			}
+			compare(fmt.Sprintf("%s[%d]", path, i), av, bv, changes)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) {
+		*changes = append(*changes, diff.Change{Path: path, A: a, B: b})
+	}
+}
+
+func emptyLike(v interface{}) interface{} {
+	switch v.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}{}
+	case []interface{}:
+		return []interface{}{}
+	}
+	return nil
+}
+
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case float64:
+		return value == 0
+	case bool:
+		return !value
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		for _, field := range value {
+			if !isEmpty(field) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// remove returns v without the field at path, applied to every element of the lists along the path
+func remove(v interface{}, path []string) interface{} {
+	switch value := v.(type) {
+	case []interface{}:
+		res := make([]interface{}, len(value))
+		for i, item := range value {
+			res[i] = remove(item, path)
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(value))
+		for key, field := range value {
+			res[key] = field
+		}
+		if len(path) == 1 {
+			delete(res, path[0])
+		} else if field, ok := res[path[0]]; ok {
+			res[path[0]] = remove(field, path[1:])
+		}
+		return res
+	}
+	return v
+}
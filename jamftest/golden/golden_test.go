@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package golden_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/classic"
+	"github.com/DataDog/jamf-api-client-go/jamftest/golden"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeT records the failures of a test
+type fakeT struct {
+	testing.TB
+	errors []string
+	fatal  bool
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.Errorf(format, args...)
+	f.fatal = true
+	runtime.Goexit()
+}
+
+// check runs test with a fakeT, in its own goroutine so Fatalf can end it
+func check(t *testing.T, test func(tb testing.TB)) *fakeT {
+	f := &fakeT{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		test(f)
+	}()
+	<-done
+	return f
+}
+
+func TestDecode(t *testing.T) {
+	building := golden.Decode[pro.Building](t, "testdata/building.json")
+	assert.Equal(t, "New York", building.City)
+
+	script := golden.Decode[classic.ScriptContents](t, "testdata/script.xml")
+	assert.Equal(t, "#!/bin/sh\nrm -rf /tmp/cache\n", script.Contents)
+
+	f := check(t, func(tb testing.TB) { golden.Decode[pro.Building](tb, "testdata/missing.json") })
+	assert.True(t, f.fatal)
+	f = check(t, func(tb testing.TB) { golden.Decode[[]pro.Building](tb, "testdata/building.json") })
+	assert.True(t, f.fatal)
+}
+
+func TestAssertRoundTrip(t *testing.T) {
+	assert.True(t, golden.AssertRoundTrip[pro.Building](t, "testdata/building.json"))
+	assert.True(t, golden.AssertRoundTrip[classic.ScriptContents](t, "testdata/script.xml"))
+
+	f := check(t, func(tb testing.TB) { golden.AssertRoundTrip[pro.Building](tb, "testdata/building_extended.json") })
+	assert.Len(t, f.errors, 1)
+	assert.Contains(t, f.errors[0], "contact.email: \"facilities@example.com\" => <missing>")
+	assert.Contains(t, f.errors[0], "floors: 12 => <missing>")
+
+	f = check(t, func(tb testing.TB) {
+		golden.AssertRoundTrip[pro.Building](tb, "testdata/building_extended.json", golden.Ignore("floors", "contact"))
+	})
+	assert.Empty(t, f.errors)
+}
+
+func TestAssert(t *testing.T) {
+	assert.True(t, golden.Assert(t, "testdata/building.json", []byte(`{"country": "United States", "zipPostalCode": "10018",
+		"stateProvince": "NY", "city": "New York", "streetAddress1": "620 8th Ave", "name": "HQ", "id": "1", "streetAddress2": ""}`)))
+
+	f := check(t, func(tb testing.TB) {
+		golden.Assert(tb, "testdata/script.xml", []byte(`<script><id>3</id><name>Clean Up</name><category>Maintenance</category>
+			<filename>cleanup.sh</filename><priority>Before</priority><script_contents>#!/bin/sh
+rm -rf /tmp/cache
+</script_contents></script>`))
+	})
+	assert.Equal(t, []string{"value differs from fixture testdata/script.xml (fixture => value):\n\tscript.priority: \"After\" => \"Before\""}, f.errors)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, "testdata/building.json")
+	}))
+	defer server.Close()
+	res, err := http.Get(server.URL)
+	assert.Nil(t, err)
+	assert.True(t, golden.AssertResponse(t, "testdata/building.json", res, golden.Ignore("id")))
+}
+
+func TestAssertUpdate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "new", "building.json")
+	t.Setenv(golden.UpdateEnv, "1")
+	assert.True(t, golden.Assert(t, path, []byte(`{"name": "Annex"}`)))
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"name": "Annex"}`, string(data))
+}
+
+func TestCompare(t *testing.T) {
+	changes := golden.Compare(
+		map[string]interface{}{"list": []interface{}{"a", "b"}, "empty": "", "zero": 0.0, "nested": map[string]interface{}{"x": true}},
+		map[string]interface{}{"list": []interface{}{"a"}, "nested": map[string]interface{}{"x": false, "y": nil}},
+	)
+	assert.Equal(t, 2, len(changes))
+	assert.Equal(t, "list[1]: \"b\" => <missing>", changes[0].String())
+	assert.Equal(t, "nested.x: true => false", changes[1].String())
+
+	assert.Empty(t, golden.Compare(
+		[]interface{}{map[string]interface{}{"id": "1", "name": "a"}, map[string]interface{}{"id": "2", "name": "a"}},
+		[]interface{}{map[string]interface{}{"name": "a"}, map[string]interface{}{"name": "a"}},
+		golden.Ignore("id"),
+	))
+}
@@ -0,0 +1,94 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const apiIntegrationsContext = "api-integrations"
+
+// APIIntegrations returns a single page of API integrations matching opts
+func (j *Client) APIIntegrations(ctx context.Context, opts *ListOptions) (*Results[APIIntegration], error) {
+	ep := j.endpoint(1, apiIntegrationsContext)
+	res, err := listPage[APIIntegration](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query API integrations from %s", ep)
+	}
+	return res, nil
+}
+
+// AllAPIIntegrations returns every API integration matching opts, requesting each page in turn
+func (j *Client) AllAPIIntegrations(ctx context.Context, opts *ListOptions) ([]APIIntegration, error) {
+	ep := j.endpoint(1, apiIntegrationsContext)
+	res, err := listAll[APIIntegration](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all API integrations from %s", ep)
+	}
+	return res, nil
+}
+
+// APIIntegrationDetails returns the details for a specific API integration given its ID
+func (j *Client) APIIntegrationDetails(ctx context.Context, id string) (*APIIntegration, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiIntegrationsContext, url.PathEscape(id)))
+	res := &APIIntegration{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query API integration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateAPIIntegration will create a new API integration in Jamf
+func (j *Client) CreateAPIIntegration(ctx context.Context, content *APIIntegration) (*CreatedResource, error) {
+	ep := j.endpoint(1, apiIntegrationsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for API integration: (%s)", ep)
+	}
+	if content.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new API integration"), "unable to process JAMF creation request for API integration: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for API integration %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateAPIIntegration will replace an API integration in Jamf given its ID
+func (j *Client) UpdateAPIIntegration(ctx context.Context, id string, content *APIIntegration) (*APIIntegration, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiIntegrationsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for API integration: %s (%s)", id, ep)
+	}
+
+	res := &APIIntegration{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for API integration: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteAPIIntegration will delete an API integration given its ID
+func (j *Client) DeleteAPIIntegration(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiIntegrationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for API integration %s from %s", id, ep)
+	}
+	return nil
+}
+
+// RotateAPIIntegrationClientCredentials generates a new client secret for an API integration given its ID,
+// the previous secret stops working immediately and the new secret cannot be retrieved again
+func (j *Client) RotateAPIIntegrationClientCredentials(ctx context.Context, id string) (*APIClientCredentials, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/client-credentials", apiIntegrationsContext, url.PathEscape(id)))
+	res := &APIClientCredentials{}
+	if err := j.do(ctx, "POST", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to rotate client credentials for API integration with ID %s on %s", id, ep)
+	}
+	return res, nil
+}
@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// APIIntegration represents an API client which authenticates with client credentials and is
+// granted the privileges of its authorization scopes (API role display names)
+type APIIntegration struct {
+	ID                         string   `json:"id,omitempty"`
+	DisplayName                string   `json:"displayName"`
+	AuthorizationScopes        []string `json:"authorizationScopes"`
+	Enabled                    bool     `json:"enabled"`
+	AccessTokenLifetimeSeconds int      `json:"accessTokenLifetimeSeconds,omitempty"`
+	AppType                    string   `json:"appType,omitempty"`
+	ClientID                   string   `json:"clientId,omitempty"`
+}
+
+// APIClientCredentials holds the client ID and secret generated for an API integration
+type APIClientCredentials struct {
+	ClientID     string `json:"clientId"`
+	ClientSecret string `json:"clientSecret"`
+}
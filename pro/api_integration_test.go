@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var API_INTEGRATIONS_API_BASE_ENDPOINT = "/api/v1/api-integrations"
+
+func apiIntegrationsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	integrations := &crudMock[pro.APIIntegration]{
+		t:      t,
+		base:   API_INTEGRATIONS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.APIIntegration{
+			{ID: "1", DisplayName: "Inventory Sync", AuthorizationScopes: []string{"Inventory Read"}, Enabled: true, AccessTokenLifetimeSeconds: 300, ClientID: "client-1"},
+			{ID: "2", DisplayName: "Script Deployer", AuthorizationScopes: []string{"Script Admin"}, Enabled: false, ClientID: "client-2"},
+		},
+		getID: func(i pro.APIIntegration) string { return i.ID },
+		setID: func(i *pro.APIIntegration, id string) { i.ID = id },
+	}
+	mux.Handle(API_INTEGRATIONS_API_BASE_ENDPOINT, integrations)
+	mux.Handle(API_INTEGRATIONS_API_BASE_ENDPOINT+"/", integrations)
+	mux.HandleFunc(fmt.Sprintf("%s/1/client-credentials", API_INTEGRATIONS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"clientId": "client-1", "clientSecret": "rotated-secret"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestQueryAllAPIIntegrations(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.APIIntegrations(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, "Inventory Sync", page.Results[0].DisplayName)
+
+	integrations, err := j.AllAPIIntegrations(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(integrations))
+}
+
+func TestQuerySpecificAPIIntegration(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	integration, err := j.APIIntegrationDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Inventory Sync", integration.DisplayName)
+	assert.True(t, integration.Enabled)
+	assert.Equal(t, 300, integration.AccessTokenLifetimeSeconds)
+	assert.Equal(t, []string{"Inventory Read"}, integration.AuthorizationScopes)
+}
+
+func TestCreateAPIIntegration(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateAPIIntegration(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	created, err := j.CreateAPIIntegration(context.Background(), &pro.APIIntegration{DisplayName: "Webhook Relay", AuthorizationScopes: []string{"Inventory Read"}, Enabled: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+}
+
+func TestUpdateAPIIntegration(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	integration, err := j.UpdateAPIIntegration(context.Background(), "2", &pro.APIIntegration{DisplayName: "Script Deployer", AuthorizationScopes: []string{"Script Admin"}, Enabled: true})
+	assert.Nil(t, err)
+	assert.True(t, integration.Enabled)
+}
+
+func TestDeleteAPIIntegration(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	assert.Nil(t, j.DeleteAPIIntegration(context.Background(), "2"))
+}
+
+func TestRotateAPIIntegrationClientCredentials(t *testing.T) {
+	testServer := apiIntegrationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	credentials, err := j.RotateAPIIntegrationClientCredentials(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "client-1", credentials.ClientID)
+	assert.Equal(t, "rotated-secret", credentials.ClientSecret)
+}
@@ -0,0 +1,96 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	apiRolesContext          = "api-roles"
+	apiRolePrivilegesContext = "api-role-privileges"
+)
+
+// APIRoles returns a single page of API roles matching opts
+func (j *Client) APIRoles(ctx context.Context, opts *ListOptions) (*Results[APIRole], error) {
+	ep := j.endpoint(1, apiRolesContext)
+	res, err := listPage[APIRole](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query API roles from %s", ep)
+	}
+	return res, nil
+}
+
+// AllAPIRoles returns every API role matching opts, requesting each page in turn
+func (j *Client) AllAPIRoles(ctx context.Context, opts *ListOptions) ([]APIRole, error) {
+	ep := j.endpoint(1, apiRolesContext)
+	res, err := listAll[APIRole](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all API roles from %s", ep)
+	}
+	return res, nil
+}
+
+// APIRoleDetails returns the details for a specific API role given its ID
+func (j *Client) APIRoleDetails(ctx context.Context, id string) (*APIRole, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiRolesContext, url.PathEscape(id)))
+	res := &APIRole{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query API role with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateAPIRole will create a new API role in Jamf
+func (j *Client) CreateAPIRole(ctx context.Context, content *APIRole) (*CreatedResource, error) {
+	ep := j.endpoint(1, apiRolesContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for API role: (%s)", ep)
+	}
+	if content.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new API role"), "unable to process JAMF creation request for API role: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for API role %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateAPIRole will replace an API role in Jamf given its ID
+func (j *Client) UpdateAPIRole(ctx context.Context, id string, content *APIRole) (*APIRole, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiRolesContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for API role: %s (%s)", id, ep)
+	}
+
+	res := &APIRole{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for API role: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteAPIRole will delete an API role given its ID
+func (j *Client) DeleteAPIRole(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", apiRolesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for API role %s from %s", id, ep)
+	}
+	return nil
+}
+
+// APIRolePrivileges returns every privilege which can be granted to an API role
+func (j *Client) APIRolePrivileges(ctx context.Context) ([]string, error) {
+	ep := j.endpoint(1, apiRolePrivilegesContext)
+	res := &APIRolePrivileges{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query API role privileges from %s", ep)
+	}
+	return res.Privileges, nil
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// APIRole represents a set of privileges which can be assigned to API integrations
+type APIRole struct {
+	ID          string   `json:"id,omitempty"`
+	DisplayName string   `json:"displayName"`
+	Privileges  []string `json:"privileges"`
+}
+
+// APIRolePrivileges holds the privileges available to API roles
+type APIRolePrivileges struct {
+	Privileges []string `json:"privileges"`
+}
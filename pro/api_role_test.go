@@ -0,0 +1,105 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var API_ROLES_API_BASE_ENDPOINT = "/api/v1/api-roles"
+
+func apiRolesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	roles := &crudMock[pro.APIRole]{
+		t:      t,
+		base:   API_ROLES_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.APIRole{
+			{ID: "1", DisplayName: "Inventory Read", Privileges: []string{"Read Computers", "Read Mobile Devices"}},
+			{ID: "2", DisplayName: "Script Admin", Privileges: []string{"Create Scripts", "Update Scripts"}},
+		},
+		getID: func(r pro.APIRole) string { return r.ID },
+		setID: func(r *pro.APIRole, id string) { r.ID = id },
+	}
+	mux.Handle(API_ROLES_API_BASE_ENDPOINT, roles)
+	mux.Handle(API_ROLES_API_BASE_ENDPOINT+"/", roles)
+	mux.HandleFunc("/api/v1/api-role-privileges", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"privileges": ["Read Computers", "Read Mobile Devices", "Create Scripts"]}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestQueryAllAPIRoles(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	roles, err := j.AllAPIRoles(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(roles))
+	assert.Equal(t, "Script Admin", roles[1].DisplayName)
+}
+
+func TestQuerySpecificAPIRole(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	role, err := j.APIRoleDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Inventory Read", role.DisplayName)
+	assert.Equal(t, []string{"Read Computers", "Read Mobile Devices"}, role.Privileges)
+}
+
+func TestCreateAPIRole(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateAPIRole(context.Background(), &pro.APIRole{Privileges: []string{"Read Computers"}})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "display name required")
+
+	created, err := j.CreateAPIRole(context.Background(), &pro.APIRole{DisplayName: "Prestage Sync", Privileges: []string{"Update Computer PreStage Enrollments"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+}
+
+func TestUpdateAPIRole(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	role, err := j.UpdateAPIRole(context.Background(), "2", &pro.APIRole{DisplayName: "Script Admin", Privileges: []string{"Read Scripts"}})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Read Scripts"}, role.Privileges)
+}
+
+func TestDeleteAPIRole(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	assert.Nil(t, j.DeleteAPIRole(context.Background(), "2"))
+	_, err := j.APIRoleDetails(context.Background(), "2")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestAPIRolePrivileges(t *testing.T) {
+	testServer := apiRolesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	privileges, err := j.APIRolePrivileges(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(privileges))
+	assert.Contains(t, privileges, "Create Scripts")
+}
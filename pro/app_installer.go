@@ -0,0 +1,173 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	appInstallersContext           = "app-installers"
+	appInstallerTitlesContext      = appInstallersContext + "/titles"
+	appInstallerDeploymentsContext = appInstallersContext + "/deployments"
+)
+
+// AppInstallerTitles returns a single page of app installer titles matching opts
+func (j *Client) AppInstallerTitles(ctx context.Context, opts *ListOptions) (*Results[AppInstallerTitle], error) {
+	ep := j.endpoint(1, appInstallerTitlesContext)
+	res, err := listPage[AppInstallerTitle](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query app installer titles from %s", ep)
+	}
+	return res, nil
+}
+
+// AllAppInstallerTitles returns every app installer title matching opts, requesting each page in turn
+func (j *Client) AllAppInstallerTitles(ctx context.Context, opts *ListOptions) ([]AppInstallerTitle, error) {
+	ep := j.endpoint(1, appInstallerTitlesContext)
+	res, err := listAll[AppInstallerTitle](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all app installer titles from %s", ep)
+	}
+	return res, nil
+}
+
+// AppInstallerTitleDetails returns the details for a specific app installer title given its ID
+func (j *Client) AppInstallerTitleDetails(ctx context.Context, id string) (*AppInstallerTitle, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", appInstallerTitlesContext, url.PathEscape(id)))
+	res := &AppInstallerTitle{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query app installer title with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// AppInstallerDeployments returns a single page of app installer deployments matching opts
+func (j *Client) AppInstallerDeployments(ctx context.Context, opts *ListOptions) (*Results[AppInstallerDeployment], error) {
+	ep := j.endpoint(1, appInstallerDeploymentsContext)
+	res, err := listPage[AppInstallerDeployment](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query app installer deployments from %s", ep)
+	}
+	return res, nil
+}
+
+// AllAppInstallerDeployments returns every app installer deployment matching opts, requesting each page in turn
+func (j *Client) AllAppInstallerDeployments(ctx context.Context, opts *ListOptions) ([]AppInstallerDeployment, error) {
+	ep := j.endpoint(1, appInstallerDeploymentsContext)
+	res, err := listAll[AppInstallerDeployment](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all app installer deployments from %s", ep)
+	}
+	return res, nil
+}
+
+// AppInstallerDeploymentDetails returns the details for a specific app installer deployment given its ID
+func (j *Client) AppInstallerDeploymentDetails(ctx context.Context, id string) (*AppInstallerDeployment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", appInstallerDeploymentsContext, url.PathEscape(id)))
+	res := &AppInstallerDeployment{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query app installer deployment with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateAppInstallerDeployment will create a new app installer deployment in Jamf
+func (j *Client) CreateAppInstallerDeployment(ctx context.Context, content *AppInstallerDeployment) (*CreatedResource, error) {
+	ep := j.endpoint(1, appInstallerDeploymentsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for app installer deployment: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *AppInstallerDeployment) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new app installer deployment"), "unable to process JAMF creation request for app installer deployment: (%s)", ep)
+	}
+	if content.AppTitleID == "" {
+		return nil, errors.Wrapf(fmt.Errorf("app title ID required for new app installer deployment"), "unable to process JAMF creation request for app installer deployment: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for app installer deployment %s on %s", content.Name, ep)
+	}
+	return res, nil
+}
+
+// UpdateAppInstallerDeployment will replace an app installer deployment in Jamf given its ID
+func (j *Client) UpdateAppInstallerDeployment(ctx context.Context, id string, content *AppInstallerDeployment) (*AppInstallerDeployment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", appInstallerDeploymentsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for app installer deployment: %s (%s)", id, ep)
+	}
+
+	res := &AppInstallerDeployment{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for app installer deployment: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteAppInstallerDeployment will delete an app installer deployment given its ID
+func (j *Client) DeleteAppInstallerDeployment(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", appInstallerDeploymentsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for app installer deployment %s from %s", id, ep)
+	}
+	return nil
+}
+
+// AppInstallerDeploymentHistory returns a single page of the change history for an app installer deployment given its ID
+func (j *Client) AppInstallerDeploymentHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceAppInstallerDeployments, id, opts)
+}
+
+// AddAppInstallerDeploymentHistoryNote adds a note to the change history for an app installer deployment given its ID
+func (j *Client) AddAppInstallerDeploymentHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceAppInstallerDeployments, id, note)
+}
+
+// AppInstallerDeploymentComputers returns a single page of the install status of each computer
+// in scope of an app installer deployment given its ID
+func (j *Client) AppInstallerDeploymentComputers(ctx context.Context, id string, opts *ListOptions) (*Results[AppInstallerDeploymentComputer], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/computers", appInstallerDeploymentsContext, url.PathEscape(id)))
+	res, err := listPage[AppInstallerDeploymentComputer](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query computers for app installer deployment with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// RedeployAppInstallerDeployment retries the installation of an app installer deployment on
+// every computer where it failed given its ID
+func (j *Client) RedeployAppInstallerDeployment(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/redeploy", appInstallerDeploymentsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to redeploy app installer deployment with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// AppInstallerTermsAndConditions returns whether the Jamf App Catalog terms and conditions have been
+// accepted, deployments cannot be created until they are
+func (j *Client) AppInstallerTermsAndConditions(ctx context.Context) (*AppInstallerTermsAndConditions, error) {
+	ep := j.endpoint(1, appInstallersContext+"/terms-and-conditions")
+	res := &AppInstallerTermsAndConditions{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query app installer terms and conditions from %s", ep)
+	}
+	return res, nil
+}
+
+// AcceptAppInstallerTermsAndConditions accepts the Jamf App Catalog terms and conditions on
+// behalf of the authenticated user
+func (j *Client) AcceptAppInstallerTermsAndConditions(ctx context.Context) error {
+	ep := j.endpoint(1, appInstallersContext+"/terms-and-conditions/accept")
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to accept app installer terms and conditions on %s", ep)
+	}
+	return nil
+}
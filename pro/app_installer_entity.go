@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Deployment types accepted by app installer deployments
+const (
+	AppInstallerDeploymentTypeInstallAutomatically = "INSTALL_AUTOMATICALLY"
+	AppInstallerDeploymentTypeSelfService          = "SELF_SERVICE"
+)
+
+// Update behaviors accepted by app installer deployments
+const (
+	AppInstallerUpdateBehaviorAutomatic = "AUTOMATIC"
+	AppInstallerUpdateBehaviorManual    = "MANUAL"
+)
+
+// AppInstallerTitle represents a Mac app available from the Jamf App Catalog
+type AppInstallerTitle struct {
+	ID                     string `json:"id"`
+	TitleName              string `json:"titleName"`
+	BundleID               string `json:"bundleId"`
+	Publisher              string `json:"publisher"`
+	IconURL                string `json:"iconUrl,omitempty"`
+	Version                string `json:"version"`
+	ShortVersion           string `json:"shortVersion,omitempty"`
+	SizeInBytes            int64  `json:"sizeInBytes"`
+	MinimumOSVersion       string `json:"minimumOsVersion,omitempty"`
+	Language               string `json:"language,omitempty"`
+	AvailabilityDate       string `json:"availabilityDate,omitempty"`
+	PackageSigningIdentity string `json:"packageSigningIdentity,omitempty"`
+}
+
+// AppInstallerDeployment represents the deployment of a Jamf App Catalog title to a smart group of computers
+type AppInstallerDeployment struct {
+	ID                              string                            `json:"id,omitempty"`
+	Name                            string                            `json:"name"`
+	Enabled                         bool                              `json:"enabled"`
+	AppTitleID                      string                            `json:"appTitleId"`
+	DeploymentType                  string                            `json:"deploymentType,omitempty"`
+	UpdateBehavior                  string                            `json:"updateBehavior,omitempty"`
+	CategoryID                      string                            `json:"categoryId,omitempty"`
+	SiteID                          string                            `json:"siteId,omitempty"`
+	SmartGroupID                    string                            `json:"smartGroupId,omitempty"`
+	InstallPredefinedConfigProfiles bool                              `json:"installPredefinedConfigProfiles"`
+	TriggerAdminNotifications       bool                              `json:"triggerAdminNotifications"`
+	NotificationSettings            *AppInstallerNotificationSettings `json:"notificationSettings,omitempty"`
+	SelfServiceSettings             *AppInstallerSelfServiceSettings  `json:"selfServiceSettings,omitempty"`
+	SelectedVersion                 string                            `json:"selectedVersion,omitempty"`
+	LatestAvailableVersion          string                            `json:"latestAvailableVersion,omitempty"`
+	VersionRemoved                  bool                              `json:"versionRemoved,omitempty"`
+}
+
+// AppInstallerNotificationSettings holds the notifications shown to users while an app installer deployment updates an app
+type AppInstallerNotificationSettings struct {
+	NotificationMessage  string `json:"notificationMessage,omitempty"`
+	NotificationInterval int    `json:"notificationInterval,omitempty"`
+	DeadlineMessage      string `json:"deadlineMessage,omitempty"`
+	Deadline             int    `json:"deadline,omitempty"`
+	QuitDelay            int    `json:"quitDelay,omitempty"`
+	CompleteMessage      string `json:"completeMessage,omitempty"`
+	Relaunch             bool   `json:"relaunch"`
+	Suppress             bool   `json:"suppress"`
+}
+
+// AppInstallerSelfServiceSettings holds how an app installer deployment is presented in Self Service
+type AppInstallerSelfServiceSettings struct {
+	IncludeInFeaturedCategory   bool                              `json:"includeInFeaturedCategory"`
+	IncludeInComplianceCategory bool                              `json:"includeInComplianceCategory"`
+	ForceViewDescription        bool                              `json:"forceViewDescription"`
+	Description                 string                            `json:"description,omitempty"`
+	Categories                  []AppInstallerSelfServiceCategory `json:"categories,omitempty"`
+}
+
+// AppInstallerSelfServiceCategory holds a Self Service category an app installer deployment is shown in
+type AppInstallerSelfServiceCategory struct {
+	ID       string `json:"id"`
+	Featured bool   `json:"featured"`
+}
+
+// AppInstallerDeploymentComputer holds the install status of an app installer deployment on a single computer
+type AppInstallerDeploymentComputer struct {
+	ComputerID       string `json:"computerId"`
+	ComputerName     string `json:"computerName"`
+	Version          string `json:"version,omitempty"`
+	InstalledVersion string `json:"installedVersion,omitempty"`
+	Status           string `json:"status"`
+	LastUpdate       string `json:"lastUpdate,omitempty"`
+}
+
+// AppInstallerTermsAndConditions holds whether the Jamf App Catalog terms and conditions have been accepted
+type AppInstallerTermsAndConditions struct {
+	Accepted bool `json:"accepted"`
+}
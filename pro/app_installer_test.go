@@ -0,0 +1,165 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var APP_INSTALLERS_API_BASE_ENDPOINT = "/api/v1/app-installers"
+
+func appInstallersResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	titles := &crudMock[pro.AppInstallerTitle]{
+		t:    t,
+		base: APP_INSTALLERS_API_BASE_ENDPOINT + "/titles",
+		items: []pro.AppInstallerTitle{
+			{ID: "0BC", TitleName: "Google Chrome", BundleID: "com.google.Chrome", Publisher: "Google", Version: "129.0.6668.90"},
+			{ID: "1A5", TitleName: "Zoom", BundleID: "us.zoom.xos", Publisher: "Zoom Video Communications", Version: "6.2.3"},
+		},
+		getID: func(a pro.AppInstallerTitle) string { return a.ID },
+		setID: func(a *pro.AppInstallerTitle, id string) { a.ID = id },
+	}
+	deployments := &crudMock[pro.AppInstallerDeployment]{
+		t:      t,
+		base:   APP_INSTALLERS_API_BASE_ENDPOINT + "/deployments",
+		nextID: 1,
+		items: []pro.AppInstallerDeployment{
+			{
+				ID:             "1",
+				Name:           "Google Chrome",
+				Enabled:        true,
+				AppTitleID:     "0BC",
+				DeploymentType: pro.AppInstallerDeploymentTypeInstallAutomatically,
+				UpdateBehavior: pro.AppInstallerUpdateBehaviorAutomatic,
+				SmartGroupID:   "1",
+				NotificationSettings: &pro.AppInstallerNotificationSettings{
+					NotificationMessage: "An update is available",
+					Deadline:            24,
+				},
+			},
+		},
+		getID: func(a pro.AppInstallerDeployment) string { return a.ID },
+		setID: func(a *pro.AppInstallerDeployment, id string) { a.ID = id },
+	}
+	mux.Handle(titles.base, titles)
+	mux.Handle(titles.base+"/", titles)
+	mux.Handle(deployments.base, deployments)
+	mux.Handle(deployments.base+"/", deployments)
+	mux.HandleFunc(deployments.base+"/1/computers", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"computerId": "1", "computerName": "Lab-01", "version": "129.0.6668.90", "installedVersion": "129.0.6668.90", "status": "INSTALLED"},
+			{"computerId": "2", "computerName": "Lab-02", "version": "129.0.6668.90", "status": "FAILED"}
+		]}`)
+	})
+	mux.HandleFunc(deployments.base+"/1/redeploy", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	accepted := false
+	mux.HandleFunc(APP_INSTALLERS_API_BASE_ENDPOINT+"/terms-and-conditions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"accepted": %t}`, accepted)
+	})
+	mux.HandleFunc(APP_INSTALLERS_API_BASE_ENDPOINT+"/terms-and-conditions/accept", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		accepted = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestAppInstallerTitles(t *testing.T) {
+	testServer := appInstallersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	titles, err := j.AllAppInstallerTitles(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, titles, 2)
+
+	title, err := j.AppInstallerTitleDetails(context.Background(), "1A5")
+	assert.Nil(t, err)
+	assert.Equal(t, "us.zoom.xos", title.BundleID)
+
+	_, err = j.AppInstallerTitleDetails(context.Background(), "FFF")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestAppInstallerDeploymentsCRUD(t *testing.T) {
+	testServer := appInstallersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	deployment, err := j.AppInstallerDeploymentDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "0BC", deployment.AppTitleID)
+	assert.Equal(t, 24, deployment.NotificationSettings.Deadline)
+
+	_, err = j.CreateAppInstallerDeployment(context.Background(), &pro.AppInstallerDeployment{Name: "Zoom"})
+	assert.NotNil(t, err)
+
+	created, err := j.CreateAppInstallerDeployment(context.Background(), &pro.AppInstallerDeployment{
+		Name:           "Zoom",
+		AppTitleID:     "1A5",
+		DeploymentType: pro.AppInstallerDeploymentTypeSelfService,
+		SelfServiceSettings: &pro.AppInstallerSelfServiceSettings{
+			Categories: []pro.AppInstallerSelfServiceCategory{{ID: "3", Featured: true}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	deployment.UpdateBehavior = pro.AppInstallerUpdateBehaviorManual
+	updated, err := j.UpdateAppInstallerDeployment(context.Background(), "1", deployment)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.AppInstallerUpdateBehaviorManual, updated.UpdateBehavior)
+
+	_, err = j.AddAppInstallerDeploymentHistoryNote(context.Background(), "1", "Switched to manual updates")
+	assert.Nil(t, err)
+	history, err := j.AppInstallerDeploymentHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Switched to manual updates", history.Results[0].Note)
+
+	assert.Nil(t, j.DeleteAppInstallerDeployment(context.Background(), "2"))
+	all, err := j.AllAppInstallerDeployments(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, all, 1)
+}
+
+func TestAppInstallerDeploymentComputersAndRedeploy(t *testing.T) {
+	testServer := appInstallersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	computers, err := j.AppInstallerDeploymentComputers(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, computers.TotalCount)
+	assert.Equal(t, "FAILED", computers.Results[1].Status)
+
+	assert.Nil(t, j.RedeployAppInstallerDeployment(context.Background(), "1"))
+}
+
+func TestAppInstallerTermsAndConditions(t *testing.T) {
+	testServer := appInstallersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	terms, err := j.AppInstallerTermsAndConditions(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, terms.Accepted)
+
+	assert.Nil(t, j.AcceptAppInstallerTermsAndConditions(context.Background()))
+
+	terms, err = j.AppInstallerTermsAndConditions(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, terms.Accepted)
+}
@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const buildingsContext = "buildings"
+
+// Buildings returns a single page of buildings matching opts
+func (j *Client) Buildings(ctx context.Context, opts *ListOptions) (*Results[Building], error) {
+	ep := j.endpoint(1, buildingsContext)
+	res, err := listPage[Building](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query buildings from %s", ep)
+	}
+	return res, nil
+}
+
+// AllBuildings returns every building matching opts, requesting each page in turn. They are served from
+// the client's Cache, when set, unless opts is set.
+func (j *Client) AllBuildings(ctx context.Context, opts *ListOptions) ([]Building, error) {
+	return cachedList(ctx, j, CacheBuildings, opts, j.allBuildings)
+}
+
+func (j *Client) allBuildings(ctx context.Context, opts *ListOptions) ([]Building, error) {
+	ep := j.endpoint(1, buildingsContext)
+	res, err := listAll[Building](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all buildings from %s", ep)
+	}
+	return res, nil
+}
+
+// BuildingDetails returns the details for a specific building given its ID
+func (j *Client) BuildingDetails(ctx context.Context, id string) (*Building, error) {
+	if res, ok := cachedItem(ctx, j, j.AllBuildings, id, func(item *Building) string { return item.ID }); ok {
+		return res, nil
+	}
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", buildingsContext, url.PathEscape(id)))
+	res := &Building{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query building with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateBuilding will create a new building in Jamf
+func (j *Client) CreateBuilding(ctx context.Context, content *Building) (*CreatedResource, error) {
+	ep := j.endpoint(1, buildingsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for building: (%s)", ep)
+	}
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new building"), "unable to process JAMF creation request for building: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for building %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheBuildings)
+	return res, nil
+}
+
+// UpdateBuilding will replace a building in Jamf given its ID
+func (j *Client) UpdateBuilding(ctx context.Context, id string, content *Building) (*Building, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", buildingsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for building: %s (%s)", id, ep)
+	}
+
+	res := &Building{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for building: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheBuildings)
+	return res, nil
+}
+
+// DeleteBuilding will delete a building given its ID
+func (j *Client) DeleteBuilding(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", buildingsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for building %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheBuildings)
+	return nil
+}
+
+// BuildingHistory returns a single page of the change history for a building given its ID
+func (j *Client) BuildingHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceBuildings, id, opts)
+}
+
+// AddBuildingHistoryNote adds a note to the change history for a building given its ID
+func (j *Client) AddBuildingHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceBuildings, id, note)
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Building represents a building in Jamf Pro
+type Building struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	StreetAddress1 string `json:"streetAddress1,omitempty"`
+	StreetAddress2 string `json:"streetAddress2,omitempty"`
+	City           string `json:"city,omitempty"`
+	StateProvince  string `json:"stateProvince,omitempty"`
+	ZipPostalCode  string `json:"zipPostalCode,omitempty"`
+	Country        string `json:"country,omitempty"`
+}
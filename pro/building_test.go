@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var BUILDINGS_API_BASE_ENDPOINT = "/api/v1/buildings"
+
+func buildingsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(&crudMock[pro.Building]{
+		t:      t,
+		base:   BUILDINGS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.Building{
+			{ID: "1", Name: "Headquarters", StreetAddress1: "1 Main St", City: "Minneapolis", Country: "US"},
+			{ID: "2", Name: "Warehouse", City: "Eau Claire"},
+		},
+		getID: func(x pro.Building) string { return x.ID },
+		setID: func(x *pro.Building, id string) { x.ID = id },
+	})
+}
+
+func TestQueryAllBuildings(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.Buildings(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+
+	buildings, err := j.AllBuildings(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(buildings))
+	assert.Equal(t, "Warehouse", buildings[1].Name)
+}
+
+func TestQuerySpecificBuilding(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	building, err := j.BuildingDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Headquarters", building.Name)
+	assert.Equal(t, "1 Main St", building.StreetAddress1)
+	assert.Equal(t, "Minneapolis", building.City)
+
+	_, err = j.BuildingDetails(context.Background(), "9")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCreateBuilding(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateBuilding(context.Background(), &pro.Building{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required")
+
+	created, err := j.CreateBuilding(context.Background(), &pro.Building{Name: "Lab"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+}
+
+func TestUpdateBuilding(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	building, err := j.UpdateBuilding(context.Background(), "2", &pro.Building{Name: "Warehouse East", ZipPostalCode: "54701"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", building.ID)
+	assert.Equal(t, "Warehouse East", building.Name)
+	assert.Equal(t, "54701", building.ZipPostalCode)
+}
+
+func TestDeleteBuilding(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	err := j.DeleteBuilding(context.Background(), "2")
+	assert.Nil(t, err)
+	_, err = j.BuildingDetails(context.Background(), "2")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestBuildingHistory(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.AddBuildingHistoryNote(context.Background(), "1", "Moved to new lease")
+	assert.Nil(t, err)
+
+	history, err := j.BuildingHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, history.TotalCount)
+	assert.Equal(t, "Moved to new lease", history.Results[0].Note)
+}
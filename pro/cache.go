@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Kinds of reference data kept in a Cache
+const (
+	CacheBuildings          = "buildings"
+	CacheCategories         = "categories"
+	CacheComputerGroups     = "computer-groups"
+	CacheDepartments        = "departments"
+	CacheMobileDeviceGroups = "mobile-device-groups"
+	CacheSites              = "sites"
+)
+
+// Cache keeps reference data which rarely changes, such as buildings, departments, categories, sites
+// and the names of computer and mobile device groups, in memory. Setting Client.Cache serves the
+// unfiltered lists and details of these resources from the cache, which is invalidated when the client
+// creates, updates or deletes one of them. Changes made by other clients are only seen once the cached
+// data expires or Invalidate is called, a Cache must therefore not be shared by clients of different
+// servers.
+type Cache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	// generations counts the invalidations of each kind, and all those of every kind, so data requested
+	// before an invalidation is not cached after it
+	generations map[string]uint64
+	all         uint64
+}
+
+type cacheEntry struct {
+	value   interface{}
+	fetched time.Time
+}
+
+// NewCache returns a Cache whose data is requested again once older than ttl, a zero ttl keeps it
+// until Invalidate is called
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{ttl: ttl, entries: map[string]cacheEntry{}, generations: map[string]uint64{}}
+}
+
+// Invalidate drops the cached data of the given kinds, e.g. CacheBuildings, or of every kind without any.
+// It is safe to call on a nil Cache.
+func (c *Cache) Invalidate(kinds ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(kinds) == 0 {
+		c.entries = map[string]cacheEntry{}
+		c.all++
+		return
+	}
+	for _, kind := range kinds {
+		delete(c.entries, kind)
+		c.generations[kind]++
+	}
+}
+
+// generation returns the number of invalidations of a kind, to be passed to set with the data requested
+// after calling it
+func (c *Cache) generation(kind string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.all + c.generations[kind]
+}
+
+func (c *Cache) get(kind string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[kind]
+	if !ok || (c.ttl > 0 && time.Since(entry.fetched) > c.ttl) {
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// set caches the data of a kind unless it was invalidated since generation was called, in which case
+// the data may be stale and is dropped
+func (c *Cache) set(kind string, value interface{}, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.all+c.generations[kind] != generation {
+		return
+	}
+	c.entries[kind] = cacheEntry{value: value, fetched: time.Now()}
+}
+
+// cachedList returns the list of a kind of reference data from the client's cache, fetching it when
+// missing or expired. Filtered lists are never cached, and a copy is returned so callers can modify it.
+func cachedList[T any](ctx context.Context, j *Client, kind string, opts *ListOptions, fetch func(context.Context, *ListOptions) ([]T, error)) ([]T, error) {
+	if j.Cache == nil || opts != nil {
+		return fetch(ctx, opts)
+	}
+	if value, ok := j.Cache.get(kind); ok {
+		return append([]T(nil), value.([]T)...), nil
+	}
+	generation := j.Cache.generation(kind)
+	res, err := fetch(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	j.Cache.set(kind, res, generation)
+	return append([]T(nil), res...), nil
+}
+
+// cachedItem looks an item up by ID in the cached list of a kind of reference data, it returns false
+// when the client has no cache or the item is not in the list
+func cachedItem[T any](ctx context.Context, j *Client, list func(context.Context, *ListOptions) ([]T, error), id string, idOf func(*T) string) (*T, bool) {
+	if j.Cache == nil {
+		return nil, false
+	}
+	items, err := list(ctx, nil)
+	if err != nil {
+		return nil, false
+	}
+	for i := range items {
+		if idOf(&items[i]) == id {
+			return &items[i], true
+		}
+	}
+	return nil, false
+}
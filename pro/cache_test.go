@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingMock counts the GET requests served by a mock by path
+func countingMock(handler http.Handler, gets map[string]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets[r.URL.Path]++
+		}
+		handler.ServeHTTP(w, r)
+	}))
+}
+
+func TestCachedBuildings(t *testing.T) {
+	gets := map[string]int{}
+	testServer := countingMock(&crudMock[pro.Building]{
+		t:      t,
+		base:   BUILDINGS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items:  []pro.Building{{ID: "1", Name: "Headquarters"}, {ID: "2", Name: "Warehouse"}},
+		getID:  func(x pro.Building) string { return x.ID },
+		setID:  func(x *pro.Building, id string) { x.ID = id },
+	}, gets)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	j.Cache = pro.NewCache(time.Hour)
+	ctx := context.Background()
+
+	buildings, err := j.AllBuildings(ctx, nil)
+	assert.Nil(t, err)
+	assert.Len(t, buildings, 2)
+	buildings[0].Name = "Modified"
+	building, err := j.BuildingDetails(ctx, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Headquarters", building.Name)
+	assert.Equal(t, 1, gets[BUILDINGS_API_BASE_ENDPOINT])
+
+	// filtered lists and buildings missing from the cache are requested from the server
+	_, err = j.AllBuildings(ctx, &pro.ListOptions{Filter: `name=="Warehouse"`})
+	assert.Nil(t, err)
+	_, err = j.BuildingDetails(ctx, "9")
+	assert.True(t, pro.IsNotFound(err))
+	assert.Equal(t, 2, gets[BUILDINGS_API_BASE_ENDPOINT])
+	assert.Equal(t, 1, gets[BUILDINGS_API_BASE_ENDPOINT+"/9"])
+
+	_, err = j.CreateBuilding(ctx, &pro.Building{Name: "Lab"})
+	assert.Nil(t, err)
+	buildings, err = j.AllBuildings(ctx, nil)
+	assert.Nil(t, err)
+	assert.Len(t, buildings, 3)
+	assert.Equal(t, 3, gets[BUILDINGS_API_BASE_ENDPOINT])
+}
+
+func TestCachedSites(t *testing.T) {
+	gets := map[string]int{}
+	testServer := countingMock(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1", "name": "Paris"}, {"id": "2", "name": "New York"}]`)
+	}), gets)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	j.Cache = pro.NewCache(0)
+	ctx := context.Background()
+
+	for _, name := range []string{"Paris", "new york"} {
+		site, err := j.SiteByName(ctx, name)
+		assert.Nil(t, err)
+		assert.NotNil(t, site)
+	}
+	assert.Equal(t, 1, gets[SITES_API_BASE_ENDPOINT])
+
+	j.Cache.Invalidate(pro.CacheSites)
+	_, err := j.Sites(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, gets[SITES_API_BASE_ENDPOINT])
+
+	var nilCache *pro.Cache
+	nilCache.Invalidate()
+}
+
+func TestCachedComputerGroups(t *testing.T) {
+	gets := map[string]int{}
+	testServer := countingMock(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case COMPUTER_GROUPS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `[{"id": "1", "name": "All Managed Clients", "smartGroup": true}]`)
+		case STATIC_COMPUTER_GROUPS_API_BASE_ENDPOINT:
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v2/computer-groups/static-groups/2"}`)
+		}
+	}), gets)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	j.Cache = pro.NewCache(time.Hour)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		groups, err := j.ComputerGroups(ctx)
+		assert.Nil(t, err)
+		assert.Len(t, groups, 1)
+	}
+	assert.Equal(t, 1, gets[COMPUTER_GROUPS_API_BASE_ENDPOINT])
+
+	_, err := j.CreateStaticComputerGroup(ctx, &pro.StaticComputerGroup{Name: "Lab"})
+	assert.Nil(t, err)
+	_, err = j.ComputerGroups(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, gets[COMPUTER_GROUPS_API_BASE_ENDPOINT])
+}
+
+func TestCacheInvalidatedDuringFetch(t *testing.T) {
+	gets := map[string]int{}
+	started, release := make(chan struct{}), make(chan struct{})
+	testServer := countingMock(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if gets[COMPUTER_GROUPS_API_BASE_ENDPOINT] == 1 {
+			close(started)
+			<-release
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `[{"id": "1", "name": "Group %d", "smartGroup": true}]`, gets[COMPUTER_GROUPS_API_BASE_ENDPOINT])
+	}), gets)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	j.Cache = pro.NewCache(time.Hour)
+	ctx := context.Background()
+
+	// the list requested before the invalidation is returned but not cached
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		groups, err := j.ComputerGroups(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "Group 1", groups[0].Name)
+	}()
+	<-started
+	j.Cache.Invalidate(pro.CacheComputerGroups)
+	close(release)
+	<-done
+
+	for i := 0; i < 2; i++ {
+		groups, err := j.ComputerGroups(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, "Group 2", groups[0].Name)
+	}
+	assert.Equal(t, 2, gets[COMPUTER_GROUPS_API_BASE_ENDPOINT])
+}
@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const categoriesContext = "categories"
+
+// Categories returns a single page of categories matching opts
+func (j *Client) Categories(ctx context.Context, opts *ListOptions) (*Results[Category], error) {
+	ep := j.endpoint(1, categoriesContext)
+	res, err := listPage[Category](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query categories from %s", ep)
+	}
+	return res, nil
+}
+
+// AllCategories returns every category matching opts, requesting each page in turn. They are served from
+// the client's Cache, when set, unless opts is set.
+func (j *Client) AllCategories(ctx context.Context, opts *ListOptions) ([]Category, error) {
+	return cachedList(ctx, j, CacheCategories, opts, j.allCategories)
+}
+
+func (j *Client) allCategories(ctx context.Context, opts *ListOptions) ([]Category, error) {
+	ep := j.endpoint(1, categoriesContext)
+	res, err := listAll[Category](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all categories from %s", ep)
+	}
+	return res, nil
+}
+
+// CategoryDetails returns the details for a specific category given its ID
+func (j *Client) CategoryDetails(ctx context.Context, id string) (*Category, error) {
+	if res, ok := cachedItem(ctx, j, j.AllCategories, id, func(item *Category) string { return item.ID }); ok {
+		return res, nil
+	}
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", categoriesContext, url.PathEscape(id)))
+	res := &Category{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query category with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateCategory will create a new category in Jamf
+func (j *Client) CreateCategory(ctx context.Context, content *Category) (*CreatedResource, error) {
+	ep := j.endpoint(1, categoriesContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for category: (%s)", ep)
+	}
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new category"), "unable to process JAMF creation request for category: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for category %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheCategories)
+	return res, nil
+}
+
+// UpdateCategory will replace a category in Jamf given its ID
+func (j *Client) UpdateCategory(ctx context.Context, id string, content *Category) (*Category, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", categoriesContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for category: %s (%s)", id, ep)
+	}
+
+	res := &Category{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for category: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheCategories)
+	return res, nil
+}
+
+// DeleteCategory will delete a category given its ID
+func (j *Client) DeleteCategory(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", categoriesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for category %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheCategories)
+	return nil
+}
+
+// CategoryHistory returns a single page of the change history for a category given its ID
+func (j *Client) CategoryHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceCategories, id, opts)
+}
+
+// AddCategoryHistoryNote adds a note to the change history for a category given its ID
+func (j *Client) AddCategoryHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceCategories, id, note)
+}
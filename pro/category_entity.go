@@ -0,0 +1,11 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Category represents a category used to organize policies, packages and Self Service items,
+// Priority orders categories in Self Service from 1 to 20
+type Category struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Priority int    `json:"priority"`
+}
@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CATEGORIES_API_BASE_ENDPOINT = "/api/v1/categories"
+
+func categoriesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	categories := &crudMock[pro.Category]{
+		t:      t,
+		base:   CATEGORIES_API_BASE_ENDPOINT,
+		nextID: 3,
+		items: []pro.Category{
+			{ID: "1", Name: "Productivity", Priority: 9},
+			{ID: "2", Name: "Security", Priority: 1},
+			{ID: "3", Name: "Developer Tools", Priority: 5},
+		},
+		getID: func(x pro.Category) string { return x.ID },
+		setID: func(x *pro.Category, id string) { x.ID = id },
+	}
+	mux.HandleFunc(categories.base, func(w http.ResponseWriter, r *http.Request) {
+		if filter := r.URL.Query().Get("filter"); filter != "" {
+			assert.Equal(t, `priority=le="5"`, filter)
+			assert.Equal(t, "priority:asc", r.URL.Query().Get("sort"))
+			w.Header().Set("Content-Type", "application/json")
+			assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, []pro.Category{categories.items[1], categories.items[2]})))
+			return
+		}
+		categories.ServeHTTP(w, r)
+	})
+	mux.Handle(categories.base+"/", categories)
+	return httptest.NewServer(mux)
+}
+
+func TestQueryAllCategories(t *testing.T) {
+	testServer := categoriesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.Categories(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+
+	categories, err := j.AllCategories(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Len(t, categories, 3)
+	assert.Equal(t, "Developer Tools", categories[2].Name)
+
+	categories, err = j.AllCategories(context.Background(), &pro.ListOptions{
+		Filter: pro.F("priority").LE("5").String(),
+		Sort:   []string{pro.F("priority").Asc()},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, categories, 2)
+	assert.Equal(t, "Security", categories[0].Name)
+}
+
+func TestQuerySpecificCategory(t *testing.T) {
+	testServer := categoriesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	category, err := j.CategoryDetails(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Security", category.Name)
+	assert.Equal(t, 1, category.Priority)
+
+	_, err = j.CategoryDetails(context.Background(), "9")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCreateUpdateDeleteCategory(t *testing.T) {
+	testServer := categoriesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateCategory(context.Background(), &pro.Category{Priority: 9})
+	assert.Contains(t, err.Error(), "name required")
+
+	created, err := j.CreateCategory(context.Background(), &pro.Category{Name: "Browsers", Priority: 9})
+	assert.Nil(t, err)
+	assert.Equal(t, "4", created.ID)
+
+	updated, err := j.UpdateCategory(context.Background(), created.ID, &pro.Category{Name: "Web Browsers", Priority: 8})
+	assert.Nil(t, err)
+	assert.Equal(t, "Web Browsers", updated.Name)
+
+	assert.Nil(t, j.DeleteCategory(context.Background(), created.ID))
+	_, err = j.CategoryDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCategoryHistory(t *testing.T) {
+	testServer := categoriesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.AddCategoryHistoryNote(context.Background(), "1", "")
+	assert.Contains(t, err.Error(), "note required")
+	_, err = j.AddCategoryHistoryNote(context.Background(), "1", "Priority lowered")
+	assert.Nil(t, err)
+
+	history, err := j.CategoryHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Priority lowered", history.Results[0].Note)
+}
@@ -0,0 +1,49 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const checkInContext = "check-in"
+
+// CheckInSettings returns how often computers check in with Jamf Pro and what they run at startup and login
+func (j *Client) CheckInSettings(ctx context.Context) (*CheckInSettings, error) {
+	ep := j.endpoint(3, checkInContext)
+	res := &CheckInSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query check-in settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateCheckInSettings will replace the computer check-in settings in Jamf
+func (j *Client) UpdateCheckInSettings(ctx context.Context, settings *CheckInSettings) (*CheckInSettings, error) {
+	ep := j.endpoint(3, checkInContext)
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for check-in settings: (%s)", ep)
+	}
+	if settings.CheckInFrequency <= 0 {
+		return nil, errors.Wrapf(fmt.Errorf("check-in frequency required"), "unable to process JAMF update request for check-in settings: (%s)", ep)
+	}
+
+	res := &CheckInSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for check-in settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// CheckInSettingsHistory returns a single page of the change history for the check-in settings
+func (j *Client) CheckInSettingsHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceCheckIn, "", opts)
+}
+
+// AddCheckInSettingsHistoryNote adds a note to the change history for the check-in settings
+func (j *Client) AddCheckInSettingsHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceCheckIn, "", note)
+}
@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// CheckInSettings represents the computer check-in frequency in minutes along with the startup
+// and login/logout hooks run by the Jamf binary
+type CheckInSettings struct {
+	CheckInFrequency                 int  `json:"checkInFrequency"`
+	CreateStartupScript              bool `json:"isCreateStartupScriptEnabled"`
+	LogStartupEvent                  bool `json:"isLogStartupEventEnabled"`
+	CheckForPoliciesAtStartup        bool `json:"isCheckForPoliciesAtStartupEnabled"`
+	ApplyComputerLevelManagedPrefs   bool `json:"isApplyComputerLevelManagedPrefsEnabled"`
+	EnsureSSHIsEnabled               bool `json:"isEnsureSshIsEnabled"`
+	CreateLoginLogoutHooks           bool `json:"isCreateLoginLogoutHooksEnabled"`
+	LogUsername                      bool `json:"isLogUsernameEnabled"`
+	CheckForPoliciesAtLoginLogout    bool `json:"isCheckForPoliciesAtLoginLogoutEnabled"`
+	ApplyUserLevelManagedPreferences bool `json:"isApplyUserLevelManagedPreferencesEnabled"`
+	HideRestorePartition             bool `json:"isHideRestorePartitionEnabled"`
+	PerformLoginActionsInBackground  bool `json:"isPerformLoginActionsInBackgroundEnabled"`
+	DisplayStatusToUser              bool `json:"isDisplayStatusToUserEnabled"`
+}
@@ -0,0 +1,83 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CHECK_IN_API_BASE_ENDPOINT = "/api/v3/check-in"
+
+func checkInResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.CheckInSettings{CheckInFrequency: 15, CreateStartupScript: true, CheckForPoliciesAtStartup: true}
+	mux.HandleFunc(CHECK_IN_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	mux.HandleFunc(CHECK_IN_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Check-in frequency changed to 15"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v3/check-in/history/2"}`)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCheckInSettings(t *testing.T) {
+	testServer := checkInResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.CheckInSettings(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 15, settings.CheckInFrequency)
+	assert.True(t, settings.CheckForPoliciesAtStartup)
+	assert.False(t, settings.CreateLoginLogoutHooks)
+
+	_, err = j.UpdateCheckInSettings(context.Background(), &pro.CheckInSettings{})
+	assert.Contains(t, err.Error(), "check-in frequency required")
+
+	settings.CheckInFrequency = 30
+	settings.CreateLoginLogoutHooks = true
+	updated, err := j.UpdateCheckInSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.Equal(t, 30, updated.CheckInFrequency)
+	assert.True(t, updated.CreateLoginLogoutHooks)
+}
+
+func TestCheckInSettingsHistory(t *testing.T) {
+	testServer := checkInResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	history, err := j.CheckInSettingsHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, history.TotalCount)
+	assert.Equal(t, "Check-in frequency changed to 15", history.Results[0].Note)
+
+	generic, err := j.History(context.Background(), pro.HistoryResourceCheckIn, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, history.Results, generic.Results)
+
+	_, err = j.AddCheckInSettingsHistoryNote(context.Background(), "")
+	assert.Contains(t, err.Error(), "note required")
+	note, err := j.AddCheckInSettingsHistoryNote(context.Background(), "Reviewed")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", note.ID)
+}
@@ -0,0 +1,236 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package pro is a client for the Jamf Pro API (/api/v{n}), the JSON-only API which Jamf is
+// building all new functionality on top of. Resources which are still only available through the
+// Classic API can be found in the classic package.
+package pro
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/internal/auth"
+	"github.com/pkg/errors"
+)
+
+// Client represents the interface used to communicate with
+// the Jamf Pro API via an HTTP client
+type Client struct {
+	Domain   string
+	Username string
+	Password string
+	Endpoint string
+	Token    *JamfToken
+	// Cache, when set, keeps the buildings, categories, departments, sites and groups listed in memory
+	Cache *Cache
+	api   *http.Client
+	site  string
+}
+
+// JamfToken represents the bearer token required for client authentication
+type JamfToken = auth.Token
+
+// Used if custom client not passed on when NewClient instantiated
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: time.Minute,
+	}
+}
+
+// NewClient returns a new Jamf Pro API client to be used for API requests
+func NewClient(domain string, username string, password string, client *http.Client) (*Client, error) {
+	if domain == "" || username == "" || password == "" {
+		return nil, errors.New("you must provide a valid Jamf domain, username, and password")
+	}
+
+	if client == nil {
+		client = defaultHTTPClient()
+	}
+
+	return &Client{
+		Domain:   domain,
+		Username: username,
+		Password: password,
+		Endpoint: fmt.Sprintf("%s/api", domain),
+		Token:    &JamfToken{},
+		api:      client,
+	}, nil
+}
+
+// WithSite returns a copy of the client scoped to a site, sharing its token, cache and HTTP client.
+// Computers, mobile device inventory and smart and static groups listed through it are filtered to the
+// site, MobileDevices, ComputerGroups and MobileDeviceGroups still return the objects of every site as
+// Jamf does not report their site. The groups, prestages, app installer deployments, enrollment customizations and volume
+// purchasing resources it creates are assigned to the site unless they already name one, the caller's
+// payload is left unchanged. An empty siteID removes the scope.
+func (j *Client) WithSite(siteID string) *Client {
+	scoped := *j
+	scoped.site = siteID
+	return &scoped
+}
+
+// Site returns the ID of the site the client is scoped to, empty when it is not
+func (j *Client) Site() string {
+	return j.site
+}
+
+// siteScoped returns opts with field matching the site of the client added to their filter
+func (j *Client) siteScoped(opts *ListOptions, field Field) *ListOptions {
+	if j.site == "" {
+		return opts
+	}
+	scoped := ListOptions{}
+	if opts != nil {
+		scoped = *opts
+	}
+	filter := field.EQ(j.site).String()
+	if scoped.Filter != "" {
+		filter = "(" + scoped.Filter + ");" + filter
+	}
+	scoped.Filter = filter
+	return &scoped
+}
+
+// withSite returns content, or a copy of it assigned to the site of the client when the client is
+// scoped and content has none, so the payload passed by the caller is never modified
+func withSite[T any](j *Client, content *T, siteID func(*T) *string) *T {
+	if j.site == "" {
+		return content
+	}
+	if id := *siteID(content); id != "" && id != "-1" {
+		return content
+	}
+	assigned := *content
+	*siteID(&assigned) = j.site
+	return &assigned
+}
+
+// endpoint builds the URL for a resource on a given version of the API, e.g. endpoint(1, "scripts")
+// returns https://jamf.example.com/api/v1/scripts
+func (j *Client) endpoint(version int, resource string) string {
+	return fmt.Sprintf("%s/v%d/%s", j.Endpoint, version, strings.TrimPrefix(resource, "/"))
+}
+
+// newRequest builds a request with body marshaled as JSON, a nil body sends no content
+func newRequest(ctx context.Context, method string, ep string, body interface{}) (*http.Request, error) {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error building JAMF %s payload for %s", method, ep)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, ep, reader)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building JAMF %s request for %s", method, ep)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// newMultipartRequest builds a request which streams file to Jamf as the form field named field
+// rather than buffering it in memory
+func newMultipartRequest(ctx context.Context, method string, ep string, field string, filename string, file io.Reader) (*http.Request, error) {
+	pr, pw := io.Pipe()
+	form := multipart.NewWriter(pw)
+	go func() {
+		part, err := form.CreateFormFile(field, filename)
+		if err == nil {
+			_, err = io.Copy(part, file)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, method, ep, pr)
+	if err != nil {
+		pr.Close()
+		return nil, errors.Wrapf(err, "error building JAMF %s upload request for %s (%s)", method, filename, ep)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	return req, nil
+}
+
+// upload streams file to Jamf as a multipart form, decoding the response into v when it is not nil
+func (j *Client) upload(ctx context.Context, ep string, field string, filename string, file io.Reader, v interface{}) error {
+	req, err := newMultipartRequest(ctx, "POST", ep, field, filename, file)
+	if err != nil {
+		return err
+	}
+	if err := j.makeAPIrequest(req, v); err != nil {
+		req.Body.Close()
+		return err
+	}
+	return nil
+}
+
+// do builds and sends a JSON request, decoding the response into v when it is not nil
+func (j *Client) do(ctx context.Context, method string, ep string, body interface{}, v interface{}) error {
+	req, err := newRequest(ctx, method, ep, body)
+	if err != nil {
+		return err
+	}
+	return j.makeAPIrequest(req, v)
+}
+
+func (j *Client) makeAPIrequest(r *http.Request, v interface{}) error {
+	err := auth.Refresh(r.Context(), j.api, j.Domain, j.Username, j.Password, j.Token)
+	if err != nil {
+		return errors.Wrapf(err, "error checking for bearer token expiration")
+	}
+
+	// Endpoints serving files (e.g. CSV exports) set their own Accept header
+	if r.Header.Get("Accept") == "" {
+		r.Header.Set("Accept", "application/json")
+	}
+	r.Header.Set("Cache-Control", "no-store, no-cache, must-revalidate, max-age=0, post-check=0, pre-check=0")
+	r.Header.Set("Authorization", auth.Bearer(j.Token))
+
+	res, err := j.api.Do(r)
+	if err != nil {
+		return errors.Wrapf(err, "error making %s request to %s", r.Method, r.URL)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return newAPIError(res)
+	}
+
+	// Some endpoints (e.g. deletes and actions) return nothing worth decoding
+	if v == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	// Raw responses are copied as is rather than decoded
+	if w, ok := v.(io.Writer); ok {
+		if _, err = io.Copy(w, res.Body); err != nil {
+			return errors.Wrapf(err, "response was successful but error occured reading response body from %s", r.URL)
+		}
+		return nil
+	}
+
+	if err = json.NewDecoder(res.Body).Decode(v); err != nil && err != io.EOF {
+		return errors.Wrapf(err, "response was successful but error occured decoding response body from %s", r.URL)
+	}
+
+	return nil
+}
+
+// CreatedResource holds the ID and location of a resource created through the Jamf Pro API
+type CreatedResource struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
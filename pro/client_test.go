@@ -0,0 +1,276 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var testToken = pro.JamfToken{
+	Token:   "abcdefghijklmnopqrstuvwxyz",
+	Expires: time.Now().Add(time.Hour).Format(time.RFC3339),
+}
+
+// newTestClient returns a client pointed at testServer with a valid bearer token
+func newTestClient(t *testing.T, testServer *httptest.Server) *pro.Client {
+	j, err := pro.NewClient(testServer.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	j.Token = &pro.JamfToken{Token: testToken.Token, Expires: testToken.Expires}
+	return j
+}
+
+// pageOf slices results the way Jamf does for the page and page-size query parameters
+func pageOf[T any](t *testing.T, r *http.Request, results []T) pro.Results[T] {
+	page, pageSize := 0, 100
+	if p := r.URL.Query().Get("page"); p != "" {
+		var err error
+		page, err = strconv.Atoi(p)
+		assert.Nil(t, err)
+	}
+	if s := r.URL.Query().Get("page-size"); s != "" {
+		var err error
+		pageSize, err = strconv.Atoi(s)
+		assert.Nil(t, err)
+	}
+	start, end := page*pageSize, (page+1)*pageSize
+	if start > len(results) {
+		start = len(results)
+	}
+	if end > len(results) {
+		end = len(results)
+	}
+	return pro.Results[T]{TotalCount: len(results), Results: results[start:end]}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// crudMock serves list, get, create, update and delete requests against an in memory store of
+// resources under base, assigning sequential IDs to created resources
+type crudMock[T any] struct {
+	t      *testing.T
+	base   string
+	items  []T
+	nextID int
+	getID  func(T) string
+	setID  func(*T, string)
+	// history holds the change history of each resource by ID
+	history map[string][]pro.HistoryEntry
+}
+
+func (m *crudMock[T]) find(id string) int {
+	for i, item := range m.items {
+		if m.getID(item) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (m *crudMock[T]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	var (
+		data []byte
+		err  error
+	)
+	id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, m.base), "/")
+	if strings.HasSuffix(id, "/history") {
+		m.serveHistory(w, r, strings.TrimSuffix(id, "/history"))
+		return
+	}
+	switch {
+	case id == "" && r.Method == "GET":
+		data, err = json.Marshal(pageOf(m.t, r, m.items))
+	case id == "" && r.Method == "POST":
+		var item T
+		assert.Nil(m.t, json.NewDecoder(r.Body).Decode(&item))
+		m.nextID++
+		newID := strconv.Itoa(m.nextID)
+		m.setID(&item, newID)
+		m.items = append(m.items, item)
+		w.WriteHeader(http.StatusCreated)
+		data, err = json.Marshal(pro.CreatedResource{ID: newID, Href: fmt.Sprintf("%s/%s", m.base, newID)})
+	case m.find(id) < 0:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Resource not found"}]}`)
+		return
+	case r.Method == "GET":
+		data, err = json.Marshal(m.items[m.find(id)])
+	case r.Method == "PUT":
+		var item T
+		assert.Nil(m.t, json.NewDecoder(r.Body).Decode(&item))
+		m.setID(&item, id)
+		m.items[m.find(id)] = item
+		data, err = json.Marshal(item)
+	case r.Method == "PATCH":
+		// decoding over the stored item leaves the fields missing from the patch unchanged
+		item := m.items[m.find(id)]
+		assert.Nil(m.t, json.NewDecoder(r.Body).Decode(&item))
+		m.items[m.find(id)] = item
+		data, err = json.Marshal(item)
+	case r.Method == "DELETE":
+		i := m.find(id)
+		m.items = append(m.items[:i], m.items[i+1:]...)
+		w.WriteHeader(http.StatusNoContent)
+		return
+	default:
+		http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		return
+	}
+	assert.Nil(m.t, err)
+	_, err = w.Write(data)
+	assert.Nil(m.t, err)
+}
+
+func (m *crudMock[T]) serveHistory(w http.ResponseWriter, r *http.Request, id string) {
+	if m.history == nil {
+		m.history = map[string][]pro.HistoryEntry{}
+	}
+	var (
+		data []byte
+		err  error
+	)
+	switch r.Method {
+	case "GET":
+		data, err = json.Marshal(pageOf(m.t, r, m.history[id]))
+	case "POST":
+		note := &pro.HistoryNote{}
+		assert.Nil(m.t, json.NewDecoder(r.Body).Decode(note))
+		entry := pro.HistoryEntry{ID: len(m.history[id]) + 1, Username: "fake-username", Date: "2024-10-01T12:00:00Z", Note: note.Note}
+		m.history[id] = append(m.history[id], entry)
+		w.WriteHeader(http.StatusCreated)
+		data, err = json.Marshal(pro.CreatedResource{ID: strconv.Itoa(entry.ID), Href: r.URL.Path})
+	}
+	assert.Nil(m.t, err)
+	_, err = w.Write(data)
+	assert.Nil(m.t, err)
+}
+
+func clientResponseMock(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("Bearer %s", testToken.Token), r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.RequestURI {
+		case "/api/v1/jamf-pro-version":
+			fmt.Fprint(w, `{"version": "11.10.0-t1727888776"}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+}
+
+func TestNewClient(t *testing.T) {
+	j, err := pro.NewClient("https://jamf.example.com", "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jamf.example.com/api", j.Endpoint)
+	assert.NotNil(t, j.Token)
+
+	_, err = pro.NewClient("https://jamf.example.com", "", "mock-password-cool", nil)
+	assert.NotNil(t, err)
+}
+
+func TestJamfProVersion(t *testing.T) {
+	testServer := clientResponseMock(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	version, err := j.JamfProVersion(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "11.10.0-t1727888776", version.Version)
+}
+
+func TestAPIError(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{
+			"httpStatus": 404,
+			"errors": [{"code": "INVALID_ID", "description": "Resource not found", "id": "0", "field": null}]
+		}`)
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.JamfProVersion(context.Background())
+	assert.NotNil(t, err)
+	assert.True(t, pro.IsNotFound(err))
+	assert.Contains(t, err.Error(), "404 INVALID_ID: Resource not found")
+}
+
+func TestAPIErrorPlainText(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "upstream unavailable", http.StatusBadGateway)
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.JamfProVersion(context.Background())
+	assert.NotNil(t, err)
+	assert.False(t, pro.IsNotFound(err))
+	assert.Contains(t, err.Error(), "502 upstream unavailable")
+}
+
+func TestWithSite(t *testing.T) {
+	filters := []string{}
+	created := pro.StaticComputerGroup{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/computers-inventory", "/api/v2/computer-groups/static-groups":
+			if r.Method == "POST" {
+				assert.Nil(t, json.NewDecoder(r.Body).Decode(&created))
+				fmt.Fprint(w, `{"id": "4", "href": "/api/v2/computer-groups/static-groups/4"}`)
+				return
+			}
+			filters = append(filters, r.URL.Query().Get("filter"))
+			fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+		}
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	paris := j.WithSite("2")
+	assert.Equal(t, "2", paris.Site())
+	assert.Equal(t, "", j.Site())
+	assert.Equal(t, j.Token, paris.Token)
+
+	_, err := paris.AllComputersInventory(context.Background(), nil)
+	assert.Nil(t, err)
+	_, err = paris.ComputersInventory(context.Background(), &pro.ListOptions{Filter: pro.F("general.name").EQ("a").Or(pro.F("general.name").EQ("b")).String()})
+	assert.Nil(t, err)
+	_, err = j.AllComputersInventory(context.Background(), nil)
+	assert.Nil(t, err)
+	_, err = paris.AllStaticComputerGroups(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`general.site.id=="2"`, `(general.name=="a",general.name=="b");general.site.id=="2"`, "", `siteId=="2"`}, filters)
+
+	group := &pro.StaticComputerGroup{Name: "Paris laptops"}
+	_, err = paris.CreateStaticComputerGroup(context.Background(), group)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.SiteID)
+	assert.Equal(t, "", group.SiteID)
+	_, err = paris.CreateStaticComputerGroup(context.Background(), &pro.StaticComputerGroup{})
+	assert.NotNil(t, err)
+	_, err = paris.CreateStaticComputerGroup(context.Background(), &pro.StaticComputerGroup{Name: "Lyon laptops", SiteID: "3"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.SiteID)
+}
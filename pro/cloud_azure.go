@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const cloudAzureContext = "cloud-azure"
+
+// CloudAzureDetails returns the details for a specific Azure AD cloud identity provider given its ID
+func (j *Client) CloudAzureDetails(ctx context.Context, id string) (*CloudAzure, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", cloudAzureContext, url.PathEscape(id)))
+	res := &CloudAzure{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Azure cloud identity provider with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateCloudAzure will create a new Azure AD cloud identity provider in Jamf
+func (j *Client) CreateCloudAzure(ctx context.Context, content *CloudAzure) (*CreatedResource, error) {
+	ep := j.endpoint(1, cloudAzureContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for Azure cloud identity provider: (%s)", ep)
+	}
+	if content.CloudIdentityProvider.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new Azure cloud identity provider"), "unable to process JAMF creation request for Azure cloud identity provider: (%s)", ep)
+	}
+	if content.Server == nil || content.Server.TenantID == "" {
+		return nil, errors.Wrapf(fmt.Errorf("tenant ID required for new Azure cloud identity provider"), "unable to process JAMF creation request for Azure cloud identity provider: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for Azure cloud identity provider %s on %s", content.CloudIdentityProvider.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateCloudAzure will replace an Azure AD cloud identity provider in Jamf given its ID
+func (j *Client) UpdateCloudAzure(ctx context.Context, id string, content *CloudAzure) (*CloudAzure, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", cloudAzureContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Azure cloud identity provider: %s (%s)", id, ep)
+	}
+
+	res := &CloudAzure{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Azure cloud identity provider: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteCloudAzure will delete an Azure AD cloud identity provider given its ID
+func (j *Client) DeleteCloudAzure(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", cloudAzureContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for Azure cloud identity provider %s from %s", id, ep)
+	}
+	return nil
+}
+
+// CloudAzureDefaultServer returns the server configuration Jamf recommends for a new Azure AD cloud identity provider
+func (j *Client) CloudAzureDefaultServer(ctx context.Context) (*CloudAzureServer, error) {
+	ep := j.endpoint(1, cloudAzureContext+"/defaults/server-configuration")
+	res := &CloudAzureServer{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query default Azure cloud identity provider server configuration from %s", ep)
+	}
+	return res, nil
+}
+
+// CloudAzureDefaultMappings returns the attribute mappings Jamf recommends for a new Azure AD cloud identity provider
+func (j *Client) CloudAzureDefaultMappings(ctx context.Context) (*CloudAzureMappings, error) {
+	ep := j.endpoint(1, cloudAzureContext+"/defaults/mappings")
+	res := &CloudAzureMappings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query default Azure cloud identity provider mappings from %s", ep)
+	}
+	return res, nil
+}
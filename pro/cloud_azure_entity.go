@@ -0,0 +1,39 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// CloudAzure represents an Azure AD (Microsoft Entra ID) cloud identity provider
+type CloudAzure struct {
+	CloudIdentityProvider CloudIdentityProvider `json:"cloudIdPCommon"`
+	Server                *CloudAzureServer     `json:"server"`
+}
+
+// CloudAzureServer holds the tenant and search settings of an Azure AD cloud identity provider
+type CloudAzureServer struct {
+	ID                                       string              `json:"id,omitempty"`
+	TenantID                                 string              `json:"tenantId"`
+	Enabled                                  bool                `json:"enabled"`
+	Migrated                                 bool                `json:"migrated"`
+	Mappings                                 *CloudAzureMappings `json:"mappings,omitempty"`
+	SearchTimeout                            int                 `json:"searchTimeout,omitempty"`
+	TransitiveMembershipEnabled              bool                `json:"transitiveMembershipEnabled"`
+	TransitiveMembershipUserField            string              `json:"transitiveMembershipUserField,omitempty"`
+	TransitiveDirectoryMembershipEnabled     bool                `json:"transitiveDirectoryMembershipEnabled"`
+	MembershipCalculationOptimizationEnabled bool                `json:"membershipCalculationOptimizationEnabled"`
+	Code                                     string              `json:"code,omitempty"`
+}
+
+// CloudAzureMappings holds the Azure AD attributes mapped to Jamf user and group fields
+type CloudAzureMappings struct {
+	UserID     string `json:"userId"`
+	UserName   string `json:"userName"`
+	RealName   string `json:"realName"`
+	Email      string `json:"email"`
+	Department string `json:"department"`
+	Building   string `json:"building"`
+	Room       string `json:"room"`
+	Phone      string `json:"phone"`
+	Position   string `json:"position"`
+	GroupID    string `json:"groupId"`
+	GroupName  string `json:"groupName"`
+}
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CLOUD_AZURE_API_BASE_ENDPOINT = "/api/v1/cloud-azure"
+
+func cloudAzureResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	providers := &crudMock[pro.CloudAzure]{
+		t:      t,
+		base:   CLOUD_AZURE_API_BASE_ENDPOINT,
+		nextID: 1001,
+		items: []pro.CloudAzure{
+			{
+				CloudIdentityProvider: pro.CloudIdentityProvider{ID: "1001", DisplayName: "Entra ID", ProviderName: pro.CloudIdentityProviderAzure},
+				Server: &pro.CloudAzureServer{
+					ID:            "1001",
+					TenantID:      "3f5b2d1a-0000-4000-8000-000000000000",
+					Enabled:       true,
+					SearchTimeout: 30,
+					Mappings:      &pro.CloudAzureMappings{UserID: "id", UserName: "userPrincipalName", Email: "mail", GroupName: "displayName"},
+				},
+			},
+		},
+		getID: func(c pro.CloudAzure) string { return c.CloudIdentityProvider.ID },
+		setID: func(c *pro.CloudAzure, id string) { c.CloudIdentityProvider.ID = id },
+	}
+	mux.Handle(CLOUD_AZURE_API_BASE_ENDPOINT, providers)
+	mux.Handle(CLOUD_AZURE_API_BASE_ENDPOINT+"/", providers)
+	mux.HandleFunc(CLOUD_AZURE_API_BASE_ENDPOINT+"/defaults/server-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"tenantId": "", "enabled": true, "migrated": false, "searchTimeout": 30, "transitiveMembershipEnabled": false, "mappings": {"userId": "id", "userName": "userPrincipalName"}}`)
+	})
+	mux.HandleFunc(CLOUD_AZURE_API_BASE_ENDPOINT+"/defaults/mappings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"userId": "id", "userName": "userPrincipalName", "realName": "displayName", "email": "mail", "department": "department", "building": "", "room": "", "phone": "mobilePhone", "position": "jobTitle", "groupId": "id", "groupName": "displayName"}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCloudAzureCRUD(t *testing.T) {
+	testServer := cloudAzureResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	provider, err := j.CloudAzureDetails(context.Background(), "1001")
+	assert.Nil(t, err)
+	assert.Equal(t, "Entra ID", provider.CloudIdentityProvider.DisplayName)
+	assert.Equal(t, "userPrincipalName", provider.Server.Mappings.UserName)
+
+	_, err = j.CreateCloudAzure(context.Background(), &pro.CloudAzure{CloudIdentityProvider: pro.CloudIdentityProvider{DisplayName: "Student Entra ID"}})
+	assert.NotNil(t, err)
+
+	server, err := j.CloudAzureDefaultServer(context.Background())
+	assert.Nil(t, err)
+	server.TenantID = "7c9e6679-0000-4000-8000-000000000000"
+	created, err := j.CreateCloudAzure(context.Background(), &pro.CloudAzure{
+		CloudIdentityProvider: pro.CloudIdentityProvider{DisplayName: "Student Entra ID", ProviderName: pro.CloudIdentityProviderAzure},
+		Server:                server,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1002", created.ID)
+
+	provider.Server.TransitiveMembershipEnabled = true
+	updated, err := j.UpdateCloudAzure(context.Background(), "1001", provider)
+	assert.Nil(t, err)
+	assert.True(t, updated.Server.TransitiveMembershipEnabled)
+
+	assert.Nil(t, j.DeleteCloudAzure(context.Background(), "1002"))
+	_, err = j.CloudAzureDetails(context.Background(), "1002")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCloudAzureDefaultMappings(t *testing.T) {
+	testServer := cloudAzureResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	mappings, err := j.CloudAzureDefaultMappings(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "jobTitle", mappings.Position)
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	cloudDistributionPointContext = "cloud-distribution-point"
+	jcdsContext                   = "jcds"
+)
+
+// CloudDistributionPoint returns the cloud distribution point Jamf Pro serves packages from
+func (j *Client) CloudDistributionPoint(ctx context.Context) (*CloudDistributionPoint, error) {
+	ep := j.endpoint(1, cloudDistributionPointContext)
+	res := &CloudDistributionPoint{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query cloud distribution point from %s", ep)
+	}
+	return res, nil
+}
+
+// CloudDistributionPointUploadCapability returns whether packages can be uploaded to the cloud
+// distribution point directly through Jamf Pro
+func (j *Client) CloudDistributionPointUploadCapability(ctx context.Context) (*CloudDistributionPointUploadCapability, error) {
+	ep := j.endpoint(1, cloudDistributionPointContext+"/upload-capability")
+	res := &CloudDistributionPointUploadCapability{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query cloud distribution point upload capability from %s", ep)
+	}
+	return res, nil
+}
+
+// JCDSFiles returns every file stored on the Jamf Cloud Distribution Service
+func (j *Client) JCDSFiles(ctx context.Context) ([]JCDSFile, error) {
+	ep := j.endpoint(1, jcdsContext+"/files")
+	res := []JCDSFile{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query JCDS files from %s", ep)
+	}
+	return res, nil
+}
+
+// JCDSFileDownloadURL returns a short lived URL the given file can be downloaded from
+func (j *Client) JCDSFileDownloadURL(ctx context.Context, fileName string) (string, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/files/%s", jcdsContext, url.PathEscape(fileName)))
+	res := &struct {
+		URI string `json:"uri"`
+	}{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return "", errors.Wrapf(err, "unable to query download URL for JCDS file %s from %s", fileName, ep)
+	}
+	return res.URI, nil
+}
+
+// CreateJCDSUpload initiates an upload to the Jamf Cloud Distribution Service, the file must then be
+// put in the returned bucket and path using the temporary AWS credentials before they expire
+func (j *Client) CreateJCDSUpload(ctx context.Context) (*JCDSUploadCredentials, error) {
+	ep := j.endpoint(1, jcdsContext+"/files")
+	res := &JCDSUploadCredentials{}
+	if err := j.do(ctx, "POST", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to initiate JCDS upload on %s", ep)
+	}
+	return res, nil
+}
+
+// RenewJCDSCredentials returns new temporary AWS credentials for an upload taking longer than the
+// lifetime of the ones returned by CreateJCDSUpload
+func (j *Client) RenewJCDSCredentials(ctx context.Context) (*JCDSUploadCredentials, error) {
+	ep := j.endpoint(1, jcdsContext+"/renew-credentials")
+	res := &JCDSUploadCredentials{}
+	if err := j.do(ctx, "POST", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to renew JCDS credentials on %s", ep)
+	}
+	return res, nil
+}
+
+// RefreshJCDSInventory asks Jamf Pro to refresh its list of files stored on the Jamf Cloud Distribution
+// Service, call it after uploading so the new file shows up in JCDSFiles
+func (j *Client) RefreshJCDSInventory(ctx context.Context) error {
+	ep := j.endpoint(1, jcdsContext+"/refresh-inventory")
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to refresh JCDS inventory on %s", ep)
+	}
+	return nil
+}
+
+// DeleteJCDSFile will delete a file from the Jamf Cloud Distribution Service given its name
+func (j *Client) DeleteJCDSFile(ctx context.Context, fileName string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/files/%s", jcdsContext, url.PathEscape(fileName)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for JCDS file %s from %s", fileName, ep)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Content delivery networks a cloud distribution point can use
+const (
+	CDNTypeNone      = "NONE"
+	CDNTypeJAMFCloud = "JAMF_CLOUD"
+	CDNTypeRackspace = "RACKSPACE"
+	CDNTypeAmazonS3  = "AMAZON_S3"
+	CDNTypeAkamai    = "AKAMAI"
+)
+
+// CloudDistributionPoint represents the cloud distribution point configured in Jamf Pro
+type CloudDistributionPoint struct {
+	InventoryID             string `json:"inventoryId,omitempty"`
+	CDNType                 string `json:"cdnType"`
+	Master                  bool   `json:"master"`
+	Username                string `json:"username,omitempty"`
+	Directory               string `json:"directory,omitempty"`
+	CDNURL                  string `json:"cdnUrl,omitempty"`
+	UploadURL               string `json:"uploadUrl,omitempty"`
+	DownloadURL             string `json:"downloadUrl,omitempty"`
+	SecondaryAuthRequired   bool   `json:"secondaryAuthRequired"`
+	SecondaryAuthStatusCode int    `json:"secondaryAuthStatusCode,omitempty"`
+	SecondaryAuthTimeToLive int    `json:"secondaryAuthTimeToLive,omitempty"`
+	RequireSignedURLs       bool   `json:"requireSignedUrls"`
+	KeyPairID               string `json:"keyPairId,omitempty"`
+	ExpirationSeconds       int    `json:"expirationSeconds,omitempty"`
+	HasConnectionSucceeded  bool   `json:"hasConnectionSucceeded"`
+	Message                 string `json:"message,omitempty"`
+}
+
+// CloudDistributionPointUploadCapability represents whether Jamf Pro can upload packages to the
+// cloud distribution point itself
+type CloudDistributionPointUploadCapability struct {
+	PrincipalDistributionTechnology bool `json:"principalDistributionTechnology"`
+	DirectUploadCapable             bool `json:"directUploadCapable"`
+}
+
+// JCDSFile represents a file stored on the Jamf Cloud Distribution Service, Length is in bytes
+type JCDSFile struct {
+	FileName string `json:"fileName"`
+	Length   int64  `json:"length"`
+	MD5      string `json:"md5"`
+	SHA3     string `json:"sha3"`
+	Region   string `json:"region"`
+}
+
+// JCDSUploadCredentials holds the temporary AWS credentials and S3 location used to upload a file
+// to the Jamf Cloud Distribution Service
+type JCDSUploadCredentials struct {
+	AccessKeyID     string `json:"accessKeyID"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	SessionToken    string `json:"sessionToken"`
+	Region          string `json:"region"`
+	Expiration      int64  `json:"expiration"`
+	BucketName      string `json:"bucketName"`
+	Path            string `json:"path"`
+	UUID            string `json:"uuid"`
+}
@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CLOUD_DISTRIBUTION_POINT_API_BASE_ENDPOINT = "/api/v1/cloud-distribution-point"
+var JCDS_API_BASE_ENDPOINT = "/api/v1/jcds"
+
+func cloudDistributionPointResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	files := []pro.JCDSFile{
+		{FileName: "Firefox.pkg", Length: 134217728, MD5: "cda725102e228b32c760a53e2ad6ff39", Region: "us-east-1"},
+		{FileName: "Slack 4.41.pkg", Length: 98566144, Region: "us-east-1"},
+	}
+	mux.HandleFunc(CLOUD_DISTRIBUTION_POINT_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"inventoryId": "1", "cdnType": "JAMF_CLOUD", "master": true, "requireSignedUrls": false, "hasConnectionSucceeded": true, "message": "Connection successful"}`)
+	})
+	mux.HandleFunc(CLOUD_DISTRIBUTION_POINT_API_BASE_ENDPOINT+"/upload-capability", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"principalDistributionTechnology": true, "directUploadCapable": true}`)
+	})
+	mux.HandleFunc(JCDS_API_BASE_ENDPOINT+"/files", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			assert.Nil(t, json.NewEncoder(w).Encode(files))
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"accessKeyID": "ASIAFAKE", "secretAccessKey": "fake-secret", "sessionToken": "fake-session", "region": "us-east-1", "expiration": 1727787600000, "bucketName": "jcds-example", "path": "tenant/data/", "uuid": "1b2c3d4e"}`)
+		}
+	})
+	mux.HandleFunc(JCDS_API_BASE_ENDPOINT+"/files/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, JCDS_API_BASE_ENDPOINT+"/files/")
+		for i, file := range files {
+			if file.FileName != name {
+				continue
+			}
+			switch r.Method {
+			case "GET":
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"uri": "https://jcds-example.s3.amazonaws.com/tenant/data/%s?X-Amz-Signature=fake"}`, file.FileName)
+			case "DELETE":
+				files = append(files[:i], files[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "File not found"}]}`)
+	})
+	mux.HandleFunc(JCDS_API_BASE_ENDPOINT+"/renew-credentials", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"accessKeyID": "ASIARENEWED", "secretAccessKey": "fake-secret", "sessionToken": "fake-session", "region": "us-east-1", "expiration": 1727791200000, "bucketName": "jcds-example", "path": "tenant/data/", "uuid": "1b2c3d4e"}`)
+	})
+	mux.HandleFunc(JCDS_API_BASE_ENDPOINT+"/refresh-inventory", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCloudDistributionPoint(t *testing.T) {
+	testServer := cloudDistributionPointResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	cdp, err := j.CloudDistributionPoint(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, pro.CDNTypeJAMFCloud, cdp.CDNType)
+	assert.True(t, cdp.HasConnectionSucceeded)
+
+	capability, err := j.CloudDistributionPointUploadCapability(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, capability.DirectUploadCapable)
+
+	assert.Nil(t, j.RefreshJCDSInventory(context.Background()))
+}
+
+func TestJCDSFiles(t *testing.T) {
+	testServer := cloudDistributionPointResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	files, err := j.JCDSFiles(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	assert.Equal(t, int64(134217728), files[0].Length)
+
+	uri, err := j.JCDSFileDownloadURL(context.Background(), "Slack 4.41.pkg")
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jcds-example.s3.amazonaws.com/tenant/data/Slack 4.41.pkg?X-Amz-Signature=fake", uri)
+
+	credentials, err := j.CreateJCDSUpload(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "ASIAFAKE", credentials.AccessKeyID)
+	assert.Equal(t, "jcds-example", credentials.BucketName)
+
+	credentials, err = j.RenewJCDSCredentials(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "ASIARENEWED", credentials.AccessKeyID)
+
+	assert.Nil(t, j.DeleteJCDSFile(context.Background(), "Firefox.pkg"))
+	err = j.DeleteJCDSFile(context.Background(), "Firefox.pkg")
+	assert.True(t, pro.IsNotFound(err))
+	assert.Nil(t, j.RefreshJCDSInventory(context.Background()))
+}
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const cloudIdentityProvidersContext = "cloud-idp"
+
+// CloudIdentityProviders returns a single page of the cloud identity providers (Azure AD and Cloud LDAP) configured in Jamf
+func (j *Client) CloudIdentityProviders(ctx context.Context, opts *ListOptions) (*Results[CloudIdentityProvider], error) {
+	ep := j.endpoint(1, cloudIdentityProvidersContext)
+	res, err := listPage[CloudIdentityProvider](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query cloud identity providers from %s", ep)
+	}
+	return res, nil
+}
+
+// TestCloudIdentityProviderUser looks up a user by username through a cloud identity provider given its ID,
+// confirming the provider can be searched with its current mappings
+func (j *Client) TestCloudIdentityProviderUser(ctx context.Context, id string, username string) (*Results[CloudIdentityProviderSearchResult], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/test-user", cloudIdentityProvidersContext, url.PathEscape(id)))
+	res := &Results[CloudIdentityProviderSearchResult]{}
+	if err := j.do(ctx, "POST", ep, &cloudIdentityProviderSearch{Username: username}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to search for user %s through cloud identity provider with ID %s on %s", username, id, ep)
+	}
+	return res, nil
+}
+
+// TestCloudIdentityProviderGroup looks up a group by name through a cloud identity provider given its ID
+func (j *Client) TestCloudIdentityProviderGroup(ctx context.Context, id string, groupName string) (*Results[CloudIdentityProviderSearchResult], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/test-group", cloudIdentityProvidersContext, url.PathEscape(id)))
+	res := &Results[CloudIdentityProviderSearchResult]{}
+	if err := j.do(ctx, "POST", ep, &cloudIdentityProviderSearch{GroupName: groupName}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to search for group %s through cloud identity provider with ID %s on %s", groupName, id, ep)
+	}
+	return res, nil
+}
+
+// TestCloudIdentityProviderUserMembership checks whether a user is a member of a group through a
+// cloud identity provider given its ID
+func (j *Client) TestCloudIdentityProviderUserMembership(ctx context.Context, id string, username string, groupName string) (*CloudIdentityProviderMembership, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/test-user-membership", cloudIdentityProvidersContext, url.PathEscape(id)))
+	res := &CloudIdentityProviderMembership{}
+	if err := j.do(ctx, "POST", ep, &cloudIdentityProviderSearch{Username: username, GroupName: groupName}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to check membership of user %s in group %s through cloud identity provider with ID %s on %s", username, groupName, id, ep)
+	}
+	return res, nil
+}
@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Cloud identity provider names
+const (
+	CloudIdentityProviderAzure     = "AZURE"
+	CloudIdentityProviderGoogle    = "GOOGLE"
+	CloudIdentityProviderJumpCloud = "JUMPCLOUD"
+	CloudIdentityProviderOkta      = "OKTA"
+	CloudIdentityProviderOneLogin  = "ONELOGIN"
+)
+
+// CloudIdentityProvider holds the details shared by every cloud identity provider
+type CloudIdentityProvider struct {
+	ID           string `json:"id,omitempty"`
+	DisplayName  string `json:"displayName"`
+	Enabled      bool   `json:"enabled,omitempty"`
+	ProviderName string `json:"providerName"`
+}
+
+// CloudIdentityProviderSearchResult holds a user or group found through a cloud identity provider
+type CloudIdentityProviderSearchResult struct {
+	DistinguishedName string            `json:"distinguishedName,omitempty"`
+	ID                string            `json:"id"`
+	UUID              string            `json:"uuid,omitempty"`
+	ServerID          string            `json:"serverId"`
+	Name              string            `json:"name"`
+	Attributes        map[string]string `json:"attributes,omitempty"`
+}
+
+// CloudIdentityProviderMembership holds whether a user is a member of a group in a cloud identity provider
+type CloudIdentityProviderMembership struct {
+	Username  string `json:"username"`
+	IsMember  bool   `json:"isMember"`
+	GroupName string `json:"groupName,omitempty"`
+}
+
+type cloudIdentityProviderSearch struct {
+	Username  string `json:"username,omitempty"`
+	GroupName string `json:"groupname,omitempty"`
+}
@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CLOUD_IDENTITY_PROVIDERS_API_BASE_ENDPOINT = "/api/v1/cloud-idp"
+
+func cloudIdentityProvidersResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(CLOUD_IDENTITY_PROVIDERS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 2, "results": [
+			{"id": "1001", "displayName": "Entra ID", "enabled": true, "providerName": "AZURE"},
+			{"id": "1002", "displayName": "Google Secure LDAP", "enabled": true, "providerName": "GOOGLE"}
+		]}`)
+	})
+	search := func(w http.ResponseWriter, r *http.Request) map[string]string {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "application/json")
+		return body
+	}
+	mux.HandleFunc(CLOUD_IDENTITY_PROVIDERS_API_BASE_ENDPOINT+"/1001/test-user", func(w http.ResponseWriter, r *http.Request) {
+		body := search(w, r)
+		fmt.Fprintf(w, `{"totalCount": 1, "results": [{"id": "a1b2", "serverId": "1001", "name": "%s", "attributes": {"email": "%s@example.com"}}]}`, body["username"], body["username"])
+	})
+	mux.HandleFunc(CLOUD_IDENTITY_PROVIDERS_API_BASE_ENDPOINT+"/1001/test-group", func(w http.ResponseWriter, r *http.Request) {
+		body := search(w, r)
+		fmt.Fprintf(w, `{"totalCount": 1, "results": [{"id": "c3d4", "serverId": "1001", "name": "%s"}]}`, body["groupname"])
+	})
+	mux.HandleFunc(CLOUD_IDENTITY_PROVIDERS_API_BASE_ENDPOINT+"/1001/test-user-membership", func(w http.ResponseWriter, r *http.Request) {
+		body := search(w, r)
+		fmt.Fprintf(w, `{"username": "%s", "groupName": "%s", "isMember": %t}`, body["username"], body["groupname"], body["groupname"] == "Staff")
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCloudIdentityProviders(t *testing.T) {
+	testServer := cloudIdentityProvidersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	providers, err := j.CloudIdentityProviders(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, providers.TotalCount)
+	assert.Equal(t, pro.CloudIdentityProviderGoogle, providers.Results[1].ProviderName)
+}
+
+func TestCloudIdentityProviderSearches(t *testing.T) {
+	testServer := cloudIdentityProvidersResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	users, err := j.TestCloudIdentityProviderUser(context.Background(), "1001", "jdoe")
+	assert.Nil(t, err)
+	assert.Equal(t, "jdoe", users.Results[0].Name)
+	assert.Equal(t, "jdoe@example.com", users.Results[0].Attributes["email"])
+
+	groups, err := j.TestCloudIdentityProviderGroup(context.Background(), "1001", "Staff")
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff", groups.Results[0].Name)
+
+	membership, err := j.TestCloudIdentityProviderUserMembership(context.Background(), "1001", "jdoe", "Staff")
+	assert.Nil(t, err)
+	assert.True(t, membership.IsMember)
+
+	membership, err = j.TestCloudIdentityProviderUserMembership(context.Background(), "1001", "jdoe", "Faculty")
+	assert.Nil(t, err)
+	assert.False(t, membership.IsMember)
+}
@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const cloudLDAPsContext = "cloud-ldaps"
+
+// Connection checks available for Cloud LDAP identity providers
+const (
+	CloudLDAPConnectionCheckStatus = "status"
+	CloudLDAPConnectionCheckBind   = "bind"
+	CloudLDAPConnectionCheckSearch = "search"
+)
+
+// CloudLDAPDetails returns the details for a specific Cloud LDAP identity provider given its ID
+func (j *Client) CloudLDAPDetails(ctx context.Context, id string) (*CloudLDAP, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", cloudLDAPsContext, url.PathEscape(id)))
+	res := &CloudLDAP{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Cloud LDAP identity provider with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateCloudLDAP will create a new Cloud LDAP identity provider in Jamf
+func (j *Client) CreateCloudLDAP(ctx context.Context, content *CloudLDAP) (*CreatedResource, error) {
+	ep := j.endpoint(2, cloudLDAPsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for Cloud LDAP identity provider: (%s)", ep)
+	}
+	if content.CloudIdentityProvider.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new Cloud LDAP identity provider"), "unable to process JAMF creation request for Cloud LDAP identity provider: (%s)", ep)
+	}
+	if content.Server == nil || content.Server.ServerURL == "" {
+		return nil, errors.Wrapf(fmt.Errorf("server URL required for new Cloud LDAP identity provider"), "unable to process JAMF creation request for Cloud LDAP identity provider: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for Cloud LDAP identity provider %s on %s", content.CloudIdentityProvider.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateCloudLDAP will replace a Cloud LDAP identity provider in Jamf given its ID
+func (j *Client) UpdateCloudLDAP(ctx context.Context, id string, content *CloudLDAP) (*CloudLDAP, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", cloudLDAPsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Cloud LDAP identity provider: %s (%s)", id, ep)
+	}
+
+	res := &CloudLDAP{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Cloud LDAP identity provider: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteCloudLDAP will delete a Cloud LDAP identity provider given its ID
+func (j *Client) DeleteCloudLDAP(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", cloudLDAPsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for Cloud LDAP identity provider %s from %s", id, ep)
+	}
+	return nil
+}
+
+// CloudLDAPMappings returns the LDAP attributes mapped to Jamf user and group fields for a Cloud LDAP
+// identity provider given its ID
+func (j *Client) CloudLDAPMappings(ctx context.Context, id string) (*CloudLDAPMappings, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/mappings", cloudLDAPsContext, url.PathEscape(id)))
+	res := &CloudLDAPMappings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mappings for Cloud LDAP identity provider with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// UpdateCloudLDAPMappings will replace the attribute mappings of a Cloud LDAP identity provider given its ID
+func (j *Client) UpdateCloudLDAPMappings(ctx context.Context, id string, content *CloudLDAPMappings) (*CloudLDAPMappings, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/mappings", cloudLDAPsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Cloud LDAP mappings: %s (%s)", id, ep)
+	}
+
+	res := &CloudLDAPMappings{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Cloud LDAP mappings: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// CloudLDAPDefaultServer returns the server configuration Jamf recommends for a new Cloud LDAP identity
+// provider, provider is one of the CloudIdentityProvider constants (e.g. CloudIdentityProviderGoogle)
+func (j *Client) CloudLDAPDefaultServer(ctx context.Context, provider string) (*CloudLDAPServer, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/defaults/%s/server-configuration", cloudLDAPsContext, url.PathEscape(provider)))
+	res := &CloudLDAPServer{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query default %s Cloud LDAP server configuration from %s", provider, ep)
+	}
+	return res, nil
+}
+
+// CloudLDAPDefaultMappings returns the attribute mappings Jamf recommends for a new Cloud LDAP identity
+// provider, provider is one of the CloudIdentityProvider constants (e.g. CloudIdentityProviderGoogle)
+func (j *Client) CloudLDAPDefaultMappings(ctx context.Context, provider string) (*CloudLDAPMappings, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/defaults/%s/mappings", cloudLDAPsContext, url.PathEscape(provider)))
+	res := &CloudLDAPMappings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query default %s Cloud LDAP mappings from %s", provider, ep)
+	}
+	return res, nil
+}
+
+// CloudLDAPConnection runs a connection check against a Cloud LDAP identity provider given its ID,
+// check is one of the CloudLDAPConnectionCheck constants
+func (j *Client) CloudLDAPConnection(ctx context.Context, id string, check string) (*CloudLDAPConnectionStatus, error) {
+	switch check {
+	case CloudLDAPConnectionCheckStatus, CloudLDAPConnectionCheckBind, CloudLDAPConnectionCheckSearch:
+	default:
+		return nil, fmt.Errorf("invalid Cloud LDAP connection check %q, please use %q, %q or %q", check, CloudLDAPConnectionCheckStatus, CloudLDAPConnectionCheckBind, CloudLDAPConnectionCheckSearch)
+	}
+
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/connection/%s", cloudLDAPsContext, url.PathEscape(id), check))
+	res := &CloudLDAPConnectionStatus{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to run %s connection check for Cloud LDAP identity provider with ID %s on %s", check, id, ep)
+	}
+	return res, nil
+}
+
+// VerifyCloudLDAPKeystore checks a PKCS12 keystore can be opened with password before it is used
+// for a Cloud LDAP identity provider, returning the details of the certificate it holds
+func (j *Client) VerifyCloudLDAPKeystore(ctx context.Context, filename string, data []byte, password string) (*CloudLDAPKeystore, error) {
+	ep := j.endpoint(2, cloudLDAPsContext+"/keystore/verify")
+	if len(data) == 0 {
+		return nil, fmt.Errorf("a keystore is required to verify on %s", ep)
+	}
+
+	res := &CloudLDAPKeystore{}
+	if err := j.do(ctx, "POST", ep, &CloudLDAPKeystore{FileName: filename, FileBytes: data, Password: password}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to verify Cloud LDAP keystore %s on %s", filename, ep)
+	}
+	return res, nil
+}
@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// CloudLDAP represents a Cloud LDAP identity provider, such as Google Secure LDAP
+type CloudLDAP struct {
+	CloudIdentityProvider CloudIdentityProvider `json:"cloudIdPCommon"`
+	Server                *CloudLDAPServer      `json:"server"`
+	Mappings              *CloudLDAPMappings    `json:"mappings,omitempty"`
+}
+
+// CloudLDAPServer holds the connection settings of a Cloud LDAP identity provider
+type CloudLDAPServer struct {
+	Enabled                                  bool               `json:"enabled"`
+	UseWildcards                             bool               `json:"useWildcards"`
+	ConnectionType                           string             `json:"connectionType"`
+	ServerURL                                string             `json:"serverUrl"`
+	DomainName                               string             `json:"domainName"`
+	Port                                     int                `json:"port"`
+	Keystore                                 *CloudLDAPKeystore `json:"keystore,omitempty"`
+	ConnectionTimeout                        int                `json:"connectionTimeout,omitempty"`
+	SearchTimeout                            int                `json:"searchTimeout,omitempty"`
+	MembershipCalculationOptimizationEnabled bool               `json:"membershipCalculationOptimizationEnabled"`
+}
+
+// CloudLDAPKeystore holds the client certificate used to authenticate to a Cloud LDAP identity provider,
+// FileBytes and Password are only sent when uploading a keystore
+type CloudLDAPKeystore struct {
+	Password       string `json:"password,omitempty"`
+	FileBytes      []byte `json:"fileBytes,omitempty"`
+	FileName       string `json:"fileName"`
+	Type           string `json:"type,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+}
+
+// CloudLDAPMappings holds the LDAP attributes mapped to Jamf user, group and membership fields
+type CloudLDAPMappings struct {
+	UserMappings       *CloudLDAPUserMappings       `json:"userMappings"`
+	GroupMappings      *CloudLDAPGroupMappings      `json:"groupMappings"`
+	MembershipMappings *CloudLDAPMembershipMappings `json:"membershipMappings"`
+}
+
+// CloudLDAPUserMappings holds how users are searched for and mapped in a Cloud LDAP identity provider
+type CloudLDAPUserMappings struct {
+	ObjectClassLimitation string `json:"objectClassLimitation"`
+	ObjectClasses         string `json:"objectClasses"`
+	SearchBase            string `json:"searchBase"`
+	SearchScope           string `json:"searchScope"`
+	AdditionalSearchBase  string `json:"additionalSearchBase,omitempty"`
+	UserID                string `json:"userID"`
+	Username              string `json:"username"`
+	RealName              string `json:"realName"`
+	EmailAddress          string `json:"emailAddress"`
+	Department            string `json:"department"`
+	Building              string `json:"building"`
+	Room                  string `json:"room"`
+	Phone                 string `json:"phone"`
+	Position              string `json:"position"`
+	UserUUID              string `json:"userUuid"`
+}
+
+// CloudLDAPGroupMappings holds how groups are searched for and mapped in a Cloud LDAP identity provider
+type CloudLDAPGroupMappings struct {
+	ObjectClassLimitation string `json:"objectClassLimitation"`
+	ObjectClasses         string `json:"objectClasses"`
+	SearchBase            string `json:"searchBase"`
+	SearchScope           string `json:"searchScope"`
+	GroupID               string `json:"groupID"`
+	GroupName             string `json:"groupName"`
+	GroupUUID             string `json:"groupUuid"`
+}
+
+// CloudLDAPMembershipMappings holds the attribute used to resolve group membership in a Cloud LDAP identity provider
+type CloudLDAPMembershipMappings struct {
+	GroupMembershipMapping string `json:"groupMembershipMapping"`
+}
+
+// CloudLDAPConnectionStatus holds the result of a connection check against a Cloud LDAP identity provider
+type CloudLDAPConnectionStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// Succeeded returns true when the connection check passed
+func (s *CloudLDAPConnectionStatus) Succeeded() bool {
+	return s.Status == "SUCCESS"
+}
@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CLOUD_LDAPS_API_BASE_ENDPOINT = "/api/v2/cloud-ldaps"
+
+func cloudLDAPsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mappings := &pro.CloudLDAPMappings{
+		UserMappings:       &pro.CloudLDAPUserMappings{ObjectClasses: "inetOrgPerson", SearchBase: "ou=Users", SearchScope: "ALL_SUBTREES", Username: "uid"},
+		GroupMappings:      &pro.CloudLDAPGroupMappings{ObjectClasses: "groupOfNames", SearchBase: "ou=Groups", SearchScope: "ALL_SUBTREES", GroupName: "cn"},
+		MembershipMappings: &pro.CloudLDAPMembershipMappings{GroupMembershipMapping: "memberOf"},
+	}
+	providers := &crudMock[pro.CloudLDAP]{
+		t:      t,
+		base:   CLOUD_LDAPS_API_BASE_ENDPOINT,
+		nextID: 1002,
+		items: []pro.CloudLDAP{
+			{
+				CloudIdentityProvider: pro.CloudIdentityProvider{ID: "1002", DisplayName: "Google Secure LDAP", ProviderName: pro.CloudIdentityProviderGoogle},
+				Server: &pro.CloudLDAPServer{
+					Enabled:        true,
+					ConnectionType: "LDAPS",
+					ServerURL:      "ldap.google.com",
+					DomainName:     "example.com",
+					Port:           636,
+					Keystore:       &pro.CloudLDAPKeystore{FileName: "google.p12", Type: "PKCS12", Subject: "CN=LDAP Client", ExpirationDate: "2027-10-01T12:00:00Z"},
+				},
+			},
+		},
+		getID: func(c pro.CloudLDAP) string { return c.CloudIdentityProvider.ID },
+		setID: func(c *pro.CloudLDAP, id string) { c.CloudIdentityProvider.ID = id },
+	}
+	mux.Handle(CLOUD_LDAPS_API_BASE_ENDPOINT, providers)
+	mux.Handle(CLOUD_LDAPS_API_BASE_ENDPOINT+"/", providers)
+	mux.HandleFunc(CLOUD_LDAPS_API_BASE_ENDPOINT+"/1002/mappings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			mappings = &pro.CloudLDAPMappings{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(mappings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(mappings))
+	})
+	mux.HandleFunc(CLOUD_LDAPS_API_BASE_ENDPOINT+"/defaults/GOOGLE/server-configuration", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"enabled": true, "useWildcards": true, "connectionType": "LDAPS", "serverUrl": "ldap.google.com", "domainName": "", "port": 636, "connectionTimeout": 15, "searchTimeout": 60}`)
+	})
+	mux.HandleFunc(CLOUD_LDAPS_API_BASE_ENDPOINT+"/defaults/GOOGLE/mappings", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(mappings))
+	})
+	mux.HandleFunc(CLOUD_LDAPS_API_BASE_ENDPOINT+"/1002/connection/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case CLOUD_LDAPS_API_BASE_ENDPOINT + "/1002/connection/search":
+			fmt.Fprint(w, `{"status": "FAILURE", "message": "No users found in ou=Users"}`)
+		default:
+			fmt.Fprint(w, `{"status": "SUCCESS"}`)
+		}
+	})
+	mux.HandleFunc(CLOUD_LDAPS_API_BASE_ENDPOINT+"/keystore/verify", func(w http.ResponseWriter, r *http.Request) {
+		keystore := &pro.CloudLDAPKeystore{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(keystore))
+		w.Header().Set("Content-Type", "application/json")
+		if keystore.Password != "mock-password-cool" || string(keystore.FileBytes) != "PKCS12-DATA" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"httpStatus": 400, "errors": [{"code": "INVALID_KEYSTORE", "description": "Unable to open keystore"}]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"fileName": "%s", "type": "PKCS12", "subject": "CN=LDAP Client", "expirationDate": "2027-10-01T12:00:00Z"}`, keystore.FileName)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestCloudLDAPCRUD(t *testing.T) {
+	testServer := cloudLDAPsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	provider, err := j.CloudLDAPDetails(context.Background(), "1002")
+	assert.Nil(t, err)
+	assert.Equal(t, 636, provider.Server.Port)
+	assert.Equal(t, "PKCS12", provider.Server.Keystore.Type)
+
+	_, err = j.CreateCloudLDAP(context.Background(), &pro.CloudLDAP{CloudIdentityProvider: pro.CloudIdentityProvider{DisplayName: "Staff LDAP"}})
+	assert.NotNil(t, err)
+
+	server, err := j.CloudLDAPDefaultServer(context.Background(), pro.CloudIdentityProviderGoogle)
+	assert.Nil(t, err)
+	server.DomainName = "staff.example.com"
+	server.Keystore = &pro.CloudLDAPKeystore{FileName: "staff.p12", FileBytes: []byte("PKCS12-DATA"), Password: "mock-password-cool"}
+	created, err := j.CreateCloudLDAP(context.Background(), &pro.CloudLDAP{
+		CloudIdentityProvider: pro.CloudIdentityProvider{DisplayName: "Staff LDAP", ProviderName: pro.CloudIdentityProviderGoogle},
+		Server:                server,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "1003", created.ID)
+
+	provider.Server.UseWildcards = true
+	updated, err := j.UpdateCloudLDAP(context.Background(), "1002", provider)
+	assert.Nil(t, err)
+	assert.True(t, updated.Server.UseWildcards)
+
+	assert.Nil(t, j.DeleteCloudLDAP(context.Background(), "1003"))
+}
+
+func TestCloudLDAPMappings(t *testing.T) {
+	testServer := cloudLDAPsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	defaults, err := j.CloudLDAPDefaultMappings(context.Background(), pro.CloudIdentityProviderGoogle)
+	assert.Nil(t, err)
+	assert.Equal(t, "uid", defaults.UserMappings.Username)
+
+	mappings, err := j.CloudLDAPMappings(context.Background(), "1002")
+	assert.Nil(t, err)
+	mappings.UserMappings.SearchBase = "ou=Staff,ou=Users"
+	updated, err := j.UpdateCloudLDAPMappings(context.Background(), "1002", mappings)
+	assert.Nil(t, err)
+	assert.Equal(t, "ou=Staff,ou=Users", updated.UserMappings.SearchBase)
+}
+
+func TestCloudLDAPConnectionAndKeystore(t *testing.T) {
+	testServer := cloudLDAPsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	status, err := j.CloudLDAPConnection(context.Background(), "1002", pro.CloudLDAPConnectionCheckBind)
+	assert.Nil(t, err)
+	assert.True(t, status.Succeeded())
+
+	status, err = j.CloudLDAPConnection(context.Background(), "1002", pro.CloudLDAPConnectionCheckSearch)
+	assert.Nil(t, err)
+	assert.False(t, status.Succeeded())
+	assert.Contains(t, status.Message, "No users found")
+
+	_, err = j.CloudLDAPConnection(context.Background(), "1002", "ping")
+	assert.NotNil(t, err)
+
+	keystore, err := j.VerifyCloudLDAPKeystore(context.Background(), "google.p12", []byte("PKCS12-DATA"), "mock-password-cool")
+	assert.Nil(t, err)
+	assert.Equal(t, "CN=LDAP Client", keystore.Subject)
+
+	_, err = j.VerifyCloudLDAPKeystore(context.Background(), "google.p12", []byte("PKCS12-DATA"), "wrong-password")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,231 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	computerGroupsContext       = "computer-groups"
+	smartComputerGroupsContext  = computerGroupsContext + "/smart-groups"
+	staticComputerGroupsContext = computerGroupsContext + "/static-groups"
+)
+
+// ComputerGroups returns every smart and static computer group with its ID and name, from the client's Cache when set
+func (j *Client) ComputerGroups(ctx context.Context) ([]ComputerGroup, error) {
+	return cachedList(ctx, j, CacheComputerGroups, nil, func(ctx context.Context, _ *ListOptions) ([]ComputerGroup, error) {
+		return j.computerGroups(ctx)
+	})
+}
+
+func (j *Client) computerGroups(ctx context.Context) ([]ComputerGroup, error) {
+	ep := j.endpoint(1, computerGroupsContext)
+	res := []ComputerGroup{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer groups from %s", ep)
+	}
+	return res, nil
+}
+
+// SmartComputerGroups returns a single page of smart computer groups matching opts
+func (j *Client) SmartComputerGroups(ctx context.Context, opts *ListOptions) (*Results[SmartComputerGroup], error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(2, smartComputerGroupsContext)
+	res, err := listPage[SmartComputerGroup](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query smart computer groups from %s", ep)
+	}
+	return res, nil
+}
+
+// AllSmartComputerGroups returns every smart computer group matching opts, requesting each page in turn
+func (j *Client) AllSmartComputerGroups(ctx context.Context, opts *ListOptions) ([]SmartComputerGroup, error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(2, smartComputerGroupsContext)
+	res, err := listAll[SmartComputerGroup](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all smart computer groups from %s", ep)
+	}
+	return res, nil
+}
+
+// SmartComputerGroupDetails returns the details for a specific smart computer group given its ID
+func (j *Client) SmartComputerGroupDetails(ctx context.Context, id string) (*SmartComputerGroup, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", smartComputerGroupsContext, url.PathEscape(id)))
+	res := &SmartComputerGroup{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query smart computer group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateSmartComputerGroup will create a new smart computer group in Jamf
+func (j *Client) CreateSmartComputerGroup(ctx context.Context, content *SmartComputerGroup) (*CreatedResource, error) {
+	ep := j.endpoint(2, smartComputerGroupsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for smart computer group: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *SmartComputerGroup) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new smart computer group"), "unable to process JAMF creation request for smart computer group: (%s)", ep)
+	}
+	if err := ValidateSmartComputerGroup(content); err != nil {
+		return nil, errors.Wrapf(err, "smart computer group validation failed: %s", content.Name)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for smart computer group %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return res, nil
+}
+
+// UpdateSmartComputerGroup will replace a smart computer group in Jamf given its ID
+func (j *Client) UpdateSmartComputerGroup(ctx context.Context, id string, content *SmartComputerGroup) (*SmartComputerGroup, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", smartComputerGroupsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for smart computer group: %s (%s)", id, ep)
+	}
+	if err := ValidateSmartComputerGroup(content); err != nil {
+		return nil, errors.Wrapf(err, "smart computer group validation failed: %s", id)
+	}
+
+	res := &SmartComputerGroup{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for smart computer group: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return res, nil
+}
+
+// DeleteSmartComputerGroup will delete a smart computer group given its ID
+func (j *Client) DeleteSmartComputerGroup(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", smartComputerGroupsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for smart computer group %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return nil
+}
+
+// SmartComputerGroupMembers returns the IDs of the computers currently in a smart computer group
+func (j *Client) SmartComputerGroupMembers(ctx context.Context, id string) (ComputerGroupMemberList, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/smart-group-membership/%s", computerGroupsContext, url.PathEscape(id)))
+	res := &computerGroupMembership{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query members of smart computer group with ID %s from %s", id, ep)
+	}
+	return res.Members, nil
+}
+
+// StaticComputerGroups returns a single page of static computer groups matching opts
+func (j *Client) StaticComputerGroups(ctx context.Context, opts *ListOptions) (*Results[StaticComputerGroup], error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(2, staticComputerGroupsContext)
+	res, err := listPage[StaticComputerGroup](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query static computer groups from %s", ep)
+	}
+	return res, nil
+}
+
+// AllStaticComputerGroups returns every static computer group matching opts, requesting each page in turn
+func (j *Client) AllStaticComputerGroups(ctx context.Context, opts *ListOptions) ([]StaticComputerGroup, error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(2, staticComputerGroupsContext)
+	res, err := listAll[StaticComputerGroup](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all static computer groups from %s", ep)
+	}
+	return res, nil
+}
+
+// StaticComputerGroupDetails returns the details for a specific static computer group given its ID
+func (j *Client) StaticComputerGroupDetails(ctx context.Context, id string) (*StaticComputerGroup, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", staticComputerGroupsContext, url.PathEscape(id)))
+	res := &StaticComputerGroup{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query static computer group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateStaticComputerGroup will create a new static computer group in Jamf
+func (j *Client) CreateStaticComputerGroup(ctx context.Context, content *StaticComputerGroup) (*CreatedResource, error) {
+	ep := j.endpoint(2, staticComputerGroupsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for static computer group: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *StaticComputerGroup) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new static computer group"), "unable to process JAMF creation request for static computer group: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for static computer group %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return res, nil
+}
+
+// UpdateStaticComputerGroup will replace a static computer group in Jamf given its ID
+func (j *Client) UpdateStaticComputerGroup(ctx context.Context, id string, content *StaticComputerGroup) (*StaticComputerGroup, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", staticComputerGroupsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for static computer group: %s (%s)", id, ep)
+	}
+
+	res := &StaticComputerGroup{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for static computer group: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return res, nil
+}
+
+// DeleteStaticComputerGroup will delete a static computer group given its ID
+func (j *Client) DeleteStaticComputerGroup(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", staticComputerGroupsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for static computer group %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheComputerGroups)
+	return nil
+}
+
+// StaticComputerGroupMembers returns the IDs of the computers assigned to a static computer group
+func (j *Client) StaticComputerGroupMembers(ctx context.Context, id string) (ComputerGroupMemberList, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/static-group-membership/%s", computerGroupsContext, url.PathEscape(id)))
+	res := &computerGroupMembership{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query members of static computer group with ID %s from %s", id, ep)
+	}
+	return res.Members, nil
+}
+
+// ComputerInGroup returns true if a computer is a member of group, the smart or static membership
+// lookup is used depending on the kind of group so no inventory has to be fetched
+func (j *Client) ComputerInGroup(ctx context.Context, group ComputerGroup, computerID string) (bool, error) {
+	lookup := j.StaticComputerGroupMembers
+	if group.SmartGroup {
+		lookup = j.SmartComputerGroupMembers
+	}
+	members, err := lookup(ctx, group.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if strconv.Itoa(member) == computerID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
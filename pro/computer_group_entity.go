@@ -0,0 +1,81 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ComputerGroup is the summary of a smart or static computer group
+type ComputerGroup struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	SmartGroup bool   `json:"smartGroup"`
+}
+
+// SmartComputerGroup represents a computer group whose membership is computed from criteria
+type SmartComputerGroup struct {
+	ID              string                        `json:"id,omitempty"`
+	Name            string                        `json:"name"`
+	Description     string                        `json:"description,omitempty"`
+	Criteria        []SmartComputerGroupCriterion `json:"criteria"`
+	SiteID          string                        `json:"siteId,omitempty"`
+	MembershipCount int                           `json:"membershipCount,omitempty"`
+}
+
+// SmartComputerGroupCriterion is a single criterion of a smart computer group, AndOr joins it
+// to the previous criterion and SearchType is an operator such as "is", "like" or "greater than"
+type SmartComputerGroupCriterion struct {
+	Name         string `json:"name"`
+	Priority     int    `json:"priority"`
+	AndOr        string `json:"andOr"`
+	SearchType   string `json:"searchType"`
+	Value        string `json:"value"`
+	OpeningParen bool   `json:"openingParen"`
+	ClosingParen bool   `json:"closingParen"`
+}
+
+// StaticComputerGroup represents a computer group whose members are assigned by ID
+type StaticComputerGroup struct {
+	ID          string   `json:"id,omitempty"`
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	SiteID      string   `json:"siteId,omitempty"`
+	Count       int      `json:"count,omitempty"`
+	Assignments []string `json:"assignments,omitempty"`
+}
+
+// ComputerGroupMemberList is the list of the IDs of the computers in a smart or static computer group,
+// see Export
+type ComputerGroupMemberList []int
+
+type computerGroupMembership struct {
+	Members ComputerGroupMemberList `json:"members"`
+}
+
+// ValidateSmartComputerGroup will validate the criteria of a smart computer group before it is sent to Jamf,
+// which otherwise rejects invalid criteria without saying which one is wrong
+func ValidateSmartComputerGroup(group *SmartComputerGroup) error {
+	depth := 0
+	for i, c := range group.Criteria {
+		if c.Name == "" || c.SearchType == "" {
+			return fmt.Errorf("criterion %d of the smart computer group requires a name and a search type", i+1)
+		}
+		if i > 0 && !strings.EqualFold(c.AndOr, "and") && !strings.EqualFold(c.AndOr, "or") {
+			return fmt.Errorf("%q is not a valid criterion conjunction for %s must be either and or or", c.AndOr, c.Name)
+		}
+		if c.OpeningParen {
+			depth++
+		}
+		if c.ClosingParen {
+			if depth--; depth < 0 {
+				return fmt.Errorf("criterion %d of the smart computer group closes a parenthesis which is not opened", i+1)
+			}
+		}
+	}
+	if depth != 0 {
+		return fmt.Errorf("smart computer group criteria have %d unclosed parentheses", depth)
+	}
+	return nil
+}
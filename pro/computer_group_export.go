@@ -0,0 +1,29 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"io"
+	"strconv"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+)
+
+// Columns available to export a ComputerGroupMemberList, Jamf only returns the IDs of the members
+var (
+	ComputerGroupMemberColumnID = export.Column[int]{Header: "Computer ID", Value: strconv.Itoa}
+)
+
+// DefaultComputerGroupMemberColumns are the columns exported when none are given to ComputerGroupMemberList.Export
+var DefaultComputerGroupMemberColumns = []export.Column[int]{
+	ComputerGroupMemberColumnID,
+}
+
+// Export writes the group members to w as CSV or NDJSON, DefaultComputerGroupMemberColumns are used
+// without columns
+func (l ComputerGroupMemberList) Export(w io.Writer, format export.Format, columns ...export.Column[int]) error {
+	if len(columns) == 0 {
+		columns = DefaultComputerGroupMemberColumns
+	}
+	return export.Write(w, format, l, columns)
+}
@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportComputerGroupMembers(t *testing.T) {
+	testServer := computerGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	members, err := j.SmartComputerGroupMembers(context.Background(), "2")
+	assert.Nil(t, err)
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, members.Export(out, export.CSV))
+	assert.Equal(t, "Computer ID\n2\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, members.Export(out, export.NDJSON, pro.ComputerGroupMemberColumnID))
+	assert.Equal(t, "{\"Computer ID\":\"2\"}\n", out.String())
+}
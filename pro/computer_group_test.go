@@ -0,0 +1,194 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var COMPUTER_GROUPS_API_BASE_ENDPOINT = "/api/v1/computer-groups"
+var SMART_COMPUTER_GROUPS_API_BASE_ENDPOINT = "/api/v2/computer-groups/smart-groups"
+var STATIC_COMPUTER_GROUPS_API_BASE_ENDPOINT = "/api/v2/computer-groups/static-groups"
+
+func computerGroupsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	smart := &crudMock[pro.SmartComputerGroup]{
+		t:      t,
+		base:   SMART_COMPUTER_GROUPS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.SmartComputerGroup{
+			{ID: "1", Name: "All Managed Clients", MembershipCount: 3},
+			{ID: "2", Name: "Sonoma Laptops", Criteria: []pro.SmartComputerGroupCriterion{
+				{Name: "Operating System Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "14."},
+				{Name: "Model", Priority: 1, AndOr: "and", SearchType: "like", Value: "MacBook"},
+			}, MembershipCount: 1},
+		},
+		getID: func(x pro.SmartComputerGroup) string { return x.ID },
+		setID: func(x *pro.SmartComputerGroup, id string) { x.ID = id },
+	}
+	static := &crudMock[pro.StaticComputerGroup]{
+		t:      t,
+		base:   STATIC_COMPUTER_GROUPS_API_BASE_ENDPOINT,
+		nextID: 3,
+		items: []pro.StaticComputerGroup{
+			{ID: "3", Name: "Loaners", Count: 2, Assignments: []string{"4", "5"}},
+		},
+		getID: func(x pro.StaticComputerGroup) string { return x.ID },
+		setID: func(x *pro.StaticComputerGroup, id string) { x.ID = id },
+	}
+	mux.Handle(smart.base, smart)
+	mux.Handle(smart.base+"/", smart)
+	mux.Handle(static.base, static)
+	mux.Handle(static.base+"/", static)
+	mux.HandleFunc(COMPUTER_GROUPS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		groups := []pro.ComputerGroup{}
+		for _, group := range smart.items {
+			groups = append(groups, pro.ComputerGroup{ID: group.ID, Name: group.Name, SmartGroup: true})
+		}
+		for _, group := range static.items {
+			groups = append(groups, pro.ComputerGroup{ID: group.ID, Name: group.Name})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(groups))
+	})
+	mux.HandleFunc("/api/v2/computer-groups/smart-group-membership/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(map[string][]int{"members": {2}}))
+	})
+	mux.HandleFunc("/api/v2/computer-groups/static-group-membership/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/computer-groups/static-group-membership/")
+		members := []int{}
+		for _, group := range static.items {
+			if group.ID == id {
+				for _, assignment := range group.Assignments {
+					member, err := strconv.Atoi(assignment)
+					assert.Nil(t, err)
+					members = append(members, member)
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(map[string][]int{"members": members}))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestComputerGroups(t *testing.T) {
+	testServer := computerGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	groups, err := j.ComputerGroups(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, groups, 3)
+	assert.True(t, groups[1].SmartGroup)
+	assert.False(t, groups[2].SmartGroup)
+
+	inGroup, err := j.ComputerInGroup(context.Background(), groups[1], "2")
+	assert.Nil(t, err)
+	assert.True(t, inGroup)
+	inGroup, err = j.ComputerInGroup(context.Background(), groups[1], "4")
+	assert.Nil(t, err)
+	assert.False(t, inGroup)
+
+	inGroup, err = j.ComputerInGroup(context.Background(), groups[2], "4")
+	assert.Nil(t, err)
+	assert.True(t, inGroup)
+}
+
+func TestSmartComputerGroups(t *testing.T) {
+	testServer := computerGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	groups, err := j.AllSmartComputerGroups(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+
+	group, err := j.SmartComputerGroupDetails(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "like", group.Criteria[1].SearchType)
+
+	_, err = j.CreateSmartComputerGroup(context.Background(), &pro.SmartComputerGroup{})
+	assert.Contains(t, err.Error(), "name required")
+	created, err := j.CreateSmartComputerGroup(context.Background(), &pro.SmartComputerGroup{Name: "Sequoia", Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Operating System Version", AndOr: "and", SearchType: "like", Value: "15."},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+	_, err = j.CreateSmartComputerGroup(context.Background(), &pro.SmartComputerGroup{Name: "Unbalanced", Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Model", SearchType: "like", Value: "MacBook", OpeningParen: true},
+	}})
+	assert.Contains(t, err.Error(), "smart computer group criteria have 1 unclosed parentheses")
+
+	group.Description = "Laptops still on Sonoma"
+	updated, err := j.UpdateSmartComputerGroup(context.Background(), "2", group)
+	assert.Nil(t, err)
+	assert.Equal(t, "Laptops still on Sonoma", updated.Description)
+
+	members, err := j.SmartComputerGroupMembers(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, pro.ComputerGroupMemberList{2}, members)
+
+	assert.Nil(t, j.DeleteSmartComputerGroup(context.Background(), "3"))
+	_, err = j.SmartComputerGroupDetails(context.Background(), "3")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestStaticComputerGroups(t *testing.T) {
+	testServer := computerGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.StaticComputerGroups(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+
+	created, err := j.CreateStaticComputerGroup(context.Background(), &pro.StaticComputerGroup{Name: "Kiosks", Assignments: []string{"1"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "4", created.ID)
+
+	group, err := j.StaticComputerGroupDetails(context.Background(), created.ID)
+	assert.Nil(t, err)
+	group.Assignments = append(group.Assignments, "2")
+	_, err = j.UpdateStaticComputerGroup(context.Background(), created.ID, group)
+	assert.Nil(t, err)
+
+	members, err := j.StaticComputerGroupMembers(context.Background(), created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.ComputerGroupMemberList{1, 2}, members)
+
+	assert.Nil(t, j.DeleteStaticComputerGroup(context.Background(), created.ID))
+	_, err = j.StaticComputerGroupDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestValidateSmartComputerGroup(t *testing.T) {
+	err := pro.ValidateSmartComputerGroup(&pro.SmartComputerGroup{Criteria: []pro.SmartComputerGroupCriterion{{Name: "Model"}}})
+	assert.Equal(t, "criterion 1 of the smart computer group requires a name and a search type", err.Error())
+
+	err = pro.ValidateSmartComputerGroup(&pro.SmartComputerGroup{Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Model", SearchType: "like", AndOr: "and"},
+		{Name: "Serial Number", SearchType: "is", AndOr: "xor"},
+	}})
+	assert.Equal(t, `"xor" is not a valid criterion conjunction for Serial Number must be either and or or`, err.Error())
+
+	err = pro.ValidateSmartComputerGroup(&pro.SmartComputerGroup{Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Model", SearchType: "like", AndOr: "and", ClosingParen: true},
+	}})
+	assert.Equal(t, "criterion 1 of the smart computer group closes a parenthesis which is not opened", err.Error())
+
+	assert.Nil(t, pro.ValidateSmartComputerGroup(&pro.SmartComputerGroup{Criteria: []pro.SmartComputerGroupCriterion{
+		{Name: "Model", SearchType: "like", AndOr: "and", OpeningParen: true},
+		{Name: "Serial Number", SearchType: "is", AndOr: "or", ClosingParen: true},
+	}}))
+}
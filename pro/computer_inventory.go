@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	computersInventoryContext       = "computers-inventory"
+	computersInventoryDetailContext = "computers-inventory-detail"
+)
+
+// ComputersInventory returns a single page of computers matching opts, use the RSQL filter
+// and sort options to have Jamf narrow down results server side. Only the GENERAL section is
+// returned unless other sections are requested.
+func (j *Client) ComputersInventory(ctx context.Context, opts *ListOptions, sections ...ComputerInventorySection) (*Results[ComputerInventory], error) {
+	opts = j.siteScoped(opts, F("general.site.id"))
+	ep := j.endpoint(1, computersInventoryContext)
+	params := opts.values()
+	for key, values := range sectionValues(sections) {
+		params[key] = values
+	}
+	res, err := listPage[ComputerInventory](ctx, j, ep, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query computers inventory from %s", ep)
+	}
+	return res, nil
+}
+
+// AllComputersInventory returns every computer matching opts, requesting each page in turn
+func (j *Client) AllComputersInventory(ctx context.Context, opts *ListOptions, sections ...ComputerInventorySection) ([]ComputerInventory, error) {
+	opts = j.siteScoped(opts, F("general.site.id"))
+	ep := j.endpoint(1, computersInventoryContext)
+	res, err := listAll[ComputerInventory](ctx, j, ep, opts, sectionValues(sections))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all computers inventory from %s", ep)
+	}
+	return res, nil
+}
+
+// IterComputersInventory yields every computer matching opts, requesting the next page once the previous
+// one has been consumed so that large fleets are not held in memory. An error ends the iteration.
+func (j *Client) IterComputersInventory(ctx context.Context, opts *ListOptions, sections ...ComputerInventorySection) iter.Seq2[ComputerInventory, error] {
+	opts = j.siteScoped(opts, F("general.site.id"))
+	return iterAll[ComputerInventory](ctx, j, j.endpoint(1, computersInventoryContext), opts, sectionValues(sections))
+}
+
+// ComputerInventoryDetails returns the inventory for a specific computer given its ID, limited to
+// the requested sections
+func (j *Client) ComputerInventoryDetails(ctx context.Context, id string, sections ...ComputerInventorySection) (*ComputerInventory, error) {
+	ep := withQuery(j.endpoint(1, fmt.Sprintf("%s/%s", computersInventoryContext, url.PathEscape(id))), sectionValues(sections))
+	res := &ComputerInventory{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer inventory with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// UpdateComputerInventory updates the inventory record for a specific computer given its ID, e.g. its
+// asset tag, user and location, or extension attribute values, returning the updated record
+func (j *Client) UpdateComputerInventory(ctx context.Context, id string, patch *ComputerInventoryUpdate) (*ComputerInventory, error) {
+	if patch == nil {
+		return nil, fmt.Errorf("empty payload for computer inventory update: %s", id)
+	}
+
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", computersInventoryDetailContext, url.PathEscape(id)))
+	res := &ComputerInventory{}
+	if err := j.do(ctx, "PATCH", ep, patch, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for computer inventory with ID %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteComputer will delete a computer and its inventory record given its ID
+func (j *Client) DeleteComputer(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", computersInventoryContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for computer %s from %s", id, ep)
+	}
+	return nil
+}
+
+// UploadComputerAttachment attaches a file to the inventory record of a computer given its ID, the file is
+// streamed to Jamf rather than buffered in memory
+func (j *Client) UploadComputerAttachment(ctx context.Context, id string, filename string, file io.Reader) (*CreatedResource, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/attachments", computersInventoryContext, url.PathEscape(id)))
+	if file == nil {
+		return nil, fmt.Errorf("a file is required to attach to computer %s (%s)", id, ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.upload(ctx, ep, "file", filename, file, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to attach %s to computer with ID %s on %s", filename, id, ep)
+	}
+	return res, nil
+}
+
+// DownloadComputerAttachment streams a file attached to the inventory record of a computer to w,
+// attachment IDs are listed in the ATTACHMENTS section of the computer's inventory
+func (j *Client) DownloadComputerAttachment(ctx context.Context, id string, attachmentID string, w io.Writer) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/attachments/%s", computersInventoryContext, url.PathEscape(id), url.PathEscape(attachmentID)))
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/octet-stream")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to download attachment %s of computer with ID %s from %s", attachmentID, id, ep)
+	}
+	return nil
+}
+
+// DeleteComputerAttachment will delete a file attached to the inventory record of a computer
+func (j *Client) DeleteComputerAttachment(ctx context.Context, id string, attachmentID string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/attachments/%s", computersInventoryContext, url.PathEscape(id), url.PathEscape(attachmentID)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for attachment %s of computer with ID %s from %s", attachmentID, id, ep)
+	}
+	return nil
+}
+
+// ComputerRecoveryLockPassword returns the Recovery Lock password of a computer with Apple silicon
+// given its ID. Jamf records each view in the computer's history under the authenticated account.
+func (j *Client) ComputerRecoveryLockPassword(ctx context.Context, id string) (string, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/view-recovery-lock-password", computersInventoryContext, url.PathEscape(id)))
+	res := &computerRecoveryLockPassword{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return "", errors.Wrapf(err, "unable to query recovery lock password for computer with ID %s from %s", id, ep)
+	}
+	return res.RecoveryLockPassword, nil
+}
+
+// ComputersFileVault returns a single page of FileVault details, including personal recovery
+// keys, for the computers that have FileVault enabled
+func (j *Client) ComputersFileVault(ctx context.Context, opts *ListOptions) (*Results[ComputerFileVault], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/filevault", computersInventoryContext))
+	res, err := listPage[ComputerFileVault](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query computers FileVault details from %s", ep)
+	}
+	return res, nil
+}
+
+// AllComputersFileVault returns the FileVault details of every computer with FileVault enabled,
+// requesting each page in turn
+func (j *Client) AllComputersFileVault(ctx context.Context, opts *ListOptions) ([]ComputerFileVault, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/filevault", computersInventoryContext))
+	res, err := listAll[ComputerFileVault](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all computers FileVault details from %s", ep)
+	}
+	return res, nil
+}
+
+// ComputerFileVault returns the FileVault details, including the personal recovery key, of
+// a computer given its ID. Jamf records each view in the computer's history.
+func (j *Client) ComputerFileVault(ctx context.Context, id string) (*ComputerFileVault, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/filevault", computersInventoryContext, url.PathEscape(id)))
+	res := &ComputerFileVault{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query FileVault details for computer with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
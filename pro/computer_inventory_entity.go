@@ -0,0 +1,432 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// ComputerInventorySection is a section of a computer's inventory which can be requested
+type ComputerInventorySection string
+
+// Sections of a computer's inventory, Jamf only returns GENERAL when no section is requested
+const (
+	ComputerInventorySectionGeneral               ComputerInventorySection = "GENERAL"
+	ComputerInventorySectionDiskEncryption        ComputerInventorySection = "DISK_ENCRYPTION"
+	ComputerInventorySectionPurchasing            ComputerInventorySection = "PURCHASING"
+	ComputerInventorySectionApplications          ComputerInventorySection = "APPLICATIONS"
+	ComputerInventorySectionStorage               ComputerInventorySection = "STORAGE"
+	ComputerInventorySectionUserAndLocation       ComputerInventorySection = "USER_AND_LOCATION"
+	ComputerInventorySectionConfigurationProfiles ComputerInventorySection = "CONFIGURATION_PROFILES"
+	ComputerInventorySectionPrinters              ComputerInventorySection = "PRINTERS"
+	ComputerInventorySectionServices              ComputerInventorySection = "SERVICES"
+	ComputerInventorySectionHardware              ComputerInventorySection = "HARDWARE"
+	ComputerInventorySectionLocalUserAccounts     ComputerInventorySection = "LOCAL_USER_ACCOUNTS"
+	ComputerInventorySectionCertificates          ComputerInventorySection = "CERTIFICATES"
+	ComputerInventorySectionAttachments           ComputerInventorySection = "ATTACHMENTS"
+	ComputerInventorySectionPlugins               ComputerInventorySection = "PLUGINS"
+	ComputerInventorySectionPackageReceipts       ComputerInventorySection = "PACKAGE_RECEIPTS"
+	ComputerInventorySectionFonts                 ComputerInventorySection = "FONTS"
+	ComputerInventorySectionSecurity              ComputerInventorySection = "SECURITY"
+	ComputerInventorySectionOperatingSystem       ComputerInventorySection = "OPERATING_SYSTEM"
+	ComputerInventorySectionLicensedSoftware      ComputerInventorySection = "LICENSED_SOFTWARE"
+	ComputerInventorySectionSoftwareUpdates       ComputerInventorySection = "SOFTWARE_UPDATES"
+	ComputerInventorySectionExtensionAttributes   ComputerInventorySection = "EXTENSION_ATTRIBUTES"
+	ComputerInventorySectionGroupMemberships      ComputerInventorySection = "GROUP_MEMBERSHIPS"
+)
+
+// ComputerInventory represents a computer returned by the Jamf Pro computers-inventory endpoints,
+// only the sections which were requested are populated
+type ComputerInventory struct {
+	ID                    string                              `json:"id"`
+	UDID                  string                              `json:"udid,omitempty"`
+	General               *ComputerInventoryGeneral           `json:"general,omitempty"`
+	DiskEncryption        *ComputerInventoryDiskEncryption    `json:"diskEncryption,omitempty"`
+	Purchasing            *ComputerInventoryPurchasing        `json:"purchasing,omitempty"`
+	Applications          []ComputerInventoryApplication      `json:"applications,omitempty"`
+	Storage               *ComputerInventoryStorage           `json:"storage,omitempty"`
+	UserAndLocation       *ComputerInventoryUserAndLocation   `json:"userAndLocation,omitempty"`
+	ConfigurationProfiles []ComputerInventoryConfigProfile    `json:"configurationProfiles,omitempty"`
+	Printers              []ComputerInventoryPrinter          `json:"printers,omitempty"`
+	Services              []ComputerInventoryService          `json:"services,omitempty"`
+	Hardware              *ComputerInventoryHardware          `json:"hardware,omitempty"`
+	LocalUserAccounts     []ComputerInventoryLocalUserAccount `json:"localUserAccounts,omitempty"`
+	Certificates          []ComputerInventoryCertificate      `json:"certificates,omitempty"`
+	Attachments           []ComputerInventoryAttachment       `json:"attachments,omitempty"`
+	Plugins               []ComputerInventoryPlugin           `json:"plugins,omitempty"`
+	PackageReceipts       *ComputerInventoryPackageReceipts   `json:"packageReceipts,omitempty"`
+	Fonts                 []ComputerInventoryFont             `json:"fonts,omitempty"`
+	Security              *ComputerInventorySecurity          `json:"security,omitempty"`
+	OperatingSystem       *ComputerInventoryOperatingSystem   `json:"operatingSystem,omitempty"`
+	LicensedSoftware      []ComputerInventoryLicensedSoftware `json:"licensedSoftware,omitempty"`
+	SoftwareUpdates       []ComputerInventorySoftwareUpdate   `json:"softwareUpdates,omitempty"`
+	ExtensionAttributes   []ExtensionAttribute                `json:"extensionAttributes,omitempty"`
+	GroupMemberships      []ComputerInventoryGroupMembership  `json:"groupMemberships,omitempty"`
+}
+
+// ComputerInventoryGeneral holds the GENERAL section of a computer's inventory
+type ComputerInventoryGeneral struct {
+	Name                                 string               `json:"name"`
+	LastIPAddress                        string               `json:"lastIpAddress,omitempty"`
+	LastReportedIP                       string               `json:"lastReportedIp,omitempty"`
+	JamfBinaryVersion                    string               `json:"jamfBinaryVersion,omitempty"`
+	Platform                             string               `json:"platform,omitempty"`
+	Barcode1                             string               `json:"barcode1,omitempty"`
+	Barcode2                             string               `json:"barcode2,omitempty"`
+	AssetTag                             string               `json:"assetTag,omitempty"`
+	RemoteManagement                     *RemoteManagement    `json:"remoteManagement,omitempty"`
+	Supervised                           bool                 `json:"supervised"`
+	MDMCapable                           *MDMCapability       `json:"mdmCapable,omitempty"`
+	ReportDate                           string               `json:"reportDate,omitempty"`
+	LastContactTime                      string               `json:"lastContactTime,omitempty"`
+	LastCloudBackupDate                  string               `json:"lastCloudBackupDate,omitempty"`
+	LastEnrolledDate                     string               `json:"lastEnrolledDate,omitempty"`
+	MDMProfileExpiration                 string               `json:"mdmProfileExpiration,omitempty"`
+	InitialEntryDate                     string               `json:"initialEntryDate,omitempty"`
+	DistributionPoint                    string               `json:"distributionPoint,omitempty"`
+	EnrollmentMethod                     *EnrollmentMethod    `json:"enrollmentMethod,omitempty"`
+	Site                                 *Site                `json:"site,omitempty"`
+	ITunesStoreAccountActive             bool                 `json:"itunesStoreAccountActive"`
+	EnrolledViaAutomatedDeviceEnrollment bool                 `json:"enrolledViaAutomatedDeviceEnrollment"`
+	UserApprovedMDM                      bool                 `json:"userApprovedMdm"`
+	DeclarativeDeviceManagementEnabled   bool                 `json:"declarativeDeviceManagementEnabled"`
+	ExtensionAttributes                  []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+	ManagementID                         string               `json:"managementId,omitempty"`
+}
+
+// RemoteManagement holds whether a device is managed and by which management account
+type RemoteManagement struct {
+	Managed            bool   `json:"managed"`
+	ManagementUsername string `json:"managementUsername,omitempty"`
+}
+
+// MDMCapability holds whether a device, and which of its users, can be managed through MDM
+type MDMCapability struct {
+	Capable      bool     `json:"capable"`
+	CapableUsers []string `json:"capableUsers,omitempty"`
+}
+
+// EnrollmentMethod holds the object (e.g. a PreStage) a device was enrolled through
+type EnrollmentMethod struct {
+	ID         string `json:"id,omitempty"`
+	ObjectName string `json:"objectName,omitempty"`
+	ObjectType string `json:"objectType,omitempty"`
+}
+
+// ExtensionAttribute holds the value of an extension attribute for a device or user
+type ExtensionAttribute struct {
+	DefinitionID string   `json:"definitionId"`
+	Name         string   `json:"name,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	Enabled      bool     `json:"enabled,omitempty"`
+	MultiValue   bool     `json:"multiValue,omitempty"`
+	Values       []string `json:"values"`
+	DataType     string   `json:"dataType,omitempty"`
+	Options      []string `json:"options,omitempty"`
+	InputType    string   `json:"inputType,omitempty"`
+}
+
+// ComputerInventoryDiskEncryption holds the DISK_ENCRYPTION section of a computer's inventory
+type ComputerInventoryDiskEncryption struct {
+	BootPartitionEncryptionDetails      *ComputerInventoryPartitionEncryption `json:"bootPartitionEncryptionDetails,omitempty"`
+	IndividualRecoveryKeyValidityStatus string                                `json:"individualRecoveryKeyValidityStatus,omitempty"`
+	InstitutionalRecoveryKeyPresent     bool                                  `json:"institutionalRecoveryKeyPresent"`
+	DiskEncryptionConfigurationName     string                                `json:"diskEncryptionConfigurationName,omitempty"`
+	FileVault2EnabledUserNames          []string                              `json:"fileVault2EnabledUserNames,omitempty"`
+	FileVault2EligibilityMessage        string                                `json:"fileVault2EligibilityMessage,omitempty"`
+}
+
+// ComputerInventoryPartitionEncryption holds the encryption state of a single partition
+type ComputerInventoryPartitionEncryption struct {
+	PartitionName              string `json:"partitionName,omitempty"`
+	PartitionFileVault2State   string `json:"partitionFileVault2State,omitempty"`
+	PartitionFileVault2Percent int    `json:"partitionFileVault2Percent"`
+}
+
+// ComputerInventoryPurchasing holds the PURCHASING section of a computer's inventory
+type ComputerInventoryPurchasing struct {
+	Leased              bool                 `json:"leased"`
+	Purchased           bool                 `json:"purchased"`
+	PONumber            string               `json:"poNumber,omitempty"`
+	PODate              string               `json:"poDate,omitempty"`
+	Vendor              string               `json:"vendor,omitempty"`
+	WarrantyDate        string               `json:"warrantyDate,omitempty"`
+	AppleCareID         string               `json:"appleCareId,omitempty"`
+	LeaseDate           string               `json:"leaseDate,omitempty"`
+	PurchasePrice       string               `json:"purchasePrice,omitempty"`
+	LifeExpectancy      int                  `json:"lifeExpectancy,omitempty"`
+	PurchasingAccount   string               `json:"purchasingAccount,omitempty"`
+	PurchasingContact   string               `json:"purchasingContact,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryApplication holds an application from the APPLICATIONS section of a computer's inventory
+type ComputerInventoryApplication struct {
+	Name              string `json:"name"`
+	Path              string `json:"path,omitempty"`
+	Version           string `json:"version,omitempty"`
+	MacAppStore       bool   `json:"macAppStore"`
+	SizeMegabytes     int    `json:"sizeMegabytes,omitempty"`
+	BundleID          string `json:"bundleId,omitempty"`
+	UpdateAvailable   bool   `json:"updateAvailable"`
+	ExternalVersionID string `json:"externalVersionId,omitempty"`
+}
+
+// ComputerInventoryStorage holds the STORAGE section of a computer's inventory
+type ComputerInventoryStorage struct {
+	BootDriveAvailableSpaceMegabytes int                     `json:"bootDriveAvailableSpaceMegabytes,omitempty"`
+	Disks                            []ComputerInventoryDisk `json:"disks,omitempty"`
+}
+
+// ComputerInventoryDisk holds a single disk attached to a computer
+type ComputerInventoryDisk struct {
+	ID            string                       `json:"id,omitempty"`
+	Device        string                       `json:"device,omitempty"`
+	Model         string                       `json:"model,omitempty"`
+	Revision      string                       `json:"revision,omitempty"`
+	SerialNumber  string                       `json:"serialNumber,omitempty"`
+	SizeMegabytes int                          `json:"sizeMegabytes,omitempty"`
+	SmartStatus   string                       `json:"smartStatus,omitempty"`
+	Type          string                       `json:"type,omitempty"`
+	Partitions    []ComputerInventoryPartition `json:"partitions,omitempty"`
+}
+
+// ComputerInventoryPartition holds a single partition of a disk
+type ComputerInventoryPartition struct {
+	Name                      string `json:"name"`
+	SizeMegabytes             int    `json:"sizeMegabytes,omitempty"`
+	AvailableMegabytes        int    `json:"availableMegabytes,omitempty"`
+	PartitionType             string `json:"partitionType,omitempty"`
+	PercentUsed               int    `json:"percentUsed,omitempty"`
+	FileVault2State           string `json:"fileVault2State,omitempty"`
+	FileVault2ProgressPercent int    `json:"fileVault2ProgressPercent,omitempty"`
+	LVMManaged                bool   `json:"lvmManaged"`
+}
+
+// ComputerInventoryUserAndLocation holds the USER_AND_LOCATION section of a computer's inventory
+type ComputerInventoryUserAndLocation struct {
+	Username            string               `json:"username,omitempty"`
+	RealName            string               `json:"realname,omitempty"`
+	Email               string               `json:"email,omitempty"`
+	Position            string               `json:"position,omitempty"`
+	Phone               string               `json:"phone,omitempty"`
+	DepartmentID        string               `json:"departmentId,omitempty"`
+	BuildingID          string               `json:"buildingId,omitempty"`
+	Room                string               `json:"room,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryConfigProfile holds a configuration profile installed on a computer
+type ComputerInventoryConfigProfile struct {
+	ID                string `json:"id,omitempty"`
+	Username          string `json:"username,omitempty"`
+	LastInstalled     string `json:"lastInstalled,omitempty"`
+	Removable         bool   `json:"removable"`
+	DisplayName       string `json:"displayName,omitempty"`
+	ProfileIdentifier string `json:"profileIdentifier,omitempty"`
+}
+
+// ComputerInventoryPrinter holds a printer mapped on a computer
+type ComputerInventoryPrinter struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"`
+	URI      string `json:"uri,omitempty"`
+	Location string `json:"location,omitempty"`
+}
+
+// ComputerInventoryService holds a service running on a computer
+type ComputerInventoryService struct {
+	Name string `json:"name"`
+}
+
+// ComputerInventoryHardware holds the HARDWARE section of a computer's inventory
+type ComputerInventoryHardware struct {
+	Make                   string               `json:"make,omitempty"`
+	Model                  string               `json:"model,omitempty"`
+	ModelIdentifier        string               `json:"modelIdentifier,omitempty"`
+	SerialNumber           string               `json:"serialNumber,omitempty"`
+	ProcessorSpeedMhz      int                  `json:"processorSpeedMhz,omitempty"`
+	ProcessorCount         int                  `json:"processorCount,omitempty"`
+	CoreCount              int                  `json:"coreCount,omitempty"`
+	ProcessorType          string               `json:"processorType,omitempty"`
+	ProcessorArchitecture  string               `json:"processorArchitecture,omitempty"`
+	BusSpeedMhz            int                  `json:"busSpeedMhz,omitempty"`
+	CacheSizeKilobytes     int                  `json:"cacheSizeKilobytes,omitempty"`
+	NetworkAdapterType     string               `json:"networkAdapterType,omitempty"`
+	MACAddress             string               `json:"macAddress,omitempty"`
+	AltNetworkAdapterType  string               `json:"altNetworkAdapterType,omitempty"`
+	AltMACAddress          string               `json:"altMacAddress,omitempty"`
+	TotalRAMMegabytes      int                  `json:"totalRamMegabytes,omitempty"`
+	OpenRAMSlots           int                  `json:"openRamSlots,omitempty"`
+	BatteryCapacityPercent int                  `json:"batteryCapacityPercent,omitempty"`
+	SMCVersion             string               `json:"smcVersion,omitempty"`
+	NICSpeed               string               `json:"nicSpeed,omitempty"`
+	OpticalDrive           string               `json:"opticalDrive,omitempty"`
+	BootROM                string               `json:"bootRom,omitempty"`
+	BleCapable             bool                 `json:"bleCapable"`
+	SupportsIOSAppInstalls bool                 `json:"supportsIosAppInstalls"`
+	AppleSilicon           bool                 `json:"appleSilicon"`
+	ExtensionAttributes    []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryLocalUserAccount holds a local user account on a computer
+type ComputerInventoryLocalUserAccount struct {
+	UID                            string `json:"uid,omitempty"`
+	UserGUID                       string `json:"userGuid,omitempty"`
+	Username                       string `json:"username"`
+	FullName                       string `json:"fullName,omitempty"`
+	Admin                          bool   `json:"admin"`
+	HomeDirectory                  string `json:"homeDirectory,omitempty"`
+	HomeDirectorySizeMb            int    `json:"homeDirectorySizeMb,omitempty"`
+	FileVault2Enabled              bool   `json:"fileVault2Enabled"`
+	UserAccountType                string `json:"userAccountType,omitempty"`
+	PasswordMinLength              int    `json:"passwordMinLength,omitempty"`
+	PasswordMaxAge                 int    `json:"passwordMaxAge,omitempty"`
+	PasswordMinComplexCharacters   int    `json:"passwordMinComplexCharacters,omitempty"`
+	PasswordHistoryDepth           int    `json:"passwordHistoryDepth,omitempty"`
+	PasswordRequireAlphanumeric    bool   `json:"passwordRequireAlphanumeric"`
+	ComputerAzureActiveDirectoryID string `json:"computerAzureActiveDirectoryId,omitempty"`
+	UserAzureActiveDirectoryID     string `json:"userAzureActiveDirectoryId,omitempty"`
+	AzureActiveDirectoryID         string `json:"azureActiveDirectoryId,omitempty"`
+}
+
+// ComputerInventoryCertificate holds a certificate installed on a computer
+type ComputerInventoryCertificate struct {
+	CommonName        string `json:"commonName,omitempty"`
+	Identity          bool   `json:"identity"`
+	ExpirationDate    string `json:"expirationDate,omitempty"`
+	Username          string `json:"username,omitempty"`
+	LifecycleStatus   string `json:"lifecycleStatus,omitempty"`
+	CertificateStatus string `json:"certificateStatus,omitempty"`
+	SubjectName       string `json:"subjectName,omitempty"`
+	SerialNumber      string `json:"serialNumber,omitempty"`
+	SHA1Fingerprint   string `json:"sha1Fingerprint,omitempty"`
+	IssuedDate        string `json:"issuedDate,omitempty"`
+}
+
+// ComputerInventoryAttachment holds a file attached to a computer's inventory record
+type ComputerInventoryAttachment struct {
+	ID        string `json:"id"`
+	Name      string `json:"name,omitempty"`
+	FileType  string `json:"fileType,omitempty"`
+	SizeBytes int64  `json:"sizeBytes,omitempty"`
+}
+
+// ComputerInventoryPlugin holds a plugin installed on a computer
+type ComputerInventoryPlugin struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// ComputerInventoryPackageReceipts holds the PACKAGE_RECEIPTS section of a computer's inventory
+type ComputerInventoryPackageReceipts struct {
+	InstalledByJamfPro      []string `json:"installedByJamfPro,omitempty"`
+	InstalledByInstallerSwu []string `json:"installedByInstallerSwu,omitempty"`
+	Cached                  []string `json:"cached,omitempty"`
+}
+
+// ComputerInventoryFont holds a font installed on a computer
+type ComputerInventoryFont struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// ComputerInventorySecurity holds the SECURITY section of a computer's inventory
+type ComputerInventorySecurity struct {
+	SIPStatus             string `json:"sipStatus,omitempty"`
+	GatekeeperStatus      string `json:"gatekeeperStatus,omitempty"`
+	XprotectVersion       string `json:"xprotectVersion,omitempty"`
+	AutoLoginDisabled     bool   `json:"autoLoginDisabled"`
+	RemoteDesktopEnabled  bool   `json:"remoteDesktopEnabled"`
+	ActivationLockEnabled bool   `json:"activationLockEnabled"`
+	RecoveryLockEnabled   bool   `json:"recoveryLockEnabled"`
+	FirewallEnabled       bool   `json:"firewallEnabled"`
+	SecureBootLevel       string `json:"secureBootLevel,omitempty"`
+	ExternalBootLevel     string `json:"externalBootLevel,omitempty"`
+	BootstrapTokenAllowed bool   `json:"bootstrapTokenAllowed"`
+}
+
+// ComputerInventoryOperatingSystem holds the OPERATING_SYSTEM section of a computer's inventory
+type ComputerInventoryOperatingSystem struct {
+	Name                     string               `json:"name,omitempty"`
+	Version                  string               `json:"version,omitempty"`
+	Build                    string               `json:"build,omitempty"`
+	SupplementalBuildVersion string               `json:"supplementalBuildVersion,omitempty"`
+	RapidSecurityResponse    string               `json:"rapidSecurityResponse,omitempty"`
+	ActiveDirectoryStatus    string               `json:"activeDirectoryStatus,omitempty"`
+	FileVault2Status         string               `json:"fileVault2Status,omitempty"`
+	SoftwareUpdateDeviceID   string               `json:"softwareUpdateDeviceId,omitempty"`
+	ExtensionAttributes      []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryLicensedSoftware holds a licensed software title found on a computer
+type ComputerInventoryLicensedSoftware struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// ComputerInventorySoftwareUpdate holds a software update available to a computer
+type ComputerInventorySoftwareUpdate struct {
+	Name        string `json:"name"`
+	Version     string `json:"version,omitempty"`
+	PackageName string `json:"packageName,omitempty"`
+}
+
+// ComputerInventoryGroupMembership holds a computer group the computer is a member of
+type ComputerInventoryGroupMembership struct {
+	GroupID    string `json:"groupId"`
+	GroupName  string `json:"groupName,omitempty"`
+	SmartGroup bool   `json:"smartGroup"`
+}
+
+// ComputerInventoryUpdate holds the fields to change on a computer's inventory record, only fields
+// which are set are sent so anything left empty is unchanged
+type ComputerInventoryUpdate struct {
+	UDID                string                                  `json:"udid,omitempty"`
+	General             *ComputerInventoryGeneralUpdate         `json:"general,omitempty"`
+	Purchasing          *ComputerInventoryPurchasing            `json:"purchasing,omitempty"`
+	UserAndLocation     *ComputerInventoryUserAndLocation       `json:"userAndLocation,omitempty"`
+	Hardware            *ComputerInventoryHardwareUpdate        `json:"hardware,omitempty"`
+	OperatingSystem     *ComputerInventoryOperatingSystemUpdate `json:"operatingSystem,omitempty"`
+	ExtensionAttributes []ExtensionAttribute                    `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryGeneralUpdate holds the writable fields of the GENERAL section
+type ComputerInventoryGeneralUpdate struct {
+	Name                string               `json:"name,omitempty"`
+	LastIPAddress       string               `json:"lastIpAddress,omitempty"`
+	Barcode1            string               `json:"barcode1,omitempty"`
+	Barcode2            string               `json:"barcode2,omitempty"`
+	AssetTag            string               `json:"assetTag,omitempty"`
+	SiteID              string               `json:"siteId,omitempty"`
+	ManagementUsername  string               `json:"managementUsername,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryHardwareUpdate holds the writable fields of the HARDWARE section
+type ComputerInventoryHardwareUpdate struct {
+	NetworkAdapterType    string               `json:"networkAdapterType,omitempty"`
+	MACAddress            string               `json:"macAddress,omitempty"`
+	AltNetworkAdapterType string               `json:"altNetworkAdapterType,omitempty"`
+	AltMACAddress         string               `json:"altMacAddress,omitempty"`
+	ExtensionAttributes   []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// ComputerInventoryOperatingSystemUpdate holds the writable fields of the OPERATING_SYSTEM section
+type ComputerInventoryOperatingSystemUpdate struct {
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+type computerRecoveryLockPassword struct {
+	RecoveryLockPassword string `json:"recoveryLockPassword"`
+}
+
+// ComputerFileVault holds the FileVault details of a computer including its escrowed
+// personal recovery key
+type ComputerFileVault struct {
+	ComputerID                          string                                `json:"computerId"`
+	Name                                string                                `json:"name,omitempty"`
+	PersonalRecoveryKey                 string                                `json:"personalRecoveryKey,omitempty"`
+	BootPartitionEncryptionDetails      *ComputerInventoryPartitionEncryption `json:"bootPartitionEncryptionDetails,omitempty"`
+	IndividualRecoveryKeyValidityStatus string                                `json:"individualRecoveryKeyValidityStatus,omitempty"`
+	InstitutionalRecoveryKeyPresent     bool                                  `json:"institutionalRecoveryKeyPresent"`
+	DiskEncryptionConfigurationName     string                                `json:"diskEncryptionConfigurationName,omitempty"`
+}
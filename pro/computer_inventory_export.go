@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/pkg/errors"
+)
+
+// ComputerInventoryColumn is a column of a computers inventory export, Value reads the column from a
+// computer whose inventory includes Section
+type ComputerInventoryColumn struct {
+	export.Column[ComputerInventory]
+	Section ComputerInventorySection
+}
+
+func computerInventoryColumn(header string, section ComputerInventorySection, value func(c ComputerInventory) string) ComputerInventoryColumn {
+	return ComputerInventoryColumn{export.Column[ComputerInventory]{Header: header, Value: value}, section}
+}
+
+// Columns commonly exported from computers inventory
+var (
+	ComputerInventoryColumnID = computerInventoryColumn("ID", ComputerInventorySectionGeneral, func(c ComputerInventory) string {
+		return c.ID
+	})
+	ComputerInventoryColumnName = computerInventoryColumn("Name", ComputerInventorySectionGeneral, func(c ComputerInventory) string {
+		if c.General == nil {
+			return ""
+		}
+		return c.General.Name
+	})
+	ComputerInventoryColumnAssetTag = computerInventoryColumn("Asset Tag", ComputerInventorySectionGeneral, func(c ComputerInventory) string {
+		if c.General == nil {
+			return ""
+		}
+		return c.General.AssetTag
+	})
+	ComputerInventoryColumnLastContactTime = computerInventoryColumn("Last Check-in", ComputerInventorySectionGeneral, func(c ComputerInventory) string {
+		if c.General == nil {
+			return ""
+		}
+		return c.General.LastContactTime
+	})
+	ComputerInventoryColumnSerialNumber = computerInventoryColumn("Serial Number", ComputerInventorySectionHardware, func(c ComputerInventory) string {
+		if c.Hardware == nil {
+			return ""
+		}
+		return c.Hardware.SerialNumber
+	})
+	ComputerInventoryColumnModel = computerInventoryColumn("Model", ComputerInventorySectionHardware, func(c ComputerInventory) string {
+		if c.Hardware == nil {
+			return ""
+		}
+		return c.Hardware.Model
+	})
+	ComputerInventoryColumnOSVersion = computerInventoryColumn("Operating System Version", ComputerInventorySectionOperatingSystem, func(c ComputerInventory) string {
+		if c.OperatingSystem == nil {
+			return ""
+		}
+		return c.OperatingSystem.Version
+	})
+	ComputerInventoryColumnUsername = computerInventoryColumn("Username", ComputerInventorySectionUserAndLocation, func(c ComputerInventory) string {
+		if c.UserAndLocation == nil {
+			return ""
+		}
+		return c.UserAndLocation.Username
+	})
+	ComputerInventoryColumnEmail = computerInventoryColumn("Email Address", ComputerInventorySectionUserAndLocation, func(c ComputerInventory) string {
+		if c.UserAndLocation == nil {
+			return ""
+		}
+		return c.UserAndLocation.Email
+	})
+)
+
+// ComputerInventoryExtensionAttributeColumn returns the column holding the value of an extension attribute
+// given its name, multiple values are joined with commas
+func ComputerInventoryExtensionAttributeColumn(name string) ComputerInventoryColumn {
+	return computerInventoryColumn(name, ComputerInventorySectionExtensionAttributes, func(c ComputerInventory) string {
+		for _, attribute := range c.ExtensionAttributes {
+			if attribute.Name == name {
+				return strings.Join(attribute.Values, ",")
+			}
+		}
+		return ""
+	})
+}
+
+// ExportComputersInventory writes the computers matching opts to w in the given format, with a header
+// row followed by one row per computer as CSV. Jamf has no export for computers inventory so results
+// are requested page by page, only fetching the sections the columns need, and written as they arrive.
+func (j *Client) ExportComputersInventory(ctx context.Context, opts *ListOptions, w io.Writer, format export.Format, columns ...ComputerInventoryColumn) error {
+	ep := j.endpoint(1, computersInventoryContext)
+	if len(columns) == 0 {
+		return fmt.Errorf("at least one column is required to export computers inventory from %s", ep)
+	}
+
+	var sections []ComputerInventorySection
+	exported := make([]export.Column[ComputerInventory], len(columns))
+	for i, column := range columns {
+		exported[i] = column.Column
+		if !containsSection(sections, column.Section) {
+			sections = append(sections, column.Section)
+		}
+	}
+	writer, err := export.NewWriter(w, format, exported)
+	if err != nil {
+		return errors.Wrap(err, "unable to export computers inventory")
+	}
+
+	pageOpts := ListOptions{PageSize: DefaultPageSize}
+	if opts != nil {
+		pageOpts = *opts
+		if pageOpts.PageSize <= 0 {
+			pageOpts.PageSize = DefaultPageSize
+		}
+	}
+	for written := 0; ; pageOpts.Page++ {
+		page, err := j.ComputersInventory(ctx, &pageOpts, sections...)
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(page.Results...); err != nil {
+			return errors.Wrap(err, "unable to export computers inventory")
+		}
+		written += len(page.Results)
+		if len(page.Results) == 0 || written >= page.TotalCount {
+			break
+		}
+	}
+	return writer.Flush()
+}
+
+func containsSection(sections []ComputerInventorySection, section ComputerInventorySection) bool {
+	for _, s := range sections {
+		if s == section {
+			return true
+		}
+	}
+	return false
+}
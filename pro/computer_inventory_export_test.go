@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportComputersInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	out := &bytes.Buffer{}
+	assert.NotNil(t, j.ExportComputersInventory(context.Background(), nil, out, export.CSV))
+
+	location := pro.ComputerInventoryColumn{Section: pro.ComputerInventorySectionUserAndLocation, Column: export.Column[pro.ComputerInventory]{Header: "Location", Value: func(c pro.ComputerInventory) string {
+		return "Building 1, Room " + c.ID
+	}}}
+	err := j.ExportComputersInventory(context.Background(), &pro.ListOptions{PageSize: 2}, out, export.CSV,
+		pro.ComputerInventoryColumnID, pro.ComputerInventoryColumnName, pro.ComputerInventoryColumnUsername, location)
+	assert.Nil(t, err)
+	assert.Equal(t, `ID,Name,Username,Location
+1,Mac-01,,"Building 1, Room 1"
+2,Mac-02,,"Building 1, Room 2"
+3,Mac-03,,"Building 1, Room 3"
+4,Mac-04,,"Building 1, Room 4"
+5,Mac-05,,"Building 1, Room 5"
+`, out.String())
+
+	out.Reset()
+	err = j.ExportComputersInventory(context.Background(), &pro.ListOptions{Filter: pro.F("general.name").EQ("Mac-02").String()}, out, export.CSV,
+		pro.ComputerInventoryColumnName, pro.ComputerInventoryColumnAssetTag, pro.ComputerInventoryColumnEmail)
+	assert.Nil(t, err)
+	assert.Equal(t, "Name,Asset Tag,Email Address\nMac-02,A2,\n", out.String())
+
+	out.Reset()
+	err = j.ExportComputersInventory(context.Background(), &pro.ListOptions{Filter: pro.F("general.name").EQ("Mac-02").String()}, out, export.NDJSON,
+		pro.ComputerInventoryColumnID, pro.ComputerInventoryColumnEmail)
+	assert.Nil(t, err)
+	assert.Equal(t, "{\"ID\":\"2\",\"Email Address\":\"\"}\n", out.String())
+
+	err = j.ExportComputersInventory(context.Background(), nil, out, export.Format(7), pro.ComputerInventoryColumnID)
+	assert.Contains(t, err.Error(), "unsupported export format")
+}
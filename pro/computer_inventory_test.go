@@ -0,0 +1,317 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var COMPUTERS_INVENTORY_API_BASE_ENDPOINT = "/api/v1/computers-inventory"
+
+var mockComputersInventory = []pro.ComputerInventory{
+	{ID: "1", UDID: "UDID-1", General: &pro.ComputerInventoryGeneral{Name: "Mac-01", AssetTag: "A1"}},
+	{ID: "2", UDID: "UDID-2", General: &pro.ComputerInventoryGeneral{Name: "Mac-02", AssetTag: "A2"}},
+	{ID: "3", UDID: "UDID-3", General: &pro.ComputerInventoryGeneral{Name: "Mac-03", AssetTag: "A3"}},
+	{ID: "4", UDID: "UDID-4", General: &pro.ComputerInventoryGeneral{Name: "Mac-04", AssetTag: "A4"}},
+	{ID: "5", UDID: "UDID-5", General: &pro.ComputerInventoryGeneral{Name: "Mac-05", AssetTag: "A5"}},
+}
+
+var mockComputersFileVault = []pro.ComputerFileVault{
+	{ComputerID: "1", Name: "Mac-01", PersonalRecoveryKey: "AAAA-BBBB-CCCC-DDDD-EEEE-FFFF", IndividualRecoveryKeyValidityStatus: "VALID"},
+	{ComputerID: "3", Name: "Mac-03", PersonalRecoveryKey: "GGGG-HHHH-JJJJ-KKKK-LLLL-MMMM", IndividualRecoveryKeyValidityStatus: "VALID",
+		BootPartitionEncryptionDetails: &pro.ComputerInventoryPartitionEncryption{PartitionName: "Macintosh HD", PartitionFileVault2State: "ENCRYPTED", PartitionFileVault2Percent: 100}},
+	{ComputerID: "5", Name: "Mac-05", IndividualRecoveryKeyValidityStatus: "UNKNOWN", InstitutionalRecoveryKeyPresent: true},
+}
+
+func computersInventoryResponseMocks(t *testing.T) *httptest.Server {
+	attachments := map[string][]byte{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var (
+			data []byte
+			err  error
+		)
+		switch r.URL.Path {
+		case COMPUTERS_INVENTORY_API_BASE_ENDPOINT:
+			if sections := r.URL.Query()["section"]; len(sections) > 0 {
+				assert.Equal(t, []string{"GENERAL", "USER_AND_LOCATION"}, sections)
+			}
+			results := mockComputersInventory
+			if filter := r.URL.Query().Get("filter"); filter != "" {
+				assert.Equal(t, `general.name=="Mac-02"`, filter)
+				results = results[1:2]
+			}
+			data, err = json.Marshal(pageOf(t, r, results))
+		case fmt.Sprintf("%s/3", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			if r.Method == "DELETE" {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			computer := mockComputersInventory[2]
+			for _, section := range r.URL.Query()["section"] {
+				switch pro.ComputerInventorySection(section) {
+				case pro.ComputerInventorySectionHardware:
+					computer.Hardware = &pro.ComputerInventoryHardware{SerialNumber: "C02ABC123", AppleSilicon: true}
+				case pro.ComputerInventorySectionOperatingSystem:
+					computer.OperatingSystem = &pro.ComputerInventoryOperatingSystem{Name: "macOS", Version: "14.6.1"}
+				}
+			}
+			if len(r.URL.Query()["section"]) > 0 && !contains(r.URL.Query()["section"], string(pro.ComputerInventorySectionGeneral)) {
+				computer.General = nil
+			}
+			data, err = json.Marshal(computer)
+		case "/api/v1/computers-inventory-detail/3":
+			assert.Equal(t, "PATCH", r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			patch := &pro.ComputerInventoryUpdate{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(patch))
+			computer := mockComputersInventory[2]
+			general := *computer.General
+			general.AssetTag = patch.General.AssetTag
+			general.Barcode1 = patch.General.Barcode1
+			computer.General = &general
+			computer.UserAndLocation = patch.UserAndLocation
+			computer.ExtensionAttributes = patch.ExtensionAttributes
+			data, err = json.Marshal(computer)
+		case fmt.Sprintf("%s/3/attachments", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			assert.Equal(t, "POST", r.Method)
+			file, header, err := r.FormFile("file")
+			assert.Nil(t, err)
+			contents, err := io.ReadAll(file)
+			assert.Nil(t, err)
+			id := strconv.Itoa(len(attachments) + 1)
+			attachments[id] = contents
+			w.WriteHeader(http.StatusCreated)
+			data, err = json.Marshal(pro.CreatedResource{ID: id, Href: r.URL.Path + "/" + id + "?name=" + header.Filename})
+			assert.Nil(t, err)
+		case fmt.Sprintf("%s/3/attachments/1", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			contents, ok := attachments["1"]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Attachment not found"}]}`)
+				return
+			}
+			if r.Method == "DELETE" {
+				delete(attachments, "1")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			assert.Equal(t, "application/octet-stream", r.Header.Get("Accept"))
+			w.Header().Set("Content-Type", "application/octet-stream")
+			data = contents
+		case fmt.Sprintf("%s/3/view-recovery-lock-password", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			assert.Equal(t, "GET", r.Method)
+			data = []byte(`{"recoveryLockPassword": "correct-horse-battery"}`)
+		case fmt.Sprintf("%s/filevault", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			data, err = json.Marshal(pageOf(t, r, mockComputersFileVault))
+		case fmt.Sprintf("%s/3/filevault", COMPUTERS_INVENTORY_API_BASE_ENDPOINT):
+			data, err = json.Marshal(mockComputersFileVault[1])
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		assert.Nil(t, err)
+		_, err = w.Write(data)
+		assert.Nil(t, err)
+	}))
+}
+
+func TestComputersInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.ComputersInventory(context.Background(), &pro.ListOptions{Page: 1, PageSize: 2, Sort: []string{"general.name:asc"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, page.TotalCount)
+	assert.Equal(t, 2, len(page.Results))
+	assert.Equal(t, "Mac-03", page.Results[0].General.Name)
+
+	page, err = j.ComputersInventory(context.Background(), &pro.ListOptions{Filter: `general.name=="Mac-02"`})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+	assert.Equal(t, "A2", page.Results[0].General.AssetTag)
+
+	page, err = j.ComputersInventory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(page.Results))
+}
+
+func TestAllComputersInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	computers, err := j.AllComputersInventory(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(computers))
+	assert.Equal(t, "Mac-05", computers[4].General.Name)
+
+	computers, err = j.AllComputersInventory(context.Background(), nil, pro.ComputerInventorySectionGeneral, pro.ComputerInventorySectionUserAndLocation)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(computers))
+}
+
+func TestIterComputersInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	names := []string{}
+	for computer, err := range j.IterComputersInventory(context.Background(), &pro.ListOptions{PageSize: 2}) {
+		assert.Nil(t, err)
+		names = append(names, computer.General.Name)
+		if len(names) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"Mac-01", "Mac-02", "Mac-03"}, names)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	errs := 0
+	for _, err := range j.IterComputersInventory(ctx, nil) {
+		assert.Contains(t, err.Error(), "unable to query page 0")
+		errs++
+	}
+	assert.Equal(t, 1, errs)
+}
+
+func TestComputerInventoryDetails(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	computer, err := j.ComputerInventoryDetails(context.Background(), "3")
+	assert.Nil(t, err)
+	assert.Equal(t, "UDID-3", computer.UDID)
+	assert.Equal(t, "Mac-03", computer.General.Name)
+
+	_, err = j.ComputerInventoryDetails(context.Background(), "99")
+	assert.NotNil(t, err)
+}
+
+func TestDeleteComputer(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	assert.Nil(t, j.DeleteComputer(context.Background(), "3"))
+	assert.NotNil(t, j.DeleteComputer(context.Background(), "99"))
+}
+
+func TestComputerInventorySections(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	computer, err := j.ComputerInventoryDetails(context.Background(), "3", pro.ComputerInventorySectionHardware, pro.ComputerInventorySectionOperatingSystem)
+	assert.Nil(t, err)
+	assert.Nil(t, computer.General)
+	assert.Equal(t, "C02ABC123", computer.Hardware.SerialNumber)
+	assert.True(t, computer.Hardware.AppleSilicon)
+	assert.Equal(t, "14.6.1", computer.OperatingSystem.Version)
+	assert.Nil(t, computer.Security)
+}
+
+func TestUpdateComputerInventory(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UpdateComputerInventory(context.Background(), "3", nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "empty payload")
+
+	computer, err := j.UpdateComputerInventory(context.Background(), "3", &pro.ComputerInventoryUpdate{
+		General: &pro.ComputerInventoryGeneralUpdate{
+			AssetTag: "ASSET-0003",
+			Barcode1: "BC-3",
+		},
+		UserAndLocation: &pro.ComputerInventoryUserAndLocation{
+			Username:   "jdoe",
+			BuildingID: "4",
+		},
+		ExtensionAttributes: []pro.ExtensionAttribute{
+			{DefinitionID: "12", Values: []string{"Engineering"}},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "ASSET-0003", computer.General.AssetTag)
+	assert.Equal(t, "BC-3", computer.General.Barcode1)
+	assert.Equal(t, "Mac-03", computer.General.Name)
+	assert.Equal(t, "jdoe", computer.UserAndLocation.Username)
+	assert.Equal(t, []string{"Engineering"}, computer.ExtensionAttributes[0].Values)
+	// the shared mock data must not be modified by the update
+	assert.Equal(t, "A3", mockComputersInventory[2].General.AssetTag)
+}
+
+func TestComputerAttachments(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UploadComputerAttachment(context.Background(), "3", "receipt.pdf", nil)
+	assert.NotNil(t, err)
+
+	created, err := j.UploadComputerAttachment(context.Background(), "3", "receipt.pdf", strings.NewReader("%PDF-1.7 receipt"))
+	assert.Nil(t, err)
+	assert.Equal(t, "1", created.ID)
+	assert.Contains(t, created.Href, "name=receipt.pdf")
+
+	contents := &bytes.Buffer{}
+	assert.Nil(t, j.DownloadComputerAttachment(context.Background(), "3", "1", contents))
+	assert.Equal(t, "%PDF-1.7 receipt", contents.String())
+
+	assert.Nil(t, j.DeleteComputerAttachment(context.Background(), "3", "1"))
+	err = j.DownloadComputerAttachment(context.Background(), "3", "1", &bytes.Buffer{})
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestComputerRecoveryLockPassword(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	password, err := j.ComputerRecoveryLockPassword(context.Background(), "3")
+	assert.Nil(t, err)
+	assert.Equal(t, "correct-horse-battery", password)
+
+	_, err = j.ComputerRecoveryLockPassword(context.Background(), "4")
+	assert.NotNil(t, err)
+}
+
+func TestComputersFileVault(t *testing.T) {
+	testServer := computersInventoryResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.ComputersFileVault(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Len(t, page.Results, 2)
+
+	all, err := j.AllComputersFileVault(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, mockComputersFileVault, all)
+
+	fileVault, err := j.ComputerFileVault(context.Background(), "3")
+	assert.Nil(t, err)
+	assert.Equal(t, "GGGG-HHHH-JJJJ-KKKK-LLLL-MMMM", fileVault.PersonalRecoveryKey)
+	assert.Equal(t, "ENCRYPTED", fileVault.BootPartitionEncryptionDetails.PartitionFileVault2State)
+
+	_, err = j.ComputerFileVault(context.Background(), "4")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const computerPrestagesContext = "computer-prestages"
+
+// ComputerPrestages returns a single page of computer prestages matching opts
+func (j *Client) ComputerPrestages(ctx context.Context, opts *ListOptions) (*Results[ComputerPrestage], error) {
+	ep := j.endpoint(3, computerPrestagesContext)
+	res, err := listPage[ComputerPrestage](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer prestages from %s", ep)
+	}
+	return res, nil
+}
+
+// AllComputerPrestages returns every computer prestage matching opts, requesting each page in turn
+func (j *Client) AllComputerPrestages(ctx context.Context, opts *ListOptions) ([]ComputerPrestage, error) {
+	ep := j.endpoint(3, computerPrestagesContext)
+	res, err := listAll[ComputerPrestage](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all computer prestages from %s", ep)
+	}
+	return res, nil
+}
+
+// ComputerPrestageDetails returns the details for a specific computer prestage given its ID
+func (j *Client) ComputerPrestageDetails(ctx context.Context, id string) (*ComputerPrestage, error) {
+	ep := j.endpoint(3, fmt.Sprintf("%s/%s", computerPrestagesContext, url.PathEscape(id)))
+	res := &ComputerPrestage{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query computer prestage with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateComputerPrestage will create a new computer prestage in Jamf
+func (j *Client) CreateComputerPrestage(ctx context.Context, content *ComputerPrestage) (*CreatedResource, error) {
+	ep := j.endpoint(3, computerPrestagesContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for computer prestage: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *ComputerPrestage) *string { return &c.SiteID })
+	if content.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new computer prestage"), "unable to process JAMF creation request for computer prestage: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for computer prestage %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateComputerPrestage will replace a computer prestage in Jamf given its ID
+func (j *Client) UpdateComputerPrestage(ctx context.Context, id string, content *ComputerPrestage) (*ComputerPrestage, error) {
+	ep := j.endpoint(3, fmt.Sprintf("%s/%s", computerPrestagesContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for computer prestage: %s (%s)", id, ep)
+	}
+
+	res := &ComputerPrestage{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for computer prestage: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteComputerPrestage will delete a computer prestage given its ID
+func (j *Client) DeleteComputerPrestage(ctx context.Context, id string) error {
+	ep := j.endpoint(3, fmt.Sprintf("%s/%s", computerPrestagesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for computer prestage %s from %s", id, ep)
+	}
+	return nil
+}
+
+// ComputerPrestageScope returns the computers assigned to a computer prestage given its ID
+func (j *Client) ComputerPrestageScope(ctx context.Context, id string) (*PrestageScope, error) {
+	return j.prestageScope(ctx, computerPrestagesContext, id)
+}
+
+// AllComputerPrestageScopes returns the ID of the computer prestage each computer is assigned to keyed by serial number
+func (j *Client) AllComputerPrestageScopes(ctx context.Context) (map[string]string, error) {
+	return j.allPrestageScopes(ctx, computerPrestagesContext)
+}
+
+// AddComputerPrestageScope assigns computers, by serial number, to a computer prestage given its ID
+func (j *Client) AddComputerPrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, computerPrestagesContext, id, "POST", "scope", serialNumbers)
+}
+
+// RemoveComputerPrestageScope unassigns computers, by serial number, from a computer prestage given its ID
+func (j *Client) RemoveComputerPrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, computerPrestagesContext, id, "POST", "scope/delete-multiple", serialNumbers)
+}
+
+// ReplaceComputerPrestageScope replaces every computer assigned to a computer prestage given its ID
+func (j *Client) ReplaceComputerPrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, computerPrestagesContext, id, "PUT", "scope", serialNumbers)
+}
@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// ComputerPrestage represents a computer PreStage enrollment, the settings applied to computers enrolled
+// through Automated Device Enrollment. Updates must include the current VersionLock.
+type ComputerPrestage struct {
+	ID                                string                           `json:"id,omitempty"`
+	DisplayName                       string                           `json:"displayName"`
+	Mandatory                         bool                             `json:"mandatory"`
+	MDMRemovable                      bool                             `json:"mdmRemovable"`
+	SupportPhoneNumber                string                           `json:"supportPhoneNumber,omitempty"`
+	SupportEmailAddress               string                           `json:"supportEmailAddress,omitempty"`
+	Department                        string                           `json:"department,omitempty"`
+	DefaultPrestage                   bool                             `json:"defaultPrestage"`
+	EnrollmentSiteID                  string                           `json:"enrollmentSiteId,omitempty"`
+	KeepExistingSiteMembership        bool                             `json:"keepExistingSiteMembership"`
+	KeepExistingLocationInformation   bool                             `json:"keepExistingLocationInformation"`
+	RequireAuthentication             bool                             `json:"requireAuthentication"`
+	AuthenticationPrompt              string                           `json:"authenticationPrompt,omitempty"`
+	PreventActivationLock             bool                             `json:"preventActivationLock"`
+	EnableDeviceBasedActivationLock   bool                             `json:"enableDeviceBasedActivationLock"`
+	DeviceEnrollmentProgramInstanceID string                           `json:"deviceEnrollmentProgramInstanceId"`
+	SkipSetupItems                    map[string]bool                  `json:"skipSetupItems,omitempty"`
+	LocationInformation               *PrestageLocation                `json:"locationInformation,omitempty"`
+	PurchasingInformation             *PrestagePurchasing              `json:"purchasingInformation,omitempty"`
+	AnchorCertificates                []string                         `json:"anchorCertificates,omitempty"`
+	EnrollmentCustomizationID         string                           `json:"enrollmentCustomizationId,omitempty"`
+	Language                          string                           `json:"language,omitempty"`
+	Region                            string                           `json:"region,omitempty"`
+	AutoAdvanceSetup                  bool                             `json:"autoAdvanceSetup"`
+	InstallProfilesDuringSetup        bool                             `json:"installProfilesDuringSetup"`
+	PrestageInstalledProfileIDs       []string                         `json:"prestageInstalledProfileIds,omitempty"`
+	CustomPackageIDs                  []string                         `json:"customPackageIds,omitempty"`
+	CustomPackageDistributionPointID  string                           `json:"customPackageDistributionPointId,omitempty"`
+	EnableRecoveryLock                bool                             `json:"enableRecoveryLock"`
+	RecoveryLockPasswordType          string                           `json:"recoveryLockPasswordType,omitempty"`
+	RecoveryLockPassword              string                           `json:"recoveryLockPassword,omitempty"`
+	RotateRecoveryLockPassword        bool                             `json:"rotateRecoveryLockPassword"`
+	ProfileUUID                       string                           `json:"profileUuid,omitempty"`
+	SiteID                            string                           `json:"siteId,omitempty"`
+	VersionLock                       int                              `json:"versionLock"`
+	AccountSettings                   *ComputerPrestageAccountSettings `json:"accountSettings,omitempty"`
+}
+
+// ComputerPrestageAccountSettings holds the local accounts created during Setup Assistant
+type ComputerPrestageAccountSettings struct {
+	ID                                      string `json:"id,omitempty"`
+	PayloadConfigured                       bool   `json:"payloadConfigured"`
+	LocalAdminAccountEnabled                bool   `json:"localAdminAccountEnabled"`
+	AdminUsername                           string `json:"adminUsername,omitempty"`
+	AdminPassword                           string `json:"adminPassword,omitempty"`
+	HiddenAdminAccount                      bool   `json:"hiddenAdminAccount"`
+	LocalUserManaged                        bool   `json:"localUserManaged"`
+	UserAccountType                         string `json:"userAccountType,omitempty"`
+	VersionLock                             int    `json:"versionLock"`
+	PrefillPrimaryAccountInfoFeatureEnabled bool   `json:"prefillPrimaryAccountInfoFeatureEnabled"`
+	PrefillType                             string `json:"prefillType,omitempty"`
+	PrefillAccountFullName                  string `json:"prefillAccountFullName,omitempty"`
+	PrefillAccountUserName                  string `json:"prefillAccountUserName,omitempty"`
+	PreventPrefillInfoFromModification      bool   `json:"preventPrefillInfoFromModification"`
+}
@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var COMPUTER_PRESTAGES_API_BASE_ENDPOINT = "/api/v3/computer-prestages"
+
+// prestageScopeMock serves the v2 scope endpoints for a single prestage, enforcing the version lock
+func prestageScopeMock(t *testing.T, mux *http.ServeMux, base string, id string, serials []string) {
+	scope := &pro.PrestageScope{PrestageID: id, VersionLock: 1}
+	for _, serial := range serials {
+		scope.Assignments = append(scope.Assignments, pro.PrestageAssignment{SerialNumber: serial})
+	}
+	write := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(v))
+	}
+	update := func(w http.ResponseWriter, r *http.Request) (*pro.PrestageScopeUpdate, bool) {
+		body := &pro.PrestageScopeUpdate{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(body))
+		if body.VersionLock != scope.VersionLock {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"httpStatus": 409, "errors": [{"code": "OPTIMISTIC_LOCK_FAILED", "description": "Optimistic lock failed"}]}`)
+			return nil, false
+		}
+		scope.VersionLock++
+		return body, true
+	}
+	mux.HandleFunc(fmt.Sprintf("%s/scope", base), func(w http.ResponseWriter, r *http.Request) {
+		serialsByPrestageID := map[string]string{}
+		for _, assignment := range scope.Assignments {
+			serialsByPrestageID[assignment.SerialNumber] = id
+		}
+		write(w, &pro.PrestageScopes{SerialsByPrestageID: serialsByPrestageID})
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/%s/scope", base, id), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			body, ok := update(w, r)
+			if !ok {
+				return
+			}
+			for _, serial := range body.SerialNumbers {
+				scope.Assignments = append(scope.Assignments, pro.PrestageAssignment{SerialNumber: serial})
+			}
+		case "PUT":
+			body, ok := update(w, r)
+			if !ok {
+				return
+			}
+			scope.Assignments = nil
+			for _, serial := range body.SerialNumbers {
+				scope.Assignments = append(scope.Assignments, pro.PrestageAssignment{SerialNumber: serial})
+			}
+		}
+		write(w, scope)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/%s/scope/delete-multiple", base, id), func(w http.ResponseWriter, r *http.Request) {
+		body, ok := update(w, r)
+		if !ok {
+			return
+		}
+		remaining := []pro.PrestageAssignment{}
+		for _, assignment := range scope.Assignments {
+			if !contains(body.SerialNumbers, assignment.SerialNumber) {
+				remaining = append(remaining, assignment)
+			}
+		}
+		scope.Assignments = remaining
+		write(w, scope)
+	})
+}
+
+func computerPrestagesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	prestages := &crudMock[pro.ComputerPrestage]{
+		t:      t,
+		base:   COMPUTER_PRESTAGES_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.ComputerPrestage{
+			{
+				ID:                                "1",
+				DisplayName:                       "Staff Macs",
+				Mandatory:                         true,
+				DeviceEnrollmentProgramInstanceID: "1",
+				SkipSetupItems:                    map[string]bool{"Siri": true, "TOS": false},
+				AccountSettings:                   &pro.ComputerPrestageAccountSettings{UserAccountType: "STANDARD"},
+				VersionLock:                       3,
+			},
+		},
+		getID: func(p pro.ComputerPrestage) string { return p.ID },
+		setID: func(p *pro.ComputerPrestage, id string) { p.ID = id },
+	}
+	mux.Handle(COMPUTER_PRESTAGES_API_BASE_ENDPOINT, prestages)
+	mux.Handle(COMPUTER_PRESTAGES_API_BASE_ENDPOINT+"/", prestages)
+	prestageScopeMock(t, mux, "/api/v2/computer-prestages", "1", []string{"C02AAA", "C02BBB"})
+	return httptest.NewServer(mux)
+}
+
+func TestComputerPrestagesCRUD(t *testing.T) {
+	testServer := computerPrestagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	prestages, err := j.AllComputerPrestages(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(prestages))
+
+	prestage, err := j.ComputerPrestageDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff Macs", prestage.DisplayName)
+	assert.True(t, prestage.SkipSetupItems["Siri"])
+	assert.Equal(t, "STANDARD", prestage.AccountSettings.UserAccountType)
+	assert.Equal(t, 3, prestage.VersionLock)
+
+	_, err = j.CreateComputerPrestage(context.Background(), &pro.ComputerPrestage{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "display name required")
+
+	created, err := j.CreateComputerPrestage(context.Background(), &pro.ComputerPrestage{DisplayName: "Lab Macs", DeviceEnrollmentProgramInstanceID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	prestage.SupportPhoneNumber = "555-0100"
+	updated, err := j.UpdateComputerPrestage(context.Background(), "1", prestage)
+	assert.Nil(t, err)
+	assert.Equal(t, "555-0100", updated.SupportPhoneNumber)
+
+	assert.Nil(t, j.DeleteComputerPrestage(context.Background(), "2"))
+}
+
+func TestComputerPrestageScope(t *testing.T) {
+	testServer := computerPrestagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	scope, err := j.ComputerPrestageScope(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C02AAA", "C02BBB"}, scope.SerialNumbers())
+
+	scope, err = j.AddComputerPrestageScope(context.Background(), "1", []string{"C02CCC"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C02AAA", "C02BBB", "C02CCC"}, scope.SerialNumbers())
+
+	scope, err = j.RemoveComputerPrestageScope(context.Background(), "1", []string{"C02AAA"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C02BBB", "C02CCC"}, scope.SerialNumbers())
+
+	scope, err = j.ReplaceComputerPrestageScope(context.Background(), "1", []string{"C02DDD"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"C02DDD"}, scope.SerialNumbers())
+	assert.Equal(t, 4, scope.VersionLock)
+
+	all, err := j.AllComputerPrestageScopes(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"C02DDD": "1"}, all)
+
+	_, err = j.AddComputerPrestageScope(context.Background(), "9", []string{"C02EEE"})
+	assert.NotNil(t, err)
+}
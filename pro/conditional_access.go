@@ -0,0 +1,44 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const conditionalAccessContext = "conditional-access"
+
+// ConditionalAccessFeatureToggle returns whether compliance is reported for shared iPad devices
+func (j *Client) ConditionalAccessFeatureToggle(ctx context.Context) (*ConditionalAccessFeatureToggle, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/device-compliance/feature-toggle", conditionalAccessContext))
+	res := &ConditionalAccessFeatureToggle{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query conditional access feature toggle from %s", ep)
+	}
+	return res, nil
+}
+
+// ComputerComplianceInformation returns the compliance state reported to each vendor such as
+// Microsoft Entra ID (Azure AD) or Intune for a specific computer given its ID
+func (j *Client) ComputerComplianceInformation(ctx context.Context, id string) ([]DeviceComplianceInformation, error) {
+	return j.deviceComplianceInformation(ctx, "computer", id)
+}
+
+// MobileDeviceComplianceInformation returns the compliance state reported to each vendor such as
+// Microsoft Entra ID (Azure AD) or Intune for a specific mobile device given its ID
+func (j *Client) MobileDeviceComplianceInformation(ctx context.Context, id string) ([]DeviceComplianceInformation, error) {
+	return j.deviceComplianceInformation(ctx, "mobile-device", id)
+}
+
+func (j *Client) deviceComplianceInformation(ctx context.Context, deviceType string, id string) ([]DeviceComplianceInformation, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/device-compliance-information/%s/%s", conditionalAccessContext, deviceType, url.PathEscape(id)))
+	res := []DeviceComplianceInformation{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query compliance information for %s with ID %s from %s", deviceType, id, ep)
+	}
+	return res, nil
+}
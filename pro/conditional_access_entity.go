@@ -0,0 +1,34 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Compliance states reported by Jamf Pro to a compliance vendor
+const (
+	ComplianceStateUnknown      = "UNKNOWN"
+	ComplianceStateNonCompliant = "NON_COMPLIANT"
+	ComplianceStateCompliant    = "COMPLIANT"
+)
+
+// ConditionalAccessFeatureToggle represents whether device compliance is enabled for shared devices
+type ConditionalAccessFeatureToggle struct {
+	SharedDeviceFeatureEnabled bool `json:"sharedDeviceFeatureEnabled"`
+}
+
+// DeviceComplianceInformation represents the compliance state of a device as reported to a single vendor
+type DeviceComplianceInformation struct {
+	DeviceID                          string                            `json:"deviceId"`
+	Applicable                        bool                              `json:"applicable"`
+	ComplianceState                   string                            `json:"complianceState"`
+	ComplianceVendor                  string                            `json:"complianceVendor"`
+	ComplianceVendorDeviceInformation ComplianceVendorDeviceInformation `json:"complianceVendorDeviceInformation"`
+}
+
+// ComplianceVendorDeviceInformation holds the identifiers the vendor uses for the device
+type ComplianceVendorDeviceInformation struct {
+	DeviceIDs []string `json:"deviceIds"`
+}
+
+// Compliant returns true when the device is applicable and reported as compliant
+func (d *DeviceComplianceInformation) Compliant() bool {
+	return d.Applicable && d.ComplianceState == ComplianceStateCompliant
+}
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var CONDITIONAL_ACCESS_API_BASE_ENDPOINT = "/api/v1/conditional-access"
+
+func conditionalAccessResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(CONDITIONAL_ACCESS_API_BASE_ENDPOINT+"/device-compliance/feature-toggle", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"sharedDeviceFeatureEnabled": true}`)
+	})
+	mux.HandleFunc(CONDITIONAL_ACCESS_API_BASE_ENDPOINT+"/device-compliance-information/computer/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, json.NewEncoder(w).Encode([]pro.DeviceComplianceInformation{{
+			DeviceID:                          "1",
+			Applicable:                        true,
+			ComplianceState:                   pro.ComplianceStateCompliant,
+			ComplianceVendor:                  "Microsoft Intune",
+			ComplianceVendorDeviceInformation: pro.ComplianceVendorDeviceInformation{DeviceIDs: []string{"5f2c7b1e-0b1e-4b4c-9c1a-3e0c2d9a8f11"}},
+		}}))
+	})
+	mux.HandleFunc(CONDITIONAL_ACCESS_API_BASE_ENDPOINT+"/device-compliance-information/mobile-device/2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Nil(t, json.NewEncoder(w).Encode([]pro.DeviceComplianceInformation{{
+			DeviceID:         "2",
+			Applicable:       true,
+			ComplianceState:  pro.ComplianceStateNonCompliant,
+			ComplianceVendor: "Microsoft Intune",
+		}}))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestConditionalAccess(t *testing.T) {
+	testServer := conditionalAccessResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	toggle, err := j.ConditionalAccessFeatureToggle(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, toggle.SharedDeviceFeatureEnabled)
+
+	computer, err := j.ComputerComplianceInformation(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Len(t, computer, 1)
+	assert.True(t, computer[0].Compliant())
+	assert.Equal(t, []string{"5f2c7b1e-0b1e-4b4c-9c1a-3e0c2d9a8f11"}, computer[0].ComplianceVendorDeviceInformation.DeviceIDs)
+
+	mobile, err := j.MobileDeviceComplianceInformation(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Len(t, mobile, 1)
+	assert.False(t, mobile[0].Compliant())
+
+	_, err = j.ComputerComplianceInformation(context.Background(), "99")
+	assert.True(t, pro.IsNotFound(err))
+}
@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const departmentsContext = "departments"
+
+// Departments returns a single page of departments matching opts
+func (j *Client) Departments(ctx context.Context, opts *ListOptions) (*Results[Department], error) {
+	ep := j.endpoint(1, departmentsContext)
+	res, err := listPage[Department](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query departments from %s", ep)
+	}
+	return res, nil
+}
+
+// AllDepartments returns every department matching opts, requesting each page in turn. They are served from
+// the client's Cache, when set, unless opts is set.
+func (j *Client) AllDepartments(ctx context.Context, opts *ListOptions) ([]Department, error) {
+	return cachedList(ctx, j, CacheDepartments, opts, j.allDepartments)
+}
+
+func (j *Client) allDepartments(ctx context.Context, opts *ListOptions) ([]Department, error) {
+	ep := j.endpoint(1, departmentsContext)
+	res, err := listAll[Department](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all departments from %s", ep)
+	}
+	return res, nil
+}
+
+// DepartmentDetails returns the details for a specific department given its ID
+func (j *Client) DepartmentDetails(ctx context.Context, id string) (*Department, error) {
+	if res, ok := cachedItem(ctx, j, j.AllDepartments, id, func(item *Department) string { return item.ID }); ok {
+		return res, nil
+	}
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", departmentsContext, url.PathEscape(id)))
+	res := &Department{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query department with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateDepartment will create a new department in Jamf
+func (j *Client) CreateDepartment(ctx context.Context, content *Department) (*CreatedResource, error) {
+	ep := j.endpoint(1, departmentsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for department: (%s)", ep)
+	}
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new department"), "unable to process JAMF creation request for department: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for department %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheDepartments)
+	return res, nil
+}
+
+// UpdateDepartment will replace a department in Jamf given its ID
+func (j *Client) UpdateDepartment(ctx context.Context, id string, content *Department) (*Department, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", departmentsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for department: %s (%s)", id, ep)
+	}
+
+	res := &Department{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for department: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheDepartments)
+	return res, nil
+}
+
+// DeleteDepartment will delete a department given its ID
+func (j *Client) DeleteDepartment(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", departmentsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for department %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheDepartments)
+	return nil
+}
+
+// DepartmentHistory returns a single page of the change history for a department given its ID
+func (j *Client) DepartmentHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceDepartments, id, opts)
+}
+
+// AddDepartmentHistoryNote adds a note to the change history for a department given its ID
+func (j *Client) AddDepartmentHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceDepartments, id, note)
+}
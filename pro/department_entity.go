@@ -0,0 +1,9 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Department represents a department in Jamf Pro
+type Department struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
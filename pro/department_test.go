@@ -0,0 +1,106 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var DEPARTMENTS_API_BASE_ENDPOINT = "/api/v1/departments"
+
+func departmentsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(&crudMock[pro.Department]{
+		t:      t,
+		base:   DEPARTMENTS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.Department{
+			{ID: "1", Name: "Engineering"},
+			{ID: "2", Name: "Finance"},
+		},
+		getID: func(x pro.Department) string { return x.ID },
+		setID: func(x *pro.Department, id string) { x.ID = id },
+	})
+}
+
+func TestQueryAllDepartments(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.Departments(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+
+	departments, err := j.AllDepartments(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(departments))
+	assert.Equal(t, "Finance", departments[1].Name)
+}
+
+func TestQuerySpecificDepartment(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	department, err := j.DepartmentDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Engineering", department.Name)
+
+	_, err = j.DepartmentDetails(context.Background(), "9")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCreateDepartment(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateDepartment(context.Background(), &pro.Department{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required")
+
+	created, err := j.CreateDepartment(context.Background(), &pro.Department{Name: "Legal"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+}
+
+func TestUpdateDepartment(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	department, err := j.UpdateDepartment(context.Background(), "2", &pro.Department{Name: "Accounting"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", department.ID)
+	assert.Equal(t, "Accounting", department.Name)
+}
+
+func TestDeleteDepartment(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	err := j.DeleteDepartment(context.Background(), "2")
+	assert.Nil(t, err)
+	_, err = j.DepartmentDetails(context.Background(), "2")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestDepartmentHistory(t *testing.T) {
+	testServer := departmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.AddDepartmentHistoryNote(context.Background(), "1", "Renamed during reorg")
+	assert.Nil(t, err)
+
+	history, err := j.DepartmentHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, history.TotalCount)
+	assert.Equal(t, "Renamed during reorg", history.Results[0].Note)
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Dependency represents a Jamf Pro resource which relies on another, e.g. an enrollment customization
+// using single sign-on or a prestage using an enrollment customization
+type Dependency struct {
+	Name              string `json:"name"`
+	Hyperlink         string `json:"hyperlink"`
+	HumanReadableName string `json:"humanReadableName"`
+}
+
+type dependencies struct {
+	Dependencies []Dependency `json:"dependencies"`
+}
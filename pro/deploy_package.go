@@ -0,0 +1,63 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const deployPackageContext = "deploy-package"
+
+// DeployPackage installs a signed package on computers through the InstallEnterpriseApplication
+// MDM command without creating a policy. Computers are targeted by ID, by computer group or both.
+func (j *Client) DeployPackage(ctx context.Context, deployment *PackageDeployment) (*PackageDeploymentResult, error) {
+	ep := j.endpoint(1, deployPackageContext)
+	if deployment == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF package deployment request: (%s)", ep)
+	}
+	if deployment.Manifest == nil || deployment.Manifest.URL == "" {
+		return nil, errors.Wrapf(fmt.Errorf("manifest with package URL required"), "unable to process JAMF package deployment request: (%s)", ep)
+	}
+	if len(deployment.Devices) == 0 && deployment.GroupID == "" {
+		return nil, errors.Wrapf(fmt.Errorf("devices or group ID required"), "unable to process JAMF package deployment request: (%s)", ep)
+	}
+
+	params := url.Values{}
+	params.Set("verbose", "true")
+	res := &PackageDeploymentResult{}
+	if err := j.do(ctx, "POST", withQuery(ep, params), deployment, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to deploy package %s on %s", deployment.Manifest.URL, ep)
+	}
+	return res, nil
+}
+
+// NewPackageManifest builds the manifest for a package served from packageURL, reading pkg to
+// compute the SHA-256 hash and size the device uses to verify its download
+func NewPackageManifest(packageURL string, pkg io.Reader) (*PackageManifest, error) {
+	if packageURL == "" {
+		return nil, fmt.Errorf("a package URL is required to build a package manifest")
+	}
+	if pkg == nil {
+		return nil, fmt.Errorf("package contents are required to build a manifest for %s", packageURL)
+	}
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, pkg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to hash package contents for %s", packageURL)
+	}
+
+	return &PackageManifest{
+		URL:         packageURL,
+		Hash:        hex.EncodeToString(hash.Sum(nil)),
+		HashType:    PackageManifestHashTypeSHA256,
+		SizeInBytes: size,
+	}, nil
+}
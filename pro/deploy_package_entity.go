@@ -0,0 +1,50 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Hash types accepted in a package manifest
+const (
+	PackageManifestHashTypeMD5    = "MD5"
+	PackageManifestHashTypeSHA256 = "SHA256"
+)
+
+// PackageDeployment holds a package manifest and the computers to install it on
+type PackageDeployment struct {
+	Manifest         *PackageManifest `json:"manifest"`
+	InstallAsManaged bool             `json:"installAsManaged"`
+	Devices          []int            `json:"devices,omitempty"`
+	GroupID          string           `json:"groupId,omitempty"`
+}
+
+// PackageManifest describes a signed distribution package hosted at URL, see NewPackageManifest
+type PackageManifest struct {
+	URL              string `json:"url"`
+	Hash             string `json:"hash"`
+	HashType         string `json:"hashType"`
+	BundleID         string `json:"bundleId"`
+	BundleVersion    string `json:"bundleVersion"`
+	Title            string `json:"title"`
+	Subtitle         string `json:"subtitle,omitempty"`
+	SizeInBytes      int64  `json:"sizeInBytes"`
+	DisplayImageURL  string `json:"displayImageUrl,omitempty"`
+	FullSizeImageURL string `json:"fullSizeImageUrl,omitempty"`
+}
+
+// PackageDeploymentResult holds the commands queued by a package deployment and any computers which could not be targeted
+type PackageDeploymentResult struct {
+	QueuedCommands []PackageDeploymentCommand `json:"queuedCommands"`
+	Errors         []PackageDeploymentError   `json:"errors"`
+}
+
+// PackageDeploymentCommand holds the InstallEnterpriseApplication command queued for a computer
+type PackageDeploymentCommand struct {
+	Device      int    `json:"device"`
+	CommandUUID string `json:"commandUuid"`
+}
+
+// PackageDeploymentError holds why a package could not be deployed to a computer or group
+type PackageDeploymentError struct {
+	Device int    `json:"device,omitempty"`
+	Group  int    `json:"group,omitempty"`
+	Reason string `json:"reason"`
+}
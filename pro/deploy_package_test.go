@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var DEPLOY_PACKAGE_API_BASE_ENDPOINT = "/api/v1/deploy-package"
+
+func deployPackageResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, DEPLOY_PACKAGE_API_BASE_ENDPOINT, r.URL.Path)
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("verbose"))
+		deployment := &pro.PackageDeployment{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(deployment))
+		res := &pro.PackageDeploymentResult{}
+		for _, device := range deployment.Devices {
+			if device > 100 {
+				res.Errors = append(res.Errors, pro.PackageDeploymentError{Device: device, Reason: "Device is not managed"})
+				continue
+			}
+			res.QueuedCommands = append(res.QueuedCommands, pro.PackageDeploymentCommand{Device: device, CommandUUID: deployment.Manifest.Hash[:8]})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		assert.Nil(t, json.NewEncoder(w).Encode(res))
+	}))
+}
+
+func TestNewPackageManifest(t *testing.T) {
+	manifest, err := pro.NewPackageManifest("https://cdn.example.com/Agent.pkg", strings.NewReader("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", manifest.Hash)
+	assert.Equal(t, pro.PackageManifestHashTypeSHA256, manifest.HashType)
+	assert.Equal(t, int64(5), manifest.SizeInBytes)
+
+	_, err = pro.NewPackageManifest("", strings.NewReader("hello"))
+	assert.NotNil(t, err)
+	_, err = pro.NewPackageManifest("https://cdn.example.com/Agent.pkg", nil)
+	assert.NotNil(t, err)
+}
+
+func TestDeployPackage(t *testing.T) {
+	testServer := deployPackageResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	manifest, err := pro.NewPackageManifest("https://cdn.example.com/Agent.pkg", strings.NewReader("hello"))
+	assert.Nil(t, err)
+	manifest.BundleID = "com.example.agent"
+	manifest.BundleVersion = "2.1.0"
+	manifest.Title = "Example Agent"
+
+	_, err = j.DeployPackage(context.Background(), &pro.PackageDeployment{Manifest: manifest})
+	assert.NotNil(t, err)
+	_, err = j.DeployPackage(context.Background(), &pro.PackageDeployment{Devices: []int{1}})
+	assert.NotNil(t, err)
+
+	res, err := j.DeployPackage(context.Background(), &pro.PackageDeployment{Manifest: manifest, InstallAsManaged: true, Devices: []int{1, 2, 101}})
+	assert.Nil(t, err)
+	assert.Len(t, res.QueuedCommands, 2)
+	assert.Equal(t, "2cf24dba", res.QueuedCommands[0].CommandUUID)
+	assert.Equal(t, 101, res.Errors[0].Device)
+}
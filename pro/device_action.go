@@ -0,0 +1,97 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const computerInventoryContext = "computer-inventory"
+
+// EraseComputer erases a computer given its inventory ID. Computers without Apple silicon or a T2
+// chip are locked with pin, a six digit code needed to use the computer after it is erased; pin is
+// ignored by other computers and may be left empty for them.
+func (j *Client) EraseComputer(ctx context.Context, id string, pin string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/erase", computerInventoryContext, url.PathEscape(id)))
+	if pin != "" {
+		if err := validateDevicePIN(pin); err != nil {
+			return errors.Wrapf(err, "unable to erase computer with ID %s (%s)", id, ep)
+		}
+	}
+
+	if err := j.do(ctx, "POST", ep, &computerErase{PIN: pin}, nil); err != nil {
+		return errors.Wrapf(err, "unable to erase computer with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// EraseMobileDevice erases a mobile device given its ID, opts may be nil to erase with the defaults
+func (j *Client) EraseMobileDevice(ctx context.Context, id string, opts *MobileDeviceErase) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/erase", mobileDevicesContext, url.PathEscape(id)))
+	if opts == nil {
+		opts = &MobileDeviceErase{}
+	}
+
+	if err := j.do(ctx, "POST", ep, opts, nil); err != nil {
+		return errors.Wrapf(err, "unable to erase mobile device with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// LockComputer locks a computer given its management ID, pin is the six digit code required to unlock it
+func (j *Client) LockComputer(ctx context.Context, managementID string, pin string, message string) (*CreatedResource, error) {
+	if err := validateDevicePIN(pin); err != nil {
+		return nil, errors.Wrapf(err, "unable to lock computer with management ID %s", managementID)
+	}
+	return j.sendDeviceAction(ctx, managementID, &DeviceLockCommand{PIN: pin, Message: message})
+}
+
+// LockMobileDevice locks a mobile device given its management ID, showing message and phoneNumber on
+// the lock screen when they are set
+func (j *Client) LockMobileDevice(ctx context.Context, managementID string, message string, phoneNumber string) (*CreatedResource, error) {
+	return j.sendDeviceAction(ctx, managementID, &DeviceLockCommand{Message: message, PhoneNumber: phoneNumber})
+}
+
+// RestartDevice restarts a computer or mobile device given its management ID, notifyUser lets a
+// logged in user on a computer postpone the restart
+func (j *Client) RestartDevice(ctx context.Context, managementID string, notifyUser bool) (*CreatedResource, error) {
+	return j.sendDeviceAction(ctx, managementID, &RestartDeviceCommand{NotifyUser: notifyUser})
+}
+
+// ShutDownDevice shuts down a computer or mobile device given its management ID
+func (j *Client) ShutDownDevice(ctx context.Context, managementID string) (*CreatedResource, error) {
+	return j.sendDeviceAction(ctx, managementID, &ShutDownDeviceCommand{})
+}
+
+// sendDeviceAction sends an MDM command to a single device, returning the queued command which
+// can be passed to MDMCommandDetails to confirm the device acknowledged it
+func (j *Client) sendDeviceAction(ctx context.Context, managementID string, command MDMCommand) (*CreatedResource, error) {
+	if managementID == "" {
+		return nil, fmt.Errorf("a management ID is required to send %s command", command.CommandType())
+	}
+	res, err := j.SendMDMCommand(ctx, command, managementID)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no %s command was queued for management ID %s", command.CommandType(), managementID)
+	}
+	return &res[0], nil
+}
+
+// validateDevicePIN checks pin is the six digit code Jamf requires to lock or erase a computer
+func validateDevicePIN(pin string) error {
+	if len(pin) != 6 {
+		return fmt.Errorf("device PIN must be 6 digits")
+	}
+	for _, c := range pin {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("device PIN must be 6 digits")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// MobileDeviceErase holds the options for erasing a mobile device
+type MobileDeviceErase struct {
+	PreserveDataPlan       bool                   `json:"preserveDataPlan"`
+	DisallowProximitySetup bool                   `json:"disallowProximitySetup"`
+	ClearActivationLock    bool                   `json:"clearActivationLock"`
+	ReturnToService        *ReturnToServiceConfig `json:"returnToService,omitempty"`
+}
+
+type computerErase struct {
+	PIN string `json:"pin,omitempty"`
+}
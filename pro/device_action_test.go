@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+// deviceActionsResponseMocks records erase payloads by path and MDM command data by command UUID
+func deviceActionsResponseMocks(t *testing.T, recorded map[string]map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	commands := []pro.MDMCommandStatus{}
+	erase := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		recorded[r.URL.Path] = body
+		w.WriteHeader(http.StatusNoContent)
+	}
+	mux.HandleFunc("/api/v1/computer-inventory/3/erase", erase)
+	mux.HandleFunc("/api/v2/mobile-devices/7/erase", erase)
+	mux.HandleFunc(MDM_COMMANDS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			results := []pro.MDMCommandStatus{}
+			for _, command := range commands {
+				if r.URL.Query().Get("filter") == fmt.Sprintf("uuid==%q", command.UUID) {
+					results = append(results, command)
+				}
+			}
+			assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, results)))
+			return
+		}
+		payload := mdmCommandPayload{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+		uuid := fmt.Sprintf("cmd-%d", len(commands)+1)
+		recorded[uuid] = payload.CommandData
+		commands = append(commands, pro.MDMCommandStatus{
+			UUID:         uuid,
+			Client:       payload.ClientData[0],
+			CommandState: pro.MDMCommandStateAcknowledged,
+			CommandType:  payload.CommandData["commandType"].(string),
+		})
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `[{"id": "%s", "href": "/api/v2/mdm/commands?filter=uuid==%s"}]`, uuid, uuid)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestEraseDevices(t *testing.T) {
+	erased := map[string]map[string]interface{}{}
+	testServer := deviceActionsResponseMocks(t, erased)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	assert.NotNil(t, j.EraseComputer(context.Background(), "3", "12ab56"))
+	assert.NotNil(t, j.EraseComputer(context.Background(), "3", "12345"))
+	assert.Empty(t, erased)
+
+	assert.Nil(t, j.EraseComputer(context.Background(), "3", "123456"))
+	assert.Equal(t, "123456", erased["/api/v1/computer-inventory/3/erase"]["pin"])
+
+	assert.Nil(t, j.EraseComputer(context.Background(), "3", ""))
+	assert.NotContains(t, erased["/api/v1/computer-inventory/3/erase"], "pin")
+
+	assert.Nil(t, j.EraseMobileDevice(context.Background(), "7", nil))
+	assert.Equal(t, false, erased["/api/v2/mobile-devices/7/erase"]["preserveDataPlan"])
+
+	assert.Nil(t, j.EraseMobileDevice(context.Background(), "7", &pro.MobileDeviceErase{
+		PreserveDataPlan:    true,
+		ClearActivationLock: true,
+		ReturnToService:     &pro.ReturnToServiceConfig{Enabled: true, WifiProfileData: "PHBsaXN0Lz4="},
+	}))
+	assert.Equal(t, true, erased["/api/v2/mobile-devices/7/erase"]["clearActivationLock"])
+	assert.Equal(t, "PHBsaXN0Lz4=", erased["/api/v2/mobile-devices/7/erase"]["returnToService"].(map[string]interface{})["wifiProfileData"])
+}
+
+func TestDeviceLockRestartShutDown(t *testing.T) {
+	sent := map[string]map[string]interface{}{}
+	testServer := deviceActionsResponseMocks(t, sent)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.LockComputer(context.Background(), "mgmt-1", "", "Return to IT")
+	assert.NotNil(t, err)
+	_, err = j.RestartDevice(context.Background(), "", false)
+	assert.NotNil(t, err)
+
+	lock, err := j.LockComputer(context.Background(), "mgmt-1", "654321", "Return to IT")
+	assert.Nil(t, err)
+	status, err := j.MDMCommandDetails(context.Background(), lock.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.MDMCommandStateAcknowledged, status.CommandState)
+	assert.Equal(t, "mgmt-1", status.Client.ManagementID)
+	assert.Equal(t, pro.MDMCommandTypeDeviceLock, status.CommandType)
+	assert.Equal(t, "654321", sent[lock.ID]["pin"])
+
+	lock, err = j.LockMobileDevice(context.Background(), "mgmt-2", "Lost iPad", "555-0100")
+	assert.Nil(t, err)
+	status, err = j.MDMCommandDetails(context.Background(), lock.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "555-0100", sent[lock.ID]["phoneNumber"])
+	assert.NotContains(t, sent[lock.ID], "pin")
+
+	restart, err := j.RestartDevice(context.Background(), "mgmt-1", true)
+	assert.Nil(t, err)
+	status, err = j.MDMCommandDetails(context.Background(), restart.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.MDMCommandTypeRestartDevice, status.CommandType)
+	assert.Equal(t, true, sent[restart.ID]["notifyUser"])
+
+	shutdown, err := j.ShutDownDevice(context.Background(), "mgmt-2")
+	assert.Nil(t, err)
+	status, err = j.MDMCommandDetails(context.Background(), shutdown.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.MDMCommandTypeShutDownDevice, status.CommandType)
+
+	_, err = j.MDMCommandDetails(context.Background(), "cmd-404")
+	assert.True(t, pro.IsNotFound(err))
+}
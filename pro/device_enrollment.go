@@ -0,0 +1,141 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const deviceEnrollmentsContext = "device-enrollments"
+
+// DeviceEnrollments returns a single page of device enrollment instances matching opts
+func (j *Client) DeviceEnrollments(ctx context.Context, opts *ListOptions) (*Results[DeviceEnrollment], error) {
+	ep := j.endpoint(1, deviceEnrollmentsContext)
+	res, err := listPage[DeviceEnrollment](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query device enrollment instances from %s", ep)
+	}
+	return res, nil
+}
+
+// AllDeviceEnrollments returns every device enrollment instance matching opts, requesting each page in turn
+func (j *Client) AllDeviceEnrollments(ctx context.Context, opts *ListOptions) ([]DeviceEnrollment, error) {
+	ep := j.endpoint(1, deviceEnrollmentsContext)
+	res, err := listAll[DeviceEnrollment](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all device enrollment instances from %s", ep)
+	}
+	return res, nil
+}
+
+// DeviceEnrollmentDetails returns the details for a specific device enrollment instance given its ID
+func (j *Client) DeviceEnrollmentDetails(ctx context.Context, id string) (*DeviceEnrollment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", deviceEnrollmentsContext, url.PathEscape(id)))
+	res := &DeviceEnrollment{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query device enrollment instance with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// UpdateDeviceEnrollment will replace a device enrollment instance in Jamf given its ID
+func (j *Client) UpdateDeviceEnrollment(ctx context.Context, id string, content *DeviceEnrollment) (*DeviceEnrollment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", deviceEnrollmentsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for device enrollment instance: %s (%s)", id, ep)
+	}
+
+	res := &DeviceEnrollment{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for device enrollment instance: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteDeviceEnrollment will delete a device enrollment instance given its ID
+func (j *Client) DeleteDeviceEnrollment(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", deviceEnrollmentsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for device enrollment instance %s from %s", id, ep)
+	}
+	return nil
+}
+
+// UploadDeviceEnrollmentToken creates a new device enrollment instance from a server token (.p7m) downloaded
+// from Apple Business Manager or Apple School Manager
+func (j *Client) UploadDeviceEnrollmentToken(ctx context.Context, filename string, token []byte) (*DeviceEnrollment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/upload-token", deviceEnrollmentsContext))
+	if len(token) == 0 {
+		return nil, errors.Wrapf(fmt.Errorf("empty token"), "unable to process JAMF upload request for device enrollment token: (%s)", ep)
+	}
+
+	res := &DeviceEnrollment{}
+	if err := j.do(ctx, "POST", ep, newDeviceEnrollmentToken(filename, token), res); err != nil {
+		return nil, errors.Wrapf(err, "unable to upload device enrollment token %s on %s", filename, ep)
+	}
+	return res, nil
+}
+
+// RenewDeviceEnrollmentToken replaces the server token of a device enrollment instance given its ID, Jamf
+// syncs with Apple once the new token is uploaded
+func (j *Client) RenewDeviceEnrollmentToken(ctx context.Context, id string, filename string, token []byte) (*DeviceEnrollment, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/upload-token", deviceEnrollmentsContext, url.PathEscape(id)))
+	if len(token) == 0 {
+		return nil, errors.Wrapf(fmt.Errorf("empty token"), "unable to process JAMF renewal request for device enrollment token: %s (%s)", id, ep)
+	}
+
+	res := &DeviceEnrollment{}
+	if err := j.do(ctx, "PUT", ep, newDeviceEnrollmentToken(filename, token), res); err != nil {
+		return nil, errors.Wrapf(err, "unable to renew device enrollment token for instance with ID %s on %s", id, ep)
+	}
+	return res, nil
+}
+
+// DeviceEnrollmentDevices returns the devices Apple has assigned to a device enrollment instance given its ID
+func (j *Client) DeviceEnrollmentDevices(ctx context.Context, id string) ([]DeviceEnrollmentDevice, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/devices", deviceEnrollmentsContext, url.PathEscape(id)))
+	res := &Results[DeviceEnrollmentDevice]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query devices for device enrollment instance with ID %s from %s", id, ep)
+	}
+	return res.Results, nil
+}
+
+// DeviceEnrollmentSyncs returns the history of syncs with Apple for a device enrollment instance given its ID
+func (j *Client) DeviceEnrollmentSyncs(ctx context.Context, id string) ([]DeviceEnrollmentSync, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/syncs", deviceEnrollmentsContext, url.PathEscape(id)))
+	res := []DeviceEnrollmentSync{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query syncs for device enrollment instance with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// LatestDeviceEnrollmentSync returns the most recent sync with Apple for a device enrollment instance given its ID
+func (j *Client) LatestDeviceEnrollmentSync(ctx context.Context, id string) (*DeviceEnrollmentSync, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/syncs/latest", deviceEnrollmentsContext, url.PathEscape(id)))
+	res := &DeviceEnrollmentSync{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query latest sync for device enrollment instance with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// DisownDeviceEnrollmentDevices releases devices, by serial number, from the organization in Apple Business
+// Manager, returning the status reported by Apple for each serial number
+func (j *Client) DisownDeviceEnrollmentDevices(ctx context.Context, id string, serialNumbers []string) (map[string]string, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/disown", deviceEnrollmentsContext, url.PathEscape(id)))
+	if len(serialNumbers) == 0 {
+		return nil, errors.Wrapf(fmt.Errorf("no serial numbers"), "unable to process JAMF disown request for device enrollment instance: %s (%s)", id, ep)
+	}
+
+	res := &DeviceEnrollmentDisown{}
+	if err := j.do(ctx, "POST", ep, &DeviceEnrollmentDisown{Devices: serialNumbers}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to disown devices from device enrollment instance with ID %s on %s", id, ep)
+	}
+	return res.Statuses, nil
+}
@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// DeviceEnrollment represents an Automated Device Enrollment instance, the link between Jamf Pro and an
+// Apple Business Manager or Apple School Manager MDM server
+type DeviceEnrollment struct {
+	ID                    string `json:"id,omitempty"`
+	Name                  string `json:"name"`
+	SupervisionIdentityID string `json:"supervisionIdentityId,omitempty"`
+	SiteID                string `json:"siteId,omitempty"`
+	ServerName            string `json:"serverName,omitempty"`
+	ServerUUID            string `json:"serverUuid,omitempty"`
+	AdminID               string `json:"adminId,omitempty"`
+	OrgName               string `json:"orgName,omitempty"`
+	OrgEmail              string `json:"orgEmail,omitempty"`
+	OrgPhone              string `json:"orgPhone,omitempty"`
+	OrgAddress            string `json:"orgAddress,omitempty"`
+	TokenExpirationDate   string `json:"tokenExpirationDate,omitempty"`
+}
+
+// DeviceEnrollmentToken holds a server token downloaded from Apple, base64 encoded for upload
+type DeviceEnrollmentToken struct {
+	TokenFileName string `json:"tokenFileName,omitempty"`
+	EncodedToken  string `json:"encodedToken"`
+}
+
+func newDeviceEnrollmentToken(filename string, token []byte) *DeviceEnrollmentToken {
+	return &DeviceEnrollmentToken{
+		TokenFileName: filename,
+		EncodedToken:  base64.StdEncoding.EncodeToString(token),
+	}
+}
+
+// DeviceEnrollmentDevice represents a device Apple has assigned to a device enrollment instance
+type DeviceEnrollmentDevice struct {
+	ID                                string                `json:"id"`
+	DeviceEnrollmentProgramInstanceID string                `json:"deviceEnrollmentProgramInstanceId,omitempty"`
+	PrestageID                        string                `json:"prestageId,omitempty"`
+	SerialNumber                      string                `json:"serialNumber"`
+	Description                       string                `json:"description,omitempty"`
+	Model                             string                `json:"model,omitempty"`
+	Color                             string                `json:"color,omitempty"`
+	AssetTag                          string                `json:"assetTag,omitempty"`
+	ProfileStatus                     string                `json:"profileStatus,omitempty"`
+	SyncState                         *DeviceEnrollmentSync `json:"syncState,omitempty"`
+	ProfileAssignTime                 string                `json:"profileAssignTime,omitempty"`
+	ProfilePushTime                   string                `json:"profilePushTime,omitempty"`
+	DeviceAssignedDate                string                `json:"deviceAssignedDate,omitempty"`
+}
+
+// DeviceEnrollmentSync holds the result of a sync between Jamf Pro and Apple
+type DeviceEnrollmentSync struct {
+	ID           int    `json:"id,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	ProfileUUID  string `json:"profileUUID,omitempty"`
+	SyncStatus   string `json:"syncStatus,omitempty"`
+	SyncState    string `json:"syncState,omitempty"`
+	InstanceID   string `json:"instanceId,omitempty"`
+	FailureCount int    `json:"failureCount,omitempty"`
+	Timestamp    string `json:"timestamp,omitempty"`
+}
+
+// DeviceEnrollmentDisown holds the serial numbers to disown, and the status of each in the response
+type DeviceEnrollmentDisown struct {
+	Devices  []string          `json:"-"`
+	Statuses map[string]string `json:"-"`
+}
+
+// MarshalJSON sends the serial numbers to disown as the devices list Jamf expects
+func (d *DeviceEnrollmentDisown) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Devices []string `json:"devices"`
+	}{d.Devices})
+}
+
+// UnmarshalJSON reads the per serial number statuses Jamf returns in the devices object
+func (d *DeviceEnrollmentDisown) UnmarshalJSON(data []byte) error {
+	res := struct {
+		Devices map[string]string `json:"devices"`
+	}{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+	d.Statuses = res.Devices
+	return nil
+}
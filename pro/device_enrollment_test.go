@@ -0,0 +1,176 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var DEVICE_ENROLLMENTS_API_BASE_ENDPOINT = "/api/v1/device-enrollments"
+
+func deviceEnrollmentsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	instances := &crudMock[pro.DeviceEnrollment]{
+		t:      t,
+		base:   DEVICE_ENROLLMENTS_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.DeviceEnrollment{
+			{ID: "1", Name: "Apple Business Manager", ServerName: "Jamf Pro MDM", OrgName: "Example Corp", TokenExpirationDate: "2025-10-01"},
+		},
+		getID: func(d pro.DeviceEnrollment) string { return d.ID },
+		setID: func(d *pro.DeviceEnrollment, id string) { d.ID = id },
+	}
+	mux.Handle(DEVICE_ENROLLMENTS_API_BASE_ENDPOINT, instances)
+	mux.Handle(DEVICE_ENROLLMENTS_API_BASE_ENDPOINT+"/", instances)
+
+	uploadToken := func(w http.ResponseWriter, r *http.Request) {
+		token := &pro.DeviceEnrollmentToken{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(token))
+		decoded, err := base64.StdEncoding.DecodeString(token.EncodedToken)
+		assert.Nil(t, err)
+		assert.Equal(t, "smime-token-contents", string(decoded))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id": "1", "name": "%s", "tokenExpirationDate": "2026-10-01"}`, token.TokenFileName)
+	}
+	mux.HandleFunc(fmt.Sprintf("%s/upload-token", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		uploadToken(w, r)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/1/upload-token", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		uploadToken(w, r)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/1/devices", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"totalCount": 2,
+			"results": [
+				{"id": "10", "serialNumber": "C02AAA", "model": "MacBook Air", "profileStatus": "ASSIGNED", "prestageId": "4"},
+				{"id": "11", "serialNumber": "C02BBB", "model": "MacBook Pro", "profileStatus": "EMPTY"}
+			]
+		}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/1/syncs", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[
+			{"syncState": "CONNECTION_ERROR", "instanceId": "1", "timestamp": "2024-10-01T10:00:00Z"},
+			{"syncState": "COMPLETED", "instanceId": "1", "timestamp": "2024-10-01T11:00:00Z"}
+		]`)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/1/syncs/latest", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"syncState": "COMPLETED", "instanceId": "1", "timestamp": "2024-10-01T11:00:00Z"}`)
+	})
+	mux.HandleFunc(fmt.Sprintf("%s/1/disown", DEVICE_ENROLLMENTS_API_BASE_ENDPOINT), func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, []string{"C02AAA", "C02ZZZ"}, body["devices"])
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"devices": {"C02AAA": "SUCCESS", "C02ZZZ": "NOT_ACCESSIBLE"}}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestQueryAllDeviceEnrollments(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	instances, err := j.AllDeviceEnrollments(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(instances))
+	assert.Equal(t, "Example Corp", instances[0].OrgName)
+
+	instance, err := j.DeviceEnrollmentDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "2025-10-01", instance.TokenExpirationDate)
+}
+
+func TestDeviceEnrollmentTokens(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UploadDeviceEnrollmentToken(context.Background(), "server.p7m", nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "empty token")
+
+	instance, err := j.UploadDeviceEnrollmentToken(context.Background(), "server.p7m", []byte("smime-token-contents"))
+	assert.Nil(t, err)
+	assert.Equal(t, "server.p7m", instance.Name)
+
+	instance, err = j.RenewDeviceEnrollmentToken(context.Background(), "1", "renewed.p7m", []byte("smime-token-contents"))
+	assert.Nil(t, err)
+	assert.Equal(t, "2026-10-01", instance.TokenExpirationDate)
+}
+
+func TestDeviceEnrollmentDevices(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	devices, err := j.DeviceEnrollmentDevices(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(devices))
+	assert.Equal(t, "C02AAA", devices[0].SerialNumber)
+	assert.Equal(t, "4", devices[0].PrestageID)
+}
+
+func TestDeviceEnrollmentSyncs(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	syncs, err := j.DeviceEnrollmentSyncs(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(syncs))
+	assert.Equal(t, "CONNECTION_ERROR", syncs[0].SyncState)
+
+	latest, err := j.LatestDeviceEnrollmentSync(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "COMPLETED", latest.SyncState)
+}
+
+func TestDisownDeviceEnrollmentDevices(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.DisownDeviceEnrollmentDevices(context.Background(), "1", nil)
+	assert.NotNil(t, err)
+
+	statuses, err := j.DisownDeviceEnrollmentDevices(context.Background(), "1", []string{"C02AAA", "C02ZZZ"})
+	assert.Nil(t, err)
+	assert.Equal(t, "SUCCESS", statuses["C02AAA"])
+	assert.Equal(t, "NOT_ACCESSIBLE", statuses["C02ZZZ"])
+}
+
+func TestDeleteDeviceEnrollment(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	assert.Nil(t, j.DeleteDeviceEnrollment(context.Background(), "1"))
+	_, err := j.DeviceEnrollmentDetails(context.Background(), "1")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestUpdateDeviceEnrollment(t *testing.T) {
+	testServer := deviceEnrollmentsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	instance, err := j.UpdateDeviceEnrollment(context.Background(), "1", &pro.DeviceEnrollment{Name: "ABM - Production", SiteID: "-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", instance.ID)
+	assert.Equal(t, "ABM - Production", instance.Name)
+}
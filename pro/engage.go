@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const engageContext = "engage"
+
+// EngageSettings returns whether Jamf Engage is enabled
+func (j *Client) EngageSettings(ctx context.Context) (*EngageSettings, error) {
+	ep := j.endpoint(2, engageContext)
+	res := &EngageSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Engage settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateEngageSettings will replace the Jamf Engage settings in Jamf
+func (j *Client) UpdateEngageSettings(ctx context.Context, settings *EngageSettings) (*EngageSettings, error) {
+	ep := j.endpoint(2, engageContext)
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Engage settings: (%s)", ep)
+	}
+
+	res := &EngageSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Engage settings (%s)", ep)
+	}
+	return res, nil
+}
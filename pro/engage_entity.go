@@ -0,0 +1,8 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// EngageSettings represents the Jamf Engage settings
+type EngageSettings struct {
+	Enabled bool `json:"isEnabled"`
+}
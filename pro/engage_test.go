@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var ENGAGE_API_BASE_ENDPOINT = "/api/v2/engage"
+
+func engageResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.EngageSettings{}
+	mux.HandleFunc(ENGAGE_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	mux.HandleFunc(ENGAGE_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Engage enabled"}]}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestEngageSettings(t *testing.T) {
+	testServer := engageResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.EngageSettings(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, settings.Enabled)
+
+	_, err = j.UpdateEngageSettings(context.Background(), nil)
+	assert.Contains(t, err.Error(), "empty payload")
+
+	updated, err := j.UpdateEngageSettings(context.Background(), &pro.EngageSettings{Enabled: true})
+	assert.Nil(t, err)
+	assert.True(t, updated.Enabled)
+
+	history, err := j.History(context.Background(), pro.HistoryResourceEngage, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Engage enabled", history.Results[0].Note)
+}
@@ -0,0 +1,111 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const enrollmentContext = "enrollment"
+
+// EnrollmentSettings returns the user-initiated enrollment settings for Jamf Pro
+func (j *Client) EnrollmentSettings(ctx context.Context) (*EnrollmentSettings, error) {
+	ep := j.endpoint(3, enrollmentContext)
+	res := &EnrollmentSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateEnrollmentSettings will replace the user-initiated enrollment settings for Jamf Pro
+func (j *Client) UpdateEnrollmentSettings(ctx context.Context, content *EnrollmentSettings) (*EnrollmentSettings, error) {
+	ep := j.endpoint(3, enrollmentContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for enrollment settings (%s)", ep)
+	}
+
+	res := &EnrollmentSettings{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for enrollment settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// EnrollmentLanguages returns a single page of the messaging configured for each language shown during user-initiated enrollment
+func (j *Client) EnrollmentLanguages(ctx context.Context, opts *ListOptions) (*Results[EnrollmentLanguage], error) {
+	ep := j.endpoint(3, enrollmentContext+"/languages")
+	res, err := listPage[EnrollmentLanguage](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment languages from %s", ep)
+	}
+	return res, nil
+}
+
+// AllEnrollmentLanguages returns the messaging configured for every language shown during user-initiated
+// enrollment, requesting each page in turn
+func (j *Client) AllEnrollmentLanguages(ctx context.Context, opts *ListOptions) ([]EnrollmentLanguage, error) {
+	ep := j.endpoint(3, enrollmentContext+"/languages")
+	res, err := listAll[EnrollmentLanguage](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all enrollment languages from %s", ep)
+	}
+	return res, nil
+}
+
+// EnrollmentLanguageDetails returns the enrollment messaging for a language given its ISO 639-1 code, e.g. "en"
+func (j *Client) EnrollmentLanguageDetails(ctx context.Context, languageCode string) (*EnrollmentLanguage, error) {
+	ep := j.endpoint(3, fmt.Sprintf("%s/languages/%s", enrollmentContext, url.PathEscape(languageCode)))
+	res := &EnrollmentLanguage{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment language %s from %s", languageCode, ep)
+	}
+	return res, nil
+}
+
+// UpdateEnrollmentLanguage will create or replace the enrollment messaging for a language given its ISO 639-1 code
+func (j *Client) UpdateEnrollmentLanguage(ctx context.Context, languageCode string, content *EnrollmentLanguage) (*EnrollmentLanguage, error) {
+	ep := j.endpoint(3, fmt.Sprintf("%s/languages/%s", enrollmentContext, url.PathEscape(languageCode)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for enrollment language: %s (%s)", languageCode, ep)
+	}
+
+	res := &EnrollmentLanguage{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for enrollment language: %s (%s)", languageCode, ep)
+	}
+	return res, nil
+}
+
+// DeleteEnrollmentLanguage will delete the enrollment messaging for a language given its ISO 639-1 code
+func (j *Client) DeleteEnrollmentLanguage(ctx context.Context, languageCode string) error {
+	ep := j.endpoint(3, fmt.Sprintf("%s/languages/%s", enrollmentContext, url.PathEscape(languageCode)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for enrollment language %s from %s", languageCode, ep)
+	}
+	return nil
+}
+
+// EnrollmentLanguageCodes returns the languages which enrollment messaging can be configured for
+func (j *Client) EnrollmentLanguageCodes(ctx context.Context) ([]EnrollmentLanguageCode, error) {
+	ep := j.endpoint(3, enrollmentContext+"/language-codes")
+	res := []EnrollmentLanguageCode{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment language codes from %s", ep)
+	}
+	return res, nil
+}
+
+// EnrollmentHistory returns a single page of the change history for the enrollment settings
+func (j *Client) EnrollmentHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceEnrollment, "", opts)
+}
+
+// AddEnrollmentHistoryNote adds a note to the change history for the enrollment settings
+func (j *Client) AddEnrollmentHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceEnrollment, "", note)
+}
@@ -0,0 +1,224 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	enrollmentCustomizationsContext      = "enrollment-customizations"
+	enrollmentCustomizationPanelsContext = "enrollment-customization"
+)
+
+// EnrollmentCustomizations returns a single page of enrollment customizations matching opts
+func (j *Client) EnrollmentCustomizations(ctx context.Context, opts *ListOptions) (*Results[EnrollmentCustomization], error) {
+	ep := j.endpoint(2, enrollmentCustomizationsContext)
+	res, err := listPage[EnrollmentCustomization](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment customizations from %s", ep)
+	}
+	return res, nil
+}
+
+// AllEnrollmentCustomizations returns every enrollment customization matching opts, requesting each page in turn
+func (j *Client) AllEnrollmentCustomizations(ctx context.Context, opts *ListOptions) ([]EnrollmentCustomization, error) {
+	ep := j.endpoint(2, enrollmentCustomizationsContext)
+	res, err := listAll[EnrollmentCustomization](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all enrollment customizations from %s", ep)
+	}
+	return res, nil
+}
+
+// EnrollmentCustomizationDetails returns the details for a specific enrollment customization given its ID
+func (j *Client) EnrollmentCustomizationDetails(ctx context.Context, id string) (*EnrollmentCustomization, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", enrollmentCustomizationsContext, url.PathEscape(id)))
+	res := &EnrollmentCustomization{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query enrollment customization with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateEnrollmentCustomization will create a new enrollment customization in Jamf
+func (j *Client) CreateEnrollmentCustomization(ctx context.Context, content *EnrollmentCustomization) (*CreatedResource, error) {
+	ep := j.endpoint(2, enrollmentCustomizationsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for enrollment customization: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *EnrollmentCustomization) *string { return &c.SiteID })
+	if content.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new enrollment customization"), "unable to process JAMF creation request for enrollment customization: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for enrollment customization %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateEnrollmentCustomization will replace an enrollment customization in Jamf given its ID
+func (j *Client) UpdateEnrollmentCustomization(ctx context.Context, id string, content *EnrollmentCustomization) (*EnrollmentCustomization, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", enrollmentCustomizationsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for enrollment customization: %s (%s)", id, ep)
+	}
+
+	res := &EnrollmentCustomization{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for enrollment customization: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteEnrollmentCustomization will delete an enrollment customization given its ID
+func (j *Client) DeleteEnrollmentCustomization(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", enrollmentCustomizationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for enrollment customization %s from %s", id, ep)
+	}
+	return nil
+}
+
+// EnrollmentCustomizationHistory returns a single page of the change history for an enrollment customization given its ID
+func (j *Client) EnrollmentCustomizationHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceEnrollmentCustomizations, id, opts)
+}
+
+// AddEnrollmentCustomizationHistoryNote adds a note to the change history for an enrollment customization given its ID
+func (j *Client) AddEnrollmentCustomizationHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceEnrollmentCustomizations, id, note)
+}
+
+// EnrollmentCustomizationPrestages returns the prestages using an enrollment customization given its ID
+func (j *Client) EnrollmentCustomizationPrestages(ctx context.Context, id string) ([]Dependency, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/prestages", enrollmentCustomizationsContext, url.PathEscape(id)))
+	res := &dependencies{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query prestages for enrollment customization with ID %s from %s", id, ep)
+	}
+	return res.Dependencies, nil
+}
+
+// UploadEnrollmentCustomizationImage uploads an image to use as the icon of an enrollment customization,
+// returning the URL to set as its BrandingSettings.IconURL
+func (j *Client) UploadEnrollmentCustomizationImage(ctx context.Context, filename string, image io.Reader) (string, error) {
+	ep := j.endpoint(2, enrollmentCustomizationsContext+"/images")
+	if image == nil {
+		return "", fmt.Errorf("an image is required to upload to %s", ep)
+	}
+	res := &enrollmentCustomizationImage{}
+	if err := j.upload(ctx, ep, "file", filename, image, res); err != nil {
+		return "", errors.Wrapf(err, "unable to upload enrollment customization image %s to %s", filename, ep)
+	}
+	return res.URL, nil
+}
+
+// EnrollmentCustomizationPanels returns every pane shown by an enrollment customization given its ID, in rank order
+func (j *Client) EnrollmentCustomizationPanels(ctx context.Context, id string) ([]EnrollmentCustomizationPanel, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/all", enrollmentCustomizationPanelsContext, url.PathEscape(id)))
+	res := &enrollmentCustomizationPanels{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query panes for enrollment customization with ID %s from %s", id, ep)
+	}
+	return res.Panels, nil
+}
+
+// DeleteEnrollmentCustomizationPanel will delete a pane of any kind from an enrollment customization
+func (j *Client) DeleteEnrollmentCustomizationPanel(ctx context.Context, id string, panelID string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/all/%s", enrollmentCustomizationPanelsContext, url.PathEscape(id), url.PathEscape(panelID)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for pane %s of enrollment customization %s from %s", panelID, id, ep)
+	}
+	return nil
+}
+
+// EnrollmentCustomizationTextPanelDetails returns a text pane of an enrollment customization
+func (j *Client) EnrollmentCustomizationTextPanelDetails(ctx context.Context, id string, panelID string) (*EnrollmentCustomizationTextPanel, error) {
+	return enrollmentCustomizationPanel[EnrollmentCustomizationTextPanel](ctx, j, "GET", id, EnrollmentCustomizationPanelTypeText, panelID, nil)
+}
+
+// CreateEnrollmentCustomizationTextPanel will add a text pane, such as terms and conditions, to an enrollment customization
+func (j *Client) CreateEnrollmentCustomizationTextPanel(ctx context.Context, id string, content *EnrollmentCustomizationTextPanel) (*EnrollmentCustomizationTextPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for new text pane of enrollment customization %s", id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "POST", id, EnrollmentCustomizationPanelTypeText, "", content)
+}
+
+// UpdateEnrollmentCustomizationTextPanel will replace a text pane of an enrollment customization
+func (j *Client) UpdateEnrollmentCustomizationTextPanel(ctx context.Context, id string, panelID string, content *EnrollmentCustomizationTextPanel) (*EnrollmentCustomizationTextPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for text pane %s of enrollment customization %s", panelID, id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "PUT", id, EnrollmentCustomizationPanelTypeText, panelID, content)
+}
+
+// EnrollmentCustomizationLDAPPanelDetails returns an LDAP authentication pane of an enrollment customization
+func (j *Client) EnrollmentCustomizationLDAPPanelDetails(ctx context.Context, id string, panelID string) (*EnrollmentCustomizationLDAPPanel, error) {
+	return enrollmentCustomizationPanel[EnrollmentCustomizationLDAPPanel](ctx, j, "GET", id, EnrollmentCustomizationPanelTypeLDAP, panelID, nil)
+}
+
+// CreateEnrollmentCustomizationLDAPPanel will add an LDAP authentication pane to an enrollment customization
+func (j *Client) CreateEnrollmentCustomizationLDAPPanel(ctx context.Context, id string, content *EnrollmentCustomizationLDAPPanel) (*EnrollmentCustomizationLDAPPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for new LDAP pane of enrollment customization %s", id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "POST", id, EnrollmentCustomizationPanelTypeLDAP, "", content)
+}
+
+// UpdateEnrollmentCustomizationLDAPPanel will replace an LDAP authentication pane of an enrollment customization
+func (j *Client) UpdateEnrollmentCustomizationLDAPPanel(ctx context.Context, id string, panelID string, content *EnrollmentCustomizationLDAPPanel) (*EnrollmentCustomizationLDAPPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for LDAP pane %s of enrollment customization %s", panelID, id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "PUT", id, EnrollmentCustomizationPanelTypeLDAP, panelID, content)
+}
+
+// EnrollmentCustomizationSSOPanelDetails returns a single sign-on authentication pane of an enrollment customization
+func (j *Client) EnrollmentCustomizationSSOPanelDetails(ctx context.Context, id string, panelID string) (*EnrollmentCustomizationSSOPanel, error) {
+	return enrollmentCustomizationPanel[EnrollmentCustomizationSSOPanel](ctx, j, "GET", id, EnrollmentCustomizationPanelTypeSSO, panelID, nil)
+}
+
+// CreateEnrollmentCustomizationSSOPanel will add a single sign-on authentication pane to an enrollment customization,
+// single sign-on must be enabled for enrollment in the SSO settings
+func (j *Client) CreateEnrollmentCustomizationSSOPanel(ctx context.Context, id string, content *EnrollmentCustomizationSSOPanel) (*EnrollmentCustomizationSSOPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for new SSO pane of enrollment customization %s", id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "POST", id, EnrollmentCustomizationPanelTypeSSO, "", content)
+}
+
+// UpdateEnrollmentCustomizationSSOPanel will replace a single sign-on authentication pane of an enrollment customization
+func (j *Client) UpdateEnrollmentCustomizationSSOPanel(ctx context.Context, id string, panelID string, content *EnrollmentCustomizationSSOPanel) (*EnrollmentCustomizationSSOPanel, error) {
+	if content == nil {
+		return nil, fmt.Errorf("empty payload for SSO pane %s of enrollment customization %s", panelID, id)
+	}
+	return enrollmentCustomizationPanel(ctx, j, "PUT", id, EnrollmentCustomizationPanelTypeSSO, panelID, content)
+}
+
+// enrollmentCustomizationPanel sends a request for a pane of the given kind, content is only sent when it is not nil
+func enrollmentCustomizationPanel[T any](ctx context.Context, j *Client, method string, id string, kind string, panelID string, content *T) (*T, error) {
+	resource := fmt.Sprintf("%s/%s/%s", enrollmentCustomizationPanelsContext, url.PathEscape(id), kind)
+	if panelID != "" {
+		resource = fmt.Sprintf("%s/%s", resource, url.PathEscape(panelID))
+	}
+	ep := j.endpoint(1, resource)
+
+	var body interface{}
+	if content != nil {
+		body = content
+	}
+	res := new(T)
+	if err := j.do(ctx, method, ep, body, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF %s request for %s pane of enrollment customization %s (%s)", method, kind, id, ep)
+	}
+	return res, nil
+}
@@ -0,0 +1,89 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Types of pane reported by EnrollmentCustomizationPanels
+const (
+	EnrollmentCustomizationPanelTypeText = "text"
+	EnrollmentCustomizationPanelTypeLDAP = "ldap"
+	EnrollmentCustomizationPanelTypeSSO  = "sso"
+)
+
+// EnrollmentCustomization represents the branding and panes shown to users during Automated Device
+// Enrollment, it is applied to devices through a computer or mobile device prestage
+type EnrollmentCustomization struct {
+	ID               string                                   `json:"id,omitempty"`
+	SiteID           string                                   `json:"siteId"`
+	DisplayName      string                                   `json:"displayName"`
+	Description      string                                   `json:"description"`
+	BrandingSettings *EnrollmentCustomizationBrandingSettings `json:"enrollmentCustomizationBrandingSettings,omitempty"`
+}
+
+// EnrollmentCustomizationBrandingSettings holds the colors and icon of an enrollment customization,
+// colors are hex values without the leading #
+type EnrollmentCustomizationBrandingSettings struct {
+	TextColor       string `json:"textColor"`
+	ButtonColor     string `json:"buttonColor"`
+	ButtonTextColor string `json:"buttonTextColor"`
+	BackgroundColor string `json:"backgroundColor"`
+	IconURL         string `json:"iconUrl"`
+}
+
+// EnrollmentCustomizationPanel holds the summary of a pane of any kind in an enrollment customization
+type EnrollmentCustomizationPanel struct {
+	ID          int    `json:"id"`
+	DisplayName string `json:"displayName"`
+	Rank        int    `json:"rank"`
+	Type        string `json:"type"`
+}
+
+// EnrollmentCustomizationTextPanel represents a pane showing text, e.g. terms and conditions, which users must continue past
+type EnrollmentCustomizationTextPanel struct {
+	ID                 int    `json:"id,omitempty"`
+	DisplayName        string `json:"displayName"`
+	Rank               int    `json:"rank"`
+	Title              string `json:"title"`
+	Body               string `json:"body"`
+	Subtext            string `json:"subtext,omitempty"`
+	BackButtonText     string `json:"backButtonText"`
+	ContinueButtonText string `json:"continueButtonText"`
+}
+
+// EnrollmentCustomizationLDAPPanel represents a pane asking users to authenticate against an LDAP server
+type EnrollmentCustomizationLDAPPanel struct {
+	ID                 int                                `json:"id,omitempty"`
+	DisplayName        string                             `json:"displayName"`
+	Rank               int                                `json:"rank"`
+	Title              string                             `json:"title"`
+	UsernameLabel      string                             `json:"usernameLabel"`
+	PasswordLabel      string                             `json:"passwordLabel"`
+	BackButtonText     string                             `json:"backButtonText"`
+	ContinueButtonText string                             `json:"continueButtonText"`
+	LDAPGroupAccess    []EnrollmentCustomizationLDAPGroup `json:"ldapGroupAccess,omitempty"`
+}
+
+// EnrollmentCustomizationLDAPGroup holds an LDAP group whose members are allowed to enroll
+type EnrollmentCustomizationLDAPGroup struct {
+	LDAPServerID int    `json:"ldapServerId"`
+	GroupName    string `json:"groupName"`
+}
+
+// EnrollmentCustomizationSSOPanel represents a pane asking users to authenticate through single sign-on
+type EnrollmentCustomizationSSOPanel struct {
+	ID                             int    `json:"id,omitempty"`
+	DisplayName                    string `json:"displayName"`
+	Rank                           int    `json:"rank"`
+	IsUseJamfConnect               bool   `json:"isUseJamfConnect"`
+	LongNameAttribute              string `json:"longNameAttribute,omitempty"`
+	ShortNameAttribute             string `json:"shortNameAttribute,omitempty"`
+	IsGroupEnrollmentAccessEnabled bool   `json:"isGroupEnrollmentAccessEnabled"`
+	GroupEnrollmentAccessName      string `json:"groupEnrollmentAccessName,omitempty"`
+}
+
+type enrollmentCustomizationPanels struct {
+	Panels []EnrollmentCustomizationPanel `json:"panels"`
+}
+
+type enrollmentCustomizationImage struct {
+	URL string `json:"url"`
+}
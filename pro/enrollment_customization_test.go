@@ -0,0 +1,228 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT = "/api/v2/enrollment-customizations"
+var ENROLLMENT_CUSTOMIZATION_PANELS_API_BASE_ENDPOINT = "/api/v1/enrollment-customization"
+
+func enrollmentCustomizationsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	customizations := &crudMock[pro.EnrollmentCustomization]{
+		t:      t,
+		base:   ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.EnrollmentCustomization{
+			{
+				ID:          "1",
+				SiteID:      "-1",
+				DisplayName: "Staff Enrollment",
+				Description: "Terms and SSO for staff devices",
+				BrandingSettings: &pro.EnrollmentCustomizationBrandingSettings{
+					TextColor:       "000000",
+					ButtonColor:     "0A60FF",
+					ButtonTextColor: "FFFFFF",
+					BackgroundColor: "F0F0F0",
+				},
+			},
+		},
+		getID: func(e pro.EnrollmentCustomization) string { return e.ID },
+		setID: func(e *pro.EnrollmentCustomization, id string) { e.ID = id },
+	}
+	mux.Handle(ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT, customizations)
+	mux.Handle(ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT+"/", customizations)
+	mux.HandleFunc(ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT+"/1/prestages", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"dependencies": [{"name": "Staff Macs", "hyperlink": "/computer-prestages/1", "humanReadableName": "Computer PreStage"}]}`)
+	})
+	mux.HandleFunc(ENROLLMENT_CUSTOMIZATIONS_API_BASE_ENDPOINT+"/images", func(w http.ResponseWriter, r *http.Request) {
+		_, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"url": "https://jamf.example.com/api/v2/enrollment-customizations/images/1?name=%s"}`, header.Filename)
+	})
+
+	panels := map[int]json.RawMessage{}
+	kinds := map[int]string{}
+	nextPanel := 0
+	panelSummaries := func() []pro.EnrollmentCustomizationPanel {
+		summaries := []pro.EnrollmentCustomizationPanel{}
+		for id := 1; id <= nextPanel; id++ {
+			if panel, ok := panels[id]; ok {
+				summary := pro.EnrollmentCustomizationPanel{}
+				assert.Nil(t, json.Unmarshal(panel, &summary))
+				summary.Type = kinds[id]
+				summaries = append(summaries, summary)
+			}
+		}
+		return summaries
+	}
+	mux.HandleFunc(ENROLLMENT_CUSTOMIZATION_PANELS_API_BASE_ENDPOINT+"/1/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, ENROLLMENT_CUSTOMIZATION_PANELS_API_BASE_ENDPOINT+"/1/"), "/")
+		kind := parts[0]
+		if kind == "all" && len(parts) == 1 {
+			assert.Nil(t, json.NewEncoder(w).Encode(map[string]interface{}{"panels": panelSummaries()}))
+			return
+		}
+		if len(parts) == 1 && r.Method == "POST" {
+			nextPanel++
+			panel := map[string]interface{}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&panel))
+			panel["id"] = nextPanel
+			data, err := json.Marshal(panel)
+			assert.Nil(t, err)
+			panels[nextPanel], kinds[nextPanel] = data, kind
+			_, err = w.Write(data)
+			assert.Nil(t, err)
+			return
+		}
+		id, _ := strconv.Atoi(parts[1])
+		if _, ok := panels[id]; !ok || (kind != "all" && kinds[id] != kind) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Panel not found"}]}`)
+			return
+		}
+		switch r.Method {
+		case "PUT":
+			panel := map[string]interface{}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&panel))
+			panel["id"] = id
+			data, err := json.Marshal(panel)
+			assert.Nil(t, err)
+			panels[id] = data
+		case "DELETE":
+			delete(panels, id)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, err := w.Write(panels[id])
+		assert.Nil(t, err)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestEnrollmentCustomizationsCRUD(t *testing.T) {
+	testServer := enrollmentCustomizationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	customization, err := j.EnrollmentCustomizationDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "0A60FF", customization.BrandingSettings.ButtonColor)
+
+	_, err = j.CreateEnrollmentCustomization(context.Background(), &pro.EnrollmentCustomization{})
+	assert.NotNil(t, err)
+
+	_, err = j.UploadEnrollmentCustomizationImage(context.Background(), "logo.png", nil)
+	assert.NotNil(t, err)
+	iconURL, err := j.UploadEnrollmentCustomizationImage(context.Background(), "logo.png", strings.NewReader("PNG-DATA"))
+	assert.Nil(t, err)
+	assert.Contains(t, iconURL, "name=logo.png")
+
+	created, err := j.CreateEnrollmentCustomization(context.Background(), &pro.EnrollmentCustomization{
+		SiteID:           "-1",
+		DisplayName:      "Student Enrollment",
+		BrandingSettings: &pro.EnrollmentCustomizationBrandingSettings{IconURL: iconURL},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	customization.Description = "Terms, LDAP and SSO for staff devices"
+	updated, err := j.UpdateEnrollmentCustomization(context.Background(), "1", customization)
+	assert.Nil(t, err)
+	assert.Equal(t, "Terms, LDAP and SSO for staff devices", updated.Description)
+
+	prestages, err := j.EnrollmentCustomizationPrestages(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff Macs", prestages[0].Name)
+
+	_, err = j.AddEnrollmentCustomizationHistoryNote(context.Background(), "1", "Added LDAP pane")
+	assert.Nil(t, err)
+	history, err := j.EnrollmentCustomizationHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Added LDAP pane", history.Results[0].Note)
+
+	assert.Nil(t, j.DeleteEnrollmentCustomization(context.Background(), "2"))
+}
+
+func TestEnrollmentCustomizationPanels(t *testing.T) {
+	testServer := enrollmentCustomizationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateEnrollmentCustomizationTextPanel(context.Background(), "1", nil)
+	assert.NotNil(t, err)
+
+	text, err := j.CreateEnrollmentCustomizationTextPanel(context.Background(), "1", &pro.EnrollmentCustomizationTextPanel{
+		DisplayName:        "Terms",
+		Rank:               0,
+		Title:              "Acceptable Use",
+		Body:               "This device is property of Example.",
+		BackButtonText:     "Back",
+		ContinueButtonText: "Agree",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, text.ID)
+
+	ldap, err := j.CreateEnrollmentCustomizationLDAPPanel(context.Background(), "1", &pro.EnrollmentCustomizationLDAPPanel{
+		DisplayName:     "Directory Login",
+		Rank:            1,
+		Title:           "Sign in",
+		UsernameLabel:   "Username",
+		PasswordLabel:   "Password",
+		LDAPGroupAccess: []pro.EnrollmentCustomizationLDAPGroup{{LDAPServerID: 1, GroupName: "Staff"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff", ldap.LDAPGroupAccess[0].GroupName)
+
+	sso, err := j.CreateEnrollmentCustomizationSSOPanel(context.Background(), "1", &pro.EnrollmentCustomizationSSOPanel{DisplayName: "Single Sign-On", Rank: 2, IsUseJamfConnect: true})
+	assert.Nil(t, err)
+
+	panels, err := j.EnrollmentCustomizationPanels(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Len(t, panels, 3)
+	assert.Equal(t, pro.EnrollmentCustomizationPanelTypeLDAP, panels[1].Type)
+
+	text.Body = "This device is property of Example. Usage is monitored."
+	updatedText, err := j.UpdateEnrollmentCustomizationTextPanel(context.Background(), "1", "1", text)
+	assert.Nil(t, err)
+	assert.Contains(t, updatedText.Body, "monitored")
+
+	ldap.Title = "Sign in with your directory account"
+	_, err = j.UpdateEnrollmentCustomizationLDAPPanel(context.Background(), "1", "2", ldap)
+	assert.Nil(t, err)
+	ldap, err = j.EnrollmentCustomizationLDAPPanelDetails(context.Background(), "1", "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Sign in with your directory account", ldap.Title)
+
+	sso.GroupEnrollmentAccessName = "Staff"
+	sso.IsGroupEnrollmentAccessEnabled = true
+	_, err = j.UpdateEnrollmentCustomizationSSOPanel(context.Background(), "1", "3", sso)
+	assert.Nil(t, err)
+	sso, err = j.EnrollmentCustomizationSSOPanelDetails(context.Background(), "1", "3")
+	assert.Nil(t, err)
+	assert.True(t, sso.IsGroupEnrollmentAccessEnabled)
+
+	_, err = j.EnrollmentCustomizationTextPanelDetails(context.Background(), "1", "3")
+	assert.True(t, pro.IsNotFound(err))
+
+	assert.Nil(t, j.DeleteEnrollmentCustomizationPanel(context.Background(), "1", "2"))
+	panels, err = j.EnrollmentCustomizationPanels(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Len(t, panels, 2)
+}
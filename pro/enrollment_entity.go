@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// What happens to pending and failed MDM commands when a device re-enrolls
+const (
+	EnrollmentFlushMDMCommandsNothing          = "DELETE_NOTHING"
+	EnrollmentFlushMDMCommandsErrors           = "DELETE_ERRORS"
+	EnrollmentFlushMDMCommandsEverythingButAck = "DELETE_EVERYTHING_EXCEPT_ACKNOWLEDGED"
+	EnrollmentFlushMDMCommandsEverything       = "DELETE_EVERYTHING"
+)
+
+// EnrollmentSettings represents the settings for user-initiated enrollment of computers and mobile devices
+type EnrollmentSettings struct {
+	InstallSingleProfile                         bool                   `json:"installSingleProfile"`
+	SigningMDMProfileEnabled                     bool                   `json:"signingMdmProfileEnabled"`
+	MDMSigningCertificate                        *EnrollmentCertificate `json:"mdmSigningCertificate,omitempty"`
+	RestrictReenrollment                         bool                   `json:"restrictReenrollment"`
+	FlushLocationInformation                     bool                   `json:"flushLocationInformation"`
+	FlushLocationHistoryInformation              bool                   `json:"flushLocationHistoryInformation"`
+	FlushPolicyHistory                           bool                   `json:"flushPolicyHistory"`
+	FlushExtensionAttributes                     bool                   `json:"flushExtensionAttributes"`
+	FlushSoftwareUpdatePlans                     bool                   `json:"flushSoftwareUpdatePlans"`
+	FlushMDMCommandsOnReenroll                   string                 `json:"flushMdmCommandsOnReenroll,omitempty"`
+	MacOSEnterpriseEnrollmentEnabled             bool                   `json:"macOsEnterpriseEnrollmentEnabled"`
+	ManagementUsername                           string                 `json:"managementUsername,omitempty"`
+	CreateManagementAccount                      bool                   `json:"createManagementAccount"`
+	HideManagementAccount                        bool                   `json:"hideManagementAccount"`
+	AllowSSHOnlyManagementAccount                bool                   `json:"allowSshOnlyManagementAccount"`
+	EnsureSSHRunning                             bool                   `json:"ensureSshRunning"`
+	LaunchSelfService                            bool                   `json:"launchSelfService"`
+	SignQuickAdd                                 bool                   `json:"signQuickAdd"`
+	DeveloperCertificateIdentity                 *EnrollmentCertificate `json:"developerCertificateIdentity,omitempty"`
+	IOSEnterpriseEnrollmentEnabled               bool                   `json:"iosEnterpriseEnrollmentEnabled"`
+	IOSPersonalEnrollmentEnabled                 bool                   `json:"iosPersonalEnrollmentEnabled"`
+	PersonalDeviceEnrollmentType                 string                 `json:"personalDeviceEnrollmentType,omitempty"`
+	AccountDrivenUserEnrollmentEnabled           bool                   `json:"accountDrivenUserEnrollmentEnabled"`
+	AccountDrivenDeviceIOSEnrollmentEnabled      bool                   `json:"accountDrivenDeviceIosEnrollmentEnabled"`
+	AccountDrivenDeviceMacOSEnrollmentEnabled    bool                   `json:"accountDrivenDeviceMacosEnrollmentEnabled"`
+	AccountDrivenUserVisionOSEnrollmentEnabled   bool                   `json:"accountDrivenUserVisionosEnrollmentEnabled"`
+	AccountDrivenDeviceVisionOSEnrollmentEnabled bool                   `json:"accountDrivenDeviceVisionosEnrollmentEnabled"`
+}
+
+// EnrollmentCertificate holds a PKCS12 identity used to sign enrollment profiles or QuickAdd packages,
+// IdentityKeystore is the base64 encoded keystore and is only sent when uploading a new identity
+type EnrollmentCertificate struct {
+	Filename         string `json:"filename"`
+	KeystorePassword string `json:"keystorePassword,omitempty"`
+	IdentityKeystore string `json:"identityKeystore,omitempty"`
+	MD5Sum           string `json:"md5Sum,omitempty"`
+}
+
+// EnrollmentLanguage represents the messaging shown in a single language during user-initiated enrollment
+type EnrollmentLanguage struct {
+	LanguageCode                     string `json:"languageCode"`
+	Name                             string `json:"name"`
+	Title                            string `json:"title"`
+	LoginDescription                 string `json:"loginDescription,omitempty"`
+	Username                         string `json:"username"`
+	Password                         string `json:"password"`
+	LoginButton                      string `json:"loginButton"`
+	DeviceClassDescription           string `json:"deviceClassDescription,omitempty"`
+	DeviceClassPersonal              string `json:"deviceClassPersonal,omitempty"`
+	DeviceClassPersonalDescription   string `json:"deviceClassPersonalDescription,omitempty"`
+	DeviceClassEnterprise            string `json:"deviceClassEnterprise,omitempty"`
+	DeviceClassEnterpriseDescription string `json:"deviceClassEnterpriseDescription,omitempty"`
+	DeviceClassButton                string `json:"deviceClassButton,omitempty"`
+	EnterpriseEula                   string `json:"enterpriseEula,omitempty"`
+	EnterpriseProfileName            string `json:"enterpriseProfileName,omitempty"`
+	EnterpriseProfileDescription     string `json:"enterpriseProfileDescription,omitempty"`
+	EnterprisePending                string `json:"enterprisePending,omitempty"`
+	QuickAddName                     string `json:"quickAddName,omitempty"`
+	QuickAddButton                   string `json:"quickAddButton,omitempty"`
+	CompleteMessage                  string `json:"completeMessage,omitempty"`
+	FailedMessage                    string `json:"failedMessage,omitempty"`
+	TryAgainButton                   string `json:"tryAgainButton,omitempty"`
+	CheckNowButton                   string `json:"checkNowButton,omitempty"`
+	CheckEnrollmentMessage           string `json:"checkEnrollmentMessage,omitempty"`
+	LogoutButton                     string `json:"logoutButton,omitempty"`
+}
+
+// EnrollmentLanguageCode holds a language which enrollment messaging can be configured for
+type EnrollmentLanguageCode struct {
+	Value string `json:"value"`
+	Name  string `json:"name"`
+}
@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var ENROLLMENT_API_BASE_ENDPOINT = "/api/v3/enrollment"
+
+func enrollmentResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := &pro.EnrollmentSettings{
+		InstallSingleProfile:             true,
+		RestrictReenrollment:             true,
+		FlushMDMCommandsOnReenroll:       pro.EnrollmentFlushMDMCommandsErrors,
+		MacOSEnterpriseEnrollmentEnabled: true,
+		ManagementUsername:               "jamfadmin",
+		CreateManagementAccount:          true,
+		LaunchSelfService:                true,
+	}
+	mux.HandleFunc(ENROLLMENT_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			settings = &pro.EnrollmentSettings{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	languages := &crudMock[pro.EnrollmentLanguage]{
+		t:    t,
+		base: ENROLLMENT_API_BASE_ENDPOINT + "/languages",
+		items: []pro.EnrollmentLanguage{
+			{LanguageCode: "en", Name: "English", Title: "Enroll Your Device", Username: "Username", Password: "Password", LoginButton: "Log In"},
+			{LanguageCode: "fr", Name: "French", Title: "Inscrivez votre appareil", Username: "Nom d'utilisateur", Password: "Mot de passe", LoginButton: "Connexion"},
+		},
+		getID: func(l pro.EnrollmentLanguage) string { return l.LanguageCode },
+		setID: func(l *pro.EnrollmentLanguage, code string) { l.LanguageCode = code },
+	}
+	mux.Handle(languages.base, languages)
+	mux.Handle(languages.base+"/", languages)
+	mux.HandleFunc(ENROLLMENT_API_BASE_ENDPOINT+"/language-codes", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"value": "en", "name": "English"}, {"value": "fr", "name": "French"}, {"value": "de", "name": "German"}]`)
+	})
+	mux.HandleFunc("/api/v2/enrollment/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Restricted re-enrollment"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v2/enrollment/history/2"}`)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestEnrollmentSettings(t *testing.T) {
+	testServer := enrollmentResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.EnrollmentSettings(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "jamfadmin", settings.ManagementUsername)
+	assert.Equal(t, pro.EnrollmentFlushMDMCommandsErrors, settings.FlushMDMCommandsOnReenroll)
+
+	_, err = j.UpdateEnrollmentSettings(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	settings.AccountDrivenUserEnrollmentEnabled = true
+	settings.FlushMDMCommandsOnReenroll = pro.EnrollmentFlushMDMCommandsEverything
+	updated, err := j.UpdateEnrollmentSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.True(t, updated.AccountDrivenUserEnrollmentEnabled)
+	assert.Equal(t, pro.EnrollmentFlushMDMCommandsEverything, updated.FlushMDMCommandsOnReenroll)
+
+	history, err := j.EnrollmentHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Restricted re-enrollment", history.Results[0].Note)
+	_, err = j.AddEnrollmentHistoryNote(context.Background(), "Enabled account driven user enrollment")
+	assert.Nil(t, err)
+}
+
+func TestEnrollmentLanguages(t *testing.T) {
+	testServer := enrollmentResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	codes, err := j.EnrollmentLanguageCodes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, codes, 3)
+
+	languages, err := j.AllEnrollmentLanguages(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, languages, 2)
+
+	french, err := j.EnrollmentLanguageDetails(context.Background(), "fr")
+	assert.Nil(t, err)
+	assert.Equal(t, "Connexion", french.LoginButton)
+
+	french.CompleteMessage = "Inscription terminée"
+	updated, err := j.UpdateEnrollmentLanguage(context.Background(), "fr", french)
+	assert.Nil(t, err)
+	assert.Equal(t, "Inscription terminée", updated.CompleteMessage)
+
+	assert.Nil(t, j.DeleteEnrollmentLanguage(context.Background(), "fr"))
+	page, err := j.EnrollmentLanguages(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+}
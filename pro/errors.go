@@ -0,0 +1,64 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// APIError represents an unsuccessful response from the Jamf Pro API
+type APIError struct {
+	StatusCode int          `json:"httpStatus"`
+	Errors     []ErrorCause `json:"errors"`
+	// Body holds the raw response when it could not be decoded as a Jamf Pro API error
+	Body string `json:"-"`
+}
+
+// ErrorCause holds a single reason a Jamf Pro API request failed
+type ErrorCause struct {
+	Code        string `json:"code"`
+	Field       string `json:"field,omitempty"`
+	Description string `json:"description"`
+	ID          string `json:"id,omitempty"`
+}
+
+func newAPIError(res *http.Response) error {
+	apiErr := &APIError{}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("request error: %s. unable to retrieve response: %s", res.Status, err.Error())
+	}
+	if err := json.Unmarshal(data, apiErr); err != nil || len(apiErr.Errors) == 0 {
+		apiErr.Body = string(data)
+	}
+	// Jamf does not always include the status in the body
+	apiErr.StatusCode = res.StatusCode
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("request error: %d %s", e.StatusCode, e.Body)
+	}
+	causes := make([]string, 0, len(e.Errors))
+	for _, cause := range e.Errors {
+		if cause.Field != "" {
+			causes = append(causes, fmt.Sprintf("%s (%s): %s", cause.Code, cause.Field, cause.Description))
+		} else {
+			causes = append(causes, fmt.Sprintf("%s: %s", cause.Code, cause.Description))
+		}
+	}
+	return fmt.Sprintf("request error: %d %s", e.StatusCode, strings.Join(causes, "; "))
+}
+
+// IsNotFound reports whether err is, or wraps, a Jamf Pro API error for a resource which does not exist
+func IsNotFound(err error) bool {
+	apiErr := &APIError{}
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,104 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// HistoryResource identifies a Jamf Pro resource keeping a change history, Path is relative to
+// the API version of the resource
+type HistoryResource struct {
+	Version int
+	Path    string
+}
+
+// Resources keeping a change history per object, pass the object ID along with them
+var (
+	HistoryResourceAppInstallerDeployments          = HistoryResource{1, appInstallerDeploymentsContext}
+	HistoryResourceBuildings                        = HistoryResource{1, buildingsContext}
+	HistoryResourceCategories                       = HistoryResource{1, categoriesContext}
+	HistoryResourceDepartments                      = HistoryResource{1, departmentsContext}
+	HistoryResourceEnrollmentCustomizations         = HistoryResource{2, enrollmentCustomizationsContext}
+	HistoryResourcePackages                         = HistoryResource{1, packagesContext}
+	HistoryResourcePatchSoftwareTitleConfigurations = HistoryResource{2, patchSoftwareTitleConfigurationsContext}
+	HistoryResourceVolumePurchasingLocations        = HistoryResource{1, volumePurchasingLocationsContext}
+)
+
+// Resources keeping a single change history, pass an empty ID along with them
+var (
+	HistoryResourceCheckIn          = HistoryResource{3, checkInContext}
+	HistoryResourceEngage           = HistoryResource{2, engageContext}
+	HistoryResourceEnrollment       = HistoryResource{2, enrollmentContext}
+	HistoryResourceInventoryPreload = HistoryResource{2, inventoryPreloadContext}
+	HistoryResourceJamfProServerURL = HistoryResource{1, jamfProServerURLContext}
+	HistoryResourceJamfProtect      = HistoryResource{1, jamfProtectContext}
+	HistoryResourceSSO              = HistoryResource{2, ssoContext}
+	HistoryResourceTeacherApp       = HistoryResource{1, teacherAppContext}
+)
+
+// HistoryEntry represents a single change or note in the history of a Jamf Pro resource
+type HistoryEntry struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+	Date     string `json:"date"`
+	Note     string `json:"note,omitempty"`
+	Details  string `json:"details,omitempty"`
+}
+
+// Time parses the date of the entry
+func (h *HistoryEntry) Time() (time.Time, error) {
+	return time.Parse(time.RFC3339, h.Date)
+}
+
+// HistoryNote holds a note to add to the history of a Jamf Pro resource
+type HistoryNote struct {
+	Note string `json:"note"`
+}
+
+// History returns a single page of the change history of a resource, id is the object whose history
+// is requested or empty for resources keeping a single history. Sort on date to get the latest changes first.
+func (j *Client) History(ctx context.Context, resource HistoryResource, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	ep := j.historyEndpoint(resource, id)
+	res, err := listPage[HistoryEntry](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query history from %s", ep)
+	}
+	return res, nil
+}
+
+// AllHistory returns the whole change history of a resource, requesting each page in turn
+func (j *Client) AllHistory(ctx context.Context, resource HistoryResource, id string, opts *ListOptions) ([]HistoryEntry, error) {
+	ep := j.historyEndpoint(resource, id)
+	res, err := listAll[HistoryEntry](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all history from %s", ep)
+	}
+	return res, nil
+}
+
+// AddHistoryNote adds a note to the change history of a resource, which lets automation record why it made a change
+func (j *Client) AddHistoryNote(ctx context.Context, resource HistoryResource, id string, note string) (*CreatedResource, error) {
+	ep := j.historyEndpoint(resource, id)
+	if note == "" {
+		return nil, errors.Wrapf(fmt.Errorf("note required"), "unable to add history note on %s", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, &HistoryNote{Note: note}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to add history note on %s", ep)
+	}
+	return res, nil
+}
+
+func (j *Client) historyEndpoint(resource HistoryResource, id string) string {
+	if id == "" {
+		return j.endpoint(resource.Version, resource.Path+"/history")
+	}
+	return j.endpoint(resource.Version, fmt.Sprintf("%s/%s/history", resource.Path, url.PathEscape(id)))
+}
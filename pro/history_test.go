@@ -0,0 +1,62 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	testServer := buildingsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.AddHistoryNote(context.Background(), pro.HistoryResourceBuildings, "1", "")
+	assert.Contains(t, err.Error(), "note required")
+
+	for _, note := range []string{"Renamed by sync job", "Address from HR system"} {
+		_, err = j.AddHistoryNote(context.Background(), pro.HistoryResourceBuildings, "1", note)
+		assert.Nil(t, err)
+	}
+
+	page, err := j.History(context.Background(), pro.HistoryResourceBuildings, "1", &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Len(t, page.Results, 1)
+
+	entries, err := j.AllHistory(context.Background(), pro.HistoryResourceBuildings, "1", &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, "Address from HR system", entries[1].Note)
+
+	date, err := entries[0].Time()
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), date)
+
+	// the typed history methods read the same history
+	typed, err := j.BuildingHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, entries, typed.Results)
+}
+
+func TestSingletonHistory(t *testing.T) {
+	testServer := jamfProtectResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.History(context.Background(), pro.HistoryResourceJamfProtect, "", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Registered", page.Results[0].Note)
+
+	created, err := j.AddHistoryNote(context.Background(), pro.HistoryResourceJamfProtect, "", "Plans synced")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	_, err = j.History(context.Background(), pro.HistoryResource{Version: 1, Path: "unknown"}, "", nil)
+	assert.True(t, pro.IsNotFound(err))
+}
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const iconContext = "icon"
+
+// UploadIcon uploads an image to use as the icon of policies, apps and Self Service items
+func (j *Client) UploadIcon(ctx context.Context, filename string, image io.Reader) (*Icon, error) {
+	ep := j.endpoint(1, iconContext)
+	if image == nil {
+		return nil, fmt.Errorf("an image is required to upload to %s", ep)
+	}
+	res := &Icon{}
+	if err := j.upload(ctx, ep, "file", filename, image, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to upload icon %s to %s", filename, ep)
+	}
+	return res, nil
+}
+
+// IconDetails returns the name and URL of an icon given its ID
+func (j *Client) IconDetails(ctx context.Context, id string) (*Icon, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", iconContext, url.PathEscape(id)))
+	res := &Icon{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query icon with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// DownloadIcon streams the image of an icon given its ID to w, nil options download the original image
+func (j *Client) DownloadIcon(ctx context.Context, id string, opts *IconDownloadOptions, w io.Writer) error {
+	ep := withQuery(j.endpoint(1, fmt.Sprintf("%s/download/%s", iconContext, url.PathEscape(id))), opts.values())
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "image/*")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to download icon with ID %s from %s", id, ep)
+	}
+	return nil
+}
+
+// values returns the query parameters for the options, nil options produce no parameters
+func (o *IconDownloadOptions) values() url.Values {
+	params := url.Values{}
+	if o == nil {
+		return params
+	}
+	if o.Resolution != "" {
+		params.Set("res", o.Resolution)
+	}
+	if o.Scale > 0 {
+		params.Set("scale", strconv.Itoa(o.Scale))
+	}
+	return params
+}
@@ -0,0 +1,26 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Resolutions an icon can be downloaded in
+const (
+	IconResolutionOriginal = "original"
+	IconResolution300      = "300"
+	IconResolution512      = "512"
+)
+
+// Icon represents an image hosted by Jamf for policies, apps and Self Service items
+type Icon struct {
+	ID   int    `json:"id"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url"`
+}
+
+// IconDownloadOptions selects the size of a downloaded icon
+type IconDownloadOptions struct {
+	// Resolution is one of the IconResolution constants, Jamf falls back to the original resolution
+	// when it is empty or unknown
+	Resolution string
+	// Scale resizes the icon to 300 pixels when it is not 0, ignoring Resolution
+	Scale int
+}
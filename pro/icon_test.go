@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var ICON_API_BASE_ENDPOINT = "/api/v1/icon"
+
+func iconResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	icons := map[string][]byte{}
+	names := map[string]string{}
+	mux.HandleFunc(ICON_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		file, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		contents, err := io.ReadAll(file)
+		assert.Nil(t, err)
+		id := strconv.Itoa(len(icons) + 1)
+		icons[id] = contents
+		names[id] = header.Filename
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id": %s, "url": "https://ics.services.jamfcloud.com/icon/hash_%s"}`, id, id)
+	})
+	mux.HandleFunc(ICON_API_BASE_ENDPOINT+"/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, ICON_API_BASE_ENDPOINT+"/")
+		if _, ok := icons[id]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Icon not found"}]}`)
+			return
+		}
+		iconID, err := strconv.Atoi(id)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pro.Icon{ID: iconID, Name: names[id], URL: "https://ics.services.jamfcloud.com/icon/hash_" + id}))
+	})
+	mux.HandleFunc(ICON_API_BASE_ENDPOINT+"/download/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "image/*", r.Header.Get("Accept"))
+		contents := icons[strings.TrimPrefix(r.URL.Path, ICON_API_BASE_ENDPOINT+"/download/")]
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprintf(w, "%s res=%s scale=%s", contents, r.URL.Query().Get("res"), r.URL.Query().Get("scale"))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestIcons(t *testing.T) {
+	testServer := iconResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UploadIcon(context.Background(), "chrome.png", nil)
+	assert.NotNil(t, err)
+
+	icon, err := j.UploadIcon(context.Background(), "chrome.png", strings.NewReader("PNG"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, icon.ID)
+
+	details, err := j.IconDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "chrome.png", details.Name)
+	assert.Equal(t, icon.URL, details.URL)
+
+	_, err = j.IconDetails(context.Background(), "2")
+	assert.True(t, pro.IsNotFound(err))
+
+	image := &bytes.Buffer{}
+	assert.Nil(t, j.DownloadIcon(context.Background(), "1", nil, image))
+	assert.Equal(t, "PNG res= scale=", image.String())
+
+	image.Reset()
+	assert.Nil(t, j.DownloadIcon(context.Background(), "1", &pro.IconDownloadOptions{Resolution: pro.IconResolution512}, image))
+	assert.Equal(t, "PNG res=512 scale=", image.String())
+
+	image.Reset()
+	assert.Nil(t, j.DownloadIcon(context.Background(), "1", &pro.IconDownloadOptions{Scale: 1}, image))
+	assert.Equal(t, "PNG res= scale=1", image.String())
+}
@@ -0,0 +1,188 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"iter"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	inventoryPreloadContext        = "inventory-preload"
+	inventoryPreloadRecordsContext = inventoryPreloadContext + "/records"
+)
+
+// InventoryPreloadRecords returns a single page of inventory preload records matching opts
+func (j *Client) InventoryPreloadRecords(ctx context.Context, opts *ListOptions) (*Results[InventoryPreloadRecord], error) {
+	ep := j.endpoint(2, inventoryPreloadRecordsContext)
+	res, err := listPage[InventoryPreloadRecord](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query inventory preload records from %s", ep)
+	}
+	return res, nil
+}
+
+// AllInventoryPreloadRecords returns every inventory preload record matching opts, requesting each page in turn
+func (j *Client) AllInventoryPreloadRecords(ctx context.Context, opts *ListOptions) ([]InventoryPreloadRecord, error) {
+	ep := j.endpoint(2, inventoryPreloadRecordsContext)
+	res, err := listAll[InventoryPreloadRecord](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all inventory preload records from %s", ep)
+	}
+	return res, nil
+}
+
+// IterInventoryPreloadRecords yields every inventory preload record matching opts, requesting each page
+// once the previous one has been consumed. An error ends the iteration.
+func (j *Client) IterInventoryPreloadRecords(ctx context.Context, opts *ListOptions) iter.Seq2[InventoryPreloadRecord, error] {
+	return iterAll[InventoryPreloadRecord](ctx, j, j.endpoint(2, inventoryPreloadRecordsContext), opts, nil)
+}
+
+// InventoryPreloadRecordDetails returns the details for a specific inventory preload record given its ID
+func (j *Client) InventoryPreloadRecordDetails(ctx context.Context, id string) (*InventoryPreloadRecord, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", inventoryPreloadRecordsContext, url.PathEscape(id)))
+	res := &InventoryPreloadRecord{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query inventory preload record with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateInventoryPreloadRecord will create a new inventory preload record in Jamf
+func (j *Client) CreateInventoryPreloadRecord(ctx context.Context, content *InventoryPreloadRecord) (*CreatedResource, error) {
+	ep := j.endpoint(2, inventoryPreloadRecordsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for inventory preload record: (%s)", ep)
+	}
+	if content.SerialNumber == "" {
+		return nil, errors.Wrapf(fmt.Errorf("serial number required for new inventory preload record"), "unable to process JAMF creation request for inventory preload record: (%s)", ep)
+	}
+	if content.DeviceType == "" {
+		return nil, errors.Wrapf(fmt.Errorf("device type required for new inventory preload record"), "unable to process JAMF creation request for inventory preload record: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for inventory preload record %s on %s", content.SerialNumber, ep)
+	}
+	return res, nil
+}
+
+// UpdateInventoryPreloadRecord will replace an inventory preload record in Jamf given its ID
+func (j *Client) UpdateInventoryPreloadRecord(ctx context.Context, id string, content *InventoryPreloadRecord) (*InventoryPreloadRecord, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", inventoryPreloadRecordsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for inventory preload record: %s (%s)", id, ep)
+	}
+
+	res := &InventoryPreloadRecord{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for inventory preload record: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteInventoryPreloadRecord will delete an inventory preload record given its ID
+func (j *Client) DeleteInventoryPreloadRecord(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", inventoryPreloadRecordsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for inventory preload record %s from %s", id, ep)
+	}
+	return nil
+}
+
+// DeleteAllInventoryPreloadRecords will delete every inventory preload record in Jamf
+func (j *Client) DeleteAllInventoryPreloadRecords(ctx context.Context) error {
+	ep := j.endpoint(2, inventoryPreloadRecordsContext+"/delete-all")
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for all inventory preload records on %s", ep)
+	}
+	return nil
+}
+
+// InventoryPreloadHistory returns a single page of the change history for inventory preload records
+func (j *Client) InventoryPreloadHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceInventoryPreload, "", opts)
+}
+
+// AddInventoryPreloadHistoryNote adds a note to the change history for inventory preload records
+func (j *Client) AddInventoryPreloadHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceInventoryPreload, "", note)
+}
+
+// InventoryPreloadExtensionAttributeColumns returns the extension attribute columns which can be
+// included in an inventory preload CSV
+func (j *Client) InventoryPreloadExtensionAttributeColumns(ctx context.Context) ([]InventoryPreloadExtensionAttributeColumn, error) {
+	ep := j.endpoint(2, inventoryPreloadContext+"/ea-columns")
+	res := &Results[InventoryPreloadExtensionAttributeColumn]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query inventory preload extension attribute columns from %s", ep)
+	}
+	return res.Results, nil
+}
+
+// InventoryPreloadCSVTemplate writes the CSV template for inventory preload records to w
+func (j *Client) InventoryPreloadCSVTemplate(ctx context.Context, w io.Writer) error {
+	ep := j.endpoint(2, inventoryPreloadContext+"/csv-template")
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/csv")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to download inventory preload CSV template from %s", ep)
+	}
+	return nil
+}
+
+// ExportInventoryPreloadRecords writes the inventory preload records matching opts to w as CSV.
+// Every column is exported when opts has no Fields.
+func (j *Client) ExportInventoryPreloadRecords(ctx context.Context, opts *InventoryPreloadExportOptions, w io.Writer) error {
+	ep := j.endpoint(2, inventoryPreloadContext+"/export")
+	if opts == nil {
+		opts = &InventoryPreloadExportOptions{}
+	}
+	req, err := newRequest(ctx, "POST", withQuery(ep, opts.ListOptions.values()), &inventoryPreloadExport{Fields: opts.Fields})
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/csv")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to export inventory preload records from %s", ep)
+	}
+	return nil
+}
+
+// ValidateInventoryPreloadCSV checks a CSV of inventory preload records without importing it,
+// returning the number of records Jamf would create
+func (j *Client) ValidateInventoryPreloadCSV(ctx context.Context, filename string, file io.Reader) (int, error) {
+	ep := j.endpoint(2, inventoryPreloadContext+"/csv-validate")
+	if file == nil {
+		return 0, fmt.Errorf("a CSV file is required to validate inventory preload records on %s", ep)
+	}
+	res := &InventoryPreloadCSVValidation{}
+	if err := j.upload(ctx, ep, "file", filename, file, res); err != nil {
+		return 0, errors.Wrapf(err, "unable to validate inventory preload CSV %s on %s", filename, ep)
+	}
+	return res.RecordCount, nil
+}
+
+// ImportInventoryPreloadCSV creates inventory preload records from a CSV in bulk, the file is
+// streamed to Jamf rather than buffered in memory. Jamf rejects the whole file if any row is invalid,
+// the causes are available from the returned *APIError.
+func (j *Client) ImportInventoryPreloadCSV(ctx context.Context, filename string, file io.Reader) ([]CreatedResource, error) {
+	ep := j.endpoint(2, inventoryPreloadContext+"/csv")
+	if file == nil {
+		return nil, fmt.Errorf("a CSV file is required to import inventory preload records on %s", ep)
+	}
+	res := []CreatedResource{}
+	if err := j.upload(ctx, ep, "file", filename, file, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to import inventory preload CSV %s on %s", filename, ep)
+	}
+	return res, nil
+}
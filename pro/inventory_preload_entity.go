@@ -0,0 +1,73 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Device types accepted for inventory preload records
+const (
+	InventoryPreloadDeviceTypeComputer     = "Computer"
+	InventoryPreloadDeviceTypeMobileDevice = "Mobile Device"
+	InventoryPreloadDeviceTypeUnknown      = "Unknown"
+)
+
+// InventoryPreloadRecord represents asset information which Jamf applies to a device, matched by
+// serial number, when it enrolls
+type InventoryPreloadRecord struct {
+	ID                  string                                    `json:"id,omitempty"`
+	SerialNumber        string                                    `json:"serialNumber"`
+	DeviceType          string                                    `json:"deviceType"`
+	Username            string                                    `json:"username,omitempty"`
+	FullName            string                                    `json:"fullName,omitempty"`
+	EmailAddress        string                                    `json:"emailAddress,omitempty"`
+	PhoneNumber         string                                    `json:"phoneNumber,omitempty"`
+	Position            string                                    `json:"position,omitempty"`
+	Department          string                                    `json:"department,omitempty"`
+	Building            string                                    `json:"building,omitempty"`
+	Room                string                                    `json:"room,omitempty"`
+	PONumber            string                                    `json:"poNumber,omitempty"`
+	PODate              string                                    `json:"poDate,omitempty"`
+	WarrantyExpiration  string                                    `json:"warrantyExpiration,omitempty"`
+	AppleCareID         string                                    `json:"appleCareId,omitempty"`
+	LifeExpectancy      string                                    `json:"lifeExpectancy,omitempty"`
+	PurchasePrice       string                                    `json:"purchasePrice,omitempty"`
+	PurchasingContact   string                                    `json:"purchasingContact,omitempty"`
+	PurchasingAccount   string                                    `json:"purchasingAccount,omitempty"`
+	LeaseExpiration     string                                    `json:"leaseExpiration,omitempty"`
+	BarCode1            string                                    `json:"barCode1,omitempty"`
+	BarCode2            string                                    `json:"barCode2,omitempty"`
+	AssetTag            string                                    `json:"assetTag,omitempty"`
+	Vendor              string                                    `json:"vendor,omitempty"`
+	ExtensionAttributes []InventoryPreloadExtensionAttributeValue `json:"extensionAttributes,omitempty"`
+}
+
+// InventoryPreloadExtensionAttributeValue holds the value of an extension attribute for an inventory preload record
+type InventoryPreloadExtensionAttributeValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// InventoryPreloadExtensionAttributeColumn represents an extension attribute column available to inventory preload CSVs
+type InventoryPreloadExtensionAttributeColumn struct {
+	Name     string `json:"name"`
+	FullName string `json:"fullName"`
+}
+
+// InventoryPreloadCSVValidation holds the result of validating an inventory preload CSV
+type InventoryPreloadCSVValidation struct {
+	RecordCount int `json:"recordCount"`
+}
+
+// InventoryPreloadExportOptions holds the records and columns to include in an inventory preload CSV export
+type InventoryPreloadExportOptions struct {
+	ListOptions
+	Fields []InventoryPreloadExportField
+}
+
+// InventoryPreloadExportField selects a column to export, optionally renaming its header
+type InventoryPreloadExportField struct {
+	FieldName          string `json:"fieldName"`
+	FieldLabelOverride string `json:"fieldLabelOverride,omitempty"`
+}
+
+type inventoryPreloadExport struct {
+	Fields []InventoryPreloadExportField `json:"fields,omitempty"`
+}
@@ -0,0 +1,201 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var INVENTORY_PRELOAD_API_BASE_ENDPOINT = "/api/v2/inventory-preload"
+
+const inventoryPreloadCSVTemplate = "Serial Number,Device Type,Username,Full Name,Email Address\n"
+
+func inventoryPreloadResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	records := &crudMock[pro.InventoryPreloadRecord]{
+		t:      t,
+		base:   INVENTORY_PRELOAD_API_BASE_ENDPOINT + "/records",
+		nextID: 1,
+		items: []pro.InventoryPreloadRecord{
+			{
+				ID:           "1",
+				SerialNumber: "C02AAAAAAAAA",
+				DeviceType:   pro.InventoryPreloadDeviceTypeComputer,
+				Username:     "jdoe",
+				AssetTag:     "A-0001",
+				ExtensionAttributes: []pro.InventoryPreloadExtensionAttributeValue{
+					{Name: "Cost Center", Value: "1234"},
+				},
+			},
+		},
+		getID: func(r pro.InventoryPreloadRecord) string { return r.ID },
+		setID: func(r *pro.InventoryPreloadRecord, id string) { r.ID = id },
+	}
+	mux.Handle(records.base, records)
+	mux.Handle(records.base+"/", records)
+	mux.HandleFunc(records.base+"/delete-all", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		records.items = nil
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "CSV imported"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v2/inventory-preload/history/2"}`)
+		}
+	})
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/ea-columns", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"name": "Cost Center", "fullName": "Extension Attribute Cost Center"}]}`)
+	})
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/csv-template", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/csv", r.Header.Get("Accept"))
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, inventoryPreloadCSVTemplate)
+	})
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/export", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "text/csv", r.Header.Get("Accept"))
+		assert.Equal(t, `deviceType=="Computer"`, r.URL.Query().Get("filter"))
+		body := map[string][]pro.InventoryPreloadExportField{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		w.Header().Set("Content-Type", "text/csv")
+		fmt.Fprint(w, body["fields"][0].FieldLabelOverride+"\n")
+		for _, record := range records.items {
+			fmt.Fprint(w, record.SerialNumber+"\n")
+		}
+	})
+	csvUpload := func(w http.ResponseWriter, r *http.Request) []string {
+		assert.Equal(t, "POST", r.Method)
+		file, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		assert.Equal(t, "preload.csv", header.Filename)
+		data, err := io.ReadAll(file)
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		return strings.Split(strings.TrimSpace(string(data)), "\n")[1:]
+	}
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/csv-validate", func(w http.ResponseWriter, r *http.Request) {
+		rows := csvUpload(w, r)
+		fmt.Fprintf(w, `{"recordCount": %d}`, len(rows))
+	})
+	mux.HandleFunc(INVENTORY_PRELOAD_API_BASE_ENDPOINT+"/csv", func(w http.ResponseWriter, r *http.Request) {
+		rows := csvUpload(w, r)
+		created := []pro.CreatedResource{}
+		for _, row := range rows {
+			if !strings.Contains(row, ",") {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"httpStatus": 400, "errors": [{"code": "INVALID_FIELD", "field": "deviceType", "description": "Device type is required"}]}`)
+				return
+			}
+			records.nextID++
+			id := fmt.Sprint(records.nextID)
+			created = append(created, pro.CreatedResource{ID: id, Href: records.base + "/" + id})
+		}
+		w.WriteHeader(http.StatusCreated)
+		assert.Nil(t, json.NewEncoder(w).Encode(created))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestInventoryPreloadRecordsCRUD(t *testing.T) {
+	testServer := inventoryPreloadResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.InventoryPreloadRecords(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+
+	record, err := j.InventoryPreloadRecordDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "C02AAAAAAAAA", record.SerialNumber)
+	assert.Equal(t, "1234", record.ExtensionAttributes[0].Value)
+
+	_, err = j.CreateInventoryPreloadRecord(context.Background(), &pro.InventoryPreloadRecord{SerialNumber: "C02BBBBBBBBB"})
+	assert.NotNil(t, err)
+
+	created, err := j.CreateInventoryPreloadRecord(context.Background(), &pro.InventoryPreloadRecord{SerialNumber: "C02BBBBBBBBB", DeviceType: pro.InventoryPreloadDeviceTypeComputer})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	record.Room = "101"
+	updated, err := j.UpdateInventoryPreloadRecord(context.Background(), "1", record)
+	assert.Nil(t, err)
+	assert.Equal(t, "101", updated.Room)
+
+	assert.Nil(t, j.DeleteInventoryPreloadRecord(context.Background(), "2"))
+	assert.Nil(t, j.DeleteAllInventoryPreloadRecords(context.Background()))
+
+	all, err := j.AllInventoryPreloadRecords(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, all)
+}
+
+func TestInventoryPreloadHistoryAndColumns(t *testing.T) {
+	testServer := inventoryPreloadResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	history, err := j.InventoryPreloadHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "CSV imported", history.Results[0].Note)
+
+	note, err := j.AddInventoryPreloadHistoryNote(context.Background(), "Spring refresh")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", note.ID)
+
+	columns, err := j.InventoryPreloadExtensionAttributeColumns(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "Cost Center", columns[0].Name)
+}
+
+func TestInventoryPreloadCSV(t *testing.T) {
+	testServer := inventoryPreloadResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	template := &bytes.Buffer{}
+	assert.Nil(t, j.InventoryPreloadCSVTemplate(context.Background(), template))
+	assert.Equal(t, inventoryPreloadCSVTemplate, template.String())
+
+	export := &bytes.Buffer{}
+	err := j.ExportInventoryPreloadRecords(context.Background(), &pro.InventoryPreloadExportOptions{
+		ListOptions: pro.ListOptions{Filter: `deviceType=="Computer"`},
+		Fields:      []pro.InventoryPreloadExportField{{FieldName: "serialNumber", FieldLabelOverride: "Serial"}},
+	}, export)
+	assert.Nil(t, err)
+	assert.Equal(t, "Serial\nC02AAAAAAAAA\n", export.String())
+
+	csv := inventoryPreloadCSVTemplate + "C02CCCCCCCCC,Computer,jdoe,Jane Doe,jdoe@example.com\nC02DDDDDDDDD,Computer,,,\n"
+	count, err := j.ValidateInventoryPreloadCSV(context.Background(), "preload.csv", strings.NewReader(csv))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	created, err := j.ImportInventoryPreloadCSV(context.Background(), "preload.csv", strings.NewReader(csv))
+	assert.Nil(t, err)
+	assert.Len(t, created, 2)
+	assert.Equal(t, "3", created[1].ID)
+
+	_, err = j.ImportInventoryPreloadCSV(context.Background(), "preload.csv", strings.NewReader(inventoryPreloadCSVTemplate+"C02EEEEEEEEE\n"))
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "Device type is required")
+
+	_, err = j.ImportInventoryPreloadCSV(context.Background(), "preload.csv", nil)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,24 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// JamfProVersion holds the version of the Jamf Pro server
+type JamfProVersion struct {
+	Version string `json:"version"`
+}
+
+// JamfProVersion returns the version of the Jamf Pro server the client is connected to
+func (j *Client) JamfProVersion(ctx context.Context) (*JamfProVersion, error) {
+	ep := j.endpoint(1, "jamf-pro-version")
+	res := &JamfProVersion{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Jamf Pro version from %s", ep)
+	}
+	return res, nil
+}
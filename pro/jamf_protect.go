@@ -0,0 +1,119 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const jamfProtectContext = "jamf-protect"
+
+// JamfProtectSettings returns the Jamf Protect integration settings and sync status
+func (j *Client) JamfProtectSettings(ctx context.Context) (*JamfProtectSettings, error) {
+	ep := j.endpoint(1, jamfProtectContext)
+	res := &JamfProtectSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Jamf Protect settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateJamfProtectSettings will update whether Jamf Protect is installed automatically on computers in scope of a plan
+func (j *Client) UpdateJamfProtectSettings(ctx context.Context, autoInstall bool) (*JamfProtectSettings, error) {
+	ep := j.endpoint(1, jamfProtectContext)
+	res := &JamfProtectSettings{}
+	if err := j.do(ctx, "PUT", ep, &jamfProtectUpdate{AutoInstall: autoInstall}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Jamf Protect settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// RegisterJamfProtect connects Jamf Pro to a Jamf Protect tenant using an API client created in Jamf Protect
+func (j *Client) RegisterJamfProtect(ctx context.Context, registration *JamfProtectRegistration) (*JamfProtectSettings, error) {
+	ep := j.endpoint(1, jamfProtectContext+"/register")
+	if registration == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF registration request for Jamf Protect: (%s)", ep)
+	}
+	if registration.ProtectURL == "" || registration.ClientID == "" || registration.Password == "" {
+		return nil, errors.Wrapf(fmt.Errorf("protect URL, client ID and password required"), "unable to process JAMF registration request for Jamf Protect: (%s)", ep)
+	}
+
+	res := &JamfProtectSettings{}
+	if err := j.do(ctx, "POST", ep, registration, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to register Jamf Protect %s on %s", registration.ProtectURL, ep)
+	}
+	return res, nil
+}
+
+// UnregisterJamfProtect disconnects Jamf Pro from Jamf Protect
+func (j *Client) UnregisterJamfProtect(ctx context.Context) error {
+	ep := j.endpoint(1, jamfProtectContext)
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to unregister Jamf Protect from %s", ep)
+	}
+	return nil
+}
+
+// JamfProtectPlans returns a single page of the plans synced from Jamf Protect
+func (j *Client) JamfProtectPlans(ctx context.Context, opts *ListOptions) (*Results[JamfProtectPlan], error) {
+	ep := j.endpoint(1, jamfProtectContext+"/plans")
+	res, err := listPage[JamfProtectPlan](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query Jamf Protect plans from %s", ep)
+	}
+	return res, nil
+}
+
+// AllJamfProtectPlans returns every plan synced from Jamf Protect, requesting each page in turn
+func (j *Client) AllJamfProtectPlans(ctx context.Context, opts *ListOptions) ([]JamfProtectPlan, error) {
+	ep := j.endpoint(1, jamfProtectContext+"/plans")
+	res, err := listAll[JamfProtectPlan](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all Jamf Protect plans from %s", ep)
+	}
+	return res, nil
+}
+
+// SyncJamfProtectPlans requests Jamf Pro sync plans from Jamf Protect, the sync happens in the
+// background and its progress is reported by JamfProtectSettings
+func (j *Client) SyncJamfProtectPlans(ctx context.Context) error {
+	ep := j.endpoint(1, jamfProtectContext+"/plans/sync")
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to sync Jamf Protect plans on %s", ep)
+	}
+	return nil
+}
+
+// JamfProtectDeploymentTasks returns a single page of the install tasks for a Jamf Protect deployment given its ID
+func (j *Client) JamfProtectDeploymentTasks(ctx context.Context, id string, opts *ListOptions) (*Results[JamfProtectDeploymentTask], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/deployments/%s/tasks", jamfProtectContext, url.PathEscape(id)))
+	res, err := listPage[JamfProtectDeploymentTask](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query tasks for Jamf Protect deployment with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// RetryJamfProtectDeploymentTasks retries the given install tasks of a Jamf Protect deployment,
+// every failed task is retried when no task IDs are given
+func (j *Client) RetryJamfProtectDeploymentTasks(ctx context.Context, id string, taskIDs []string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/deployments/%s/tasks/retry", jamfProtectContext, url.PathEscape(id)))
+	if err := j.do(ctx, "POST", ep, &jamfProtectTaskRetry{IDs: taskIDs}, nil); err != nil {
+		return errors.Wrapf(err, "unable to retry tasks for Jamf Protect deployment with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// JamfProtectHistory returns a single page of the change history for the Jamf Protect integration
+func (j *Client) JamfProtectHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceJamfProtect, "", opts)
+}
+
+// AddJamfProtectHistoryNote adds a note to the change history for the Jamf Protect integration
+func (j *Client) AddJamfProtectHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceJamfProtect, "", note)
+}
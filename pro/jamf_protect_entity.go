@@ -0,0 +1,64 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Sync statuses reported by the Jamf Protect integration
+const (
+	JamfProtectSyncStatusInProgress = "IN_PROGRESS"
+	JamfProtectSyncStatusCompleted  = "COMPLETED"
+	JamfProtectSyncStatusError      = "ERROR"
+	JamfProtectSyncStatusUnknown    = "UNKNOWN"
+)
+
+// JamfProtectSettings represents the connection between Jamf Pro and a Jamf Protect tenant
+type JamfProtectSettings struct {
+	ID             string `json:"id"`
+	APIClientID    string `json:"apiClientId"`
+	APIClientName  string `json:"apiClientName"`
+	RegistrationID string `json:"registrationId"`
+	ProtectURL     string `json:"protectUrl"`
+	LastSyncTime   string `json:"lastSyncTime,omitempty"`
+	SyncStatus     string `json:"syncStatus"`
+	AutoInstall    bool   `json:"autoInstall"`
+}
+
+// Syncing returns true while plans are being synced from Jamf Protect
+func (s *JamfProtectSettings) Syncing() bool {
+	return s.SyncStatus == JamfProtectSyncStatusInProgress
+}
+
+// JamfProtectRegistration holds the Jamf Protect API client used to register the integration
+type JamfProtectRegistration struct {
+	ProtectURL string `json:"protectUrl"`
+	ClientID   string `json:"clientId"`
+	Password   string `json:"password"`
+}
+
+// JamfProtectPlan represents a plan synced from Jamf Protect and the configuration profile deploying it
+type JamfProtectPlan struct {
+	UUID             string `json:"uuid"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description,omitempty"`
+	ProfileID        int    `json:"profileId"`
+	ProfileName      string `json:"profileName"`
+	ScopeDescription string `json:"scopeDescription,omitempty"`
+}
+
+// JamfProtectDeploymentTask holds the status of installing Jamf Protect on a single computer
+type JamfProtectDeploymentTask struct {
+	ID           string `json:"id"`
+	ComputerID   string `json:"computerId"`
+	ComputerName string `json:"computerName"`
+	Version      string `json:"version"`
+	Updated      string `json:"updated"`
+	Status       string `json:"status"`
+}
+
+type jamfProtectUpdate struct {
+	AutoInstall bool `json:"autoInstall"`
+}
+
+type jamfProtectTaskRetry struct {
+	IDs []string `json:"ids,omitempty"`
+}
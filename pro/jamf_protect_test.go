@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var JAMF_PROTECT_API_BASE_ENDPOINT = "/api/v1/jamf-protect"
+
+func jamfProtectResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	var settings *pro.JamfProtectSettings
+	plans := []pro.JamfProtectPlan{}
+	tasks := []pro.JamfProtectDeploymentTask{
+		{ID: "1", ComputerID: "1", ComputerName: "Lab-01", Version: "5.4.0", Status: "COMPLETE"},
+		{ID: "2", ComputerID: "2", ComputerName: "Lab-02", Version: "5.4.0", Status: "FAILED"},
+	}
+	writeSettings := func(w http.ResponseWriter) {
+		if settings == nil {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "NOT_FOUND", "description": "Jamf Protect is not registered"}]}`)
+			return
+		}
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	}
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "PUT":
+			body := map[string]bool{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			settings.AutoInstall = body["autoInstall"]
+		case "DELETE":
+			settings = nil
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeSettings(w)
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/register", func(w http.ResponseWriter, r *http.Request) {
+		registration := &pro.JamfProtectRegistration{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(registration))
+		settings = &pro.JamfProtectSettings{
+			ID:             "1",
+			APIClientID:    registration.ClientID,
+			APIClientName:  "Jamf Pro",
+			RegistrationID: "a8b4d6e2",
+			ProtectURL:     registration.ProtectURL,
+			SyncStatus:     pro.JamfProtectSyncStatusCompleted,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		writeSettings(w)
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/plans", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, plans)))
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/plans/sync", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		plans = append(plans, pro.JamfProtectPlan{UUID: "2a9f1c3e", ID: "1", Name: "Default Plan", ProfileID: 12, ProfileName: "Jamf Protect - Default Plan"})
+		settings.SyncStatus = pro.JamfProtectSyncStatusInProgress
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/deployments/1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, tasks)))
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/deployments/1/tasks/retry", func(w http.ResponseWriter, r *http.Request) {
+		body := map[string][]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		for i := range tasks {
+			if tasks[i].Status == "FAILED" && (len(body["ids"]) == 0 || contains(body["ids"], tasks[i].ID)) {
+				tasks[i].Status = "QUEUED"
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(JAMF_PROTECT_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Registered"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v1/jamf-protect/history/2"}`)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestJamfProtectRegistration(t *testing.T) {
+	testServer := jamfProtectResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.JamfProtectSettings(context.Background())
+	assert.True(t, pro.IsNotFound(err))
+
+	_, err = j.RegisterJamfProtect(context.Background(), &pro.JamfProtectRegistration{ProtectURL: "https://example.protect.jamfcloud.com/graphql"})
+	assert.NotNil(t, err)
+
+	settings, err := j.RegisterJamfProtect(context.Background(), &pro.JamfProtectRegistration{
+		ProtectURL: "https://example.protect.jamfcloud.com/graphql",
+		ClientID:   "fake-client-id",
+		Password:   "mock-password-cool",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "fake-client-id", settings.APIClientID)
+	assert.False(t, settings.AutoInstall)
+
+	settings, err = j.UpdateJamfProtectSettings(context.Background(), true)
+	assert.Nil(t, err)
+	assert.True(t, settings.AutoInstall)
+
+	assert.Nil(t, j.UnregisterJamfProtect(context.Background()))
+	_, err = j.JamfProtectSettings(context.Background())
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestJamfProtectPlanSync(t *testing.T) {
+	testServer := jamfProtectResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.RegisterJamfProtect(context.Background(), &pro.JamfProtectRegistration{ProtectURL: "https://example.protect.jamfcloud.com/graphql", ClientID: "fake-client-id", Password: "mock-password-cool"})
+	assert.Nil(t, err)
+
+	plans, err := j.AllJamfProtectPlans(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Empty(t, plans)
+
+	assert.Nil(t, j.SyncJamfProtectPlans(context.Background()))
+	settings, err := j.JamfProtectSettings(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, settings.Syncing())
+
+	page, err := j.JamfProtectPlans(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Default Plan", page.Results[0].Name)
+	assert.Equal(t, 12, page.Results[0].ProfileID)
+
+	history, err := j.JamfProtectHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Registered", history.Results[0].Note)
+	_, err = j.AddJamfProtectHistoryNote(context.Background(), "Plans synced")
+	assert.Nil(t, err)
+}
+
+func TestJamfProtectDeploymentTasks(t *testing.T) {
+	testServer := jamfProtectResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	tasks, err := j.JamfProtectDeploymentTasks(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "FAILED", tasks.Results[1].Status)
+
+	assert.Nil(t, j.RetryJamfProtectDeploymentTasks(context.Background(), "1", nil))
+	tasks, err = j.JamfProtectDeploymentTasks(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "QUEUED", tasks.Results[1].Status)
+}
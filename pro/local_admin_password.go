@@ -0,0 +1,88 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const localAdminPasswordContext = "local-admin-password"
+
+// LocalAdminPasswordSettings returns the Local Administrator Password Solution (LAPS) settings
+func (j *Client) LocalAdminPasswordSettings(ctx context.Context) (*LocalAdminPasswordSettings, error) {
+	ep := j.endpoint(2, localAdminPasswordContext+"/settings")
+	res := &LocalAdminPasswordSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query local admin password settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateLocalAdminPasswordSettings will update the LAPS settings
+func (j *Client) UpdateLocalAdminPasswordSettings(ctx context.Context, settings *LocalAdminPasswordSettings) (*LocalAdminPasswordSettings, error) {
+	ep := j.endpoint(2, localAdminPasswordContext+"/settings")
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for local admin password settings: (%s)", ep)
+	}
+
+	res := &LocalAdminPasswordSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for local admin password settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// LocalAdminPasswordPendingRotations returns the LAPS accounts waiting for their password to be rotated
+func (j *Client) LocalAdminPasswordPendingRotations(ctx context.Context) (*Results[LocalAdminPasswordPendingRotation], error) {
+	ep := j.endpoint(2, localAdminPasswordContext+"/pending-rotations")
+	res := &Results[LocalAdminPasswordPendingRotation]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query local admin password pending rotations from %s", ep)
+	}
+	return res, nil
+}
+
+// LocalAdminAccounts returns the LAPS capable accounts of a device given its management ID
+func (j *Client) LocalAdminAccounts(ctx context.Context, managementID string) (*Results[LocalAdminAccount], error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/accounts", localAdminPasswordContext, url.PathEscape(managementID)))
+	res := &Results[LocalAdminAccount]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query local admin accounts for device %s from %s", managementID, ep)
+	}
+	return res, nil
+}
+
+// LocalAdminPassword returns the current password of a LAPS account given the device management ID
+// and the account username. Jamf records each view in the account's audit history.
+func (j *Client) LocalAdminPassword(ctx context.Context, managementID string, username string) (string, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/account/%s/password", localAdminPasswordContext, url.PathEscape(managementID), url.PathEscape(username)))
+	res := &localAdminPassword{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return "", errors.Wrapf(err, "unable to query local admin password for %s on device %s from %s", username, managementID, ep)
+	}
+	return res.Password, nil
+}
+
+// LocalAdminPasswordAudit returns the passwords of a LAPS account along with who viewed each of them
+func (j *Client) LocalAdminPasswordAudit(ctx context.Context, managementID string, username string) (*Results[LocalAdminPasswordAudit], error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/account/%s/audit", localAdminPasswordContext, url.PathEscape(managementID), url.PathEscape(username)))
+	res := &Results[LocalAdminPasswordAudit]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query local admin password audit for %s on device %s from %s", username, managementID, ep)
+	}
+	return res, nil
+}
+
+// LocalAdminPasswordHistory returns the view and rotation events of every LAPS account on a device
+func (j *Client) LocalAdminPasswordHistory(ctx context.Context, managementID string) (*Results[LocalAdminPasswordEvent], error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/history", localAdminPasswordContext, url.PathEscape(managementID)))
+	res := &Results[LocalAdminPasswordEvent]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query local admin password history for device %s from %s", managementID, ep)
+	}
+	return res, nil
+}
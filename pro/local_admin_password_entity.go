@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Sources of a LAPS account
+const (
+	LocalAdminAccountSourceMDM = "MDM"
+	LocalAdminAccountSourceJMF = "JMF"
+)
+
+// Event types reported in the LAPS history of a device
+const (
+	LocalAdminPasswordEventCreated = "CREATED"
+	LocalAdminPasswordEventViewed  = "VIEWED"
+	LocalAdminPasswordEventRotated = "ROTATED"
+)
+
+// LocalAdminPasswordSettings represents the Local Administrator Password Solution (LAPS) settings,
+// rotation and expiration times are in seconds
+type LocalAdminPasswordSettings struct {
+	AutoDeployEnabled        bool `json:"autoDeployEnabled"`
+	PasswordRotationTime     int  `json:"passwordRotationTime"`
+	AutoRotateEnabled        bool `json:"autoRotateEnabled"`
+	AutoRotateExpirationTime int  `json:"autoRotateExpirationTime"`
+}
+
+// LocalAdminAccount represents an account whose password is managed by LAPS
+type LocalAdminAccount struct {
+	ClientManagementID string `json:"clientManagementId"`
+	GUID               string `json:"guid,omitempty"`
+	Username           string `json:"username"`
+	UserSource         string `json:"userSource"`
+}
+
+// LocalAdminPasswordPendingRotation represents a LAPS account waiting for its password to be rotated
+type LocalAdminPasswordPendingRotation struct {
+	LAPSUser    LocalAdminAccount `json:"lapsUser"`
+	CreatedDate string            `json:"createdDate"`
+}
+
+// LocalAdminPasswordAudit holds a password of a LAPS account and the times it was viewed
+type LocalAdminPasswordAudit struct {
+	Password       string                        `json:"password"`
+	DateLastSeen   string                        `json:"dateLastSeen,omitempty"`
+	ExpirationTime string                        `json:"expirationTime,omitempty"`
+	Audits         []LocalAdminPasswordAuditView `json:"audits"`
+}
+
+// LocalAdminPasswordAuditView records who viewed a LAPS password and when
+type LocalAdminPasswordAuditView struct {
+	ViewedBy string `json:"viewedBy"`
+	DateSeen string `json:"dateSeen"`
+}
+
+// LocalAdminPasswordEvent represents a view or rotation of a LAPS account password
+type LocalAdminPasswordEvent struct {
+	Username  string `json:"username"`
+	EventType string `json:"eventType"`
+	EventTime string `json:"eventTime"`
+	ViewedBy  string `json:"viewedBy,omitempty"`
+}
+
+type localAdminPassword struct {
+	Password string `json:"password"`
+}
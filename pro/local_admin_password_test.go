@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var LOCAL_ADMIN_PASSWORD_API_BASE_ENDPOINT = "/api/v2/local-admin-password"
+
+var mockLocalAdminManagementID = "b9e8f0a2-5c4d-4e1f-9a3b-7d6c5e4f3a2b"
+
+func localAdminPasswordResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.LocalAdminPasswordSettings{PasswordRotationTime: 3600, AutoRotateExpirationTime: 7776000}
+	account := pro.LocalAdminAccount{ClientManagementID: mockLocalAdminManagementID, GUID: "4F2A1D3C", Username: "ladmin", UserSource: pro.LocalAdminAccountSourceMDM}
+	views := []pro.LocalAdminPasswordAuditView{}
+	events := []pro.LocalAdminPasswordEvent{{Username: "ladmin", EventType: pro.LocalAdminPasswordEventCreated, EventTime: "2024-10-01T12:00:00Z"}}
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(v))
+	}
+	mux.HandleFunc(LOCAL_ADMIN_PASSWORD_API_BASE_ENDPOINT+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		writeJSON(w, settings)
+	})
+	mux.HandleFunc(LOCAL_ADMIN_PASSWORD_API_BASE_ENDPOINT+"/pending-rotations", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, pro.Results[pro.LocalAdminPasswordPendingRotation]{TotalCount: 1, Results: []pro.LocalAdminPasswordPendingRotation{{LAPSUser: account, CreatedDate: "2024-10-02T08:00:00Z"}}})
+	})
+	device := LOCAL_ADMIN_PASSWORD_API_BASE_ENDPOINT + "/" + mockLocalAdminManagementID
+	mux.HandleFunc(device+"/accounts", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, pro.Results[pro.LocalAdminAccount]{TotalCount: 1, Results: []pro.LocalAdminAccount{account}})
+	})
+	mux.HandleFunc(device+"/account/ladmin/password", func(w http.ResponseWriter, r *http.Request) {
+		views = append(views, pro.LocalAdminPasswordAuditView{ViewedBy: "helpdesk", DateSeen: "2024-10-03T09:30:00Z"})
+		events = append(events, pro.LocalAdminPasswordEvent{Username: "ladmin", EventType: pro.LocalAdminPasswordEventViewed, EventTime: "2024-10-03T09:30:00Z", ViewedBy: "helpdesk"})
+		writeJSON(w, map[string]string{"password": "flash-tidy-quartz"})
+	})
+	mux.HandleFunc(device+"/account/ladmin/audit", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, pro.Results[pro.LocalAdminPasswordAudit]{TotalCount: 1, Results: []pro.LocalAdminPasswordAudit{{Password: "flash-tidy-quartz", Audits: views}}})
+	})
+	mux.HandleFunc(device+"/history", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, pro.Results[pro.LocalAdminPasswordEvent]{TotalCount: len(events), Results: events})
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "NOT_FOUND", "description": "Device not found"}]}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestLocalAdminPasswordSettings(t *testing.T) {
+	testServer := localAdminPasswordResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.LocalAdminPasswordSettings(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, settings.AutoDeployEnabled)
+	assert.Equal(t, 3600, settings.PasswordRotationTime)
+
+	_, err = j.UpdateLocalAdminPasswordSettings(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	settings.AutoDeployEnabled = true
+	settings.AutoRotateEnabled = true
+	updated, err := j.UpdateLocalAdminPasswordSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.True(t, updated.AutoDeployEnabled)
+	assert.True(t, updated.AutoRotateEnabled)
+
+	pending, err := j.LocalAdminPasswordPendingRotations(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, pending.TotalCount)
+	assert.Equal(t, "ladmin", pending.Results[0].LAPSUser.Username)
+}
+
+func TestLocalAdminPassword(t *testing.T) {
+	testServer := localAdminPasswordResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	accounts, err := j.LocalAdminAccounts(context.Background(), mockLocalAdminManagementID)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.LocalAdminAccountSourceMDM, accounts.Results[0].UserSource)
+
+	password, err := j.LocalAdminPassword(context.Background(), mockLocalAdminManagementID, accounts.Results[0].Username)
+	assert.Nil(t, err)
+	assert.Equal(t, "flash-tidy-quartz", password)
+
+	audit, err := j.LocalAdminPasswordAudit(context.Background(), mockLocalAdminManagementID, "ladmin")
+	assert.Nil(t, err)
+	assert.Len(t, audit.Results[0].Audits, 1)
+	assert.Equal(t, "helpdesk", audit.Results[0].Audits[0].ViewedBy)
+
+	history, err := j.LocalAdminPasswordHistory(context.Background(), mockLocalAdminManagementID)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, history.TotalCount)
+	assert.Equal(t, pro.LocalAdminPasswordEventViewed, history.Results[1].EventType)
+
+	_, err = j.LocalAdminPassword(context.Background(), "unknown", "ladmin")
+	assert.True(t, pro.IsNotFound(err))
+}
@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	managedSoftwareUpdatesContext = "managed-software-updates"
+	managedSoftwareUpdatePlans    = managedSoftwareUpdatesContext + "/plans"
+	managedSoftwareUpdateToggle   = managedSoftwareUpdatePlans + "/feature-toggle"
+)
+
+// AvailableSoftwareUpdates returns the macOS and iOS versions that update plans can target
+func (j *Client) AvailableSoftwareUpdates(ctx context.Context) (*AvailableSoftwareUpdates, error) {
+	ep := j.endpoint(1, managedSoftwareUpdatesContext+"/available-updates")
+	res := &availableSoftwareUpdates{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query available software updates from %s", ep)
+	}
+	return &res.AvailableUpdates, nil
+}
+
+// ManagedSoftwareUpdatePlans returns a single page of managed software update plans matching opts
+func (j *Client) ManagedSoftwareUpdatePlans(ctx context.Context, opts *ListOptions) (*Results[ManagedSoftwareUpdatePlan], error) {
+	ep := j.endpoint(1, managedSoftwareUpdatePlans)
+	res, err := listPage[ManagedSoftwareUpdatePlan](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed software update plans from %s", ep)
+	}
+	return res, nil
+}
+
+// AllManagedSoftwareUpdatePlans returns every managed software update plan matching opts, requesting each page in turn
+func (j *Client) AllManagedSoftwareUpdatePlans(ctx context.Context, opts *ListOptions) ([]ManagedSoftwareUpdatePlan, error) {
+	ep := j.endpoint(1, managedSoftwareUpdatePlans)
+	res, err := listAll[ManagedSoftwareUpdatePlan](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all managed software update plans from %s", ep)
+	}
+	return res, nil
+}
+
+// ManagedSoftwareUpdatePlanDetails returns the configuration and status of a managed software update plan given its ID
+func (j *Client) ManagedSoftwareUpdatePlanDetails(ctx context.Context, id string) (*ManagedSoftwareUpdatePlan, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", managedSoftwareUpdatePlans, url.PathEscape(id)))
+	res := &ManagedSoftwareUpdatePlan{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed software update plan with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// ManagedSoftwareUpdateGroupPlans returns the managed software update plans created for the members of a group,
+// groupType is one of the ManagedSoftwareUpdateObjectType group constants
+func (j *Client) ManagedSoftwareUpdateGroupPlans(ctx context.Context, groupID string, groupType string) (*Results[ManagedSoftwareUpdatePlan], error) {
+	ep := withQuery(j.endpoint(1, fmt.Sprintf("%s/group/%s", managedSoftwareUpdatePlans, url.PathEscape(groupID))), url.Values{"group-type": {groupType}})
+	res := &Results[ManagedSoftwareUpdatePlan]{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed software update plans for group with ID %s from %s", groupID, ep)
+	}
+	return res, nil
+}
+
+// CreateManagedSoftwareUpdatePlan will create a managed software update plan for each of the given devices
+func (j *Client) CreateManagedSoftwareUpdatePlan(ctx context.Context, config *ManagedSoftwareUpdatePlanConfig, devices ...ManagedSoftwareUpdateDevice) ([]ManagedSoftwareUpdatePlanCreated, error) {
+	ep := j.endpoint(1, managedSoftwareUpdatePlans)
+	if err := config.validate(); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for managed software update plan: (%s)", ep)
+	}
+	if len(devices) == 0 {
+		return nil, errors.Wrapf(fmt.Errorf("at least one device required"), "unable to process JAMF creation request for managed software update plan: (%s)", ep)
+	}
+
+	res := &managedSoftwareUpdatePlansCreated{}
+	if err := j.do(ctx, "POST", ep, &managedSoftwareUpdateDevicePlan{Devices: devices, Config: config}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to create managed software update plan for %d devices on %s", len(devices), ep)
+	}
+	return res.Plans, nil
+}
+
+// CreateManagedSoftwareUpdateGroupPlan will create a managed software update plan for each member of a group
+func (j *Client) CreateManagedSoftwareUpdateGroupPlan(ctx context.Context, config *ManagedSoftwareUpdatePlanConfig, group ManagedSoftwareUpdateGroup) ([]ManagedSoftwareUpdatePlanCreated, error) {
+	ep := j.endpoint(1, managedSoftwareUpdatePlans+"/group")
+	if err := config.validate(); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for managed software update group plan: (%s)", ep)
+	}
+	if group.GroupID == "" || group.ObjectType == "" {
+		return nil, errors.Wrapf(fmt.Errorf("group ID and object type required"), "unable to process JAMF creation request for managed software update group plan: (%s)", ep)
+	}
+
+	res := &managedSoftwareUpdatePlansCreated{}
+	if err := j.do(ctx, "POST", ep, &managedSoftwareUpdateGroupPlan{Group: group, Config: config}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to create managed software update plan for group with ID %s on %s", group.GroupID, ep)
+	}
+	return res.Plans, nil
+}
+
+// ManagedSoftwareUpdateFeatureToggle returns whether managed software updates through declarative
+// device management are enabled
+func (j *Client) ManagedSoftwareUpdateFeatureToggle(ctx context.Context) (*ManagedSoftwareUpdateFeatureToggle, error) {
+	ep := j.endpoint(1, managedSoftwareUpdateToggle)
+	res := &ManagedSoftwareUpdateFeatureToggle{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed software update feature toggle from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateManagedSoftwareUpdateFeatureToggle will turn managed software updates on or off. Jamf migrates
+// existing software update settings in the background, use ManagedSoftwareUpdateFeatureToggleStatus
+// to follow its progress.
+func (j *Client) UpdateManagedSoftwareUpdateFeatureToggle(ctx context.Context, enabled bool) (*ManagedSoftwareUpdateFeatureToggle, error) {
+	ep := j.endpoint(1, managedSoftwareUpdateToggle)
+	res := &ManagedSoftwareUpdateFeatureToggle{}
+	if err := j.do(ctx, "PUT", ep, &ManagedSoftwareUpdateFeatureToggle{Toggle: enabled}, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for managed software update feature toggle (%s)", ep)
+	}
+	return res, nil
+}
+
+// ManagedSoftwareUpdateFeatureToggleStatus returns the progress of the background jobs run when
+// managed software updates are turned on or off
+func (j *Client) ManagedSoftwareUpdateFeatureToggleStatus(ctx context.Context) (*ManagedSoftwareUpdateFeatureToggleStatus, error) {
+	ep := j.endpoint(1, managedSoftwareUpdateToggle+"/status")
+	res := &ManagedSoftwareUpdateFeatureToggleStatus{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query managed software update feature toggle status from %s", ep)
+	}
+	return res, nil
+}
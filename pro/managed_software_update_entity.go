@@ -0,0 +1,174 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import "fmt"
+
+// Object types of the devices and groups a managed software update plan targets
+const (
+	ManagedSoftwareUpdateObjectTypeComputer          = "COMPUTER"
+	ManagedSoftwareUpdateObjectTypeMobileDevice      = "MOBILE_DEVICE"
+	ManagedSoftwareUpdateObjectTypeAppleTV           = "APPLE_TV"
+	ManagedSoftwareUpdateObjectTypeComputerGroup     = "COMPUTER_GROUP"
+	ManagedSoftwareUpdateObjectTypeMobileDeviceGroup = "MOBILE_DEVICE_GROUP"
+)
+
+// Actions a managed software update plan performs on its devices
+const (
+	ManagedSoftwareUpdateActionDownloadOnly                 = "DOWNLOAD_ONLY"
+	ManagedSoftwareUpdateActionDownloadInstall              = "DOWNLOAD_INSTALL"
+	ManagedSoftwareUpdateActionDownloadInstallAllowDeferral = "DOWNLOAD_INSTALL_ALLOW_DEFERRAL"
+	ManagedSoftwareUpdateActionDownloadInstallRestart       = "DOWNLOAD_INSTALL_RESTART"
+	ManagedSoftwareUpdateActionDownloadInstallSchedule      = "DOWNLOAD_INSTALL_SCHEDULE"
+)
+
+// Versions a managed software update plan can target
+const (
+	ManagedSoftwareUpdateVersionLatestMajor     = "LATEST_MAJOR"
+	ManagedSoftwareUpdateVersionLatestMinor     = "LATEST_MINOR"
+	ManagedSoftwareUpdateVersionLatestAny       = "LATEST_ANY"
+	ManagedSoftwareUpdateVersionSpecificVersion = "SPECIFIC_VERSION"
+	ManagedSoftwareUpdateVersionCustomVersion   = "CUSTOM_VERSION"
+)
+
+// States of a managed software update plan
+const (
+	ManagedSoftwareUpdatePlanStateInit                  = "Init"
+	ManagedSoftwareUpdatePlanStatePendingPlanValidation = "PendingPlanValidation"
+	ManagedSoftwareUpdatePlanStateAcceptingPlan         = "AcceptingPlan"
+	ManagedSoftwareUpdatePlanStateRejectingPlan         = "RejectingPlan"
+	ManagedSoftwareUpdatePlanStateProcessingPlanType    = "ProcessingPlanType"
+	ManagedSoftwareUpdatePlanStateStartingPlan          = "StartingPlan"
+	ManagedSoftwareUpdatePlanStatePlanFailed            = "PlanFailed"
+	ManagedSoftwareUpdatePlanStatePlanCanceled          = "PlanCanceled"
+	ManagedSoftwareUpdatePlanStatePlanCompleted         = "PlanCompleted"
+	ManagedSoftwareUpdatePlanStatePlanException         = "PlanException"
+)
+
+// AvailableSoftwareUpdates lists the OS versions available to managed software update plans
+type AvailableSoftwareUpdates struct {
+	MacOS []string `json:"macOS"`
+	IOS   []string `json:"iOS"`
+}
+
+// ManagedSoftwareUpdateDevice identifies a device targeted by a managed software update plan
+type ManagedSoftwareUpdateDevice struct {
+	DeviceID   string `json:"deviceId"`
+	ObjectType string `json:"objectType"`
+	Href       string `json:"href,omitempty"`
+}
+
+// ManagedSoftwareUpdateGroup identifies a group whose members are targeted by a managed software update plan
+type ManagedSoftwareUpdateGroup struct {
+	GroupID    string `json:"groupId"`
+	ObjectType string `json:"objectType"`
+}
+
+// ManagedSoftwareUpdatePlanConfig holds the update settings shared by the plans created in one request,
+// ForceInstallLocalDateTime is a local date time such as 2024-12-25T21:00:00 and is only used with
+// the DOWNLOAD_INSTALL_SCHEDULE action
+type ManagedSoftwareUpdatePlanConfig struct {
+	UpdateAction              string `json:"updateAction"`
+	VersionType               string `json:"versionType"`
+	SpecificVersion           string `json:"specificVersion,omitempty"`
+	BuildVersion              string `json:"buildVersion,omitempty"`
+	MaxDeferrals              int    `json:"maxDeferrals,omitempty"`
+	ForceInstallLocalDateTime string `json:"forceInstallLocalDateTime,omitempty"`
+}
+
+func (c *ManagedSoftwareUpdatePlanConfig) validate() error {
+	if c == nil {
+		return fmt.Errorf("empty payload")
+	}
+	if c.UpdateAction == "" || c.VersionType == "" {
+		return fmt.Errorf("update action and version type required")
+	}
+	if (c.VersionType == ManagedSoftwareUpdateVersionSpecificVersion || c.VersionType == ManagedSoftwareUpdateVersionCustomVersion) && c.SpecificVersion == "" {
+		return fmt.Errorf("specific version required for version type %s", c.VersionType)
+	}
+	return nil
+}
+
+// ManagedSoftwareUpdatePlan represents the update plan of a single device
+type ManagedSoftwareUpdatePlan struct {
+	PlanUUID                  string                          `json:"planUuid"`
+	Device                    ManagedSoftwareUpdateDevice     `json:"device"`
+	UpdateAction              string                          `json:"updateAction"`
+	VersionType               string                          `json:"versionType"`
+	SpecificVersion           string                          `json:"specificVersion,omitempty"`
+	BuildVersion              string                          `json:"buildVersion,omitempty"`
+	MaxDeferrals              int                             `json:"maxDeferrals"`
+	ForceInstallLocalDateTime string                          `json:"forceInstallLocalDateTime,omitempty"`
+	RecipeID                  string                          `json:"recipeId,omitempty"`
+	Status                    ManagedSoftwareUpdatePlanStatus `json:"status"`
+}
+
+// Done returns true once the plan has completed, failed or been canceled
+func (p *ManagedSoftwareUpdatePlan) Done() bool {
+	switch p.Status.State {
+	case ManagedSoftwareUpdatePlanStatePlanCompleted, ManagedSoftwareUpdatePlanStatePlanFailed,
+		ManagedSoftwareUpdatePlanStatePlanCanceled, ManagedSoftwareUpdatePlanStatePlanException,
+		ManagedSoftwareUpdatePlanStateRejectingPlan:
+		return true
+	}
+	return false
+}
+
+// ManagedSoftwareUpdatePlanStatus holds the state of a plan and why it failed if it did
+type ManagedSoftwareUpdatePlanStatus struct {
+	State        string   `json:"state"`
+	ErrorReasons []string `json:"errorReasons,omitempty"`
+}
+
+// ManagedSoftwareUpdatePlanCreated references a plan created for a device
+type ManagedSoftwareUpdatePlanCreated struct {
+	Device ManagedSoftwareUpdateDevice `json:"device"`
+	PlanID string                      `json:"planId"`
+	Href   string                      `json:"href"`
+}
+
+// ManagedSoftwareUpdateFeatureToggle reports whether managed software updates are enabled
+type ManagedSoftwareUpdateFeatureToggle struct {
+	Toggle                       bool `json:"toggle"`
+	ForceInstallLocalDateEnabled bool `json:"forceInstallLocalDateEnabled,omitempty"`
+	DSSEnabled                   bool `json:"dssEnabled,omitempty"`
+	RecipeEnabled                bool `json:"recipeEnabled,omitempty"`
+}
+
+// ManagedSoftwareUpdateFeatureToggleStatus holds the progress of turning managed software updates on and off
+type ManagedSoftwareUpdateFeatureToggleStatus struct {
+	ToggleOn  *ManagedSoftwareUpdateToggleJob `json:"toggleOn,omitempty"`
+	ToggleOff *ManagedSoftwareUpdateToggleJob `json:"toggleOff,omitempty"`
+}
+
+// ManagedSoftwareUpdateToggleJob represents a background job run by the feature toggle
+type ManagedSoftwareUpdateToggleJob struct {
+	StartTime                string  `json:"startTime,omitempty"`
+	EndTime                  string  `json:"endTime,omitempty"`
+	ElapsedTime              int     `json:"elapsedTime"`
+	State                    string  `json:"state"`
+	TotalRecords             int     `json:"totalRecords"`
+	ProcessedRecords         int     `json:"processedRecords"`
+	PercentComplete          float64 `json:"percentComplete"`
+	FormattedPercentComplete string  `json:"formattedPercentComplete,omitempty"`
+	ExitState                string  `json:"exitState,omitempty"`
+	ExitMessage              string  `json:"exitMessage,omitempty"`
+}
+
+type availableSoftwareUpdates struct {
+	AvailableUpdates AvailableSoftwareUpdates `json:"availableUpdates"`
+}
+
+type managedSoftwareUpdateDevicePlan struct {
+	Devices []ManagedSoftwareUpdateDevice    `json:"devices"`
+	Config  *ManagedSoftwareUpdatePlanConfig `json:"config"`
+}
+
+type managedSoftwareUpdateGroupPlan struct {
+	Group  ManagedSoftwareUpdateGroup       `json:"group"`
+	Config *ManagedSoftwareUpdatePlanConfig `json:"config"`
+}
+
+type managedSoftwareUpdatePlansCreated struct {
+	Plans []ManagedSoftwareUpdatePlanCreated `json:"plans"`
+}
@@ -0,0 +1,187 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT = "/api/v1/managed-software-updates"
+
+func managedSoftwareUpdatesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	plans := []pro.ManagedSoftwareUpdatePlan{}
+	toggle := pro.ManagedSoftwareUpdateFeatureToggle{}
+	groupMembers := map[string][]string{"7": {"11", "12"}}
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(v))
+	}
+	createPlans := func(w http.ResponseWriter, config *pro.ManagedSoftwareUpdatePlanConfig, devices []pro.ManagedSoftwareUpdateDevice) {
+		created := []pro.ManagedSoftwareUpdatePlanCreated{}
+		for _, device := range devices {
+			id := fmt.Sprintf("plan-%d", len(plans)+1)
+			device.Href = "/api/v1/computers-inventory/" + device.DeviceID
+			plans = append(plans, pro.ManagedSoftwareUpdatePlan{
+				PlanUUID:        id,
+				Device:          device,
+				UpdateAction:    config.UpdateAction,
+				VersionType:     config.VersionType,
+				SpecificVersion: config.SpecificVersion,
+				MaxDeferrals:    config.MaxDeferrals,
+				Status:          pro.ManagedSoftwareUpdatePlanStatus{State: pro.ManagedSoftwareUpdatePlanStateInit},
+			})
+			created = append(created, pro.ManagedSoftwareUpdatePlanCreated{Device: device, PlanID: id, Href: MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT + "/plans/" + id})
+		}
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, map[string]interface{}{"plans": created})
+	}
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/available-updates", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]interface{}{"availableUpdates": pro.AvailableSoftwareUpdates{MacOS: []string{"15.0.1", "14.7"}, IOS: []string{"18.0.1"}}})
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			body := struct {
+				Devices []pro.ManagedSoftwareUpdateDevice    `json:"devices"`
+				Config  *pro.ManagedSoftwareUpdatePlanConfig `json:"config"`
+			}{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+			createPlans(w, body.Config, body.Devices)
+			return
+		}
+		writeJSON(w, pageOf(t, r, plans))
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/group", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := struct {
+			Group  pro.ManagedSoftwareUpdateGroup       `json:"group"`
+			Config *pro.ManagedSoftwareUpdatePlanConfig `json:"config"`
+		}{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, pro.ManagedSoftwareUpdateObjectTypeComputerGroup, body.Group.ObjectType)
+		devices := []pro.ManagedSoftwareUpdateDevice{}
+		for _, id := range groupMembers[body.Group.GroupID] {
+			devices = append(devices, pro.ManagedSoftwareUpdateDevice{DeviceID: id, ObjectType: pro.ManagedSoftwareUpdateObjectTypeComputer})
+		}
+		createPlans(w, body.Config, devices)
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/group/7", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, pro.ManagedSoftwareUpdateObjectTypeComputerGroup, r.URL.Query().Get("group-type"))
+		results := []pro.ManagedSoftwareUpdatePlan{}
+		for _, plan := range plans {
+			if contains(groupMembers["7"], plan.Device.DeviceID) {
+				results = append(results, plan)
+			}
+		}
+		writeJSON(w, pro.Results[pro.ManagedSoftwareUpdatePlan]{TotalCount: len(results), Results: results})
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/")
+		for i := range plans {
+			if plans[i].PlanUUID == id {
+				plans[i].Status.State = pro.ManagedSoftwareUpdatePlanStatePlanCompleted
+				writeJSON(w, plans[i])
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "NOT_FOUND", "description": "Plan not found"}]}`)
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/feature-toggle", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&toggle))
+		}
+		writeJSON(w, toggle)
+	})
+	mux.HandleFunc(MANAGED_SOFTWARE_UPDATES_API_BASE_ENDPOINT+"/plans/feature-toggle/status", func(w http.ResponseWriter, r *http.Request) {
+		job := &pro.ManagedSoftwareUpdateToggleJob{State: "COMPLETED", TotalRecords: 42, ProcessedRecords: 42, PercentComplete: 100, FormattedPercentComplete: "100%"}
+		writeJSON(w, pro.ManagedSoftwareUpdateFeatureToggleStatus{ToggleOn: job})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestAvailableSoftwareUpdates(t *testing.T) {
+	testServer := managedSoftwareUpdatesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	updates, err := j.AvailableSoftwareUpdates(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"15.0.1", "14.7"}, updates.MacOS)
+	assert.Equal(t, []string{"18.0.1"}, updates.IOS)
+}
+
+func TestManagedSoftwareUpdatePlans(t *testing.T) {
+	testServer := managedSoftwareUpdatesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	config := &pro.ManagedSoftwareUpdatePlanConfig{
+		UpdateAction: pro.ManagedSoftwareUpdateActionDownloadInstallAllowDeferral,
+		VersionType:  pro.ManagedSoftwareUpdateVersionSpecificVersion,
+		MaxDeferrals: 3,
+	}
+	_, err := j.CreateManagedSoftwareUpdatePlan(context.Background(), nil, pro.ManagedSoftwareUpdateDevice{DeviceID: "1", ObjectType: pro.ManagedSoftwareUpdateObjectTypeComputer})
+	assert.NotNil(t, err)
+	_, err = j.CreateManagedSoftwareUpdatePlan(context.Background(), config, pro.ManagedSoftwareUpdateDevice{DeviceID: "1", ObjectType: pro.ManagedSoftwareUpdateObjectTypeComputer})
+	assert.Contains(t, err.Error(), "specific version required")
+
+	config.SpecificVersion = "15.0.1"
+	_, err = j.CreateManagedSoftwareUpdatePlan(context.Background(), config)
+	assert.Contains(t, err.Error(), "at least one device required")
+
+	created, err := j.CreateManagedSoftwareUpdatePlan(context.Background(), config, pro.ManagedSoftwareUpdateDevice{DeviceID: "1", ObjectType: pro.ManagedSoftwareUpdateObjectTypeComputer})
+	assert.Nil(t, err)
+	assert.Len(t, created, 1)
+	assert.Equal(t, "1", created[0].Device.DeviceID)
+
+	_, err = j.CreateManagedSoftwareUpdateGroupPlan(context.Background(), config, pro.ManagedSoftwareUpdateGroup{GroupID: "7"})
+	assert.NotNil(t, err)
+	created, err = j.CreateManagedSoftwareUpdateGroupPlan(context.Background(), config, pro.ManagedSoftwareUpdateGroup{GroupID: "7", ObjectType: pro.ManagedSoftwareUpdateObjectTypeComputerGroup})
+	assert.Nil(t, err)
+	assert.Len(t, created, 2)
+
+	all, err := j.AllManagedSoftwareUpdatePlans(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Len(t, all, 3)
+
+	group, err := j.ManagedSoftwareUpdateGroupPlans(context.Background(), "7", pro.ManagedSoftwareUpdateObjectTypeComputerGroup)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, group.TotalCount)
+
+	plan, err := j.ManagedSoftwareUpdatePlanDetails(context.Background(), created[0].PlanID)
+	assert.Nil(t, err)
+	assert.Equal(t, "15.0.1", plan.SpecificVersion)
+	assert.True(t, plan.Done())
+
+	_, err = j.ManagedSoftwareUpdatePlanDetails(context.Background(), "unknown")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestManagedSoftwareUpdateFeatureToggle(t *testing.T) {
+	testServer := managedSoftwareUpdatesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	toggle, err := j.ManagedSoftwareUpdateFeatureToggle(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, toggle.Toggle)
+
+	toggle, err = j.UpdateManagedSoftwareUpdateFeatureToggle(context.Background(), true)
+	assert.Nil(t, err)
+	assert.True(t, toggle.Toggle)
+
+	status, err := j.ManagedSoftwareUpdateFeatureToggleStatus(context.Background())
+	assert.Nil(t, err)
+	assert.Nil(t, status.ToggleOff)
+	assert.Equal(t, 42, status.ToggleOn.ProcessedRecords)
+}
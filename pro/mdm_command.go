@@ -0,0 +1,143 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const mdmCommandsContext = "mdm/commands"
+
+// SendMDMCommand queues an MDM command for the devices or users with the given management IDs,
+// returning a resource for each queued command. Management IDs are reported in the general
+// section of computer and mobile device inventory.
+func (j *Client) SendMDMCommand(ctx context.Context, command MDMCommand, managementIDs ...string) ([]CreatedResource, error) {
+	ep := j.endpoint(2, mdmCommandsContext)
+	if command == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF MDM command request: (%s)", ep)
+	}
+	if len(managementIDs) == 0 {
+		return nil, errors.Wrapf(fmt.Errorf("at least one management ID required for %s command", command.CommandType()), "unable to process JAMF MDM command request: (%s)", ep)
+	}
+
+	data, err := mdmCommandData(command)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF MDM command request for %s: (%s)", command.CommandType(), ep)
+	}
+	payload := &mdmCommandRequest{CommandData: data}
+	for _, id := range managementIDs {
+		payload.ClientData = append(payload.ClientData, MDMCommandClient{ManagementID: id})
+	}
+
+	res := []CreatedResource{}
+	if err := j.do(ctx, "POST", ep, payload, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to send %s command to %d clients on %s", command.CommandType(), len(managementIDs), ep)
+	}
+	return res, nil
+}
+
+// MDMCommands returns a single page of MDM commands matching opts, Jamf requires a filter such as
+// `clientManagementId=="..."` or `commandState=="PENDING"`
+func (j *Client) MDMCommands(ctx context.Context, opts *ListOptions) (*Results[MDMCommandStatus], error) {
+	ep := j.endpoint(2, mdmCommandsContext)
+	res, err := listPage[MDMCommandStatus](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query MDM commands from %s", ep)
+	}
+	return res, nil
+}
+
+// AllMDMCommands returns every MDM command matching opts, requesting each page in turn
+func (j *Client) AllMDMCommands(ctx context.Context, opts *ListOptions) ([]MDMCommandStatus, error) {
+	ep := j.endpoint(2, mdmCommandsContext)
+	res, err := listAll[MDMCommandStatus](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all MDM commands from %s", ep)
+	}
+	return res, nil
+}
+
+// IterMDMCommands yields every MDM command matching opts, requesting each page once the previous one has
+// been consumed. An error ends the iteration.
+func (j *Client) IterMDMCommands(ctx context.Context, opts *ListOptions) iter.Seq2[MDMCommandStatus, error] {
+	return iterAll[MDMCommandStatus](ctx, j, j.endpoint(2, mdmCommandsContext), opts, nil)
+}
+
+// MDMCommandDetails returns the state of a queued MDM command given its UUID, e.g. to confirm a
+// device acknowledged a command sent with SendMDMCommand
+func (j *Client) MDMCommandDetails(ctx context.Context, uuid string) (*MDMCommandStatus, error) {
+	page, err := j.MDMCommands(ctx, &ListOptions{PageSize: 1, Filter: fmt.Sprintf("uuid==%q", uuid)})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query MDM command with UUID %s", uuid)
+	}
+	if len(page.Results) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("MDM command with UUID %s not found", uuid)}
+	}
+	return &page.Results[0], nil
+}
+
+// DefaultCommandPollInterval is the time between two polls of a command awaited with a non-positive pollInterval
+const DefaultCommandPollInterval = 5 * time.Second
+
+// AwaitMDMCommand polls the state of an MDM command given its UUID every pollInterval until the device
+// acknowledges it, reports an error or ctx is done, e.g. when its deadline is exceeded which is reported
+// as a CommandTimedOut outcome rather than an error. Commands the device answers with NOT_NOW are
+// retried by the device and keep being polled.
+func (j *Client) AwaitMDMCommand(ctx context.Context, uuid string, pollInterval time.Duration) (*CommandResult, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultCommandPollInterval
+	}
+	res := &CommandResult{}
+	for {
+		status, err := j.MDMCommandDetails(ctx, uuid)
+		if err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				res.Outcome = CommandTimedOut
+				return res, nil
+			}
+			return nil, err
+		}
+		res.Status, res.Polls = status, res.Polls+1
+		switch status.CommandState {
+		case MDMCommandStateAcknowledged:
+			res.Outcome = CommandCompleted
+			return res, nil
+		case MDMCommandStateError:
+			res.Outcome = CommandFailed
+			return res, nil
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if ctx.Err() == context.DeadlineExceeded {
+				res.Outcome = CommandTimedOut
+				return res, nil
+			}
+			return nil, errors.Wrapf(ctx.Err(), "stopped waiting for MDM command with UUID %s", uuid)
+		case <-timer.C:
+		}
+	}
+}
+
+// mdmCommandData marshals a command with its commandType so callers cannot send a mismatched type
+func mdmCommandData(command MDMCommand) (map[string]interface{}, error) {
+	raw, err := json.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	data["commandType"] = command.CommandType()
+	return data, nil
+}
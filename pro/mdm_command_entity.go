@@ -0,0 +1,215 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// MDM command types accepted by SendMDMCommand
+const (
+	MDMCommandTypeClearPasscode         = "CLEAR_PASSCODE"
+	MDMCommandTypeDeclarativeManagement = "DECLARATIVE_MANAGEMENT"
+	MDMCommandTypeDeleteUser            = "DELETE_USER"
+	MDMCommandTypeDeviceLock            = "DEVICE_LOCK"
+	MDMCommandTypeDisableLostMode       = "DISABLE_LOST_MODE"
+	MDMCommandTypeDisableRemoteDesktop  = "DISABLE_REMOTE_DESKTOP"
+	MDMCommandTypeEnableLostMode        = "ENABLE_LOST_MODE"
+	MDMCommandTypeEnableRemoteDesktop   = "ENABLE_REMOTE_DESKTOP"
+	MDMCommandTypeEraseDevice           = "ERASE_DEVICE"
+	MDMCommandTypeLogOutUser            = "LOG_OUT_USER"
+	MDMCommandTypeRestartDevice         = "RESTART_DEVICE"
+	MDMCommandTypeSetRecoveryLock       = "SET_RECOVERY_LOCK"
+	MDMCommandTypeSettings              = "SETTINGS"
+	MDMCommandTypeShutDownDevice        = "SHUT_DOWN_DEVICE"
+)
+
+// States reported for queued MDM commands
+const (
+	MDMCommandStatePending      = "PENDING"
+	MDMCommandStateAcknowledged = "ACKNOWLEDGED"
+	MDMCommandStateNotNow       = "NOT_NOW"
+	MDMCommandStateError        = "ERROR"
+)
+
+// CommandOutcome is how an awaited MDM command ended
+type CommandOutcome string
+
+// Outcomes of awaited MDM commands
+const (
+	CommandCompleted CommandOutcome = "completed"
+	CommandFailed    CommandOutcome = "failed"
+	CommandTimedOut  CommandOutcome = "timed out"
+)
+
+// CommandResult is returned by AwaitMDMCommand, Status holds the last state polled which is nil when the
+// command timed out before it could be polled
+type CommandResult struct {
+	Outcome CommandOutcome
+	Status  *MDMCommandStatus
+	Polls   int
+}
+
+// MDMCommand is implemented by every typed MDM command payload, CommandType is sent as the
+// commandType of the payload
+type MDMCommand interface {
+	CommandType() string
+}
+
+// Bool returns a pointer to v, for optional settings where false and unset must be told apart
+func Bool(v bool) *bool {
+	return &v
+}
+
+// ClearPasscodeCommand removes the passcode from a mobile device
+type ClearPasscodeCommand struct{}
+
+// CommandType implements MDMCommand
+func (ClearPasscodeCommand) CommandType() string { return MDMCommandTypeClearPasscode }
+
+// DeclarativeManagementCommand tells a device to synchronize its declarations, Data holds the
+// base64 encoded synchronization tokens and may be left empty
+type DeclarativeManagementCommand struct {
+	Data string `json:"data,omitempty"`
+}
+
+// CommandType implements MDMCommand
+func (DeclarativeManagementCommand) CommandType() string { return MDMCommandTypeDeclarativeManagement }
+
+// DeleteUserCommand deletes a user, or every user, from a Shared iPad or a computer
+type DeleteUserCommand struct {
+	UserName       string `json:"userName,omitempty"`
+	ForceDeletion  bool   `json:"forceDeletion"`
+	DeleteAllUsers bool   `json:"deleteAllUsers"`
+}
+
+// CommandType implements MDMCommand
+func (DeleteUserCommand) CommandType() string { return MDMCommandTypeDeleteUser }
+
+// DeviceLockCommand locks a device, computers require a six digit PIN to unlock
+type DeviceLockCommand struct {
+	Message     string `json:"message,omitempty"`
+	PhoneNumber string `json:"phoneNumber,omitempty"`
+	PIN         string `json:"pin,omitempty"`
+}
+
+// CommandType implements MDMCommand
+func (DeviceLockCommand) CommandType() string { return MDMCommandTypeDeviceLock }
+
+// DisableLostModeCommand takes a supervised mobile device out of Lost Mode
+type DisableLostModeCommand struct{}
+
+// CommandType implements MDMCommand
+func (DisableLostModeCommand) CommandType() string { return MDMCommandTypeDisableLostMode }
+
+// DisableRemoteDesktopCommand turns off Remote Management on a computer
+type DisableRemoteDesktopCommand struct{}
+
+// CommandType implements MDMCommand
+func (DisableRemoteDesktopCommand) CommandType() string { return MDMCommandTypeDisableRemoteDesktop }
+
+// EnableLostModeCommand puts a supervised mobile device into Lost Mode, a message or phone number is required
+type EnableLostModeCommand struct {
+	LostModeMessage  string `json:"lostModeMessage,omitempty"`
+	LostModePhone    string `json:"lostModePhone,omitempty"`
+	LostModeFootnote string `json:"lostModeFootnote,omitempty"`
+}
+
+// CommandType implements MDMCommand
+func (EnableLostModeCommand) CommandType() string { return MDMCommandTypeEnableLostMode }
+
+// EnableRemoteDesktopCommand turns on Remote Management on a computer
+type EnableRemoteDesktopCommand struct{}
+
+// CommandType implements MDMCommand
+func (EnableRemoteDesktopCommand) CommandType() string { return MDMCommandTypeEnableRemoteDesktop }
+
+// EraseDeviceCommand erases a device, computers without Apple silicon or a T2 chip require a six digit PIN
+type EraseDeviceCommand struct {
+	PIN                    string                 `json:"pin,omitempty"`
+	ObliterationBehavior   string                 `json:"obliterationBehavior,omitempty"`
+	PreserveDataPlan       bool                   `json:"preserveDataPlan"`
+	DisallowProximitySetup bool                   `json:"disallowProximitySetup"`
+	ReturnToService        *ReturnToServiceConfig `json:"returnToService,omitempty"`
+}
+
+// CommandType implements MDMCommand
+func (EraseDeviceCommand) CommandType() string { return MDMCommandTypeEraseDevice }
+
+// ReturnToServiceConfig re-enrolls a device automatically after it is erased, the profiles are base64 encoded
+type ReturnToServiceConfig struct {
+	Enabled         bool   `json:"enabled"`
+	MDMProfileData  string `json:"mdmProfileData,omitempty"`
+	WifiProfileData string `json:"wifiProfileData,omitempty"`
+}
+
+// LogOutUserCommand logs the current user out of a Shared iPad
+type LogOutUserCommand struct{}
+
+// CommandType implements MDMCommand
+func (LogOutUserCommand) CommandType() string { return MDMCommandTypeLogOutUser }
+
+// RestartDeviceCommand restarts a device, the kernel cache options only apply to computers
+type RestartDeviceCommand struct {
+	RebuildKernelCache bool     `json:"rebuildKernelCache"`
+	KextPaths          []string `json:"kextPaths,omitempty"`
+	NotifyUser         bool     `json:"notifyUser"`
+}
+
+// CommandType implements MDMCommand
+func (RestartDeviceCommand) CommandType() string { return MDMCommandTypeRestartDevice }
+
+// SetRecoveryLockCommand sets the Recovery Lock password of a computer with Apple silicon,
+// an empty NewPassword clears it
+type SetRecoveryLockCommand struct {
+	NewPassword string `json:"newPassword"`
+}
+
+// CommandType implements MDMCommand
+func (SetRecoveryLockCommand) CommandType() string { return MDMCommandTypeSetRecoveryLock }
+
+// SettingsCommand changes device settings, only the settings which are set are sent
+type SettingsCommand struct {
+	DeviceName                  string                  `json:"deviceName,omitempty"`
+	HostName                    string                  `json:"hostName,omitempty"`
+	TimeZone                    string                  `json:"timeZone,omitempty"`
+	BluetoothEnabled            *bool                   `json:"bluetooth,omitempty"`
+	PersonalHotspotEnabled      *bool                   `json:"personalHotspotEnabled,omitempty"`
+	DataRoamingEnabled          *bool                   `json:"dataRoamingEnabled,omitempty"`
+	VoiceRoamingEnabled         *bool                   `json:"voiceRoamingEnabled,omitempty"`
+	DiagnosticSubmissionEnabled *bool                   `json:"diagnosticSubmissionEnabled,omitempty"`
+	AppAnalyticsEnabled         *bool                   `json:"appAnalyticsEnabled,omitempty"`
+	SoftwareUpdateSettings      *SoftwareUpdateSettings `json:"softwareUpdateSettings,omitempty"`
+}
+
+// CommandType implements MDMCommand
+func (SettingsCommand) CommandType() string { return MDMCommandTypeSettings }
+
+// SoftwareUpdateSettings holds how a device presents available software updates
+type SoftwareUpdateSettings struct {
+	RecommendationCadence string `json:"recommendationCadence,omitempty"`
+}
+
+// ShutDownDeviceCommand shuts a device down
+type ShutDownDeviceCommand struct{}
+
+// CommandType implements MDMCommand
+func (ShutDownDeviceCommand) CommandType() string { return MDMCommandTypeShutDownDevice }
+
+// MDMCommandClient identifies a device or user an MDM command is sent to
+type MDMCommandClient struct {
+	ManagementID string `json:"managementId"`
+	ClientType   string `json:"clientType,omitempty"`
+}
+
+// MDMCommandStatus represents an MDM command queued for a client and how far it has progressed
+type MDMCommandStatus struct {
+	UUID          string           `json:"uuid"`
+	Client        MDMCommandClient `json:"client"`
+	CommandState  string           `json:"commandState"`
+	CommandType   string           `json:"commandType"`
+	DateSent      string           `json:"dateSent"`
+	DateCompleted string           `json:"dateCompleted,omitempty"`
+	ProfileID     int              `json:"profileId,omitempty"`
+}
+
+type mdmCommandRequest struct {
+	ClientData  []MDMCommandClient     `json:"clientData"`
+	CommandData map[string]interface{} `json:"commandData"`
+}
@@ -0,0 +1,167 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MDM_COMMANDS_API_BASE_ENDPOINT = "/api/v2/mdm/commands"
+
+type mdmCommandPayload struct {
+	ClientData  []pro.MDMCommandClient `json:"clientData"`
+	CommandData map[string]interface{} `json:"commandData"`
+}
+
+func mdmCommandsResponseMocks(t *testing.T, sent *[]mdmCommandPayload) *httptest.Server {
+	commands := []pro.MDMCommandStatus{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, MDM_COMMANDS_API_BASE_ENDPOINT, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			assert.Equal(t, `commandState=="PENDING"`, r.URL.Query().Get("filter"))
+			assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, commands)))
+		case "POST":
+			payload := mdmCommandPayload{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&payload))
+			*sent = append(*sent, payload)
+			created := []pro.CreatedResource{}
+			for _, client := range payload.ClientData {
+				uuid := fmt.Sprintf("cmd-%d", len(commands)+1)
+				commands = append(commands, pro.MDMCommandStatus{
+					UUID:         uuid,
+					Client:       client,
+					CommandState: pro.MDMCommandStatePending,
+					CommandType:  payload.CommandData["commandType"].(string),
+				})
+				created = append(created, pro.CreatedResource{ID: uuid, Href: "/api/v2/mdm/commands?filter=uuid==" + uuid})
+			}
+			w.WriteHeader(http.StatusCreated)
+			assert.Nil(t, json.NewEncoder(w).Encode(created))
+		}
+	}))
+}
+
+func TestSendMDMCommand(t *testing.T) {
+	sent := []mdmCommandPayload{}
+	testServer := mdmCommandsResponseMocks(t, &sent)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.SendMDMCommand(context.Background(), nil, "a1")
+	assert.NotNil(t, err)
+	_, err = j.SendMDMCommand(context.Background(), &pro.EnableRemoteDesktopCommand{})
+	assert.NotNil(t, err)
+
+	created, err := j.SendMDMCommand(context.Background(), &pro.SetRecoveryLockCommand{NewPassword: "mock-password-cool"}, "a1", "b2")
+	assert.Nil(t, err)
+	assert.Len(t, created, 2)
+	assert.Equal(t, "SET_RECOVERY_LOCK", sent[0].CommandData["commandType"])
+	assert.Equal(t, "mock-password-cool", sent[0].CommandData["newPassword"])
+	assert.Equal(t, "b2", sent[0].ClientData[1].ManagementID)
+
+	_, err = j.SendMDMCommand(context.Background(), &pro.EnableRemoteDesktopCommand{}, "a1")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"commandType": "ENABLE_REMOTE_DESKTOP"}, sent[1].CommandData)
+
+	_, err = j.SendMDMCommand(context.Background(), &pro.SettingsCommand{
+		DeviceName:             "Lab-01",
+		BluetoothEnabled:       pro.Bool(false),
+		SoftwareUpdateSettings: &pro.SoftwareUpdateSettings{RecommendationCadence: "NEWEST"},
+	}, "a1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Lab-01", sent[2].CommandData["deviceName"])
+	assert.Equal(t, false, sent[2].CommandData["bluetooth"])
+	assert.NotContains(t, sent[2].CommandData, "dataRoamingEnabled")
+
+	_, err = j.SendMDMCommand(context.Background(), &pro.DeclarativeManagementCommand{}, "a1")
+	assert.Nil(t, err)
+	assert.Equal(t, "DECLARATIVE_MANAGEMENT", sent[3].CommandData["commandType"])
+}
+
+func TestMDMCommands(t *testing.T) {
+	sent := []mdmCommandPayload{}
+	testServer := mdmCommandsResponseMocks(t, &sent)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.SendMDMCommand(context.Background(), &pro.RestartDeviceCommand{NotifyUser: true}, "a1", "b2", "c3")
+	assert.Nil(t, err)
+
+	page, err := j.MDMCommands(context.Background(), &pro.ListOptions{PageSize: 2, Filter: `commandState=="PENDING"`})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Len(t, page.Results, 2)
+
+	all, err := j.AllMDMCommands(context.Background(), &pro.ListOptions{Filter: `commandState=="PENDING"`})
+	assert.Nil(t, err)
+	assert.Len(t, all, 3)
+	assert.Equal(t, pro.MDMCommandTypeRestartDevice, all[2].CommandType)
+	assert.Equal(t, "c3", all[2].Client.ManagementID)
+}
+
+func TestAwaitMDMCommand(t *testing.T) {
+	polls := map[string]int{}
+	states := map[string][]string{
+		"done":    {pro.MDMCommandStatePending, pro.MDMCommandStateNotNow, pro.MDMCommandStateAcknowledged},
+		"failed":  {pro.MDMCommandStateError},
+		"pending": {pro.MDMCommandStatePending},
+	}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, MDM_COMMANDS_API_BASE_ENDPOINT, r.URL.Path)
+		uuid := ""
+		fmt.Sscanf(r.URL.Query().Get("filter"), "uuid==%q", &uuid)
+		history, ok := states[uuid]
+		results := []pro.MDMCommandStatus{}
+		if ok {
+			state := history[len(history)-1]
+			if polls[uuid] < len(history) {
+				state = history[polls[uuid]]
+			}
+			polls[uuid]++
+			results = append(results, pro.MDMCommandStatus{UUID: uuid, CommandState: state})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pro.Results[pro.MDMCommandStatus]{TotalCount: len(results), Results: results}))
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	res, err := j.AwaitMDMCommand(context.Background(), "done", time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.CommandCompleted, res.Outcome)
+	assert.Equal(t, 3, res.Polls)
+
+	res, err = j.AwaitMDMCommand(context.Background(), "failed", time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.CommandFailed, res.Outcome)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	res, err = j.AwaitMDMCommand(ctx, "pending", 5*time.Millisecond)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.CommandTimedOut, res.Outcome)
+	assert.Equal(t, pro.MDMCommandStatePending, res.Status.CommandState)
+
+	_, err = j.AwaitMDMCommand(context.Background(), "unknown", time.Millisecond)
+	assert.True(t, pro.IsNotFound(err))
+
+	// a non-positive interval polls every DefaultCommandPollInterval instead of continuously
+	polls["pending"] = 0
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	res, err = j.AwaitMDMCommand(ctx, "pending", 0)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.CommandTimedOut, res.Outcome)
+	assert.Equal(t, 1, res.Polls)
+}
@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const mobileDevicesContext = "mobile-devices"
+
+// MobileDevices returns a single page of mobile devices ordered by opts. The devices of every site are
+// returned as the list does not report their site, use MobileDevicesInventory on a client scoped with
+// WithSite to list the devices of a site.
+func (j *Client) MobileDevices(ctx context.Context, opts *ListOptions) (*Results[MobileDevice], error) {
+	ep := j.endpoint(2, mobileDevicesContext)
+	res, err := listPage[MobileDevice](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile devices from %s", ep)
+	}
+	return res, nil
+}
+
+// AllMobileDevices returns every mobile device, requesting each page in turn. The devices of every site
+// are returned, see MobileDevices.
+func (j *Client) AllMobileDevices(ctx context.Context, opts *ListOptions) ([]MobileDevice, error) {
+	ep := j.endpoint(2, mobileDevicesContext)
+	res, err := listAll[MobileDevice](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all mobile devices from %s", ep)
+	}
+	return res, nil
+}
+
+// IterMobileDevices yields every mobile device matching opts, requesting each page once the previous one
+// has been consumed. An error ends the iteration. The devices of every site are yielded, see MobileDevices.
+func (j *Client) IterMobileDevices(ctx context.Context, opts *ListOptions) iter.Seq2[MobileDevice, error] {
+	return iterAll[MobileDevice](ctx, j, j.endpoint(2, mobileDevicesContext), opts, nil)
+}
+
+// MobileDevicesInventory returns a single page of mobile device inventory matching opts, use the RSQL
+// filter and sort options to have Jamf narrow down results server side. Only the GENERAL section is
+// returned unless other sections are requested.
+func (j *Client) MobileDevicesInventory(ctx context.Context, opts *ListOptions, sections ...MobileDeviceSection) (*Results[MobileDeviceInventory], error) {
+	opts = j.siteScoped(opts, F("general.siteId"))
+	ep := j.endpoint(2, fmt.Sprintf("%s/detail", mobileDevicesContext))
+	params := opts.values()
+	for key, values := range sectionValues(sections) {
+		params[key] = values
+	}
+	res, err := listPage[MobileDeviceInventory](ctx, j, ep, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile devices inventory from %s", ep)
+	}
+	return res, nil
+}
+
+// AllMobileDevicesInventory returns the inventory of every mobile device matching opts, requesting each page in turn
+func (j *Client) AllMobileDevicesInventory(ctx context.Context, opts *ListOptions, sections ...MobileDeviceSection) ([]MobileDeviceInventory, error) {
+	opts = j.siteScoped(opts, F("general.siteId"))
+	ep := j.endpoint(2, fmt.Sprintf("%s/detail", mobileDevicesContext))
+	res, err := listAll[MobileDeviceInventory](ctx, j, ep, opts, sectionValues(sections))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all mobile devices inventory from %s", ep)
+	}
+	return res, nil
+}
+
+// IterMobileDevicesInventory yields the inventory of every mobile device matching opts, requesting each
+// page once the previous one has been consumed. An error ends the iteration.
+func (j *Client) IterMobileDevicesInventory(ctx context.Context, opts *ListOptions, sections ...MobileDeviceSection) iter.Seq2[MobileDeviceInventory, error] {
+	opts = j.siteScoped(opts, F("general.siteId"))
+	return iterAll[MobileDeviceInventory](ctx, j, j.endpoint(2, fmt.Sprintf("%s/detail", mobileDevicesContext)), opts, sectionValues(sections))
+}
+
+// MobileDeviceDetails returns the inventory for a specific mobile device given its ID, limited to
+// the requested sections
+func (j *Client) MobileDeviceDetails(ctx context.Context, id string, sections ...MobileDeviceSection) (*MobileDeviceInventory, error) {
+	page, err := j.MobileDevicesInventory(ctx, &ListOptions{PageSize: 1, Filter: fmt.Sprintf("mobileDeviceId==%q", id)}, sections...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device with ID %s", id)
+	}
+	if len(page.Results) == 0 {
+		return nil, &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("mobile device with ID %s not found", id)}
+	}
+	return &page.Results[0], nil
+}
+
+// UpdateMobileDevice updates a specific mobile device given its ID, e.g. its name, asset tag, user and
+// location, or extension attribute values
+func (j *Client) UpdateMobileDevice(ctx context.Context, id string, patch *MobileDeviceUpdate) (*MobileDevice, error) {
+	if patch == nil {
+		return nil, fmt.Errorf("empty payload for mobile device update: %s", id)
+	}
+
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", mobileDevicesContext, url.PathEscape(id)))
+	res := &MobileDevice{}
+	if err := j.do(ctx, "PATCH", ep, patch, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device with ID %s (%s)", id, ep)
+	}
+	return res, nil
+}
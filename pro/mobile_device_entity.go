@@ -0,0 +1,233 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// MobileDeviceSection is a section of a mobile device's inventory which can be requested
+type MobileDeviceSection string
+
+// Sections of a mobile device's inventory, Jamf only returns GENERAL when no section is requested
+const (
+	MobileDeviceSectionGeneral              MobileDeviceSection = "GENERAL"
+	MobileDeviceSectionHardware             MobileDeviceSection = "HARDWARE"
+	MobileDeviceSectionUserAndLocation      MobileDeviceSection = "USER_AND_LOCATION"
+	MobileDeviceSectionPurchasing           MobileDeviceSection = "PURCHASING"
+	MobileDeviceSectionSecurity             MobileDeviceSection = "SECURITY"
+	MobileDeviceSectionApplications         MobileDeviceSection = "APPLICATIONS"
+	MobileDeviceSectionEbooks               MobileDeviceSection = "EBOOKS"
+	MobileDeviceSectionNetwork              MobileDeviceSection = "NETWORK"
+	MobileDeviceSectionServiceSubscriptions MobileDeviceSection = "SERVICE_SUBSCRIPTIONS"
+	MobileDeviceSectionCertificates         MobileDeviceSection = "CERTIFICATES"
+	MobileDeviceSectionProfiles             MobileDeviceSection = "PROFILES"
+	MobileDeviceSectionUserProfiles         MobileDeviceSection = "USER_PROFILES"
+	MobileDeviceSectionProvisioningProfiles MobileDeviceSection = "PROVISIONING_PROFILES"
+	MobileDeviceSectionSharedUsers          MobileDeviceSection = "SHARED_USERS"
+	MobileDeviceSectionExtensionAttributes  MobileDeviceSection = "EXTENSION_ATTRIBUTES"
+)
+
+// MobileDevice holds the basic information for a mobile device returned by the mobile-devices list
+type MobileDevice struct {
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	SerialNumber           string `json:"serialNumber,omitempty"`
+	WifiMACAddress         string `json:"wifiMacAddress,omitempty"`
+	UDID                   string `json:"udid,omitempty"`
+	PhoneNumber            string `json:"phoneNumber,omitempty"`
+	Model                  string `json:"model,omitempty"`
+	ModelIdentifier        string `json:"modelIdentifier,omitempty"`
+	Username               string `json:"username,omitempty"`
+	Type                   string `json:"type,omitempty"`
+	ManagementID           string `json:"managementId,omitempty"`
+	SoftwareUpdateDeviceID string `json:"softwareUpdateDeviceId,omitempty"`
+}
+
+// MobileDeviceInventory represents a mobile device returned by the mobile-devices detail endpoint,
+// only the sections which were requested are populated
+type MobileDeviceInventory struct {
+	MobileDeviceID      string                       `json:"mobileDeviceId"`
+	DeviceType          string                       `json:"deviceType,omitempty"`
+	General             *MobileDeviceGeneral         `json:"general,omitempty"`
+	Hardware            *MobileDeviceHardware        `json:"hardware,omitempty"`
+	UserAndLocation     *MobileDeviceUserAndLocation `json:"userAndLocation,omitempty"`
+	Purchasing          *MobileDevicePurchasing      `json:"purchasing,omitempty"`
+	Security            *MobileDeviceSecurity        `json:"security,omitempty"`
+	Applications        []MobileDeviceApplication    `json:"applications,omitempty"`
+	Network             *MobileDeviceNetwork         `json:"network,omitempty"`
+	Certificates        []MobileDeviceCertificate    `json:"certificates,omitempty"`
+	Profiles            []MobileDeviceProfile        `json:"profiles,omitempty"`
+	SharedUsers         []MobileDeviceSharedUser     `json:"sharedUsers,omitempty"`
+	ExtensionAttributes []ExtensionAttribute         `json:"extensionAttributes,omitempty"`
+}
+
+// MobileDeviceGeneral holds the GENERAL section of a mobile device's inventory
+type MobileDeviceGeneral struct {
+	UDID                               string               `json:"udid,omitempty"`
+	DisplayName                        string               `json:"displayName"`
+	AssetTag                           string               `json:"assetTag,omitempty"`
+	SiteID                             string               `json:"siteId,omitempty"`
+	LastInventoryUpdateDate            string               `json:"lastInventoryUpdateDate,omitempty"`
+	OSVersion                          string               `json:"osVersion,omitempty"`
+	OSRapidSecurityResponse            string               `json:"osRapidSecurityResponse,omitempty"`
+	OSBuild                            string               `json:"osBuild,omitempty"`
+	OSSupplementalBuildVersion         string               `json:"osSupplementalBuildVersion,omitempty"`
+	SoftwareUpdateDeviceID             string               `json:"softwareUpdateDeviceId,omitempty"`
+	IPAddress                          string               `json:"ipAddress,omitempty"`
+	Managed                            bool                 `json:"managed"`
+	Supervised                         bool                 `json:"supervised"`
+	DeviceOwnershipType                string               `json:"deviceOwnershipType,omitempty"`
+	EnrollmentMethodPrestage           *EnrollmentMethod    `json:"enrollmentMethodPrestage,omitempty"`
+	EnrollmentSessionTokenValid        bool                 `json:"enrollmentSessionTokenValid"`
+	LastEnrolledDate                   string               `json:"lastEnrolledDate,omitempty"`
+	MDMProfileExpirationDate           string               `json:"mdmProfileExpirationDate,omitempty"`
+	TimeZone                           string               `json:"timeZone,omitempty"`
+	DeclarativeDeviceManagementEnabled bool                 `json:"declarativeDeviceManagementEnabled"`
+	ManagementID                       string               `json:"managementId,omitempty"`
+	SharedIpad                         bool                 `json:"sharedIpad"`
+	DiagnosticAndUsageReportingEnabled bool                 `json:"diagnosticAndUsageReportingEnabled"`
+	AppAnalyticsEnabled                bool                 `json:"appAnalyticsEnabled"`
+	ResidentUsers                      int                  `json:"residentUsers,omitempty"`
+	QuotaSize                          int                  `json:"quotaSize,omitempty"`
+	TemporarySessionOnly               bool                 `json:"temporarySessionOnly"`
+	LastCloudBackupDate                string               `json:"lastCloudBackupDate,omitempty"`
+	DeviceLocatorServiceEnabled        bool                 `json:"deviceLocatorServiceEnabled"`
+	DoNotDisturbEnabled                bool                 `json:"doNotDisturbEnabled"`
+	ITunesStoreAccountActive           bool                 `json:"itunesStoreAccountActive"`
+	ExchangeDeviceID                   string               `json:"exchangeDeviceId,omitempty"`
+	Tethered                           bool                 `json:"tethered"`
+	ExtensionAttributes                []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// MobileDeviceHardware holds the HARDWARE section of a mobile device's inventory
+type MobileDeviceHardware struct {
+	CapacityMb                int                  `json:"capacityMb,omitempty"`
+	AvailableSpaceMb          int                  `json:"availableSpaceMb,omitempty"`
+	UsedSpacePercentage       int                  `json:"usedSpacePercentage,omitempty"`
+	BatteryLevel              int                  `json:"batteryLevel,omitempty"`
+	BatteryHealth             string               `json:"batteryHealth,omitempty"`
+	SerialNumber              string               `json:"serialNumber,omitempty"`
+	WifiMACAddress            string               `json:"wifiMacAddress,omitempty"`
+	BluetoothMACAddress       string               `json:"bluetoothMacAddress,omitempty"`
+	ModemFirmwareVersion      string               `json:"modemFirmwareVersion,omitempty"`
+	Model                     string               `json:"model,omitempty"`
+	ModelIdentifier           string               `json:"modelIdentifier,omitempty"`
+	ModelNumber               string               `json:"modelNumber,omitempty"`
+	BluetoothLowEnergyCapable bool                 `json:"bluetoothLowEnergyCapable"`
+	DeviceID                  string               `json:"deviceId,omitempty"`
+	ExtensionAttributes       []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// MobileDeviceUserAndLocation holds the USER_AND_LOCATION section of a mobile device's inventory
+type MobileDeviceUserAndLocation struct {
+	Username            string               `json:"username,omitempty"`
+	RealName            string               `json:"realName,omitempty"`
+	EmailAddress        string               `json:"emailAddress,omitempty"`
+	Position            string               `json:"position,omitempty"`
+	PhoneNumber         string               `json:"phoneNumber,omitempty"`
+	DepartmentID        string               `json:"departmentId,omitempty"`
+	BuildingID          string               `json:"buildingId,omitempty"`
+	Room                string               `json:"room,omitempty"`
+	Building            string               `json:"building,omitempty"`
+	Department          string               `json:"department,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// MobileDevicePurchasing holds the PURCHASING section of a mobile device's inventory
+type MobileDevicePurchasing struct {
+	Purchased           bool                 `json:"purchased"`
+	Leased              bool                 `json:"leased"`
+	PONumber            string               `json:"poNumber,omitempty"`
+	Vendor              string               `json:"vendor,omitempty"`
+	AppleCareID         string               `json:"appleCareId,omitempty"`
+	PurchasePrice       string               `json:"purchasePrice,omitempty"`
+	PurchasingAccount   string               `json:"purchasingAccount,omitempty"`
+	PODate              string               `json:"poDate,omitempty"`
+	WarrantyExpiresDate string               `json:"warrantyExpiresDate,omitempty"`
+	LeaseExpiresDate    string               `json:"leaseExpiresDate,omitempty"`
+	LifeExpectancy      int                  `json:"lifeExpectancy,omitempty"`
+	PurchasingContact   string               `json:"purchasingContact,omitempty"`
+	ExtensionAttributes []ExtensionAttribute `json:"extensionAttributes,omitempty"`
+}
+
+// MobileDeviceSecurity holds the SECURITY section of a mobile device's inventory
+type MobileDeviceSecurity struct {
+	DataProtected                          bool   `json:"dataProtected"`
+	BlockLevelEncryptionCapable            bool   `json:"blockLevelEncryptionCapable"`
+	FileLevelEncryptionCapable             bool   `json:"fileLevelEncryptionCapable"`
+	PasscodePresent                        bool   `json:"passcodePresent"`
+	PasscodeCompliant                      bool   `json:"passcodeCompliant"`
+	PasscodeCompliantWithProfile           bool   `json:"passcodeCompliantWithProfile"`
+	HardwareEncryption                     int    `json:"hardwareEncryption,omitempty"`
+	ActivationLockEnabled                  bool   `json:"activationLockEnabled"`
+	JailBreakDetected                      bool   `json:"jailBreakDetected"`
+	PasscodeLockGracePeriodEnforcedSeconds int    `json:"passcodeLockGracePeriodEnforcedSeconds,omitempty"`
+	PersonalDeviceProfileCurrent           bool   `json:"personalDeviceProfileCurrent"`
+	LostModeEnabled                        bool   `json:"lostModeEnabled"`
+	LostModePersistent                     bool   `json:"lostModePersistent"`
+	LostModeMessage                        string `json:"lostModeMessage,omitempty"`
+	LostModePhoneNumber                    string `json:"lostModePhoneNumber,omitempty"`
+	LostModeFootnote                       string `json:"lostModeFootnote,omitempty"`
+}
+
+// MobileDeviceApplication holds an application installed on a mobile device
+type MobileDeviceApplication struct {
+	Identifier       string `json:"identifier"`
+	Name             string `json:"name,omitempty"`
+	Version          string `json:"version,omitempty"`
+	ShortVersion     string `json:"shortVersion,omitempty"`
+	ManagementStatus string `json:"managementStatus,omitempty"`
+	ValidationStatus bool   `json:"validationStatus"`
+	BundleSize       string `json:"bundleSize,omitempty"`
+	DynamicSize      string `json:"dynamicSize,omitempty"`
+}
+
+// MobileDeviceNetwork holds the NETWORK section of a mobile device's inventory
+type MobileDeviceNetwork struct {
+	CellularTechnology     string `json:"cellularTechnology,omitempty"`
+	VoiceRoamingEnabled    bool   `json:"voiceRoamingEnabled"`
+	IMEI                   string `json:"imei,omitempty"`
+	ICCID                  string `json:"iccid,omitempty"`
+	MEID                   string `json:"meid,omitempty"`
+	EID                    string `json:"eid,omitempty"`
+	CarrierSettingsVersion string `json:"carrierSettingsVersion,omitempty"`
+	CurrentCarrierNetwork  string `json:"currentCarrierNetwork,omitempty"`
+	HomeCarrierNetwork     string `json:"homeCarrierNetwork,omitempty"`
+	DataRoamingEnabled     bool   `json:"dataRoamingEnabled"`
+	Roaming                bool   `json:"roaming"`
+	PersonalHotspotEnabled bool   `json:"personalHotspotEnabled"`
+	PhoneNumber            string `json:"phoneNumber,omitempty"`
+}
+
+// MobileDeviceCertificate holds a certificate installed on a mobile device
+type MobileDeviceCertificate struct {
+	CommonName     string `json:"commonName,omitempty"`
+	Identity       bool   `json:"identity"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+// MobileDeviceProfile holds a configuration profile installed on a mobile device
+type MobileDeviceProfile struct {
+	DisplayName   string `json:"displayName"`
+	Version       string `json:"version,omitempty"`
+	UUID          string `json:"uuid,omitempty"`
+	Identifier    string `json:"identifier,omitempty"`
+	Removable     bool   `json:"removable"`
+	LastInstalled string `json:"lastInstalled,omitempty"`
+}
+
+// MobileDeviceSharedUser holds a user of a Shared iPad
+type MobileDeviceSharedUser struct {
+	ManagedAppleID string `json:"managedAppleId"`
+	LoggedIn       bool   `json:"loggedIn"`
+	DataToSync     bool   `json:"dataToSync"`
+}
+
+// MobileDeviceUpdate holds the fields to change on a mobile device, only fields which are set are
+// sent so anything left empty is unchanged
+type MobileDeviceUpdate struct {
+	Name                       string                       `json:"name,omitempty"`
+	EnforceName                bool                         `json:"enforceName,omitempty"`
+	AssetTag                   string                       `json:"assetTag,omitempty"`
+	SiteID                     string                       `json:"siteId,omitempty"`
+	TimeZone                   string                       `json:"timeZone,omitempty"`
+	Location                   *MobileDeviceUserAndLocation `json:"location,omitempty"`
+	UpdatedExtensionAttributes []ExtensionAttribute         `json:"updatedExtensionAttributes,omitempty"`
+}
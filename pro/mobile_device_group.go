@@ -0,0 +1,244 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	mobileDeviceGroupsContext       = "mobile-device-groups"
+	smartMobileDeviceGroupsContext  = mobileDeviceGroupsContext + "/smart-groups"
+	staticMobileDeviceGroupsContext = mobileDeviceGroupsContext + "/static-groups"
+)
+
+// MobileDeviceGroups returns every smart and static mobile device group with its ID and name, from the client's Cache
+// when set
+func (j *Client) MobileDeviceGroups(ctx context.Context) ([]MobileDeviceGroup, error) {
+	return cachedList(ctx, j, CacheMobileDeviceGroups, nil, func(ctx context.Context, _ *ListOptions) ([]MobileDeviceGroup, error) {
+		return j.mobileDeviceGroups(ctx)
+	})
+}
+
+func (j *Client) mobileDeviceGroups(ctx context.Context) ([]MobileDeviceGroup, error) {
+	ep := j.endpoint(1, mobileDeviceGroupsContext)
+	res := []MobileDeviceGroup{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device groups from %s", ep)
+	}
+	return res, nil
+}
+
+// SmartMobileDeviceGroups returns a single page of smart mobile device groups matching opts
+func (j *Client) SmartMobileDeviceGroups(ctx context.Context, opts *ListOptions) (*Results[SmartMobileDeviceGroup], error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(1, smartMobileDeviceGroupsContext)
+	res, err := listPage[SmartMobileDeviceGroup](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query smart mobile device groups from %s", ep)
+	}
+	return res, nil
+}
+
+// AllSmartMobileDeviceGroups returns every smart mobile device group matching opts, requesting each page in turn
+func (j *Client) AllSmartMobileDeviceGroups(ctx context.Context, opts *ListOptions) ([]SmartMobileDeviceGroup, error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(1, smartMobileDeviceGroupsContext)
+	res, err := listAll[SmartMobileDeviceGroup](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all smart mobile device groups from %s", ep)
+	}
+	return res, nil
+}
+
+// SmartMobileDeviceGroupDetails returns the details for a specific smart mobile device group given its ID
+func (j *Client) SmartMobileDeviceGroupDetails(ctx context.Context, id string) (*SmartMobileDeviceGroup, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", smartMobileDeviceGroupsContext, url.PathEscape(id)))
+	res := &SmartMobileDeviceGroup{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query smart mobile device group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateSmartMobileDeviceGroup will create a new smart mobile device group in Jamf
+func (j *Client) CreateSmartMobileDeviceGroup(ctx context.Context, content *SmartMobileDeviceGroup) (*CreatedResource, error) {
+	ep := j.endpoint(1, smartMobileDeviceGroupsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for smart mobile device group: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *SmartMobileDeviceGroup) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new smart mobile device group"), "unable to process JAMF creation request for smart mobile device group: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for smart mobile device group %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return res, nil
+}
+
+// UpdateSmartMobileDeviceGroup will replace a smart mobile device group in Jamf given its ID
+func (j *Client) UpdateSmartMobileDeviceGroup(ctx context.Context, id string, content *SmartMobileDeviceGroup) (*SmartMobileDeviceGroup, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", smartMobileDeviceGroupsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for smart mobile device group: %s (%s)", id, ep)
+	}
+
+	res := &SmartMobileDeviceGroup{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for smart mobile device group: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return res, nil
+}
+
+// DeleteSmartMobileDeviceGroup will delete a smart mobile device group given its ID
+func (j *Client) DeleteSmartMobileDeviceGroup(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", smartMobileDeviceGroupsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for smart mobile device group %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return nil
+}
+
+// SmartMobileDeviceGroupMembers returns the mobile devices currently in a smart mobile device group
+func (j *Client) SmartMobileDeviceGroupMembers(ctx context.Context, id string) (MobileDeviceGroupMemberList, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/smart-group-membership/%s", mobileDeviceGroupsContext, url.PathEscape(id)))
+	res, err := listAll[MobileDeviceGroupMember](ctx, j, ep, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query members of smart mobile device group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// StaticMobileDeviceGroups returns a single page of static mobile device groups matching opts
+func (j *Client) StaticMobileDeviceGroups(ctx context.Context, opts *ListOptions) (*Results[StaticMobileDeviceGroup], error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(1, staticMobileDeviceGroupsContext)
+	res, err := listPage[StaticMobileDeviceGroup](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query static mobile device groups from %s", ep)
+	}
+	return res, nil
+}
+
+// AllStaticMobileDeviceGroups returns every static mobile device group matching opts, requesting each page in turn
+func (j *Client) AllStaticMobileDeviceGroups(ctx context.Context, opts *ListOptions) ([]StaticMobileDeviceGroup, error) {
+	opts = j.siteScoped(opts, F("siteId"))
+	ep := j.endpoint(1, staticMobileDeviceGroupsContext)
+	res, err := listAll[StaticMobileDeviceGroup](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all static mobile device groups from %s", ep)
+	}
+	return res, nil
+}
+
+// StaticMobileDeviceGroupDetails returns the details for a specific static mobile device group given its ID
+func (j *Client) StaticMobileDeviceGroupDetails(ctx context.Context, id string) (*StaticMobileDeviceGroup, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", staticMobileDeviceGroupsContext, url.PathEscape(id)))
+	res := &StaticMobileDeviceGroup{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query static mobile device group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateStaticMobileDeviceGroup will create a new static mobile device group in Jamf
+func (j *Client) CreateStaticMobileDeviceGroup(ctx context.Context, content *StaticMobileDeviceGroup) (*CreatedResource, error) {
+	ep := j.endpoint(1, staticMobileDeviceGroupsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for static mobile device group: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *StaticMobileDeviceGroup) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new static mobile device group"), "unable to process JAMF creation request for static mobile device group: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for static mobile device group %s on %s", content.Name, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return res, nil
+}
+
+// UpdateStaticMobileDeviceGroup will update a static mobile device group in Jamf given its ID, only the
+// fields set on content are changed and only the devices listed in its assignments are added or removed
+func (j *Client) UpdateStaticMobileDeviceGroup(ctx context.Context, id string, content *StaticMobileDeviceGroup) (*StaticMobileDeviceGroup, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", staticMobileDeviceGroupsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for static mobile device group: %s (%s)", id, ep)
+	}
+
+	res := &StaticMobileDeviceGroup{}
+	if err := j.do(ctx, "PATCH", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for static mobile device group: %s (%s)", id, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return res, nil
+}
+
+// AddStaticMobileDeviceGroupMembers will assign mobile devices to a static mobile device group given their IDs
+func (j *Client) AddStaticMobileDeviceGroupMembers(ctx context.Context, id string, deviceIDs ...string) (*StaticMobileDeviceGroup, error) {
+	return j.UpdateStaticMobileDeviceGroup(ctx, id, &StaticMobileDeviceGroup{Assignments: staticMobileDeviceGroupAssignments(true, deviceIDs)})
+}
+
+// RemoveStaticMobileDeviceGroupMembers will unassign mobile devices from a static mobile device group given their IDs
+func (j *Client) RemoveStaticMobileDeviceGroupMembers(ctx context.Context, id string, deviceIDs ...string) (*StaticMobileDeviceGroup, error) {
+	return j.UpdateStaticMobileDeviceGroup(ctx, id, &StaticMobileDeviceGroup{Assignments: staticMobileDeviceGroupAssignments(false, deviceIDs)})
+}
+
+// DeleteStaticMobileDeviceGroup will delete a static mobile device group given its ID
+func (j *Client) DeleteStaticMobileDeviceGroup(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", staticMobileDeviceGroupsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for static mobile device group %s from %s", id, ep)
+	}
+	j.Cache.Invalidate(CacheMobileDeviceGroups)
+	return nil
+}
+
+// StaticMobileDeviceGroupMembers returns the mobile devices assigned to a static mobile device group
+func (j *Client) StaticMobileDeviceGroupMembers(ctx context.Context, id string) (MobileDeviceGroupMemberList, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/static-group-membership/%s", mobileDeviceGroupsContext, url.PathEscape(id)))
+	res, err := listAll[MobileDeviceGroupMember](ctx, j, ep, nil, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query members of static mobile device group with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// MobileDeviceInGroup returns true if a mobile device is a member of group, the smart or static
+// membership lookup is used depending on the kind of group
+func (j *Client) MobileDeviceInGroup(ctx context.Context, group MobileDeviceGroup, deviceID string) (bool, error) {
+	lookup := j.StaticMobileDeviceGroupMembers
+	if group.SmartGroup {
+		lookup = j.SmartMobileDeviceGroupMembers
+	}
+	members, err := lookup(ctx, group.ID)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if member.MobileDeviceID == deviceID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func staticMobileDeviceGroupAssignments(selected bool, deviceIDs []string) []StaticMobileDeviceGroupAssignment {
+	assignments := make([]StaticMobileDeviceGroupAssignment, 0, len(deviceIDs))
+	for _, id := range deviceIDs {
+		assignments = append(assignments, StaticMobileDeviceGroupAssignment{MobileDeviceID: id, Selected: selected})
+	}
+	return assignments
+}
@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// MobileDeviceGroup is the summary of a smart or static mobile device group
+type MobileDeviceGroup struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	SmartGroup bool   `json:"isSmartGroup"`
+}
+
+// SmartMobileDeviceGroup represents a mobile device group whose membership is computed from criteria
+type SmartMobileDeviceGroup struct {
+	ID          string                            `json:"groupId,omitempty"`
+	Name        string                            `json:"groupName"`
+	Description string                            `json:"groupDescription,omitempty"`
+	Criteria    []SmartMobileDeviceGroupCriterion `json:"criteria"`
+	SiteID      string                            `json:"siteId,omitempty"`
+	Count       int                               `json:"count,omitempty"`
+}
+
+// SmartMobileDeviceGroupCriterion is a single criterion of a smart mobile device group, AndOr joins it
+// to the previous criterion and SearchType is an operator such as "is", "like" or "greater than"
+type SmartMobileDeviceGroupCriterion struct {
+	Name         string `json:"name"`
+	Priority     int    `json:"priority"`
+	AndOr        string `json:"andOr"`
+	SearchType   string `json:"searchType"`
+	Value        string `json:"value"`
+	OpeningParen bool   `json:"openingParen"`
+	ClosingParen bool   `json:"closingParen"`
+}
+
+// StaticMobileDeviceGroup represents a mobile device group whose members are assigned by ID
+type StaticMobileDeviceGroup struct {
+	ID          string                              `json:"groupId,omitempty"`
+	Name        string                              `json:"groupName,omitempty"`
+	Description string                              `json:"groupDescription,omitempty"`
+	SiteID      string                              `json:"siteId,omitempty"`
+	Count       int                                 `json:"count,omitempty"`
+	Assignments []StaticMobileDeviceGroupAssignment `json:"assignments,omitempty"`
+}
+
+// StaticMobileDeviceGroupAssignment adds a mobile device to a static group when Selected is true
+// and removes it otherwise
+type StaticMobileDeviceGroupAssignment struct {
+	MobileDeviceID string `json:"mobileDeviceId"`
+	Selected       bool   `json:"selected"`
+}
+
+// MobileDeviceGroupMemberList is the list of mobile devices in a smart or static mobile device group, see Export
+type MobileDeviceGroupMemberList []MobileDeviceGroupMember
+
+// MobileDeviceGroupMember is a mobile device belonging to a smart or static mobile device group
+type MobileDeviceGroupMember struct {
+	MobileDeviceID string `json:"mobileDeviceId"`
+	Name           string `json:"name"`
+	SerialNumber   string `json:"serialNumber"`
+	UDID           string `json:"udid"`
+}
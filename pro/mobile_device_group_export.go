@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"io"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+)
+
+// Columns available to export a MobileDeviceGroupMemberList
+var (
+	MobileDeviceGroupMemberColumnID = export.Column[MobileDeviceGroupMember]{Header: "Mobile Device ID", Value: func(m MobileDeviceGroupMember) string {
+		return m.MobileDeviceID
+	}}
+	MobileDeviceGroupMemberColumnName = export.Column[MobileDeviceGroupMember]{Header: "Name", Value: func(m MobileDeviceGroupMember) string {
+		return m.Name
+	}}
+	MobileDeviceGroupMemberColumnSerialNumber = export.Column[MobileDeviceGroupMember]{Header: "Serial Number", Value: func(m MobileDeviceGroupMember) string {
+		return m.SerialNumber
+	}}
+	MobileDeviceGroupMemberColumnUDID = export.Column[MobileDeviceGroupMember]{Header: "UDID", Value: func(m MobileDeviceGroupMember) string {
+		return m.UDID
+	}}
+)
+
+// DefaultMobileDeviceGroupMemberColumns are the columns exported when none are given to MobileDeviceGroupMemberList.Export
+var DefaultMobileDeviceGroupMemberColumns = []export.Column[MobileDeviceGroupMember]{
+	MobileDeviceGroupMemberColumnID,
+	MobileDeviceGroupMemberColumnName,
+	MobileDeviceGroupMemberColumnSerialNumber,
+	MobileDeviceGroupMemberColumnUDID,
+}
+
+// Export writes the group members to w as CSV or NDJSON, DefaultMobileDeviceGroupMemberColumns are
+// used without columns
+func (l MobileDeviceGroupMemberList) Export(w io.Writer, format export.Format, columns ...export.Column[MobileDeviceGroupMember]) error {
+	if len(columns) == 0 {
+		columns = DefaultMobileDeviceGroupMemberColumns
+	}
+	return export.Write(w, format, l, columns)
+}
@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/export"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportMobileDeviceGroupMembers(t *testing.T) {
+	testServer := mobileDeviceGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	members, err := j.SmartMobileDeviceGroupMembers(context.Background(), "2")
+	assert.Nil(t, err)
+
+	out := &bytes.Buffer{}
+	assert.Nil(t, members.Export(out, export.CSV))
+	assert.Equal(t, "Mobile Device ID,Name,Serial Number,UDID\n2,Library iPad,DMPX0000AAAA,\n", out.String())
+
+	out.Reset()
+	assert.Nil(t, members.Export(out, export.NDJSON, pro.MobileDeviceGroupMemberColumnSerialNumber))
+	assert.Equal(t, "{\"Serial Number\":\"DMPX0000AAAA\"}\n", out.String())
+}
@@ -0,0 +1,212 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT = "/api/v1/mobile-device-groups"
+var SMART_MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT = "/api/v1/mobile-device-groups/smart-groups"
+var STATIC_MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT = "/api/v1/mobile-device-groups/static-groups"
+
+func mobileDeviceGroupsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	smart := &crudMock[pro.SmartMobileDeviceGroup]{
+		t:      t,
+		base:   SMART_MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.SmartMobileDeviceGroup{
+			{ID: "1", Name: "All Managed iPads", Count: 3},
+			{ID: "2", Name: "iPadOS 17", Criteria: []pro.SmartMobileDeviceGroupCriterion{
+				{Name: "OS Version", Priority: 0, AndOr: "and", SearchType: "like", Value: "17."},
+			}, Count: 1},
+		},
+		getID: func(x pro.SmartMobileDeviceGroup) string { return x.ID },
+		setID: func(x *pro.SmartMobileDeviceGroup, id string) { x.ID = id },
+	}
+	static := &crudMock[pro.StaticMobileDeviceGroup]{
+		t:      t,
+		base:   STATIC_MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT,
+		nextID: 3,
+		items: []pro.StaticMobileDeviceGroup{
+			{ID: "3", Name: "Cart A", Count: 2, Assignments: []pro.StaticMobileDeviceGroupAssignment{
+				{MobileDeviceID: "4", Selected: true},
+				{MobileDeviceID: "5", Selected: true},
+			}},
+		},
+		getID: func(x pro.StaticMobileDeviceGroup) string { return x.ID },
+		setID: func(x *pro.StaticMobileDeviceGroup, id string) { x.ID = id },
+	}
+	mux.Handle(smart.base, smart)
+	mux.Handle(smart.base+"/", smart)
+	mux.Handle(static.base, static)
+	mux.HandleFunc(static.base+"/", func(w http.ResponseWriter, r *http.Request) {
+		i := static.find(strings.TrimPrefix(r.URL.Path, static.base+"/"))
+		if r.Method != "PATCH" || i < 0 {
+			static.ServeHTTP(w, r)
+			return
+		}
+		// assignments in a patch only add or remove the devices they list
+		patch := &pro.StaticMobileDeviceGroup{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(patch))
+		group := &static.items[i]
+		for _, assignment := range patch.Assignments {
+			kept := []pro.StaticMobileDeviceGroupAssignment{}
+			for _, existing := range group.Assignments {
+				if existing.MobileDeviceID != assignment.MobileDeviceID {
+					kept = append(kept, existing)
+				}
+			}
+			if assignment.Selected {
+				kept = append(kept, assignment)
+			}
+			group.Assignments = kept
+		}
+		group.Count = len(group.Assignments)
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(group))
+	})
+	mux.HandleFunc(MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		groups := []pro.MobileDeviceGroup{}
+		for _, group := range smart.items {
+			groups = append(groups, pro.MobileDeviceGroup{ID: group.ID, Name: group.Name, SmartGroup: true})
+		}
+		for _, group := range static.items {
+			groups = append(groups, pro.MobileDeviceGroup{ID: group.ID, Name: group.Name})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(groups))
+	})
+	mux.HandleFunc(MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT+"/smart-group-membership/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, []pro.MobileDeviceGroupMember{
+			{MobileDeviceID: "2", Name: "Library iPad", SerialNumber: "DMPX0000AAAA"},
+		})))
+	})
+	mux.HandleFunc(MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT+"/static-group-membership/", func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, MOBILE_DEVICE_GROUPS_API_BASE_ENDPOINT+"/static-group-membership/")
+		members := []pro.MobileDeviceGroupMember{}
+		for _, group := range static.items {
+			if group.ID == id {
+				for _, assignment := range group.Assignments {
+					members = append(members, pro.MobileDeviceGroupMember{MobileDeviceID: assignment.MobileDeviceID})
+				}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, members)))
+	})
+	return httptest.NewServer(mux)
+}
+
+func mobileDeviceIDs(members []pro.MobileDeviceGroupMember) []string {
+	ids := []string{}
+	for _, member := range members {
+		ids = append(ids, member.MobileDeviceID)
+	}
+	return ids
+}
+
+func TestMobileDeviceGroups(t *testing.T) {
+	testServer := mobileDeviceGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	groups, err := j.MobileDeviceGroups(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, groups, 3)
+	assert.True(t, groups[1].SmartGroup)
+	assert.False(t, groups[2].SmartGroup)
+
+	inGroup, err := j.MobileDeviceInGroup(context.Background(), groups[1], "2")
+	assert.Nil(t, err)
+	assert.True(t, inGroup)
+	inGroup, err = j.MobileDeviceInGroup(context.Background(), groups[1], "4")
+	assert.Nil(t, err)
+	assert.False(t, inGroup)
+
+	inGroup, err = j.MobileDeviceInGroup(context.Background(), groups[2], "4")
+	assert.Nil(t, err)
+	assert.True(t, inGroup)
+}
+
+func TestSmartMobileDeviceGroups(t *testing.T) {
+	testServer := mobileDeviceGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	groups, err := j.AllSmartMobileDeviceGroups(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, groups, 2)
+
+	group, err := j.SmartMobileDeviceGroupDetails(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "OS Version", group.Criteria[0].Name)
+
+	_, err = j.CreateSmartMobileDeviceGroup(context.Background(), &pro.SmartMobileDeviceGroup{})
+	assert.Contains(t, err.Error(), "name required")
+	created, err := j.CreateSmartMobileDeviceGroup(context.Background(), &pro.SmartMobileDeviceGroup{Name: "iPadOS 18", Criteria: []pro.SmartMobileDeviceGroupCriterion{
+		{Name: "OS Version", AndOr: "and", SearchType: "like", Value: "18."},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+
+	group.Description = "iPads still on iPadOS 17"
+	updated, err := j.UpdateSmartMobileDeviceGroup(context.Background(), "2", group)
+	assert.Nil(t, err)
+	assert.Equal(t, "iPads still on iPadOS 17", updated.Description)
+
+	members, err := j.SmartMobileDeviceGroupMembers(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2"}, mobileDeviceIDs(members))
+	assert.Equal(t, "DMPX0000AAAA", members[0].SerialNumber)
+
+	assert.Nil(t, j.DeleteSmartMobileDeviceGroup(context.Background(), "3"))
+	_, err = j.SmartMobileDeviceGroupDetails(context.Background(), "3")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestStaticMobileDeviceGroups(t *testing.T) {
+	testServer := mobileDeviceGroupsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.StaticMobileDeviceGroups(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+
+	created, err := j.CreateStaticMobileDeviceGroup(context.Background(), &pro.StaticMobileDeviceGroup{Name: "Cart B", Assignments: []pro.StaticMobileDeviceGroupAssignment{
+		{MobileDeviceID: "1", Selected: true},
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, "4", created.ID)
+
+	group, err := j.AddStaticMobileDeviceGroupMembers(context.Background(), created.ID, "2", "3")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, group.Count)
+	assert.Equal(t, "Cart B", group.Name)
+
+	group, err = j.RemoveStaticMobileDeviceGroupMembers(context.Background(), created.ID, "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, group.Count)
+
+	members, err := j.StaticMobileDeviceGroupMembers(context.Background(), created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"2", "3"}, mobileDeviceIDs(members))
+
+	_, err = j.AddStaticMobileDeviceGroupMembers(context.Background(), "99", "1")
+	assert.True(t, pro.IsNotFound(err))
+
+	assert.Nil(t, j.DeleteStaticMobileDeviceGroup(context.Background(), created.ID))
+	_, err = j.StaticMobileDeviceGroupDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
@@ -0,0 +1,109 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const mobileDevicePrestagesContext = "mobile-device-prestages"
+
+// MobileDevicePrestages returns a single page of mobile device prestages matching opts
+func (j *Client) MobileDevicePrestages(ctx context.Context, opts *ListOptions) (*Results[MobileDevicePrestage], error) {
+	ep := j.endpoint(2, mobileDevicePrestagesContext)
+	res, err := listPage[MobileDevicePrestage](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device prestages from %s", ep)
+	}
+	return res, nil
+}
+
+// AllMobileDevicePrestages returns every mobile device prestage matching opts, requesting each page in turn
+func (j *Client) AllMobileDevicePrestages(ctx context.Context, opts *ListOptions) ([]MobileDevicePrestage, error) {
+	ep := j.endpoint(2, mobileDevicePrestagesContext)
+	res, err := listAll[MobileDevicePrestage](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all mobile device prestages from %s", ep)
+	}
+	return res, nil
+}
+
+// MobileDevicePrestageDetails returns the details for a specific mobile device prestage given its ID
+func (j *Client) MobileDevicePrestageDetails(ctx context.Context, id string) (*MobileDevicePrestage, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", mobileDevicePrestagesContext, url.PathEscape(id)))
+	res := &MobileDevicePrestage{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query mobile device prestage with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateMobileDevicePrestage will create a new mobile device prestage in Jamf
+func (j *Client) CreateMobileDevicePrestage(ctx context.Context, content *MobileDevicePrestage) (*CreatedResource, error) {
+	ep := j.endpoint(2, mobileDevicePrestagesContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for mobile device prestage: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *MobileDevicePrestage) *string { return &c.SiteID })
+	if content.DisplayName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name required for new mobile device prestage"), "unable to process JAMF creation request for mobile device prestage: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for mobile device prestage %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateMobileDevicePrestage will replace a mobile device prestage in Jamf given its ID
+func (j *Client) UpdateMobileDevicePrestage(ctx context.Context, id string, content *MobileDevicePrestage) (*MobileDevicePrestage, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", mobileDevicePrestagesContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for mobile device prestage: %s (%s)", id, ep)
+	}
+
+	res := &MobileDevicePrestage{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for mobile device prestage: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteMobileDevicePrestage will delete a mobile device prestage given its ID
+func (j *Client) DeleteMobileDevicePrestage(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", mobileDevicePrestagesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for mobile device prestage %s from %s", id, ep)
+	}
+	return nil
+}
+
+// MobileDevicePrestageScope returns the mobile devices assigned to a mobile device prestage given its ID
+func (j *Client) MobileDevicePrestageScope(ctx context.Context, id string) (*PrestageScope, error) {
+	return j.prestageScope(ctx, mobileDevicePrestagesContext, id)
+}
+
+// AllMobileDevicePrestageScopes returns the ID of the mobile device prestage each device is assigned to keyed by serial number
+func (j *Client) AllMobileDevicePrestageScopes(ctx context.Context) (map[string]string, error) {
+	return j.allPrestageScopes(ctx, mobileDevicePrestagesContext)
+}
+
+// AddMobileDevicePrestageScope assigns mobile devices, by serial number, to a mobile device prestage given its ID
+func (j *Client) AddMobileDevicePrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, mobileDevicePrestagesContext, id, "POST", "scope", serialNumbers)
+}
+
+// RemoveMobileDevicePrestageScope unassigns mobile devices, by serial number, from a mobile device prestage given its ID
+func (j *Client) RemoveMobileDevicePrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, mobileDevicePrestagesContext, id, "POST", "scope/delete-multiple", serialNumbers)
+}
+
+// ReplaceMobileDevicePrestageScope replaces every mobile device assigned to a mobile device prestage given its ID
+func (j *Client) ReplaceMobileDevicePrestageScope(ctx context.Context, id string, serialNumbers []string) (*PrestageScope, error) {
+	return j.changePrestageScope(ctx, mobileDevicePrestagesContext, id, "PUT", "scope", serialNumbers)
+}
@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// MobileDevicePrestage represents a mobile device PreStage enrollment, the settings applied to iOS, iPadOS
+// and tvOS devices enrolled through Automated Device Enrollment. Updates must include the current VersionLock.
+type MobileDevicePrestage struct {
+	ID                                  string                     `json:"id,omitempty"`
+	DisplayName                         string                     `json:"displayName"`
+	Mandatory                           bool                       `json:"mandatory"`
+	MDMRemovable                        bool                       `json:"mdmRemovable"`
+	SupportPhoneNumber                  string                     `json:"supportPhoneNumber,omitempty"`
+	SupportEmailAddress                 string                     `json:"supportEmailAddress,omitempty"`
+	Department                          string                     `json:"department,omitempty"`
+	DefaultPrestage                     bool                       `json:"defaultPrestage"`
+	EnrollmentSiteID                    string                     `json:"enrollmentSiteId,omitempty"`
+	KeepExistingSiteMembership          bool                       `json:"keepExistingSiteMembership"`
+	KeepExistingLocationInformation     bool                       `json:"keepExistingLocationInformation"`
+	RequireAuthentication               bool                       `json:"requireAuthentication"`
+	AuthenticationPrompt                string                     `json:"authenticationPrompt,omitempty"`
+	PreventActivationLock               bool                       `json:"preventActivationLock"`
+	EnableDeviceBasedActivationLock     bool                       `json:"enableDeviceBasedActivationLock"`
+	DeviceEnrollmentProgramInstanceID   string                     `json:"deviceEnrollmentProgramInstanceId"`
+	SkipSetupItems                      map[string]bool            `json:"skipSetupItems,omitempty"`
+	LocationInformation                 *PrestageLocation          `json:"locationInformation,omitempty"`
+	PurchasingInformation               *PrestagePurchasing        `json:"purchasingInformation,omitempty"`
+	AnchorCertificates                  []string                   `json:"anchorCertificates,omitempty"`
+	EnrollmentCustomizationID           string                     `json:"enrollmentCustomizationId,omitempty"`
+	Language                            string                     `json:"language,omitempty"`
+	Region                              string                     `json:"region,omitempty"`
+	AutoAdvanceSetup                    bool                       `json:"autoAdvanceSetup"`
+	AllowPairing                        bool                       `json:"allowPairing"`
+	MultiUser                           bool                       `json:"multiUser"`
+	Supervised                          bool                       `json:"supervised"`
+	MaximumSharedAccounts               int                        `json:"maximumSharedAccounts,omitempty"`
+	ConfigureDeviceBeforeSetupAssistant bool                       `json:"configureDeviceBeforeSetupAssistant"`
+	Names                               *MobileDevicePrestageNames `json:"names,omitempty"`
+	SendTimezone                        bool                       `json:"sendTimezone"`
+	Timezone                            string                     `json:"timezone,omitempty"`
+	StorageQuotaSizeMegabytes           int                        `json:"storageQuotaSizeMegabytes,omitempty"`
+	UseStorageQuotaSize                 bool                       `json:"useStorageQuotaSize"`
+	TemporarySessionOnly                bool                       `json:"temporarySessionOnly"`
+	EnforceTemporarySessionTimeout      bool                       `json:"enforceTemporarySessionTimeout"`
+	TemporarySessionTimeout             int                        `json:"temporarySessionTimeout,omitempty"`
+	EnforceUserSessionTimeout           bool                       `json:"enforceUserSessionTimeout"`
+	UserSessionTimeout                  int                        `json:"userSessionTimeout,omitempty"`
+	SiteID                              string                     `json:"siteId,omitempty"`
+	VersionLock                         int                        `json:"versionLock"`
+	PrestageMinimumOSTargetVersionType  string                     `json:"prestageMinimumOsTargetVersionType,omitempty"`
+	MinimumOSSpecificVersion            string                     `json:"minimumOsSpecificVersion,omitempty"`
+}
+
+// MobileDevicePrestageNames holds how devices enrolled through a mobile device prestage are named
+type MobileDevicePrestageNames struct {
+	AssignNamesUsing       string                     `json:"assignNamesUsing,omitempty"`
+	PrestageDeviceNames    []MobileDevicePrestageName `json:"prestageDeviceNames,omitempty"`
+	DeviceNamePrefix       string                     `json:"deviceNamePrefix,omitempty"`
+	DeviceNameSuffix       string                     `json:"deviceNameSuffix,omitempty"`
+	SingleDeviceName       string                     `json:"singleDeviceName,omitempty"`
+	ManageNames            bool                       `json:"manageNames"`
+	DeviceNamingConfigured bool                       `json:"deviceNamingConfigured"`
+}
+
+// MobileDevicePrestageName holds a single name from a list of names assigned to devices in turn
+type MobileDevicePrestageName struct {
+	ID         string `json:"id,omitempty"`
+	DeviceName string `json:"deviceName"`
+	Used       bool   `json:"used"`
+}
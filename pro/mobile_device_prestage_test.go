@@ -0,0 +1,105 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICE_PRESTAGES_API_BASE_ENDPOINT = "/api/v2/mobile-device-prestages"
+
+func mobileDevicePrestagesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	prestages := &crudMock[pro.MobileDevicePrestage]{
+		t:      t,
+		base:   MOBILE_DEVICE_PRESTAGES_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.MobileDevicePrestage{
+			{
+				ID:                                "1",
+				DisplayName:                       "Cart iPads",
+				Supervised:                        true,
+				MultiUser:                         true,
+				MaximumSharedAccounts:             10,
+				DeviceEnrollmentProgramInstanceID: "1",
+				Names: &pro.MobileDevicePrestageNames{
+					AssignNamesUsing: "List of Names",
+					PrestageDeviceNames: []pro.MobileDevicePrestageName{
+						{DeviceName: "Cart-01"},
+						{DeviceName: "Cart-02", Used: true},
+					},
+				},
+			},
+		},
+		getID: func(p pro.MobileDevicePrestage) string { return p.ID },
+		setID: func(p *pro.MobileDevicePrestage, id string) { p.ID = id },
+	}
+	mux.Handle(MOBILE_DEVICE_PRESTAGES_API_BASE_ENDPOINT, prestages)
+	mux.Handle(MOBILE_DEVICE_PRESTAGES_API_BASE_ENDPOINT+"/", prestages)
+	prestageScopeMock(t, mux, MOBILE_DEVICE_PRESTAGES_API_BASE_ENDPOINT, "1", []string{"DMPAAA"})
+	return httptest.NewServer(mux)
+}
+
+func TestMobileDevicePrestagesCRUD(t *testing.T) {
+	testServer := mobileDevicePrestagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.MobileDevicePrestages(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+
+	prestage, err := j.MobileDevicePrestageDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Cart iPads", prestage.DisplayName)
+	assert.True(t, prestage.MultiUser)
+	assert.Equal(t, 10, prestage.MaximumSharedAccounts)
+	assert.True(t, prestage.Names.PrestageDeviceNames[1].Used)
+
+	_, err = j.CreateMobileDevicePrestage(context.Background(), &pro.MobileDevicePrestage{})
+	assert.NotNil(t, err)
+
+	created, err := j.CreateMobileDevicePrestage(context.Background(), &pro.MobileDevicePrestage{DisplayName: "1:1 iPads", Supervised: true})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	prestage.Timezone = "America/Chicago"
+	prestage.SendTimezone = true
+	updated, err := j.UpdateMobileDevicePrestage(context.Background(), "1", prestage)
+	assert.Nil(t, err)
+	assert.Equal(t, "America/Chicago", updated.Timezone)
+
+	assert.Nil(t, j.DeleteMobileDevicePrestage(context.Background(), "2"))
+}
+
+func TestMobileDevicePrestageScope(t *testing.T) {
+	testServer := mobileDevicePrestagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	scope, err := j.AddMobileDevicePrestageScope(context.Background(), "1", []string{"DMPBBB", "DMPCCC"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DMPAAA", "DMPBBB", "DMPCCC"}, scope.SerialNumbers())
+
+	scope, err = j.RemoveMobileDevicePrestageScope(context.Background(), "1", []string{"DMPAAA"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DMPBBB", "DMPCCC"}, scope.SerialNumbers())
+
+	scope, err = j.ReplaceMobileDevicePrestageScope(context.Background(), "1", []string{"DMPDDD"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"DMPDDD"}, scope.SerialNumbers())
+
+	scope, err = j.MobileDevicePrestageScope(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 4, scope.VersionLock)
+
+	all, err := j.AllMobileDevicePrestageScopes(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "1", all["DMPDDD"])
+}
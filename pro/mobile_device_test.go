@@ -0,0 +1,132 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var MOBILE_DEVICES_API_BASE_ENDPOINT = "/api/v2/mobile-devices"
+
+var mockMobileDevices = []pro.MobileDevice{
+	{ID: "1", Name: "iPad-01", SerialNumber: "DMP1", Type: "ios"},
+	{ID: "2", Name: "iPad-02", SerialNumber: "DMP2", Type: "ios"},
+	{ID: "3", Name: "Apple TV", SerialNumber: "DMP3", Type: "tvos"},
+}
+
+func mobileDevicesResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		var (
+			data []byte
+			err  error
+		)
+		switch r.URL.Path {
+		case MOBILE_DEVICES_API_BASE_ENDPOINT:
+			data, err = json.Marshal(pageOf(t, r, mockMobileDevices))
+		case fmt.Sprintf("%s/detail", MOBILE_DEVICES_API_BASE_ENDPOINT):
+			inventory := []pro.MobileDeviceInventory{}
+			for _, device := range mockMobileDevices {
+				if filter := r.URL.Query().Get("filter"); filter != "" && filter != fmt.Sprintf("mobileDeviceId==%q", device.ID) {
+					continue
+				}
+				entry := pro.MobileDeviceInventory{
+					MobileDeviceID: device.ID,
+					DeviceType:     device.Type,
+					General:        &pro.MobileDeviceGeneral{DisplayName: device.Name, Managed: true},
+				}
+				if contains(r.URL.Query()["section"], string(pro.MobileDeviceSectionHardware)) {
+					entry.Hardware = &pro.MobileDeviceHardware{SerialNumber: device.SerialNumber}
+				}
+				inventory = append(inventory, entry)
+			}
+			data, err = json.Marshal(pageOf(t, r, inventory))
+		case fmt.Sprintf("%s/2", MOBILE_DEVICES_API_BASE_ENDPOINT):
+			assert.Equal(t, "PATCH", r.Method)
+			patch := &pro.MobileDeviceUpdate{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(patch))
+			device := mockMobileDevices[1]
+			device.Name = patch.Name
+			device.Username = patch.Location.Username
+			data, err = json.Marshal(device)
+		default:
+			http.Error(w, fmt.Sprintf("bad Jamf API %s call to %s", r.Method, r.URL), http.StatusInternalServerError)
+			return
+		}
+		assert.Nil(t, err)
+		_, err = w.Write(data)
+		assert.Nil(t, err)
+	}))
+}
+
+func TestMobileDevices(t *testing.T) {
+	testServer := mobileDevicesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.MobileDevices(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Equal(t, 2, len(page.Results))
+
+	devices, err := j.AllMobileDevices(context.Background(), &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(devices))
+	assert.Equal(t, "Apple TV", devices[2].Name)
+}
+
+func TestMobileDevicesInventory(t *testing.T) {
+	testServer := mobileDevicesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.MobileDevicesInventory(context.Background(), nil, pro.MobileDeviceSectionGeneral, pro.MobileDeviceSectionHardware)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+	assert.Equal(t, "DMP1", page.Results[0].Hardware.SerialNumber)
+
+	inventory, err := j.AllMobileDevicesInventory(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(inventory))
+	assert.Nil(t, inventory[0].Hardware)
+}
+
+func TestMobileDeviceDetails(t *testing.T) {
+	testServer := mobileDevicesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	device, err := j.MobileDeviceDetails(context.Background(), "2", pro.MobileDeviceSectionHardware)
+	assert.Nil(t, err)
+	assert.Equal(t, "2", device.MobileDeviceID)
+	assert.Equal(t, "DMP2", device.Hardware.SerialNumber)
+
+	_, err = j.MobileDeviceDetails(context.Background(), "42")
+	assert.NotNil(t, err)
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestUpdateMobileDevice(t *testing.T) {
+	testServer := mobileDevicesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UpdateMobileDevice(context.Background(), "2", nil)
+	assert.NotNil(t, err)
+
+	device, err := j.UpdateMobileDevice(context.Background(), "2", &pro.MobileDeviceUpdate{
+		Name:     "Cart iPad 2",
+		Location: &pro.MobileDeviceUserAndLocation{Username: "student42"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "Cart iPad 2", device.Name)
+	assert.Equal(t, "student42", device.Username)
+}
@@ -0,0 +1,32 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const notificationsContext = "notifications"
+
+// Notifications returns the alerts shown to the authenticated account in the Jamf Pro console
+func (j *Client) Notifications(ctx context.Context) ([]Notification, error) {
+	ep := j.endpoint(1, notificationsContext)
+	res := []Notification{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query notifications from %s", ep)
+	}
+	return res, nil
+}
+
+// DeleteNotification will dismiss a notification given its type and ID
+func (j *Client) DeleteNotification(ctx context.Context, notificationType string, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/%s", notificationsContext, url.PathEscape(notificationType), url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for notification %s %s from %s", notificationType, id, ep)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Common types of Jamf Pro console notifications
+const (
+	NotificationTypeAPNsCertRevoked       = "APNS_CERT_REVOKED"
+	NotificationTypeAPNsCertExpiring      = "PUSH_CERT_WILL_EXPIRE"
+	NotificationTypeAPNsCertExpired       = "PUSH_CERT_EXPIRED"
+	NotificationTypeAPNsConnectionFailure = "APNS_CONNECTION_FAILURE"
+	NotificationTypeDEPInstanceExpiring   = "DEP_INSTANCE_WILL_EXPIRE"
+	NotificationTypeDEPInstanceExpired    = "DEP_INSTANCE_EXPIRED"
+	NotificationTypeVPPTokenExpiring      = "VPP_ACCOUNT_WILL_EXPIRE"
+	NotificationTypeVPPTokenExpired       = "VPP_ACCOUNT_EXPIRED"
+	NotificationTypeTomcatSSLCertExpiring = "TOMCAT_SSL_CERT_WILL_EXPIRE"
+	NotificationTypeTomcatSSLCertExpired  = "TOMCAT_SSL_CERT_EXPIRED"
+	NotificationTypeSSOCertExpiring       = "SSO_CERT_WILL_EXPIRE"
+	NotificationTypeSSOCertExpired        = "SSO_CERT_EXPIRED"
+	NotificationTypeBuiltInCACertExpiring = "BUILT_IN_CA_CERT_WILL_EXPIRE"
+	NotificationTypeBuiltInCACertExpired  = "BUILT_IN_CA_CERT_EXPIRED"
+	NotificationTypePatchUpdate           = "PATCH_UPDATE"
+)
+
+// Notification represents an alert shown in the Jamf Pro console, Params holds details
+// specific to the type such as the name and expiration date of a token
+type Notification struct {
+	Type   string                 `json:"type"`
+	ID     string                 `json:"id"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
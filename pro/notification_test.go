@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var NOTIFICATIONS_API_BASE_ENDPOINT = "/api/v1/notifications"
+
+func notificationsResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	notifications := []pro.Notification{
+		{Type: pro.NotificationTypeVPPTokenExpiring, ID: "1", Params: map[string]interface{}{"name": "Example Inc", "days": 14}},
+		{Type: pro.NotificationTypeAPNsConnectionFailure, ID: "0"},
+	}
+	mux.HandleFunc(NOTIFICATIONS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(notifications))
+	})
+	mux.HandleFunc(NOTIFICATIONS_API_BASE_ENDPOINT+"/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		key := strings.TrimPrefix(r.URL.Path, NOTIFICATIONS_API_BASE_ENDPOINT+"/")
+		for i, notification := range notifications {
+			if notification.Type+"/"+notification.ID == key {
+				notifications = append(notifications[:i], notifications[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"httpStatus": 404, "errors": [{"code": "INVALID_ID", "description": "Notification not found"}]}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestNotifications(t *testing.T) {
+	testServer := notificationsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	notifications, err := j.Notifications(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, notifications, 2)
+	assert.Equal(t, pro.NotificationTypeVPPTokenExpiring, notifications[0].Type)
+	assert.Equal(t, "Example Inc", notifications[0].Params["name"])
+
+	assert.Nil(t, j.DeleteNotification(context.Background(), notifications[0].Type, notifications[0].ID))
+	err = j.DeleteNotification(context.Background(), notifications[0].Type, notifications[0].ID)
+	assert.True(t, pro.IsNotFound(err))
+
+	notifications, err = j.Notifications(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, notifications, 1)
+	assert.Equal(t, pro.NotificationTypeAPNsConnectionFailure, notifications[0].Type)
+}
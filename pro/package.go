@@ -0,0 +1,120 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const packagesContext = "packages"
+
+// Packages returns a single page of packages matching opts
+func (j *Client) Packages(ctx context.Context, opts *ListOptions) (*Results[Package], error) {
+	ep := j.endpoint(1, packagesContext)
+	res, err := listPage[Package](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query packages from %s", ep)
+	}
+	return res, nil
+}
+
+// AllPackages returns every package matching opts, requesting each page in turn
+func (j *Client) AllPackages(ctx context.Context, opts *ListOptions) ([]Package, error) {
+	ep := j.endpoint(1, packagesContext)
+	res, err := listAll[Package](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all packages from %s", ep)
+	}
+	return res, nil
+}
+
+// PackageDetails returns the details for a specific package given its ID
+func (j *Client) PackageDetails(ctx context.Context, id string) (*Package, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", packagesContext, url.PathEscape(id)))
+	res := &Package{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query package with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreatePackage will create a new package in Jamf
+func (j *Client) CreatePackage(ctx context.Context, content *Package) (*CreatedResource, error) {
+	ep := j.endpoint(1, packagesContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for package: (%s)", ep)
+	}
+	if content.PackageName == "" || content.FileName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("package name and file name required for new package"), "unable to process JAMF creation request for package: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for package %s on %s", content.PackageName, ep)
+	}
+	return res, nil
+}
+
+// UpdatePackage will replace a package in Jamf given its ID
+func (j *Client) UpdatePackage(ctx context.Context, id string, content *Package) (*Package, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", packagesContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for package: %s (%s)", id, ep)
+	}
+
+	res := &Package{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for package: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeletePackage will delete a package given its ID
+func (j *Client) DeletePackage(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", packagesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for package %s from %s", id, ep)
+	}
+	return nil
+}
+
+// UploadPackage streams the file of a package given its ID to Jamf, which stores it on the cloud distribution
+// point. The file is never buffered in memory and its checksums are computed while it is sent, use
+// PackageChecksums.Verify with the package details to make sure Jamf received the same content. Jamf does
+// not support resuming an interrupted upload, the whole file has to be sent again.
+func (j *Client) UploadPackage(ctx context.Context, id string, filename string, file io.Reader) (*PackageChecksums, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/upload", packagesContext, url.PathEscape(id)))
+	if file == nil {
+		return nil, fmt.Errorf("a file is required to upload to %s", ep)
+	}
+
+	md5Hash, sha256Hash, sha512Hash := md5.New(), sha256.New(), sha512.New()
+	tee := io.TeeReader(file, io.MultiWriter(md5Hash, sha256Hash, sha512Hash))
+	if err := j.upload(ctx, ep, "file", filename, tee, &CreatedResource{}); err != nil {
+		return nil, errors.Wrapf(err, "unable to upload file %s for package with ID %s to %s", filename, id, ep)
+	}
+	return &PackageChecksums{
+		MD5:    hex.EncodeToString(md5Hash.Sum(nil)),
+		SHA256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		SHA512: hex.EncodeToString(sha512Hash.Sum(nil)),
+	}, nil
+}
+
+// PackageHistory returns a single page of the change history for a package given its ID
+func (j *Client) PackageHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourcePackages, id, opts)
+}
+
+// AddPackageHistoryNote adds a note to the change history for a package given its ID
+func (j *Client) AddPackageHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourcePackages, id, note)
+}
@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Hash types Jamf reports for package files
+const (
+	PackageHashTypeMD5    = "MD5"
+	PackageHashTypeSHA512 = "SHA_512"
+)
+
+// Package represents a package that policies and prestages can install, CategoryID is "-1" when
+// the package has no category
+type Package struct {
+	ID                   string `json:"id,omitempty"`
+	PackageName          string `json:"packageName"`
+	FileName             string `json:"fileName"`
+	CategoryID           string `json:"categoryId"`
+	Info                 string `json:"info,omitempty"`
+	Notes                string `json:"notes,omitempty"`
+	Priority             int    `json:"priority"`
+	OSRequirements       string `json:"osRequirements,omitempty"`
+	FillUserTemplate     bool   `json:"fillUserTemplate"`
+	Indexed              bool   `json:"indexed,omitempty"`
+	FillExistingUsers    bool   `json:"fillExistingUsers,omitempty"`
+	SWU                  bool   `json:"swu,omitempty"`
+	RebootRequired       bool   `json:"rebootRequired"`
+	SelfHealNotify       bool   `json:"selfHealNotify,omitempty"`
+	SelfHealingAction    string `json:"selfHealingAction,omitempty"`
+	OSInstall            bool   `json:"osInstall"`
+	SerialNumber         string `json:"serialNumber,omitempty"`
+	ParentPackageID      string `json:"parentPackageId,omitempty"`
+	BasePath             string `json:"basePath,omitempty"`
+	SuppressUpdates      bool   `json:"suppressUpdates"`
+	CloudTransferStatus  string `json:"cloudTransferStatus,omitempty"`
+	IgnoreConflicts      bool   `json:"ignoreConflicts,omitempty"`
+	SuppressFromDock     bool   `json:"suppressFromDock"`
+	SuppressEULA         bool   `json:"suppressEula"`
+	SuppressRegistration bool   `json:"suppressRegistration"`
+	InstallLanguage      string `json:"installLanguage,omitempty"`
+	MD5                  string `json:"md5,omitempty"`
+	SHA256               string `json:"sha256,omitempty"`
+	HashType             string `json:"hashType,omitempty"`
+	HashValue            string `json:"hashValue,omitempty"`
+	Size                 string `json:"size,omitempty"`
+	OSInstallerVersion   string `json:"osInstallerVersion,omitempty"`
+	Manifest             string `json:"manifest,omitempty"`
+	ManifestFileName     string `json:"manifestFileName,omitempty"`
+	Format               string `json:"format,omitempty"`
+}
+
+// PackageChecksums holds the hex encoded checksums of a package file computed while it was uploaded
+type PackageChecksums struct {
+	MD5    string
+	SHA256 string
+	SHA512 string
+}
+
+// Verify compares the checksums with every checksum Jamf reports for p, returning an error on the
+// first mismatch or when Jamf reports none to compare with
+func (c *PackageChecksums) Verify(p *Package) error {
+	type check struct{ name, uploaded, reported string }
+	var checks []check
+	if p.MD5 != "" {
+		checks = append(checks, check{"MD5", c.MD5, p.MD5})
+	}
+	if p.SHA256 != "" {
+		checks = append(checks, check{"SHA-256", c.SHA256, p.SHA256})
+	}
+	if p.HashValue != "" {
+		switch p.HashType {
+		case PackageHashTypeMD5:
+			checks = append(checks, check{"MD5", c.MD5, p.HashValue})
+		case PackageHashTypeSHA512:
+			checks = append(checks, check{"SHA-512", c.SHA512, p.HashValue})
+		}
+	}
+	if len(checks) == 0 {
+		return fmt.Errorf("no checksum reported by Jamf for package %s", p.PackageName)
+	}
+
+	for _, check := range checks {
+		if !strings.EqualFold(check.uploaded, check.reported) {
+			return fmt.Errorf("%s checksum mismatch for package %s: uploaded %s, Jamf reports %s", check.name, p.PackageName, check.uploaded, check.reported)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var PACKAGES_API_BASE_ENDPOINT = "/api/v1/packages"
+
+func packagesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	packages := &crudMock[pro.Package]{
+		t:      t,
+		base:   PACKAGES_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.Package{
+			{ID: "1", PackageName: "Google Chrome", FileName: "GoogleChrome.pkg", CategoryID: "-1", Priority: 10},
+		},
+		getID: func(x pro.Package) string { return x.ID },
+		setID: func(x *pro.Package, id string) { x.ID = id },
+	}
+	mux.Handle(packages.base, packages)
+	mux.HandleFunc(packages.base+"/", func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/upload") {
+			packages.ServeHTTP(w, r)
+			return
+		}
+		assert.Equal(t, "POST", r.Method)
+		file, _, err := r.FormFile("file")
+		assert.Nil(t, err)
+		contents, err := io.ReadAll(file)
+		assert.Nil(t, err)
+		sum := sha512.Sum512(contents)
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, packages.base+"/"), "/upload")
+		for i := range packages.items {
+			if packages.items[i].ID == id {
+				packages.items[i].HashType = pro.PackageHashTypeSHA512
+				packages.items[i].HashValue = hex.EncodeToString(sum[:])
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		assert.Nil(t, json.NewEncoder(w).Encode(pro.CreatedResource{ID: id, Href: packages.base + "/" + id}))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestPackages(t *testing.T) {
+	testServer := packagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreatePackage(context.Background(), &pro.Package{PackageName: "Slack"})
+	assert.Contains(t, err.Error(), "package name and file name required")
+	created, err := j.CreatePackage(context.Background(), &pro.Package{PackageName: "Slack", FileName: "Slack.pkg", CategoryID: "-1", Priority: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	packages, err := j.AllPackages(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, packages, 2)
+
+	pkg, err := j.PackageDetails(context.Background(), created.ID)
+	assert.Nil(t, err)
+	pkg.RebootRequired = true
+	updated, err := j.UpdatePackage(context.Background(), created.ID, pkg)
+	assert.Nil(t, err)
+	assert.True(t, updated.RebootRequired)
+
+	_, err = j.AddPackageHistoryNote(context.Background(), created.ID, "Reboot required from 4.40")
+	assert.Nil(t, err)
+	history, err := j.PackageHistory(context.Background(), created.ID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, history.TotalCount)
+
+	assert.Nil(t, j.DeletePackage(context.Background(), created.ID))
+	_, err = j.PackageDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestUploadPackage(t *testing.T) {
+	testServer := packagesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UploadPackage(context.Background(), "1", "GoogleChrome.pkg", nil)
+	assert.NotNil(t, err)
+
+	checksums, err := j.UploadPackage(context.Background(), "1", "GoogleChrome.pkg", strings.NewReader("xar! package contents"))
+	assert.Nil(t, err)
+	assert.Equal(t, "cda725102e228b32c760a53e2ad6ff39", checksums.MD5)
+
+	pkg, err := j.PackageDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Nil(t, checksums.Verify(pkg))
+
+	pkg.HashValue = strings.Repeat("0", 128)
+	assert.Contains(t, checksums.Verify(pkg).Error(), "SHA-512 checksum mismatch")
+
+	pkg.MD5 = strings.ToUpper(checksums.MD5)
+	pkg.HashType, pkg.HashValue = "", ""
+	assert.Nil(t, checksums.Verify(pkg))
+
+	assert.NotNil(t, checksums.Verify(&pro.Package{PackageName: "Google Chrome"}))
+}
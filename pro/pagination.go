@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPageSize is the number of results requested per page when iterating over all results
+const DefaultPageSize = 100
+
+// ListOptions holds the paging, sorting and filtering parameters accepted by Jamf Pro API list endpoints
+type ListOptions struct {
+	// Page is the zero based page to request
+	Page int
+	// PageSize is the number of results per page, Jamf defaults to 100
+	PageSize int
+	// Sort holds sort criteria in the form property:asc|desc, e.g. "general.name:asc" or F("general.name").Asc()
+	Sort []string
+	// Filter is an RSQL query, e.g. `general.name=="Mac*"`, which can be built with F
+	Filter string
+}
+
+// Results represents a single page of results returned by a Jamf Pro API list endpoint
+type Results[T any] struct {
+	TotalCount int `json:"totalCount"`
+	Results    []T `json:"results"`
+}
+
+// values returns the query parameters for the options, nil options produce no parameters
+func (o *ListOptions) values() url.Values {
+	params := url.Values{}
+	if o == nil {
+		return params
+	}
+	if o.Page > 0 {
+		params.Set("page", strconv.Itoa(o.Page))
+	}
+	if o.PageSize > 0 {
+		params.Set("page-size", strconv.Itoa(o.PageSize))
+	}
+	if len(o.Sort) > 0 {
+		params.Set("sort", strings.Join(o.Sort, ","))
+	}
+	if o.Filter != "" {
+		params.Set("filter", o.Filter)
+	}
+	return params
+}
+
+// withQuery appends params to ep when there are any
+func withQuery(ep string, params url.Values) string {
+	if len(params) == 0 {
+		return ep
+	}
+	return fmt.Sprintf("%s?%s", ep, params.Encode())
+}
+
+// sectionValues returns the section query parameters for the requested sections of a resource
+func sectionValues[S ~string](sections []S) url.Values {
+	params := url.Values{}
+	for _, section := range sections {
+		params.Add("section", string(section))
+	}
+	return params
+}
+
+// listPage requests a single page of results from ep
+func listPage[T any](ctx context.Context, j *Client, ep string, params url.Values) (*Results[T], error) {
+	res := &Results[T]{}
+	if err := j.do(ctx, "GET", withQuery(ep, params), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// listAll requests every page of results from ep starting at opts.Page. extra holds any parameters
+// beyond paging, sorting and filtering (e.g. inventory sections).
+func listAll[T any](ctx context.Context, j *Client, ep string, opts *ListOptions, extra url.Values) ([]T, error) {
+	var all []T
+	for item, err := range iterAll[T](ctx, j, ep, opts, extra) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
+
+// iterAll yields every result from ep like listAll, requesting the next page once the results of the
+// previous one have been consumed. An error requesting a page is yielded once and ends the iteration.
+func iterAll[T any](ctx context.Context, j *Client, ep string, opts *ListOptions, extra url.Values) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageOpts := ListOptions{PageSize: DefaultPageSize}
+		if opts != nil {
+			pageOpts = *opts
+			if pageOpts.PageSize <= 0 {
+				pageOpts.PageSize = DefaultPageSize
+			}
+		}
+
+		for {
+			params := pageOpts.values()
+			for key, values := range extra {
+				params[key] = values
+			}
+			page, err := listPage[T](ctx, j, ep, params)
+			if err != nil {
+				var zero T
+				yield(zero, errors.Wrapf(err, "unable to query page %d from %s", pageOpts.Page, ep))
+				return
+			}
+			for _, item := range page.Results {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if len(page.Results) == 0 || len(page.Results) < pageOpts.PageSize || (pageOpts.Page+1)*pageOpts.PageSize >= page.TotalCount {
+				return
+			}
+			pageOpts.Page++
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	patchSoftwareTitleConfigurationsContext = "patch-software-title-configurations"
+	patchPoliciesContext                    = "patch-policies"
+)
+
+// PatchSoftwareTitleConfigurations returns every patch software title configuration in Jamf,
+// the endpoint is not paginated
+func (j *Client) PatchSoftwareTitleConfigurations(ctx context.Context) ([]PatchSoftwareTitleConfiguration, error) {
+	ep := j.endpoint(2, patchSoftwareTitleConfigurationsContext)
+	res := []PatchSoftwareTitleConfiguration{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch software title configurations from %s", ep)
+	}
+	return res, nil
+}
+
+// PatchSoftwareTitleConfigurationDetails returns the details for a specific patch software title configuration given its ID
+func (j *Client) PatchSoftwareTitleConfigurationDetails(ctx context.Context, id string) (*PatchSoftwareTitleConfiguration, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	res := &PatchSoftwareTitleConfiguration{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch software title configuration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreatePatchSoftwareTitleConfiguration will create a new patch software title configuration in Jamf
+func (j *Client) CreatePatchSoftwareTitleConfiguration(ctx context.Context, content *PatchSoftwareTitleConfiguration) (*CreatedResource, error) {
+	ep := j.endpoint(2, patchSoftwareTitleConfigurationsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for patch software title configuration: (%s)", ep)
+	}
+	if content.SoftwareTitleID == "" {
+		return nil, errors.Wrapf(fmt.Errorf("software title ID required for new patch software title configuration"), "unable to process JAMF creation request for patch software title configuration: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for patch software title configuration %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdatePatchSoftwareTitleConfiguration will update a patch software title configuration in Jamf given its ID,
+// the content is sent as a JSON merge patch
+func (j *Client) UpdatePatchSoftwareTitleConfiguration(ctx context.Context, id string, content *PatchSoftwareTitleConfiguration) (*PatchSoftwareTitleConfiguration, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for patch software title configuration: %s (%s)", id, ep)
+	}
+
+	req, err := newRequest(ctx, "PATCH", ep, content)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+
+	res := &PatchSoftwareTitleConfiguration{}
+	if err := j.makeAPIrequest(req, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for patch software title configuration: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeletePatchSoftwareTitleConfiguration will delete a patch software title configuration given its ID
+func (j *Client) DeletePatchSoftwareTitleConfiguration(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for patch software title configuration %s from %s", id, ep)
+	}
+	return nil
+}
+
+// PatchSoftwareTitleConfigurationHistory returns a single page of the change history for a patch
+// software title configuration given its ID
+func (j *Client) PatchSoftwareTitleConfigurationHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourcePatchSoftwareTitleConfigurations, id, opts)
+}
+
+// PatchSummary returns how many devices are up to date with a patch software title configuration given its ID
+func (j *Client) PatchSummary(ctx context.Context, id string) (*PatchSummary, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/patch-summary", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	res := &PatchSummary{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch summary for patch software title configuration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// PatchVersionSummaries returns how many devices are on each version of a patch software title
+// configuration given its ID
+func (j *Client) PatchVersionSummaries(ctx context.Context, id string) ([]PatchVersionSummary, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/patch-summary/versions", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	res := []PatchVersionSummary{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch version summaries for patch software title configuration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// PatchDefinitions returns a single page of the versions defined by the patch source for a patch
+// software title configuration given its ID
+func (j *Client) PatchDefinitions(ctx context.Context, id string, opts *ListOptions) (*Results[PatchDefinition], error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/definitions", patchSoftwareTitleConfigurationsContext, url.PathEscape(id)))
+	res, err := listPage[PatchDefinition](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch definitions for patch software title configuration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// PatchPolicies returns a single page of patch policies matching opts
+func (j *Client) PatchPolicies(ctx context.Context, opts *ListOptions) (*Results[PatchPolicy], error) {
+	ep := j.endpoint(2, patchPoliciesContext)
+	res, err := listPage[PatchPolicy](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query patch policies from %s", ep)
+	}
+	return res, nil
+}
+
+// AllPatchPolicies returns every patch policy matching opts, requesting each page in turn
+func (j *Client) AllPatchPolicies(ctx context.Context, opts *ListOptions) ([]PatchPolicy, error) {
+	ep := j.endpoint(2, patchPoliciesContext)
+	res, err := listAll[PatchPolicy](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all patch policies from %s", ep)
+	}
+	return res, nil
+}
+
+// PatchPolicyLogs returns a single page of the latest install attempt on each device in scope of a
+// patch policy given its ID
+func (j *Client) PatchPolicyLogs(ctx context.Context, id string, opts *ListOptions) (*Results[PatchPolicyLog], error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs", patchPoliciesContext, url.PathEscape(id)))
+	res, err := listPage[PatchPolicyLog](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query logs for patch policy with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// AllPatchPolicyLogs returns the latest install attempt on every device in scope of a patch policy
+// given its ID, requesting each page in turn
+func (j *Client) AllPatchPolicyLogs(ctx context.Context, id string, opts *ListOptions) ([]PatchPolicyLog, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs", patchPoliciesContext, url.PathEscape(id)))
+	res, err := listAll[PatchPolicyLog](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all logs for patch policy with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// PatchPolicyDeviceLog returns the latest install attempt of a patch policy on a single device
+func (j *Client) PatchPolicyDeviceLog(ctx context.Context, id string, deviceID string) (*PatchPolicyLog, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs/%s", patchPoliciesContext, url.PathEscape(id), url.PathEscape(deviceID)))
+	res := &PatchPolicyLog{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query log for device %s on patch policy with ID %s from %s", deviceID, id, ep)
+	}
+	return res, nil
+}
+
+// PatchPolicyEligibleRetryCount returns how many devices have failed a patch policy and can be retried
+func (j *Client) PatchPolicyEligibleRetryCount(ctx context.Context, id string) (int, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs/eligible-retry-count", patchPoliciesContext, url.PathEscape(id)))
+	res := &patchPolicyRetryCount{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return 0, errors.Wrapf(err, "unable to query eligible retry count for patch policy with ID %s from %s", id, ep)
+	}
+	return res.Count, nil
+}
+
+// RetryPatchPolicy retries a patch policy on the given devices where it failed
+func (j *Client) RetryPatchPolicy(ctx context.Context, id string, deviceIDs []string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs/retry", patchPoliciesContext, url.PathEscape(id)))
+	if len(deviceIDs) == 0 {
+		return fmt.Errorf("at least one device ID is required to retry patch policy %s (%s)", id, ep)
+	}
+	if err := j.do(ctx, "POST", ep, &patchPolicyRetry{DeviceIDs: deviceIDs}, nil); err != nil {
+		return errors.Wrapf(err, "unable to retry patch policy with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// RetryAllPatchPolicyDevices retries a patch policy on every device where it failed
+func (j *Client) RetryAllPatchPolicyDevices(ctx context.Context, id string) error {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/logs/retry-all", patchPoliciesContext, url.PathEscape(id)))
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to retry patch policy with ID %s on all devices on %s", id, ep)
+	}
+	return nil
+}
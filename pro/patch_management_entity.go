@@ -0,0 +1,126 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Install statuses reported by patch policy logs
+const (
+	PatchPolicyLogStatusPending   = "PENDING"
+	PatchPolicyLogStatusCompleted = "COMPLETED"
+	PatchPolicyLogStatusFailed    = "FAILED"
+	PatchPolicyLogStatusUnknown   = "UNKNOWN"
+)
+
+// PatchSoftwareTitleConfiguration represents a software title from a patch source which Jamf
+// reports on and patches
+type PatchSoftwareTitleConfiguration struct {
+	ID                     string                      `json:"id,omitempty"`
+	DisplayName            string                      `json:"displayName"`
+	CategoryID             string                      `json:"categoryId,omitempty"`
+	SiteID                 string                      `json:"siteId,omitempty"`
+	UINotifications        bool                        `json:"uiNotifications"`
+	EmailNotifications     bool                        `json:"emailNotifications"`
+	SoftwareTitleID        string                      `json:"softwareTitleId"`
+	ExtensionAttributes    []PatchExtensionAttribute   `json:"extensionAttributes,omitempty"`
+	SoftwareTitleName      string                      `json:"softwareTitleName,omitempty"`
+	SoftwareTitleNameID    string                      `json:"softwareTitleNameId,omitempty"`
+	SoftwareTitlePublisher string                      `json:"softwareTitlePublisher,omitempty"`
+	JamfOfficial           bool                        `json:"jamfOfficial,omitempty"`
+	PatchSourceName        string                      `json:"patchSourceName,omitempty"`
+	PatchSourceEnabled     bool                        `json:"patchSourceEnabled,omitempty"`
+	Packages               []PatchSoftwareTitlePackage `json:"packages,omitempty"`
+}
+
+// PatchExtensionAttribute holds whether an extension attribute required by a patch software title has been accepted
+type PatchExtensionAttribute struct {
+	Accepted bool   `json:"accepted"`
+	EAID     string `json:"eaId"`
+}
+
+// PatchSoftwareTitlePackage links a package to a version of a patch software title
+type PatchSoftwareTitlePackage struct {
+	PackageID   string `json:"packageId"`
+	Version     string `json:"version"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// PatchSummary holds how many devices are up to date with a patch software title configuration
+type PatchSummary struct {
+	SoftwareTitleID              string `json:"softwareTitleId"`
+	SoftwareTitleConfigurationID string `json:"softwareTitleConfigurationId"`
+	Title                        string `json:"title"`
+	LatestVersion                string `json:"latestVersion"`
+	ReleaseDate                  string `json:"releaseDate,omitempty"`
+	UpToDate                     int    `json:"upToDate"`
+	OutOfDate                    int    `json:"outOfDate"`
+	OnDashboard                  bool   `json:"onDashboard"`
+}
+
+// PatchVersionSummary holds how many devices are on a single version of a patch software title
+type PatchVersionSummary struct {
+	AbsoluteOrderID string `json:"absoluteOrderId"`
+	Version         string `json:"version"`
+	OnVersion       int    `json:"onVersion"`
+}
+
+// PatchDefinition represents a version of a patch software title defined by its patch source
+type PatchDefinition struct {
+	Version                string         `json:"version"`
+	MinimumOperatingSystem string         `json:"minimumOperatingSystem,omitempty"`
+	ReleaseDate            string         `json:"releaseDate,omitempty"`
+	RebootRequired         bool           `json:"rebootRequired"`
+	KillApps               []PatchKillApp `json:"killApps,omitempty"`
+	Standalone             bool           `json:"standalone"`
+	AbsoluteOrderID        string         `json:"absoluteOrderId"`
+}
+
+// PatchKillApp holds an app which must be quit before a patch can be installed
+type PatchKillApp struct {
+	AppName string `json:"appName"`
+}
+
+// PatchPolicy represents a policy which installs a version of a patch software title
+type PatchPolicy struct {
+	ID                           string `json:"id"`
+	Name                         string `json:"name"`
+	Enabled                      bool   `json:"enabled"`
+	TargetPatchVersion           string `json:"targetPatchVersion"`
+	DeploymentMethod             string `json:"deploymentMethod"`
+	SoftwareTitleID              string `json:"softwareTitleId"`
+	SoftwareTitleConfigurationID string `json:"softwareTitleConfigurationId"`
+	KillAppsDelayMinutes         int    `json:"killAppsDelayMinutes,omitempty"`
+	KillAppsMessage              string `json:"killAppsMessage,omitempty"`
+	IsDowngrade                  bool   `json:"isDowngrade"`
+	IsPatchUnknownVersion        bool   `json:"isPatchUnknownVersion"`
+	NotificationHeader           string `json:"notificationHeader,omitempty"`
+	SelfServiceEnforceDeadline   bool   `json:"selfServiceEnforceDeadline"`
+	SelfServiceDeadline          int    `json:"selfServiceDeadline,omitempty"`
+	InstallButtonText            string `json:"installButtonText,omitempty"`
+	SelfServiceDescription       string `json:"selfServiceDescription,omitempty"`
+	IconID                       string `json:"iconId,omitempty"`
+	ReminderFrequency            int    `json:"reminderFrequency,omitempty"`
+	ReminderEnabled              bool   `json:"reminderEnabled"`
+}
+
+// PatchPolicyLog holds the latest install attempt of a patch policy on a single device
+type PatchPolicyLog struct {
+	DeviceName              string `json:"deviceName"`
+	DeviceID                string `json:"deviceId"`
+	StatusCode              int    `json:"statusCode"`
+	StatusDate              string `json:"statusDate"`
+	StatusEnum              string `json:"statusEnum"`
+	AttemptNumber           int    `json:"attemptNumber"`
+	IgnoredForPatchPolicyID string `json:"ignoredForPatchPolicyId,omitempty"`
+}
+
+// Failed returns true when the latest install attempt failed and can be retried
+func (l *PatchPolicyLog) Failed() bool {
+	return l.StatusEnum == PatchPolicyLogStatusFailed
+}
+
+type patchPolicyRetryCount struct {
+	Count int `json:"count"`
+}
+
+type patchPolicyRetry struct {
+	DeviceIDs []string `json:"deviceIds"`
+}
@@ -0,0 +1,213 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT = "/api/v2/patch-software-title-configurations"
+var PATCH_POLICIES_API_BASE_ENDPOINT = "/api/v2/patch-policies"
+
+func patchManagementResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	configurations := map[string]*pro.PatchSoftwareTitleConfiguration{
+		"1": {ID: "1", DisplayName: "Mozilla Firefox", SoftwareTitleID: "5", UINotifications: true, PatchSourceName: "Jamf", JamfOfficial: true},
+	}
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			list := []*pro.PatchSoftwareTitleConfiguration{}
+			for _, configuration := range configurations {
+				list = append(list, configuration)
+			}
+			assert.Nil(t, json.NewEncoder(w).Encode(list))
+		case "POST":
+			configuration := &pro.PatchSoftwareTitleConfiguration{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(configuration))
+			configuration.ID = fmt.Sprint(len(configurations) + 1)
+			configurations[configuration.ID] = configuration
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"id": "%s", "href": "%s/%s"}`, configuration.ID, PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT, configuration.ID)
+		}
+	})
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT+"/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+		case "PATCH":
+			assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"))
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(configurations["1"]))
+		case "DELETE":
+			delete(configurations, "1")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		assert.Nil(t, json.NewEncoder(w).Encode(configurations["1"]))
+	})
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT+"/1/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Created"}]}`)
+	})
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT+"/1/patch-summary", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"softwareTitleId": "5", "softwareTitleConfigurationId": "1", "title": "Mozilla Firefox", "latestVersion": "131.0.2", "upToDate": 40, "outOfDate": 2, "onDashboard": true}`)
+	})
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT+"/1/patch-summary/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"absoluteOrderId": "0", "version": "131.0.2", "onVersion": 40}, {"absoluteOrderId": "1", "version": "131.0", "onVersion": 2}]`)
+	})
+	mux.HandleFunc(PATCH_SOFTWARE_TITLE_CONFIGURATIONS_API_BASE_ENDPOINT+"/1/definitions", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 1, "results": [{"version": "131.0.2", "minimumOperatingSystem": "10.15", "rebootRequired": false, "killApps": [{"appName": "Firefox.app"}], "standalone": true, "absoluteOrderId": "0"}]}`)
+	})
+	logs := []pro.PatchPolicyLog{
+		{DeviceName: "Lab-01", DeviceID: "1", StatusCode: 4, StatusEnum: pro.PatchPolicyLogStatusCompleted, AttemptNumber: 1},
+		{DeviceName: "Lab-02", DeviceID: "2", StatusCode: 2, StatusEnum: pro.PatchPolicyLogStatusFailed, AttemptNumber: 1},
+		{DeviceName: "Lab-03", DeviceID: "3", StatusCode: 2, StatusEnum: pro.PatchPolicyLogStatusFailed, AttemptNumber: 2},
+	}
+	retry := func(ids ...string) {
+		for i := range logs {
+			if logs[i].Failed() && (len(ids) == 0 || contains(ids, logs[i].DeviceID)) {
+				logs[i].StatusEnum = pro.PatchPolicyLogStatusPending
+				logs[i].AttemptNumber++
+			}
+		}
+	}
+	policies := &crudMock[pro.PatchPolicy]{
+		t:     t,
+		base:  PATCH_POLICIES_API_BASE_ENDPOINT,
+		items: []pro.PatchPolicy{{ID: "1", Name: "Firefox latest", Enabled: true, TargetPatchVersion: "131.0.2", SoftwareTitleConfigurationID: "1"}},
+		getID: func(p pro.PatchPolicy) string { return p.ID },
+		setID: func(p *pro.PatchPolicy, id string) { p.ID = id },
+	}
+	mux.Handle(PATCH_POLICIES_API_BASE_ENDPOINT, policies)
+	mux.HandleFunc(PATCH_POLICIES_API_BASE_ENDPOINT+"/1/logs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, logs)))
+	})
+	mux.HandleFunc(PATCH_POLICIES_API_BASE_ENDPOINT+"/1/logs/2", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(logs[1]))
+	})
+	mux.HandleFunc(PATCH_POLICIES_API_BASE_ENDPOINT+"/1/logs/eligible-retry-count", func(w http.ResponseWriter, r *http.Request) {
+		count := 0
+		for _, log := range logs {
+			if log.Failed() {
+				count++
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"count": %d}`, count)
+	})
+	mux.HandleFunc(PATCH_POLICIES_API_BASE_ENDPOINT+"/1/logs/retry", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string][]string{}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		retry(body["deviceIds"]...)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(PATCH_POLICIES_API_BASE_ENDPOINT+"/1/logs/retry-all", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		retry()
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestPatchSoftwareTitleConfigurations(t *testing.T) {
+	testServer := patchManagementResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	configurations, err := j.PatchSoftwareTitleConfigurations(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, configurations, 1)
+
+	configuration, err := j.PatchSoftwareTitleConfigurationDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Mozilla Firefox", configuration.DisplayName)
+	assert.True(t, configuration.JamfOfficial)
+
+	_, err = j.CreatePatchSoftwareTitleConfiguration(context.Background(), &pro.PatchSoftwareTitleConfiguration{DisplayName: "Google Chrome"})
+	assert.NotNil(t, err)
+
+	created, err := j.CreatePatchSoftwareTitleConfiguration(context.Background(), &pro.PatchSoftwareTitleConfiguration{DisplayName: "Google Chrome", SoftwareTitleID: "7"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	configuration.UINotifications = false
+	configuration.EmailNotifications = true
+	updated, err := j.UpdatePatchSoftwareTitleConfiguration(context.Background(), "1", configuration)
+	assert.Nil(t, err)
+	assert.False(t, updated.UINotifications)
+	assert.True(t, updated.EmailNotifications)
+
+	history, err := j.PatchSoftwareTitleConfigurationHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Created", history.Results[0].Note)
+
+	assert.Nil(t, j.DeletePatchSoftwareTitleConfiguration(context.Background(), "1"))
+}
+
+func TestPatchSummaries(t *testing.T) {
+	testServer := patchManagementResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	summary, err := j.PatchSummary(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "131.0.2", summary.LatestVersion)
+	assert.Equal(t, 2, summary.OutOfDate)
+
+	versions, err := j.PatchVersionSummaries(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, 40, versions[0].OnVersion)
+
+	definitions, err := j.PatchDefinitions(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Firefox.app", definitions.Results[0].KillApps[0].AppName)
+}
+
+func TestPatchPolicyLogs(t *testing.T) {
+	testServer := patchManagementResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	policies, err := j.AllPatchPolicies(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "131.0.2", policies[0].TargetPatchVersion)
+
+	logs, err := j.AllPatchPolicyLogs(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Len(t, logs, 3)
+
+	log, err := j.PatchPolicyDeviceLog(context.Background(), "1", "2")
+	assert.Nil(t, err)
+	assert.True(t, log.Failed())
+
+	count, err := j.PatchPolicyEligibleRetryCount(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, count)
+
+	assert.NotNil(t, j.RetryPatchPolicy(context.Background(), "1", nil))
+	assert.Nil(t, j.RetryPatchPolicy(context.Background(), "1", []string{"2"}))
+	count, err = j.PatchPolicyEligibleRetryCount(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, count)
+
+	assert.Nil(t, j.RetryAllPatchPolicyDevices(context.Background(), "1"))
+	page, err := j.PatchPolicyLogs(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.Results[2].AttemptNumber)
+}
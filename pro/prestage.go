@@ -0,0 +1,48 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// prestageScope returns the devices assigned to a prestage, scope endpoints are only available on v2
+func (j *Client) prestageScope(ctx context.Context, prestageContext string, id string) (*PrestageScope, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/scope", prestageContext, url.PathEscape(id)))
+	res := &PrestageScope{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query scope for prestage with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// allPrestageScopes returns the serial numbers assigned to every prestage keyed by serial number
+func (j *Client) allPrestageScopes(ctx context.Context, prestageContext string) (map[string]string, error) {
+	ep := j.endpoint(2, fmt.Sprintf("%s/scope", prestageContext))
+	res := &PrestageScopes{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query prestage scopes from %s", ep)
+	}
+	return res.SerialsByPrestageID, nil
+}
+
+// changePrestageScope adds, removes or replaces the serial numbers assigned to a prestage. The prestage's
+// current version lock is fetched first so callers do not have to track it.
+func (j *Client) changePrestageScope(ctx context.Context, prestageContext string, id string, method string, path string, serialNumbers []string) (*PrestageScope, error) {
+	current, err := j.prestageScope(ctx, prestageContext, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ep := j.endpoint(2, fmt.Sprintf("%s/%s/%s", prestageContext, url.PathEscape(id), path))
+	res := &PrestageScope{}
+	update := &PrestageScopeUpdate{SerialNumbers: serialNumbers, VersionLock: current.VersionLock}
+	if err := j.do(ctx, method, ep, update, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to update scope for prestage with ID %s on %s", id, ep)
+	}
+	return res, nil
+}
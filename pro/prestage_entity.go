@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// PrestageLocation holds the user and location information assigned to devices enrolled through a prestage
+type PrestageLocation struct {
+	ID           string `json:"id,omitempty"`
+	Username     string `json:"username"`
+	Realname     string `json:"realname"`
+	Phone        string `json:"phone"`
+	Email        string `json:"email"`
+	Room         string `json:"room"`
+	Position     string `json:"position"`
+	DepartmentID string `json:"departmentId"`
+	BuildingID   string `json:"buildingId"`
+	VersionLock  int    `json:"versionLock"`
+}
+
+// PrestagePurchasing holds the purchasing information assigned to devices enrolled through a prestage
+type PrestagePurchasing struct {
+	ID                string `json:"id,omitempty"`
+	Leased            bool   `json:"leased"`
+	Purchased         bool   `json:"purchased"`
+	AppleCareID       string `json:"appleCareId"`
+	PONumber          string `json:"poNumber"`
+	Vendor            string `json:"vendor"`
+	PurchasePrice     string `json:"purchasePrice"`
+	LifeExpectancy    int    `json:"lifeExpectancy"`
+	PurchasingAccount string `json:"purchasingAccount"`
+	PurchasingContact string `json:"purchasingContact"`
+	LeaseDate         string `json:"leaseDate"`
+	PODate            string `json:"poDate"`
+	WarrantyDate      string `json:"warrantyDate"`
+	VersionLock       int    `json:"versionLock"`
+}
+
+// PrestageScope holds the devices assigned to a prestage
+type PrestageScope struct {
+	PrestageID  string               `json:"prestageId"`
+	Assignments []PrestageAssignment `json:"assignments"`
+	VersionLock int                  `json:"versionLock"`
+}
+
+// SerialNumbers returns the serial numbers of every device assigned to the prestage
+func (s *PrestageScope) SerialNumbers() []string {
+	serials := make([]string, 0, len(s.Assignments))
+	for _, assignment := range s.Assignments {
+		serials = append(serials, assignment.SerialNumber)
+	}
+	return serials
+}
+
+// PrestageAssignment holds a single device assigned to a prestage
+type PrestageAssignment struct {
+	SerialNumber   string `json:"serialNumber"`
+	AssignmentDate string `json:"assignmentDate,omitempty"`
+	UserAssigned   string `json:"userAssigned,omitempty"`
+}
+
+// PrestageScopeUpdate holds the serial numbers to add, remove or replace in a prestage's scope
+type PrestageScopeUpdate struct {
+	SerialNumbers []string `json:"serialNumbers"`
+	VersionLock   int      `json:"versionLock"`
+}
+
+// PrestageScopes holds the prestage ID each device is assigned to keyed by serial number
+type PrestageScopes struct {
+	SerialsByPrestageID map[string]string `json:"serialsByPrestageId"`
+}
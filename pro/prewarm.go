@@ -0,0 +1,108 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPrewarmInterval is the time between two refreshes of the same data by a Prewarmer whose cache never expires
+const DefaultPrewarmInterval = 15 * time.Minute
+
+// minPrewarmTick is the shortest time between two refreshes of a Prewarmer
+const minPrewarmTick = time.Millisecond
+
+// Prewarmer keeps the data of a client's Cache hot for latency-sensitive tools, requesting it again in the
+// background before it expires so lookups never wait for Jamf. Client and its Cache must be set.
+type Prewarmer struct {
+	Client *Client
+	// Kinds of data refreshed, e.g. CacheComputerGroups, every kind a Cache holds when empty
+	Kinds []string
+	// Interval is the time between two refreshes of the same kind, half the TTL of the cache when zero or
+	// DefaultPrewarmInterval when the cache never expires. The refreshes of the different kinds are spread
+	// evenly over it so the server never receives them in bursts, and are at least a millisecond apart.
+	Interval time.Duration
+	// OnError, when set, is called with the errors of the refreshes, the data cached before is kept
+	OnError func(kind string, err error)
+}
+
+// Run refreshes every kind once, one after the other, then keeps refreshing them until ctx is done and
+// returns its error
+func (p *Prewarmer) Run(ctx context.Context) error {
+	if p.Client == nil || p.Client.Cache == nil {
+		return errors.New("unable to prewarm the cache, the client has no Cache")
+	}
+	kinds := p.Kinds
+	if len(kinds) == 0 {
+		kinds = []string{CacheBuildings, CacheCategories, CacheComputerGroups, CacheDepartments, CacheMobileDeviceGroups, CacheSites}
+	}
+	for _, kind := range kinds {
+		if p.Client.fetchCached(kind) == nil {
+			return errors.Errorf("unable to prewarm the cache, unknown kind of data %s", kind)
+		}
+	}
+	interval := p.Interval
+	switch {
+	case interval > 0:
+	case p.Client.Cache.ttl > 0:
+		interval = p.Client.Cache.ttl / 2
+	default:
+		interval = DefaultPrewarmInterval
+	}
+	tick := interval / time.Duration(len(kinds))
+	if tick < minPrewarmTick {
+		tick = minPrewarmTick
+	}
+
+	for _, kind := range kinds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p.refresh(ctx, kind)
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for i := 0; ; i = (i + 1) % len(kinds) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		p.refresh(ctx, kinds[i])
+	}
+}
+
+func (p *Prewarmer) refresh(ctx context.Context, kind string) {
+	generation := p.Client.Cache.generation(kind)
+	value, err := p.Client.fetchCached(kind)(ctx)
+	if err != nil {
+		if p.OnError != nil && ctx.Err() == nil {
+			p.OnError(kind, err)
+		}
+		return
+	}
+	p.Client.Cache.set(kind, value, generation)
+}
+
+// fetchCached returns the function requesting the data of a kind kept in a Cache, nil for unknown kinds
+func (j *Client) fetchCached(kind string) func(context.Context) (interface{}, error) {
+	var fetch func(context.Context) (interface{}, error)
+	switch kind {
+	case CacheBuildings:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.allBuildings(ctx, nil) }
+	case CacheCategories:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.allCategories(ctx, nil) }
+	case CacheComputerGroups:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.computerGroups(ctx) }
+	case CacheDepartments:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.allDepartments(ctx, nil) }
+	case CacheMobileDeviceGroups:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.mobileDeviceGroups(ctx) }
+	case CacheSites:
+		fetch = func(ctx context.Context) (interface{}, error) { return j.sites(ctx) }
+	}
+	return fetch
+}
@@ -0,0 +1,115 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrewarmer(t *testing.T) {
+	mu := sync.Mutex{}
+	gets := map[string]int{}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gets[r.URL.Path]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case BUILDINGS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "1", "name": "Headquarters"}]}`)
+		case COMPUTER_GROUPS_API_BASE_ENDPOINT:
+			fmt.Fprint(w, `[{"id": "1", "name": "All Managed Clients", "smartGroup": true}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"httpStatus": 404, "errors": []}`)
+		}
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+	j.Cache = pro.NewCache(time.Hour)
+
+	var failed []string
+	prewarmer := &pro.Prewarmer{
+		Client:   j,
+		Kinds:    []string{pro.CacheBuildings, pro.CacheComputerGroups, pro.CacheCategories},
+		Interval: 30 * time.Millisecond,
+		OnError:  func(kind string, err error) { failed = append(failed, kind) },
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, prewarmer.Run(ctx))
+
+	assert.GreaterOrEqual(t, gets[BUILDINGS_API_BASE_ENDPOINT], 2)
+	assert.GreaterOrEqual(t, gets[COMPUTER_GROUPS_API_BASE_ENDPOINT], 2)
+	assert.Contains(t, failed, pro.CacheCategories)
+	assert.NotContains(t, failed, pro.CacheBuildings)
+
+	// lookups are served from the warmed cache
+	refreshed := gets[BUILDINGS_API_BASE_ENDPOINT]
+	building, err := j.BuildingDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "Headquarters", building.Name)
+	groups, err := j.ComputerGroups(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, groups, 1)
+	assert.Equal(t, refreshed, gets[BUILDINGS_API_BASE_ENDPOINT])
+}
+
+func TestPrewarmerErrors(t *testing.T) {
+	testServer := httptest.NewServer(http.NotFoundHandler())
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	err := (&pro.Prewarmer{Client: j}).Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "the client has no Cache")
+
+	j.Cache = pro.NewCache(0)
+	err = (&pro.Prewarmer{Client: j, Kinds: []string{"printers"}}).Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "unknown kind of data printers")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, (&pro.Prewarmer{Client: j}).Run(ctx))
+}
+
+func TestPrewarmerShortInterval(t *testing.T) {
+	mu := sync.Mutex{}
+	gets := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		gets++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	// intervals shorter than a nanosecond per kind neither panic nor fall back to DefaultPrewarmInterval
+	for _, p := range []*pro.Prewarmer{
+		{Client: j, Kinds: []string{pro.CacheBuildings, pro.CacheCategories}, Interval: time.Nanosecond},
+		{Client: j, Kinds: []string{pro.CacheBuildings, pro.CacheCategories}},
+	} {
+		j.Cache = pro.NewCache(time.Nanosecond)
+		mu.Lock()
+		gets = 0
+		mu.Unlock()
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		assert.Equal(t, context.DeadlineExceeded, p.Run(ctx))
+		cancel()
+		mu.Lock()
+		assert.Greater(t, gets, 2)
+		mu.Unlock()
+	}
+}
@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const reenrollmentContext = "reenrollment"
+
+// ReenrollmentSettings returns which records Jamf Pro clears when a device re-enrolls
+func (j *Client) ReenrollmentSettings(ctx context.Context) (*ReenrollmentSettings, error) {
+	ep := j.endpoint(1, reenrollmentContext)
+	res := &ReenrollmentSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query re-enrollment settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateReenrollmentSettings will replace the re-enrollment settings in Jamf
+func (j *Client) UpdateReenrollmentSettings(ctx context.Context, settings *ReenrollmentSettings) (*ReenrollmentSettings, error) {
+	ep := j.endpoint(1, reenrollmentContext)
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for re-enrollment settings: (%s)", ep)
+	}
+
+	res := &ReenrollmentSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for re-enrollment settings (%s)", ep)
+	}
+	return res, nil
+}
@@ -0,0 +1,21 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Options for clearing the MDM command queue of a device when it re-enrolls
+const (
+	FlushMDMQueueNothing                      = "DELETE_NOTHING"
+	FlushMDMQueueErrors                       = "DELETE_ERRORS"
+	FlushMDMQueueEverythingExceptAcknowledged = "DELETE_EVERYTHING_EXCEPT_ACKNOWLEDGED"
+	FlushMDMQueueEverything                   = "DELETE_EVERYTHING"
+)
+
+// ReenrollmentSettings represents the records Jamf Pro clears from a device when it re-enrolls
+type ReenrollmentSettings struct {
+	FlushPolicyHistory              bool   `json:"isFlushPolicyHistoryEnabled"`
+	FlushLocationInformation        bool   `json:"isFlushLocationInformationEnabled"`
+	FlushLocationInformationHistory bool   `json:"isFlushLocationInformationHistoryEnabled"`
+	FlushExtensionAttributes        bool   `json:"isFlushExtensionAttributesEnabled"`
+	FlushSoftwareUpdatePlans        bool   `json:"isFlushSoftwareUpdatePlansEnabled"`
+	FlushMDMQueue                   string `json:"flushMDMQueue"`
+}
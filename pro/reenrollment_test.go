@@ -0,0 +1,51 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var REENROLLMENT_API_BASE_ENDPOINT = "/api/v1/reenrollment"
+
+func reenrollmentResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.ReenrollmentSettings{FlushPolicyHistory: true, FlushMDMQueue: pro.FlushMDMQueueErrors}
+	mux.HandleFunc(REENROLLMENT_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestReenrollmentSettings(t *testing.T) {
+	testServer := reenrollmentResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.ReenrollmentSettings(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, settings.FlushPolicyHistory)
+	assert.False(t, settings.FlushLocationInformationHistory)
+	assert.Equal(t, pro.FlushMDMQueueErrors, settings.FlushMDMQueue)
+
+	_, err = j.UpdateReenrollmentSettings(context.Background(), nil)
+	assert.Contains(t, err.Error(), "empty payload")
+
+	settings.FlushLocationInformationHistory = true
+	settings.FlushMDMQueue = pro.FlushMDMQueueEverythingExceptAcknowledged
+	updated, err := j.UpdateReenrollmentSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.True(t, updated.FlushLocationInformationHistory)
+	assert.Equal(t, pro.FlushMDMQueueEverythingExceptAcknowledged, updated.FlushMDMQueue)
+}
@@ -0,0 +1,83 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const returnToServiceContext = "return-to-service"
+
+// ReturnToServiceConfigurations returns a single page of Return to Service configurations matching opts
+func (j *Client) ReturnToServiceConfigurations(ctx context.Context, opts *ListOptions) (*Results[ReturnToServiceConfiguration], error) {
+	ep := j.endpoint(1, returnToServiceContext)
+	res, err := listPage[ReturnToServiceConfiguration](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query Return to Service configurations from %s", ep)
+	}
+	return res, nil
+}
+
+// AllReturnToServiceConfigurations returns every Return to Service configuration matching opts, requesting each page in turn
+func (j *Client) AllReturnToServiceConfigurations(ctx context.Context, opts *ListOptions) ([]ReturnToServiceConfiguration, error) {
+	ep := j.endpoint(1, returnToServiceContext)
+	res, err := listAll[ReturnToServiceConfiguration](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all Return to Service configurations from %s", ep)
+	}
+	return res, nil
+}
+
+// ReturnToServiceConfigurationDetails returns the details for a specific Return to Service configuration given its ID
+func (j *Client) ReturnToServiceConfigurationDetails(ctx context.Context, id string) (*ReturnToServiceConfiguration, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", returnToServiceContext, url.PathEscape(id)))
+	res := &ReturnToServiceConfiguration{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Return to Service configuration with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateReturnToServiceConfiguration will create a new Return to Service configuration in Jamf
+func (j *Client) CreateReturnToServiceConfiguration(ctx context.Context, content *ReturnToServiceConfiguration) (*CreatedResource, error) {
+	ep := j.endpoint(1, returnToServiceContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for Return to Service configuration: (%s)", ep)
+	}
+	if content.DisplayName == "" || content.WifiProfileID == "" {
+		return nil, errors.Wrapf(fmt.Errorf("display name and Wi-Fi profile ID required for new Return to Service configuration"), "unable to process JAMF creation request for Return to Service configuration: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for Return to Service configuration %s on %s", content.DisplayName, ep)
+	}
+	return res, nil
+}
+
+// UpdateReturnToServiceConfiguration will replace a Return to Service configuration in Jamf given its ID
+func (j *Client) UpdateReturnToServiceConfiguration(ctx context.Context, id string, content *ReturnToServiceConfiguration) (*ReturnToServiceConfiguration, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", returnToServiceContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Return to Service configuration: %s (%s)", id, ep)
+	}
+
+	res := &ReturnToServiceConfiguration{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Return to Service configuration: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteReturnToServiceConfiguration will delete a Return to Service configuration given its ID
+func (j *Client) DeleteReturnToServiceConfiguration(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", returnToServiceContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for Return to Service configuration %s from %s", id, ep)
+	}
+	return nil
+}
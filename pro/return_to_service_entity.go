@@ -0,0 +1,12 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// ReturnToServiceConfiguration names the Wi-Fi configuration profile a device joins after being erased
+// with Return to Service, so it can re-enroll without user interaction. Enable Return to Service on
+// EraseDeviceCommand or MobileDeviceErase to use it.
+type ReturnToServiceConfiguration struct {
+	ID            string `json:"id,omitempty"`
+	DisplayName   string `json:"displayName"`
+	WifiProfileID string `json:"wifiProfileId"`
+}
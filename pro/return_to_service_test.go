@@ -0,0 +1,56 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var RETURN_TO_SERVICE_API_BASE_ENDPOINT = "/api/v1/return-to-service"
+
+func returnToServiceResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(&crudMock[pro.ReturnToServiceConfiguration]{
+		t:      t,
+		base:   RETURN_TO_SERVICE_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.ReturnToServiceConfiguration{
+			{ID: "1", DisplayName: "Classroom Wi-Fi", WifiProfileID: "14"},
+		},
+		getID: func(x pro.ReturnToServiceConfiguration) string { return x.ID },
+		setID: func(x *pro.ReturnToServiceConfiguration, id string) { x.ID = id },
+	})
+}
+
+func TestReturnToServiceConfigurations(t *testing.T) {
+	testServer := returnToServiceResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateReturnToServiceConfiguration(context.Background(), &pro.ReturnToServiceConfiguration{DisplayName: "Lab Wi-Fi"})
+	assert.Contains(t, err.Error(), "Wi-Fi profile ID required")
+	created, err := j.CreateReturnToServiceConfiguration(context.Background(), &pro.ReturnToServiceConfiguration{DisplayName: "Lab Wi-Fi", WifiProfileID: "15"})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	configurations, err := j.AllReturnToServiceConfigurations(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, configurations, 2)
+
+	configuration, err := j.ReturnToServiceConfigurationDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "14", configuration.WifiProfileID)
+
+	configuration.WifiProfileID = "16"
+	updated, err := j.UpdateReturnToServiceConfiguration(context.Background(), "1", configuration)
+	assert.Nil(t, err)
+	assert.Equal(t, "16", updated.WifiProfileID)
+
+	assert.Nil(t, j.DeleteReturnToServiceConfiguration(context.Background(), created.ID))
+	_, err = j.ReturnToServiceConfigurationDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
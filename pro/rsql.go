@@ -0,0 +1,141 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import "strings"
+
+// Field is a property of a Jamf Pro resource that can be used in an RSQL filter,
+// e.g. F("general.name").Contains("lab").And(F("hardware.model").EQ("MacBookPro18,1"))
+type Field string
+
+// F returns the field for a property name, nested properties are separated with dots
+func F(name string) Field {
+	return Field(name)
+}
+
+// EQ matches resources where the field equals value, value may contain * wildcards
+func (f Field) EQ(value string) Filter {
+	return f.compare("==", value)
+}
+
+// NE matches resources where the field does not equal value
+func (f Field) NE(value string) Filter {
+	return f.compare("!=", value)
+}
+
+// LT matches resources where the field is lower than value
+func (f Field) LT(value string) Filter {
+	return f.compare("=lt=", value)
+}
+
+// LE matches resources where the field is lower than or equal to value
+func (f Field) LE(value string) Filter {
+	return f.compare("=le=", value)
+}
+
+// GT matches resources where the field is greater than value
+func (f Field) GT(value string) Filter {
+	return f.compare("=gt=", value)
+}
+
+// GE matches resources where the field is greater than or equal to value
+func (f Field) GE(value string) Filter {
+	return f.compare("=ge=", value)
+}
+
+// Contains matches resources where the field contains value
+func (f Field) Contains(value string) Filter {
+	return f.EQ("*" + value + "*")
+}
+
+// StartsWith matches resources where the field starts with value
+func (f Field) StartsWith(value string) Filter {
+	return f.EQ(value + "*")
+}
+
+// EndsWith matches resources where the field ends with value
+func (f Field) EndsWith(value string) Filter {
+	return f.EQ("*" + value)
+}
+
+// In matches resources where the field equals one of values
+func (f Field) In(values ...string) Filter {
+	return f.list("=in=", values)
+}
+
+// Out matches resources where the field equals none of values
+func (f Field) Out(values ...string) Filter {
+	return f.list("=out=", values)
+}
+
+// Asc returns the sort criterion ordering results by the field in ascending order
+func (f Field) Asc() string {
+	return string(f) + ":asc"
+}
+
+// Desc returns the sort criterion ordering results by the field in descending order
+func (f Field) Desc() string {
+	return string(f) + ":desc"
+}
+
+func (f Field) compare(operator string, value string) Filter {
+	return Filter{expr: string(f) + operator + quoteRSQL(value)}
+}
+
+func (f Field) list(operator string, values []string) Filter {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quoteRSQL(value)
+	}
+	return Filter{expr: string(f) + operator + "(" + strings.Join(quoted, ",") + ")"}
+}
+
+// Filter is an RSQL expression accepted by the filter parameter of Jamf Pro API list endpoints,
+// use String to set ListOptions.Filter. The zero Filter matches every resource.
+type Filter struct {
+	expr string
+	// or is set when the top level operator of expr is an OR, which binds looser than AND
+	or bool
+}
+
+// And matches resources matching the filter and all of others
+func (f Filter) And(others ...Filter) Filter {
+	return f.join(";", false, others)
+}
+
+// Or matches resources matching the filter or any of others
+func (f Filter) Or(others ...Filter) Filter {
+	return f.join(",", true, others)
+}
+
+// String returns the RSQL expression of the filter
+func (f Filter) String() string {
+	return f.expr
+}
+
+func (f Filter) join(operator string, or bool, others []Filter) Filter {
+	var filters []Filter
+	for _, filter := range append([]Filter{f}, others...) {
+		if filter.expr != "" {
+			filters = append(filters, filter)
+		}
+	}
+	if len(filters) < 2 {
+		return append(filters, Filter{})[0]
+	}
+
+	parts := make([]string, len(filters))
+	for i, filter := range filters {
+		parts[i] = filter.expr
+		if filter.or && !or {
+			parts[i] = "(" + filter.expr + ")"
+		}
+	}
+	return Filter{expr: strings.Join(parts, operator), or: or}
+}
+
+// quoteRSQL quotes value so reserved characters such as spaces, commas and parentheses are
+// part of the value rather than the expression
+func quoteRSQL(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
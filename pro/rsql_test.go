@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterOperators(t *testing.T) {
+	for expected, filter := range map[string]pro.Filter{
+		`general.name=="Lab-01"`:              pro.F("general.name").EQ("Lab-01"),
+		`general.name!="Lab-01"`:              pro.F("general.name").NE("Lab-01"),
+		`general.name=="*lab*"`:               pro.F("general.name").Contains("lab"),
+		`general.name=="lab*"`:                pro.F("general.name").StartsWith("lab"),
+		`general.name=="*lab"`:                pro.F("general.name").EndsWith("lab"),
+		`id=lt="10"`:                          pro.F("id").LT("10"),
+		`id=le="10"`:                          pro.F("id").LE("10"),
+		`id=gt="10"`:                          pro.F("id").GT("10"),
+		`id=ge="10"`:                          pro.F("id").GE("10"),
+		`id=in=("1","2","3")`:                 pro.F("id").In("1", "2", "3"),
+		`id=out=("4")`:                        pro.F("id").Out("4"),
+		`general.name=="Mac, \"Lab\" (2) \\"`: pro.F("general.name").EQ(`Mac, "Lab" (2) \`),
+	} {
+		assert.Equal(t, expected, filter.String())
+	}
+}
+
+func TestFilterComposition(t *testing.T) {
+	name := pro.F("general.name").Contains("lab")
+	model := pro.F("hardware.model").EQ("MacBookPro18,1")
+	assert.Equal(t, `general.name=="*lab*";hardware.model=="MacBookPro18,1"`, name.And(model).String())
+	assert.Equal(t, `general.name=="*lab*",hardware.model=="MacBookPro18,1"`, name.Or(model).String())
+
+	// OR binds looser than AND so it is grouped when nested in an AND
+	site := pro.F("general.site.name").EQ("Paris")
+	assert.Equal(t, `general.site.name=="Paris";(general.name=="*lab*",hardware.model=="MacBookPro18,1")`, site.And(name.Or(model)).String())
+	assert.Equal(t, `general.site.name=="Paris",general.name=="*lab*";hardware.model=="MacBookPro18,1"`, site.Or(name.And(model)).String())
+	assert.Equal(t, `general.name=="*lab*",hardware.model=="MacBookPro18,1"`, name.Or(model).And().String())
+
+	// the zero filter matches everything and is dropped from compositions
+	assert.Equal(t, "", pro.Filter{}.String())
+	assert.Equal(t, name.String(), pro.Filter{}.And(name).String())
+	assert.Equal(t, name.String(), name.Or(pro.Filter{}).String())
+}
+
+func TestFilterListOptions(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `general.name=="*lab*";hardware.model=in=("MacBookPro18,1","MacBookPro18,2")`, r.URL.Query().Get("filter"))
+		assert.Equal(t, "general.name:asc,id:desc", r.URL.Query().Get("sort"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"totalCount": 0, "results": []}`))
+	}))
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.ComputersInventory(context.Background(), &pro.ListOptions{
+		Filter: pro.F("general.name").Contains("lab").And(pro.F("hardware.model").In("MacBookPro18,1", "MacBookPro18,2")).String(),
+		Sort:   []string{pro.F("general.name").Asc(), pro.F("id").Desc()},
+	})
+	assert.Nil(t, err)
+}
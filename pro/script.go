@@ -0,0 +1,83 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const scriptsContext = "scripts"
+
+// Scripts returns a single page of scripts matching opts
+func (j *Client) Scripts(ctx context.Context, opts *ListOptions) (*Results[Script], error) {
+	ep := j.endpoint(1, scriptsContext)
+	res, err := listPage[Script](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query scripts from %s", ep)
+	}
+	return res, nil
+}
+
+// AllScripts returns every script matching opts, requesting each page in turn
+func (j *Client) AllScripts(ctx context.Context, opts *ListOptions) ([]Script, error) {
+	ep := j.endpoint(1, scriptsContext)
+	res, err := listAll[Script](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all scripts from %s", ep)
+	}
+	return res, nil
+}
+
+// ScriptDetails returns the details for a specific script given its ID
+func (j *Client) ScriptDetails(ctx context.Context, id string) (*Script, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", scriptsContext, url.PathEscape(id)))
+	res := &Script{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query script with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateScript will create a new script in Jamf
+func (j *Client) CreateScript(ctx context.Context, content *Script) (*CreatedResource, error) {
+	ep := j.endpoint(1, scriptsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for script: (%s)", ep)
+	}
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new script"), "unable to process JAMF creation request for script: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for script %s on %s", content.Name, ep)
+	}
+	return res, nil
+}
+
+// UpdateScript will replace a script in Jamf given its ID
+func (j *Client) UpdateScript(ctx context.Context, id string, content *Script) (*Script, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", scriptsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for script: %s (%s)", id, ep)
+	}
+
+	res := &Script{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for script: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteScript will delete a script given its ID
+func (j *Client) DeleteScript(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", scriptsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for script %s from %s", id, ep)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Script priorities, determining when a script runs relative to the rest of a policy
+const (
+	ScriptPriorityBefore   = "BEFORE"
+	ScriptPriorityAfter    = "AFTER"
+	ScriptPriorityAtReboot = "AT_REBOOT"
+)
+
+// Script represents a script stored in Jamf Pro
+type Script struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	Info           string `json:"info,omitempty"`
+	Notes          string `json:"notes,omitempty"`
+	Priority       string `json:"priority,omitempty"`
+	CategoryID     string `json:"categoryId,omitempty"`
+	CategoryName   string `json:"categoryName,omitempty"`
+	Parameter4     string `json:"parameter4,omitempty"`
+	Parameter5     string `json:"parameter5,omitempty"`
+	Parameter6     string `json:"parameter6,omitempty"`
+	Parameter7     string `json:"parameter7,omitempty"`
+	Parameter8     string `json:"parameter8,omitempty"`
+	Parameter9     string `json:"parameter9,omitempty"`
+	Parameter10    string `json:"parameter10,omitempty"`
+	Parameter11    string `json:"parameter11,omitempty"`
+	OSRequirements string `json:"osRequirements,omitempty"`
+	ScriptContents string `json:"scriptContents,omitempty"`
+}
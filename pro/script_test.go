@@ -0,0 +1,102 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var SCRIPTS_API_BASE_ENDPOINT = "/api/v1/scripts"
+
+func scriptsResponseMocks(t *testing.T) *httptest.Server {
+	return httptest.NewServer(&crudMock[pro.Script]{
+		t:      t,
+		base:   SCRIPTS_API_BASE_ENDPOINT,
+		nextID: 2,
+		items: []pro.Script{
+			{ID: "1", Name: "Install Rosetta", Priority: pro.ScriptPriorityBefore, ScriptContents: "#!/bin/sh\nsoftwareupdate --install-rosetta"},
+			{ID: "2", Name: "Rename Computer", Priority: pro.ScriptPriorityAfter, Parameter4: "Prefix"},
+		},
+		getID: func(s pro.Script) string { return s.ID },
+		setID: func(s *pro.Script, id string) { s.ID = id },
+	})
+}
+
+func TestQueryAllScripts(t *testing.T) {
+	testServer := scriptsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.Scripts(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, "Install Rosetta", page.Results[0].Name)
+
+	scripts, err := j.AllScripts(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(scripts))
+	assert.Equal(t, "Rename Computer", scripts[1].Name)
+}
+
+func TestQuerySpecificScript(t *testing.T) {
+	testServer := scriptsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	script, err := j.ScriptDetails(context.Background(), "2")
+	assert.Nil(t, err)
+	assert.Equal(t, "Rename Computer", script.Name)
+	assert.Equal(t, pro.ScriptPriorityAfter, script.Priority)
+	assert.Equal(t, "Prefix", script.Parameter4)
+
+	_, err = j.ScriptDetails(context.Background(), "9")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestCreateScript(t *testing.T) {
+	testServer := scriptsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateScript(context.Background(), &pro.Script{})
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "name required")
+
+	created, err := j.CreateScript(context.Background(), &pro.Script{Name: "Collect Logs", ScriptContents: "#!/bin/sh\nsysdiagnose"})
+	assert.Nil(t, err)
+	assert.Equal(t, "3", created.ID)
+	assert.Equal(t, "/api/v1/scripts/3", created.Href)
+
+	script, err := j.ScriptDetails(context.Background(), created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "Collect Logs", script.Name)
+}
+
+func TestUpdateScript(t *testing.T) {
+	testServer := scriptsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	script, err := j.UpdateScript(context.Background(), "1", &pro.Script{Name: "Install Rosetta 2", Priority: pro.ScriptPriorityAtReboot})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", script.ID)
+	assert.Equal(t, "Install Rosetta 2", script.Name)
+	assert.Equal(t, pro.ScriptPriorityAtReboot, script.Priority)
+}
+
+func TestDeleteScript(t *testing.T) {
+	testServer := scriptsResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	err := j.DeleteScript(context.Background(), "1")
+	assert.Nil(t, err)
+
+	_, err = j.ScriptDetails(context.Background(), "1")
+	assert.True(t, pro.IsNotFound(err))
+}
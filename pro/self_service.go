@@ -0,0 +1,195 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	selfServiceContext   = "self-service"
+	macOSBrandingContext = selfServiceContext + "/branding/macos"
+	iOSBrandingContext   = selfServiceContext + "/branding/ios"
+)
+
+// SelfServiceSettings returns the install, login and configuration settings of Self Service
+func (j *Client) SelfServiceSettings(ctx context.Context) (*SelfServiceSettings, error) {
+	ep := j.endpoint(1, selfServiceContext+"/settings")
+	res := &SelfServiceSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Self Service settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateSelfServiceSettings will replace the Self Service settings
+func (j *Client) UpdateSelfServiceSettings(ctx context.Context, settings *SelfServiceSettings) (*SelfServiceSettings, error) {
+	ep := j.endpoint(1, selfServiceContext+"/settings")
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Self Service settings: (%s)", ep)
+	}
+
+	res := &SelfServiceSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Self Service settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// UploadSelfServiceBrandingImage uploads an image for Self Service branding, returning the URL Jamf hosts it at
+func (j *Client) UploadSelfServiceBrandingImage(ctx context.Context, filename string, image io.Reader) (string, error) {
+	ep := j.endpoint(1, selfServiceContext+"/branding/images")
+	if image == nil {
+		return "", fmt.Errorf("an image is required to upload to %s", ep)
+	}
+	res := &selfServiceBrandingImage{}
+	if err := j.upload(ctx, ep, "file", filename, image, res); err != nil {
+		return "", errors.Wrapf(err, "unable to upload Self Service branding image %s to %s", filename, ep)
+	}
+	return res.URL, nil
+}
+
+// MacOSSelfServiceBrandings returns a single page of macOS Self Service brandings matching opts
+func (j *Client) MacOSSelfServiceBrandings(ctx context.Context, opts *ListOptions) (*Results[MacOSSelfServiceBranding], error) {
+	ep := j.endpoint(1, macOSBrandingContext)
+	res, err := listPage[MacOSSelfServiceBranding](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query macOS Self Service brandings from %s", ep)
+	}
+	return res, nil
+}
+
+// AllMacOSSelfServiceBrandings returns every macOS Self Service branding matching opts, requesting each page in turn
+func (j *Client) AllMacOSSelfServiceBrandings(ctx context.Context, opts *ListOptions) ([]MacOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, macOSBrandingContext)
+	res, err := listAll[MacOSSelfServiceBranding](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all macOS Self Service brandings from %s", ep)
+	}
+	return res, nil
+}
+
+// MacOSSelfServiceBrandingDetails returns the details for a specific macOS Self Service branding given its ID
+func (j *Client) MacOSSelfServiceBrandingDetails(ctx context.Context, id string) (*MacOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", macOSBrandingContext, url.PathEscape(id)))
+	res := &MacOSSelfServiceBranding{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query macOS Self Service branding with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateMacOSSelfServiceBranding will create a new macOS Self Service branding in Jamf
+func (j *Client) CreateMacOSSelfServiceBranding(ctx context.Context, content *MacOSSelfServiceBranding) (*CreatedResource, error) {
+	ep := j.endpoint(1, macOSBrandingContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for macOS Self Service branding: (%s)", ep)
+	}
+	if content.BrandingName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("branding name required for new macOS Self Service branding"), "unable to process JAMF creation request for macOS Self Service branding: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for macOS Self Service branding %s on %s", content.BrandingName, ep)
+	}
+	return res, nil
+}
+
+// UpdateMacOSSelfServiceBranding will replace a macOS Self Service branding in Jamf given its ID
+func (j *Client) UpdateMacOSSelfServiceBranding(ctx context.Context, id string, content *MacOSSelfServiceBranding) (*MacOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", macOSBrandingContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for macOS Self Service branding: %s (%s)", id, ep)
+	}
+
+	res := &MacOSSelfServiceBranding{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for macOS Self Service branding: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteMacOSSelfServiceBranding will delete a macOS Self Service branding given its ID
+func (j *Client) DeleteMacOSSelfServiceBranding(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", macOSBrandingContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for macOS Self Service branding %s from %s", id, ep)
+	}
+	return nil
+}
+
+// IOSSelfServiceBrandings returns a single page of iOS Self Service brandings matching opts
+func (j *Client) IOSSelfServiceBrandings(ctx context.Context, opts *ListOptions) (*Results[IOSSelfServiceBranding], error) {
+	ep := j.endpoint(1, iOSBrandingContext)
+	res, err := listPage[IOSSelfServiceBranding](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query iOS Self Service brandings from %s", ep)
+	}
+	return res, nil
+}
+
+// AllIOSSelfServiceBrandings returns every iOS Self Service branding matching opts, requesting each page in turn
+func (j *Client) AllIOSSelfServiceBrandings(ctx context.Context, opts *ListOptions) ([]IOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, iOSBrandingContext)
+	res, err := listAll[IOSSelfServiceBranding](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all iOS Self Service brandings from %s", ep)
+	}
+	return res, nil
+}
+
+// IOSSelfServiceBrandingDetails returns the details for a specific iOS Self Service branding given its ID
+func (j *Client) IOSSelfServiceBrandingDetails(ctx context.Context, id string) (*IOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", iOSBrandingContext, url.PathEscape(id)))
+	res := &IOSSelfServiceBranding{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query iOS Self Service branding with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateIOSSelfServiceBranding will create a new iOS Self Service branding in Jamf
+func (j *Client) CreateIOSSelfServiceBranding(ctx context.Context, content *IOSSelfServiceBranding) (*CreatedResource, error) {
+	ep := j.endpoint(1, iOSBrandingContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for iOS Self Service branding: (%s)", ep)
+	}
+	if content.BrandingName == "" {
+		return nil, errors.Wrapf(fmt.Errorf("branding name required for new iOS Self Service branding"), "unable to process JAMF creation request for iOS Self Service branding: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for iOS Self Service branding %s on %s", content.BrandingName, ep)
+	}
+	return res, nil
+}
+
+// UpdateIOSSelfServiceBranding will replace an iOS Self Service branding in Jamf given its ID
+func (j *Client) UpdateIOSSelfServiceBranding(ctx context.Context, id string, content *IOSSelfServiceBranding) (*IOSSelfServiceBranding, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", iOSBrandingContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for iOS Self Service branding: %s (%s)", id, ep)
+	}
+
+	res := &IOSSelfServiceBranding{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for iOS Self Service branding: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteIOSSelfServiceBranding will delete an iOS Self Service branding given its ID
+func (j *Client) DeleteIOSSelfServiceBranding(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", iOSBrandingContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for iOS Self Service branding %s from %s", id, ep)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Login levels of Self Service
+const (
+	SelfServiceLoginLevelNotRequired = "NotRequired"
+	SelfServiceLoginLevelAnonymous   = "Anonymous"
+	SelfServiceLoginLevelRequired    = "Required"
+)
+
+// SelfServiceSettings holds the install, login and configuration settings of Self Service
+type SelfServiceSettings struct {
+	InstallSettings       SelfServiceInstallSettings       `json:"installSettings"`
+	LoginSettings         SelfServiceLoginSettings         `json:"loginSettings"`
+	ConfigurationSettings SelfServiceConfigurationSettings `json:"configurationSettings"`
+}
+
+// SelfServiceInstallSettings controls how Self Service is installed on computers
+type SelfServiceInstallSettings struct {
+	InstallAutomatically bool   `json:"installAutomatically"`
+	InstallLocation      string `json:"installLocation"`
+}
+
+// SelfServiceLoginSettings controls how users log in to Self Service
+type SelfServiceLoginSettings struct {
+	UserLoginLevel  string `json:"userLoginLevel"`
+	AllowRememberMe bool   `json:"allowRememberMe"`
+	UseFido2        bool   `json:"useFido2"`
+	AuthType        string `json:"authType"`
+}
+
+// SelfServiceConfigurationSettings controls notifications and the landing page of Self Service
+type SelfServiceConfigurationSettings struct {
+	NotificationsEnabled  bool   `json:"notificationsEnabled"`
+	AlertUserApprovedMdm  bool   `json:"alertUserApprovedMdm"`
+	DefaultLandingPage    string `json:"defaultLandingPage"`
+	DefaultHomeCategoryID int    `json:"defaultHomeCategoryId"`
+	BookmarksName         string `json:"bookmarksName,omitempty"`
+}
+
+// MacOSSelfServiceBranding represents the appearance of Self Service on macOS
+type MacOSSelfServiceBranding struct {
+	ID                    string `json:"id,omitempty"`
+	ApplicationName       string `json:"applicationName"`
+	BrandingName          string `json:"brandingName"`
+	BrandingNameSecondary string `json:"brandingNameSecondary,omitempty"`
+	IconID                int    `json:"iconId,omitempty"`
+	BrandingHeaderImageID int    `json:"brandingHeaderImageId,omitempty"`
+	HomeHeading           string `json:"homeHeading,omitempty"`
+	HomeSubheading        string `json:"homeSubheading,omitempty"`
+}
+
+// IOSSelfServiceBranding represents the appearance of Self Service on iOS, colors are hex codes without a leading #
+type IOSSelfServiceBranding struct {
+	ID                        string `json:"id,omitempty"`
+	BrandingName              string `json:"brandingName"`
+	IconID                    int    `json:"iconId,omitempty"`
+	HeaderBackgroundColorCode string `json:"headerBackgroundColorCode,omitempty"`
+	MenuIconColorCode         string `json:"menuIconColorCode,omitempty"`
+	BrandingNameColorCode     string `json:"brandingNameColorCode,omitempty"`
+	StatusBarTextColor        string `json:"statusBarTextColor,omitempty"`
+}
+
+type selfServiceBrandingImage struct {
+	URL string `json:"url"`
+}
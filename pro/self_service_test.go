@@ -0,0 +1,122 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var SELF_SERVICE_API_BASE_ENDPOINT = "/api/v1/self-service"
+
+func selfServiceResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.SelfServiceSettings{
+		InstallSettings:       pro.SelfServiceInstallSettings{InstallAutomatically: true, InstallLocation: "/Applications"},
+		LoginSettings:         pro.SelfServiceLoginSettings{UserLoginLevel: pro.SelfServiceLoginLevelNotRequired, AuthType: "Basic"},
+		ConfigurationSettings: pro.SelfServiceConfigurationSettings{NotificationsEnabled: true, DefaultLandingPage: "HOME", DefaultHomeCategoryID: -1},
+	}
+	mux.HandleFunc(SELF_SERVICE_API_BASE_ENDPOINT+"/settings", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	mux.HandleFunc(SELF_SERVICE_API_BASE_ENDPOINT+"/branding/images", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		_, header, err := r.FormFile("file")
+		assert.Nil(t, err)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"url": "https://jamf.example.com/api/v1/self-service/branding/images/download/1?name=%s"}`, header.Filename)
+	})
+	macOS := &crudMock[pro.MacOSSelfServiceBranding]{
+		t:      t,
+		base:   SELF_SERVICE_API_BASE_ENDPOINT + "/branding/macos",
+		nextID: 1,
+		items: []pro.MacOSSelfServiceBranding{
+			{ID: "1", ApplicationName: "Self Service", BrandingName: "Example IT", HomeHeading: "Welcome"},
+		},
+		getID: func(x pro.MacOSSelfServiceBranding) string { return x.ID },
+		setID: func(x *pro.MacOSSelfServiceBranding, id string) { x.ID = id },
+	}
+	iOS := &crudMock[pro.IOSSelfServiceBranding]{
+		t:     t,
+		base:  SELF_SERVICE_API_BASE_ENDPOINT + "/branding/ios",
+		items: []pro.IOSSelfServiceBranding{},
+		getID: func(x pro.IOSSelfServiceBranding) string { return x.ID },
+		setID: func(x *pro.IOSSelfServiceBranding, id string) { x.ID = id },
+	}
+	mux.Handle(macOS.base, macOS)
+	mux.Handle(macOS.base+"/", macOS)
+	mux.Handle(iOS.base, iOS)
+	mux.Handle(iOS.base+"/", iOS)
+	return httptest.NewServer(mux)
+}
+
+func TestSelfServiceSettings(t *testing.T) {
+	testServer := selfServiceResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.SelfServiceSettings(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "/Applications", settings.InstallSettings.InstallLocation)
+	assert.Equal(t, pro.SelfServiceLoginLevelNotRequired, settings.LoginSettings.UserLoginLevel)
+
+	_, err = j.UpdateSelfServiceSettings(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	settings.LoginSettings.UserLoginLevel = pro.SelfServiceLoginLevelRequired
+	settings.ConfigurationSettings.BookmarksName = "Resources"
+	updated, err := j.UpdateSelfServiceSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.Equal(t, pro.SelfServiceLoginLevelRequired, updated.LoginSettings.UserLoginLevel)
+	assert.Equal(t, "Resources", updated.ConfigurationSettings.BookmarksName)
+}
+
+func TestSelfServiceBranding(t *testing.T) {
+	testServer := selfServiceResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.UploadSelfServiceBrandingImage(context.Background(), "header.png", nil)
+	assert.NotNil(t, err)
+	url, err := j.UploadSelfServiceBrandingImage(context.Background(), "header.png", strings.NewReader("\x89PNG"))
+	assert.Nil(t, err)
+	assert.Contains(t, url, "name=header.png")
+
+	brandings, err := j.AllMacOSSelfServiceBrandings(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Len(t, brandings, 1)
+
+	branding, err := j.MacOSSelfServiceBrandingDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	branding.HomeSubheading = "Install approved software"
+	updated, err := j.UpdateMacOSSelfServiceBranding(context.Background(), "1", branding)
+	assert.Nil(t, err)
+	assert.Equal(t, "Install approved software", updated.HomeSubheading)
+
+	_, err = j.CreateIOSSelfServiceBranding(context.Background(), &pro.IOSSelfServiceBranding{HeaderBackgroundColorCode: "632CA6"})
+	assert.Contains(t, err.Error(), "branding name required")
+	created, err := j.CreateIOSSelfServiceBranding(context.Background(), &pro.IOSSelfServiceBranding{BrandingName: "Example IT", HeaderBackgroundColorCode: "632CA6"})
+	assert.Nil(t, err)
+	assert.Equal(t, "1", created.ID)
+
+	page, err := j.IOSSelfServiceBrandings(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "632CA6", page.Results[0].HeaderBackgroundColorCode)
+
+	assert.Nil(t, j.DeleteIOSSelfServiceBranding(context.Background(), created.ID))
+	_, err = j.IOSSelfServiceBrandingDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
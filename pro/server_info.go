@@ -0,0 +1,86 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	jamfProServerURLContext   = "jamf-pro-server-url"
+	jamfProInformationContext = "jamf-pro-information"
+)
+
+// JamfProServerURL returns the URL devices use to reach the Jamf Pro server
+func (j *Client) JamfProServerURL(ctx context.Context) (*JamfProServerURL, error) {
+	ep := j.endpoint(1, jamfProServerURLContext)
+	res := &JamfProServerURL{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Jamf Pro server URL from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateJamfProServerURL will update the URL devices use to reach the Jamf Pro server
+func (j *Client) UpdateJamfProServerURL(ctx context.Context, serverURL *JamfProServerURL) (*JamfProServerURL, error) {
+	ep := j.endpoint(1, jamfProServerURLContext)
+	if serverURL == nil || serverURL.URL == "" {
+		return nil, errors.Wrapf(fmt.Errorf("URL required"), "unable to process JAMF update request for Jamf Pro server URL: (%s)", ep)
+	}
+
+	res := &JamfProServerURL{}
+	if err := j.do(ctx, "PUT", ep, serverURL, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Jamf Pro server URL (%s)", ep)
+	}
+	return res, nil
+}
+
+// JamfProServerURLHistory returns a single page of the change history for the Jamf Pro server URL
+func (j *Client) JamfProServerURLHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceJamfProServerURL, "", opts)
+}
+
+// AddJamfProServerURLHistoryNote adds a note to the change history for the Jamf Pro server URL
+func (j *Client) AddJamfProServerURLHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceJamfProServerURL, "", note)
+}
+
+// JamfProInformation returns which optional features are enabled on the Jamf Pro server
+func (j *Client) JamfProInformation(ctx context.Context) (*JamfProInformation, error) {
+	ep := j.endpoint(1, jamfProInformationContext)
+	res := &JamfProInformation{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Jamf Pro information from %s", ep)
+	}
+	return res, nil
+}
+
+// StartupStatus returns the progress of the Jamf Pro server startup. The endpoint is not versioned and
+// is requested without a bearer token since authentication is unavailable until the server has started.
+func (j *Client) StartupStatus(ctx context.Context) (*StartupStatus, error) {
+	ep := fmt.Sprintf("%s/startup-status", j.Endpoint)
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := j.api.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error making %s request to %s", req.Method, req.URL)
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, errors.Wrapf(newAPIError(res), "unable to query Jamf Pro startup status from %s", ep)
+	}
+
+	status := &StartupStatus{}
+	if err := json.NewDecoder(res.Body).Decode(status); err != nil {
+		return nil, errors.Wrapf(err, "response was successful but error occured decoding response body from %s", ep)
+	}
+	return status, nil
+}
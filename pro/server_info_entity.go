@@ -0,0 +1,42 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// JamfProServerURL holds the URL devices use to reach Jamf Pro, UnsecuredEnrollmentURL is only
+// used when enrollment is served over a different URL than management
+type JamfProServerURL struct {
+	URL                    string `json:"url"`
+	UnsecuredEnrollmentURL string `json:"unsecuredEnrollmentUrl,omitempty"`
+}
+
+// JamfProInformation reports which optional features are enabled on the Jamf Pro server
+type JamfProInformation struct {
+	VPPTokenEnabled         bool `json:"vppTokenEnabled"`
+	DEPAccountEnabled       bool `json:"depAccountEnabled"`
+	BYODEnabled             bool `json:"byodEnabled"`
+	UserMigrationEnabled    bool `json:"userMigrationEnabled"`
+	CloudDeploymentsEnabled bool `json:"cloudDeploymentsEnabled"`
+	PatchEnabled            bool `json:"patchEnabled"`
+	SSOSAMLEnabled          bool `json:"ssoSamlEnabled"`
+	SMTPEnabled             bool `json:"smtpEnabled"`
+}
+
+// StartupStatus holds the progress of the Jamf Pro server startup, codes identify the current step,
+// warning and error for localization while the other fields hold their English description
+type StartupStatus struct {
+	Step                    string `json:"step"`
+	StepCode                string `json:"stepCode"`
+	StepParam               string `json:"stepParam,omitempty"`
+	Percentage              int    `json:"percentage"`
+	Warning                 string `json:"warning,omitempty"`
+	WarningCode             string `json:"warningCode,omitempty"`
+	WarningParam            string `json:"warningParam,omitempty"`
+	Error                   string `json:"error,omitempty"`
+	ErrorCode               string `json:"errorCode,omitempty"`
+	SetupAssistantNecessary bool   `json:"setupAssistantNecessary"`
+}
+
+// Started returns true once the server has completed its startup without error
+func (s *StartupStatus) Started() bool {
+	return s.Percentage >= 100 && s.ErrorCode == ""
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var JAMF_PRO_SERVER_URL_API_BASE_ENDPOINT = "/api/v1/jamf-pro-server-url"
+
+func serverInfoResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	serverURL := pro.JamfProServerURL{URL: "https://jamf.example.com:8443"}
+	percentage := 40
+	mux.HandleFunc(JAMF_PRO_SERVER_URL_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&serverURL))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(serverURL))
+	})
+	mux.HandleFunc(JAMF_PRO_SERVER_URL_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "Moved to port 443"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v1/jamf-pro-server-url/history/2"}`)
+		}
+	})
+	mux.HandleFunc("/api/v1/jamf-pro-information", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"vppTokenEnabled": true, "depAccountEnabled": true, "byodEnabled": false, "userMigrationEnabled": false, "cloudDeploymentsEnabled": true, "patchEnabled": true, "ssoSamlEnabled": true, "smtpEnabled": false}`)
+	})
+	mux.HandleFunc("/api/startup-status", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"step": "Initializing cache", "stepCode": "CACHE_INIT", "percentage": %d, "setupAssistantNecessary": false}`, percentage)
+		percentage = 100
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestJamfProServerURL(t *testing.T) {
+	testServer := serverInfoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	serverURL, err := j.JamfProServerURL(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jamf.example.com:8443", serverURL.URL)
+
+	_, err = j.UpdateJamfProServerURL(context.Background(), &pro.JamfProServerURL{})
+	assert.Contains(t, err.Error(), "URL required")
+	serverURL, err = j.UpdateJamfProServerURL(context.Background(), &pro.JamfProServerURL{URL: "https://jamf.example.com"})
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jamf.example.com", serverURL.URL)
+
+	history, err := j.JamfProServerURLHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Moved to port 443", history.Results[0].Note)
+	_, err = j.AddJamfProServerURLHistoryNote(context.Background(), "Dropped port")
+	assert.Nil(t, err)
+}
+
+func TestJamfProInformation(t *testing.T) {
+	testServer := serverInfoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	info, err := j.JamfProInformation(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, info.VPPTokenEnabled)
+	assert.True(t, info.SSOSAMLEnabled)
+	assert.False(t, info.BYODEnabled)
+}
+
+func TestStartupStatus(t *testing.T) {
+	testServer := serverInfoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	status, err := j.StartupStatus(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "CACHE_INIT", status.StepCode)
+	assert.False(t, status.Started())
+
+	status, err = j.StartupStatus(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, status.Started())
+}
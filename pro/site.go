@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const sitesContext = "sites"
+
+// Sites returns every site of the Jamf Pro server, from the client's Cache when set
+func (j *Client) Sites(ctx context.Context) ([]Site, error) {
+	return cachedList(ctx, j, CacheSites, nil, func(ctx context.Context, _ *ListOptions) ([]Site, error) {
+		return j.sites(ctx)
+	})
+}
+
+func (j *Client) sites(ctx context.Context) ([]Site, error) {
+	ep := j.endpoint(1, sitesContext)
+	res := []Site{}
+	if err := j.do(ctx, "GET", ep, nil, &res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query sites from %s", ep)
+	}
+	return res, nil
+}
+
+// SiteByName returns the site with the given name, ignoring case. Jamf has no endpoint to look a site up
+// so every site is requested and an APIError with a 404 status is returned when none matches.
+func (j *Client) SiteByName(ctx context.Context, name string) (*Site, error) {
+	sites, err := j.Sites(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sites {
+		if strings.EqualFold(sites[i].Name, name) {
+			return &sites[i], nil
+		}
+	}
+	return nil, &APIError{StatusCode: http.StatusNotFound, Body: fmt.Sprintf("site with name %s not found", name)}
+}
+
+// SiteObjects returns a single page of the objects assigned to a site given its ID, filter on objectType
+// to only get objects of one kind
+func (j *Client) SiteObjects(ctx context.Context, id string, opts *ListOptions) (*Results[SiteObject], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/objects", sitesContext, url.PathEscape(id)))
+	res, err := listPage[SiteObject](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query objects of site with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// AllSiteObjects returns every object assigned to a site given its ID, requesting each page in turn
+func (j *Client) AllSiteObjects(ctx context.Context, id string, opts *ListOptions) ([]SiteObject, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/objects", sitesContext, url.PathEscape(id)))
+	res, err := listAll[SiteObject](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all objects of site with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
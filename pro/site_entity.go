@@ -0,0 +1,16 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Site represents a Jamf Pro site a resource is assigned to
+type Site struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// SiteObject references an object assigned to a site, ObjectType is e.g. "Computer", "Policy" or "Mobile Device"
+type SiteObject struct {
+	SiteID     string `json:"siteId"`
+	ObjectType string `json:"objectType"`
+	ObjectID   string `json:"objectId"`
+}
@@ -0,0 +1,72 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var SITES_API_BASE_ENDPOINT = "/api/v1/sites"
+
+func sitesResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	objects := []pro.SiteObject{
+		{SiteID: "1", ObjectType: "Computer", ObjectID: "12"},
+		{SiteID: "1", ObjectType: "Computer", ObjectID: "13"},
+		{SiteID: "1", ObjectType: "Policy", ObjectID: "4"},
+	}
+	mux.HandleFunc(SITES_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `[{"id": "1", "name": "Paris"}, {"id": "2", "name": "New York"}]`)
+	})
+	mux.HandleFunc(SITES_API_BASE_ENDPOINT+"/1/objects", func(w http.ResponseWriter, r *http.Request) {
+		results := objects
+		if filter := r.URL.Query().Get("filter"); filter != "" {
+			assert.Equal(t, `objectType=="Computer"`, filter)
+			results = objects[:2]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, results)))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestSites(t *testing.T) {
+	testServer := sitesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	sites, err := j.Sites(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []pro.Site{{ID: "1", Name: "Paris"}, {ID: "2", Name: "New York"}}, sites)
+
+	site, err := j.SiteByName(context.Background(), "new york")
+	assert.Nil(t, err)
+	assert.Equal(t, "2", site.ID)
+
+	_, err = j.SiteByName(context.Background(), "London")
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestSiteObjects(t *testing.T) {
+	testServer := sitesResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.SiteObjects(context.Background(), "1", &pro.ListOptions{PageSize: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.TotalCount)
+
+	objects, err := j.AllSiteObjects(context.Background(), "1", &pro.ListOptions{PageSize: 1, Filter: pro.F("objectType").EQ("Computer").String()})
+	assert.Nil(t, err)
+	assert.Len(t, objects, 2)
+	assert.Equal(t, "13", objects[1].ObjectID)
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const ssoContext = "sso"
+
+// SSOSettings returns the single sign-on settings for Jamf Pro
+func (j *Client) SSOSettings(ctx context.Context) (*SSOSettings, error) {
+	ep := j.endpoint(2, ssoContext)
+	res := &SSOSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query SSO settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateSSOSettings will replace the single sign-on settings for Jamf Pro
+func (j *Client) UpdateSSOSettings(ctx context.Context, content *SSOSettings) (*SSOSettings, error) {
+	ep := j.endpoint(2, ssoContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for SSO settings (%s)", ep)
+	}
+
+	res := &SSOSettings{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for SSO settings (%s)", ep)
+	}
+	return res, nil
+}
+
+// DisableSSO turns off single sign-on, leaving the rest of the settings in place
+func (j *Client) DisableSSO(ctx context.Context) error {
+	ep := j.endpoint(1, ssoContext+"/disable")
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to disable SSO on %s", ep)
+	}
+	return nil
+}
+
+// SSODependencies returns the enrollment customizations which rely on single sign-on and would
+// break if it were disabled
+func (j *Client) SSODependencies(ctx context.Context) ([]Dependency, error) {
+	ep := j.endpoint(2, ssoContext+"/dependencies")
+	res := &dependencies{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query SSO dependencies from %s", ep)
+	}
+	return res.Dependencies, nil
+}
+
+// SSOCertificate returns the certificate used to sign SAML requests
+func (j *Client) SSOCertificate(ctx context.Context) (*SSOCertificate, error) {
+	ep := j.endpoint(2, ssoContext+"/cert")
+	res := &SSOCertificate{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query SSO certificate from %s", ep)
+	}
+	return res, nil
+}
+
+// RegenerateSSOCertificate replaces the certificate used to sign SAML requests with a new one
+// generated by Jamf Pro, the identity provider must be given the new metadata afterwards
+func (j *Client) RegenerateSSOCertificate(ctx context.Context) (*SSOCertificate, error) {
+	ep := j.endpoint(2, ssoContext+"/cert")
+	res := &SSOCertificate{}
+	if err := j.do(ctx, "POST", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to regenerate SSO certificate on %s", ep)
+	}
+	return res, nil
+}
+
+// SSOFailover returns the failover URL used to log in to Jamf Pro with a local account
+// while single sign-on is enabled
+func (j *Client) SSOFailover(ctx context.Context) (*SSOFailover, error) {
+	ep := j.endpoint(1, ssoContext+"/failover")
+	res := &SSOFailover{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query SSO failover URL from %s", ep)
+	}
+	return res, nil
+}
+
+// RegenerateSSOFailover replaces the failover URL with a new one, the previous URL stops working immediately
+func (j *Client) RegenerateSSOFailover(ctx context.Context) (*SSOFailover, error) {
+	ep := j.endpoint(1, ssoContext+"/failover/generate")
+	res := &SSOFailover{}
+	if err := j.do(ctx, "POST", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to regenerate SSO failover URL on %s", ep)
+	}
+	return res, nil
+}
+
+// DeleteSSOCertificate will delete the certificate used to sign SAML requests
+func (j *Client) DeleteSSOCertificate(ctx context.Context) error {
+	ep := j.endpoint(2, ssoContext+"/cert")
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for SSO certificate from %s", ep)
+	}
+	return nil
+}
+
+// DownloadSSOCertificate writes the certificate used to sign SAML requests to w
+func (j *Client) DownloadSSOCertificate(ctx context.Context, w io.Writer) error {
+	ep := j.endpoint(2, ssoContext+"/cert/download")
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/plain")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to download SSO certificate from %s", ep)
+	}
+	return nil
+}
+
+// DownloadSSOMetadata writes the SAML metadata for Jamf Pro, used to configure the identity provider, to w
+func (j *Client) DownloadSSOMetadata(ctx context.Context, w io.Writer) error {
+	ep := j.endpoint(2, ssoContext+"/metadata/download")
+	req, err := newRequest(ctx, "GET", ep, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/plain")
+	if err := j.makeAPIrequest(req, w); err != nil {
+		return errors.Wrapf(err, "unable to download SSO metadata from %s", ep)
+	}
+	return nil
+}
+
+// SSOHistory returns a single page of the change history for the single sign-on settings
+func (j *Client) SSOHistory(ctx context.Context, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceSSO, "", opts)
+}
+
+// AddSSOHistoryNote adds a note to the change history for the single sign-on settings
+func (j *Client) AddSSOHistoryNote(ctx context.Context, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceSSO, "", note)
+}
@@ -0,0 +1,97 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import "time"
+
+// Single sign-on configuration types
+const (
+	SSOConfigurationTypeSAML         = "SAML"
+	SSOConfigurationTypeOIDC         = "OIDC"
+	SSOConfigurationTypeOIDCWithSAML = "OIDC_WITH_SAML"
+)
+
+// SSOSettings represents the single sign-on settings for Jamf Pro
+type SSOSettings struct {
+	SSOEnabled                                     bool                 `json:"ssoEnabled"`
+	ConfigurationType                              string               `json:"configurationType"`
+	OIDCSettings                                   *SSOOIDCSettings     `json:"oidcSettings,omitempty"`
+	SAMLSettings                                   *SSOSAMLSettings     `json:"samlSettings,omitempty"`
+	SSOBypassAllowed                               bool                 `json:"ssoBypassAllowed"`
+	SSOForEnrollmentEnabled                        bool                 `json:"ssoForEnrollmentEnabled"`
+	SSOForMacOSSelfServiceEnabled                  bool                 `json:"ssoForMacOsSelfServiceEnabled"`
+	EnrollmentSSOForAccountDrivenEnrollmentEnabled bool                 `json:"enrollmentSsoForAccountDrivenEnrollmentEnabled"`
+	GroupEnrollmentAccessEnabled                   bool                 `json:"groupEnrollmentAccessEnabled"`
+	GroupEnrollmentAccessName                      string               `json:"groupEnrollmentAccessName,omitempty"`
+	EnrollmentSSOConfig                            *SSOEnrollmentConfig `json:"enrollmentSsoConfig,omitempty"`
+}
+
+// SSOOIDCSettings holds the OpenID Connect settings for single sign-on
+type SSOOIDCSettings struct {
+	UserMapping string `json:"userMapping"`
+}
+
+// SSOSAMLSettings holds the SAML identity provider settings for single sign-on
+type SSOSAMLSettings struct {
+	IDPURL                             string `json:"idpUrl,omitempty"`
+	EntityID                           string `json:"entityId"`
+	MetadataSource                     string `json:"metadataSource"`
+	UserMapping                        string `json:"userMapping"`
+	IDPProviderType                    string `json:"idpProviderType"`
+	GroupRDNKey                        string `json:"groupRdnKey,omitempty"`
+	UserAttributeName                  string `json:"userAttributeName,omitempty"`
+	GroupAttributeName                 string `json:"groupAttributeName,omitempty"`
+	UserAttributeEnableCustomAttribute bool   `json:"userAttributeEnableCustomAttribute"`
+	MetadataFileName                   string `json:"metadataFileName,omitempty"`
+	OtherProviderTypeName              string `json:"otherProviderTypeName,omitempty"`
+	FederationMetadataFile             string `json:"federationMetadataFile,omitempty"`
+	TokenExpirationDisabled            bool   `json:"tokenExpirationDisabled"`
+	SessionTimeout                     int    `json:"sessionTimeout,omitempty"`
+}
+
+// SSOEnrollmentConfig holds the hosts allowed to use single sign-on for enrollment
+type SSOEnrollmentConfig struct {
+	Hosts          []string `json:"hosts"`
+	ManagementHint string   `json:"managementHint,omitempty"`
+}
+
+// SSOCertificate represents the keystore holding the certificate used to sign SAML requests
+type SSOCertificate struct {
+	Keystore        *SSOKeystore        `json:"keystore,omitempty"`
+	KeystoreDetails *SSOKeystoreDetails `json:"keystoreDetails,omitempty"`
+}
+
+// SSOKeystore holds how the SAML signing keystore was set up and the keys it contains
+type SSOKeystore struct {
+	Keys              []SSOKeystoreKey `json:"keys,omitempty"`
+	Key               string           `json:"key,omitempty"`
+	Type              string           `json:"type"`
+	KeystoreSetupType string           `json:"keystoreSetupType"`
+	KeystoreFileName  string           `json:"keystoreFileName,omitempty"`
+}
+
+// SSOKeystoreKey holds a key available in the SAML signing keystore
+type SSOKeystoreKey struct {
+	ID    string `json:"id"`
+	Valid bool   `json:"valid"`
+}
+
+// SSOKeystoreDetails holds the details of the certificate used to sign SAML requests
+type SSOKeystoreDetails struct {
+	Keys         []string `json:"keys,omitempty"`
+	SerialNumber int64    `json:"serialNumber"`
+	Subject      string   `json:"subject"`
+	Issuer       string   `json:"issuer"`
+	Expiration   string   `json:"expiration"`
+}
+
+// SSOFailover represents the URL used to bypass single sign-on, GenerationTime is in milliseconds since the epoch
+type SSOFailover struct {
+	FailoverURL    string `json:"failoverUrl"`
+	GenerationTime int64  `json:"generationTime"`
+}
+
+// Generated returns when the failover URL was generated
+func (f *SSOFailover) Generated() time.Time {
+	return time.UnixMilli(f.GenerationTime)
+}
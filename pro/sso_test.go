@@ -0,0 +1,185 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var SSO_API_BASE_ENDPOINT = "/api/v2/sso"
+
+const ssoCertificatePEM = "-----BEGIN CERTIFICATE-----\nMIIFake\n-----END CERTIFICATE-----\n"
+
+func ssoResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := &pro.SSOSettings{
+		SSOEnabled:        true,
+		ConfigurationType: pro.SSOConfigurationTypeSAML,
+		SAMLSettings: &pro.SSOSAMLSettings{
+			EntityID:        "https://jamf.example.com/saml/metadata",
+			MetadataSource:  "URL",
+			IDPURL:          "https://idp.example.com/metadata",
+			UserMapping:     "EMAIL",
+			IDPProviderType: "OKTA",
+		},
+		SSOBypassAllowed:        true,
+		SSOForEnrollmentEnabled: true,
+		EnrollmentSSOConfig:     &pro.SSOEnrollmentConfig{Hosts: []string{"example.com"}},
+	}
+	serial := int64(1000)
+	certificate := func() *pro.SSOCertificate {
+		return &pro.SSOCertificate{
+			Keystore:        &pro.SSOKeystore{Type: "JKS", KeystoreSetupType: "GENERATED", Keys: []pro.SSOKeystoreKey{{ID: "1", Valid: true}}},
+			KeystoreDetails: &pro.SSOKeystoreDetails{SerialNumber: serial, Subject: "CN=jamf.example.com", Issuer: "CN=jamf.example.com", Expiration: "2034-10-01T12:00:00Z"},
+		}
+	}
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			settings = &pro.SSOSettings{}
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	mux.HandleFunc("/api/v1/sso/disable", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		settings.SSOEnabled = false
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT+"/dependencies", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"dependencies": [{"name": "Staff Enrollment", "hyperlink": "/enrollment-customizations/1", "humanReadableName": "Enrollment Customization"}]}`)
+	})
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT+"/cert", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			serial++
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(certificate()))
+	})
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT+"/cert/download", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "text/plain", r.Header.Get("Accept"))
+		fmt.Fprint(w, ssoCertificatePEM)
+	})
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT+"/metadata/download", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<md:EntityDescriptor entityID="https://jamf.example.com/saml/metadata"/>`)
+	})
+	failover := pro.SSOFailover{FailoverURL: "https://jamf.example.com/?failover=a1b2c3", GenerationTime: 1727784000000}
+	mux.HandleFunc("/api/v1/sso/failover", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(failover))
+	})
+	mux.HandleFunc("/api/v1/sso/failover/generate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		failover = pro.SSOFailover{FailoverURL: "https://jamf.example.com/?failover=d4e5f6", GenerationTime: 1730462400000}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(failover))
+	})
+	mux.HandleFunc(SSO_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": 1, "username": "admin", "date": "2024-10-01T12:00:00Z", "note": "SSO enabled"}]}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "2", "href": "/api/v2/sso/history/2"}`)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestSSOSettings(t *testing.T) {
+	testServer := ssoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.SSOSettings(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, settings.SSOEnabled)
+	assert.Equal(t, "OKTA", settings.SAMLSettings.IDPProviderType)
+
+	_, err = j.UpdateSSOSettings(context.Background(), nil)
+	assert.NotNil(t, err)
+
+	settings.SAMLSettings.SessionTimeout = 480
+	settings.EnrollmentSSOConfig.Hosts = append(settings.EnrollmentSSOConfig.Hosts, "students.example.com")
+	updated, err := j.UpdateSSOSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.Equal(t, 480, updated.SAMLSettings.SessionTimeout)
+	assert.Len(t, updated.EnrollmentSSOConfig.Hosts, 2)
+
+	dependencies, err := j.SSODependencies(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "Staff Enrollment", dependencies[0].Name)
+
+	assert.Nil(t, j.DisableSSO(context.Background()))
+	settings, err = j.SSOSettings(context.Background())
+	assert.Nil(t, err)
+	assert.False(t, settings.SSOEnabled)
+
+	metadata := &bytes.Buffer{}
+	assert.Nil(t, j.DownloadSSOMetadata(context.Background(), metadata))
+	assert.Contains(t, metadata.String(), "EntityDescriptor")
+
+	history, err := j.SSOHistory(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "SSO enabled", history.Results[0].Note)
+	_, err = j.AddSSOHistoryNote(context.Background(), "SSO disabled for IdP migration")
+	assert.Nil(t, err)
+}
+
+func TestSSOCertificate(t *testing.T) {
+	testServer := ssoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	certificate, err := j.SSOCertificate(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1000), certificate.KeystoreDetails.SerialNumber)
+	assert.True(t, certificate.Keystore.Keys[0].Valid)
+
+	certificate, err = j.RegenerateSSOCertificate(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1001), certificate.KeystoreDetails.SerialNumber)
+
+	pem := &bytes.Buffer{}
+	assert.Nil(t, j.DownloadSSOCertificate(context.Background(), pem))
+	assert.Equal(t, ssoCertificatePEM, pem.String())
+
+	assert.Nil(t, j.DeleteSSOCertificate(context.Background()))
+}
+
+func TestSSOFailover(t *testing.T) {
+	testServer := ssoResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	failover, err := j.SSOFailover(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jamf.example.com/?failover=a1b2c3", failover.FailoverURL)
+	assert.Equal(t, time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), failover.Generated().UTC())
+
+	regenerated, err := j.RegenerateSSOFailover(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "https://jamf.example.com/?failover=d4e5f6", regenerated.FailoverURL)
+	assert.True(t, regenerated.Generated().After(failover.Generated()))
+
+	failover, err = j.SSOFailover(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, regenerated.FailoverURL, failover.FailoverURL)
+}
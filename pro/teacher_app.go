@@ -0,0 +1,36 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+const teacherAppContext = "teacher-app"
+
+// TeacherAppSettings returns the Jamf Teacher settings used to restrict student devices during class
+func (j *Client) TeacherAppSettings(ctx context.Context) (*TeacherAppSettings, error) {
+	ep := j.endpoint(1, teacherAppContext)
+	res := &TeacherAppSettings{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query Teacher app settings from %s", ep)
+	}
+	return res, nil
+}
+
+// UpdateTeacherAppSettings will replace the Jamf Teacher settings in Jamf
+func (j *Client) UpdateTeacherAppSettings(ctx context.Context, settings *TeacherAppSettings) (*TeacherAppSettings, error) {
+	ep := j.endpoint(1, teacherAppContext)
+	if settings == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for Teacher app settings: (%s)", ep)
+	}
+
+	res := &TeacherAppSettings{}
+	if err := j.do(ctx, "PUT", ep, settings, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for Teacher app settings (%s)", ep)
+	}
+	return res, nil
+}
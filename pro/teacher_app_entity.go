@@ -0,0 +1,20 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// TeacherAppSettings represents the Jamf Teacher settings, MaxRestrictionLengthSeconds caps how long
+// a teacher can lock student devices to the safelisted apps and AutoClear lifts restrictions at the
+// end of the school day in the given time zone
+type TeacherAppSettings struct {
+	Enabled                     bool                      `json:"isEnabled"`
+	TimezoneID                  string                    `json:"timezoneId"`
+	AutoClear                   string                    `json:"autoClear"`
+	MaxRestrictionLengthSeconds int                       `json:"maxRestrictionLengthSeconds"`
+	SafelistedApps              []TeacherAppSafelistedApp `json:"safelistedApps"`
+}
+
+// TeacherAppSafelistedApp is an app teachers can restrict student devices to
+type TeacherAppSafelistedApp struct {
+	Name     string `json:"name"`
+	BundleID string `json:"bundleId"`
+}
@@ -0,0 +1,67 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var TEACHER_APP_API_BASE_ENDPOINT = "/api/v1/teacher-app"
+
+func teacherAppResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	settings := pro.TeacherAppSettings{
+		Enabled:                     true,
+		TimezoneID:                  "America/New_York",
+		AutoClear:                   "15:30",
+		MaxRestrictionLengthSeconds: 3600,
+		SafelistedApps:              []pro.TeacherAppSafelistedApp{{Name: "Pages", BundleID: "com.apple.Pages"}},
+	}
+	mux.HandleFunc(TEACHER_APP_API_BASE_ENDPOINT, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&settings))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(settings))
+	})
+	mux.HandleFunc(TEACHER_APP_API_BASE_ENDPOINT+"/history", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case "GET":
+			fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": "1", "href": "/api/v1/teacher-app/history/1"}`)
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestTeacherAppSettings(t *testing.T) {
+	testServer := teacherAppResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	settings, err := j.TeacherAppSettings(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, settings.Enabled)
+	assert.Equal(t, 3600, settings.MaxRestrictionLengthSeconds)
+	assert.Equal(t, "com.apple.Pages", settings.SafelistedApps[0].BundleID)
+
+	settings.SafelistedApps = append(settings.SafelistedApps, pro.TeacherAppSafelistedApp{Name: "Keynote", BundleID: "com.apple.Keynote"})
+	updated, err := j.UpdateTeacherAppSettings(context.Background(), settings)
+	assert.Nil(t, err)
+	assert.Len(t, updated.SafelistedApps, 2)
+
+	note, err := j.AddHistoryNote(context.Background(), pro.HistoryResourceTeacherApp, "", "Added Keynote to safelist")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", note.ID)
+}
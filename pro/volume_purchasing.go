@@ -0,0 +1,209 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	volumePurchasingLocationsContext     = "volume-purchasing-locations"
+	volumePurchasingSubscriptionsContext = "volume-purchasing-subscriptions"
+)
+
+// VolumePurchasingLocations returns a single page of volume purchasing locations matching opts
+func (j *Client) VolumePurchasingLocations(ctx context.Context, opts *ListOptions) (*Results[VolumePurchasingLocation], error) {
+	ep := j.endpoint(1, volumePurchasingLocationsContext)
+	res, err := listPage[VolumePurchasingLocation](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query volume purchasing locations from %s", ep)
+	}
+	return res, nil
+}
+
+// AllVolumePurchasingLocations returns every volume purchasing location matching opts, requesting each page in turn
+func (j *Client) AllVolumePurchasingLocations(ctx context.Context, opts *ListOptions) ([]VolumePurchasingLocation, error) {
+	ep := j.endpoint(1, volumePurchasingLocationsContext)
+	res, err := listAll[VolumePurchasingLocation](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all volume purchasing locations from %s", ep)
+	}
+	return res, nil
+}
+
+// VolumePurchasingLocationDetails returns the details for a specific volume purchasing location given its ID
+func (j *Client) VolumePurchasingLocationDetails(ctx context.Context, id string) (*VolumePurchasingLocation, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingLocationsContext, url.PathEscape(id)))
+	res := &VolumePurchasingLocation{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query volume purchasing location with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateVolumePurchasingLocation will create a new volume purchasing location in Jamf
+func (j *Client) CreateVolumePurchasingLocation(ctx context.Context, content *VolumePurchasingLocation) (*CreatedResource, error) {
+	ep := j.endpoint(1, volumePurchasingLocationsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for volume purchasing location: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *VolumePurchasingLocation) *string { return &c.SiteID })
+	if content.ServiceToken == "" {
+		return nil, errors.Wrapf(fmt.Errorf("service token required for new volume purchasing location"), "unable to process JAMF creation request for volume purchasing location: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for volume purchasing location %s on %s", content.ServiceToken, ep)
+	}
+	return res, nil
+}
+
+// UpdateVolumePurchasingLocation will update a volume purchasing location in Jamf given its ID, set ServiceToken
+// to renew the location's token
+func (j *Client) UpdateVolumePurchasingLocation(ctx context.Context, id string, content *VolumePurchasingLocation) (*VolumePurchasingLocation, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingLocationsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for volume purchasing location: %s (%s)", id, ep)
+	}
+
+	res := &VolumePurchasingLocation{}
+	if err := j.do(ctx, "PATCH", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for volume purchasing location: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteVolumePurchasingLocation will delete a volume purchasing location given its ID
+func (j *Client) DeleteVolumePurchasingLocation(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingLocationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for volume purchasing location %s from %s", id, ep)
+	}
+	return nil
+}
+
+// VolumePurchasingLocationContent returns a single page of the apps and books purchased by a volume purchasing location
+func (j *Client) VolumePurchasingLocationContent(ctx context.Context, id string, opts *ListOptions) (*Results[VolumePurchasingContent], error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/content", volumePurchasingLocationsContext, url.PathEscape(id)))
+	res, err := listPage[VolumePurchasingContent](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query content for volume purchasing location with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// AllVolumePurchasingLocationContent returns every app and book purchased by a volume purchasing location,
+// requesting each page in turn
+func (j *Client) AllVolumePurchasingLocationContent(ctx context.Context, id string, opts *ListOptions) ([]VolumePurchasingContent, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/content", volumePurchasingLocationsContext, url.PathEscape(id)))
+	res, err := listAll[VolumePurchasingContent](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all content for volume purchasing location with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// ReclaimVolumePurchasingLocation asks Jamf to sync licenses and content with Apple for a volume purchasing location
+func (j *Client) ReclaimVolumePurchasingLocation(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/reclaim", volumePurchasingLocationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to reclaim volume purchasing location with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// RevokeVolumePurchasingLocationLicenses revokes every license assigned through a volume purchasing location,
+// Apple processes the revocation asynchronously
+func (j *Client) RevokeVolumePurchasingLocationLicenses(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s/revoke-licenses", volumePurchasingLocationsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "POST", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to revoke licenses of volume purchasing location with ID %s on %s", id, ep)
+	}
+	return nil
+}
+
+// VolumePurchasingLocationHistory returns a single page of the change history for a volume purchasing location given its ID
+func (j *Client) VolumePurchasingLocationHistory(ctx context.Context, id string, opts *ListOptions) (*Results[HistoryEntry], error) {
+	return j.History(ctx, HistoryResourceVolumePurchasingLocations, id, opts)
+}
+
+// AddVolumePurchasingLocationHistoryNote adds a note to the change history for a volume purchasing location given its ID
+func (j *Client) AddVolumePurchasingLocationHistoryNote(ctx context.Context, id string, note string) (*CreatedResource, error) {
+	return j.AddHistoryNote(ctx, HistoryResourceVolumePurchasingLocations, id, note)
+}
+
+// VolumePurchasingSubscriptions returns a single page of volume purchasing subscriptions matching opts
+func (j *Client) VolumePurchasingSubscriptions(ctx context.Context, opts *ListOptions) (*Results[VolumePurchasingSubscription], error) {
+	ep := j.endpoint(1, volumePurchasingSubscriptionsContext)
+	res, err := listPage[VolumePurchasingSubscription](ctx, j, ep, opts.values())
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query volume purchasing subscriptions from %s", ep)
+	}
+	return res, nil
+}
+
+// AllVolumePurchasingSubscriptions returns every volume purchasing subscription matching opts, requesting each page in turn
+func (j *Client) AllVolumePurchasingSubscriptions(ctx context.Context, opts *ListOptions) ([]VolumePurchasingSubscription, error) {
+	ep := j.endpoint(1, volumePurchasingSubscriptionsContext)
+	res, err := listAll[VolumePurchasingSubscription](ctx, j, ep, opts, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to query all volume purchasing subscriptions from %s", ep)
+	}
+	return res, nil
+}
+
+// VolumePurchasingSubscriptionDetails returns the details for a specific volume purchasing subscription given its ID
+func (j *Client) VolumePurchasingSubscriptionDetails(ctx context.Context, id string) (*VolumePurchasingSubscription, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingSubscriptionsContext, url.PathEscape(id)))
+	res := &VolumePurchasingSubscription{}
+	if err := j.do(ctx, "GET", ep, nil, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to query volume purchasing subscription with ID %s from %s", id, ep)
+	}
+	return res, nil
+}
+
+// CreateVolumePurchasingSubscription will create a new volume purchasing subscription in Jamf
+func (j *Client) CreateVolumePurchasingSubscription(ctx context.Context, content *VolumePurchasingSubscription) (*CreatedResource, error) {
+	ep := j.endpoint(1, volumePurchasingSubscriptionsContext)
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF creation request for volume purchasing subscription: (%s)", ep)
+	}
+	content = withSite(j, content, func(c *VolumePurchasingSubscription) *string { return &c.SiteID })
+	if content.Name == "" {
+		return nil, errors.Wrapf(fmt.Errorf("name required for new volume purchasing subscription"), "unable to process JAMF creation request for volume purchasing subscription: (%s)", ep)
+	}
+
+	res := &CreatedResource{}
+	if err := j.do(ctx, "POST", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF creation request for volume purchasing subscription %s on %s", content.Name, ep)
+	}
+	return res, nil
+}
+
+// UpdateVolumePurchasingSubscription will replace a volume purchasing subscription in Jamf given its ID
+func (j *Client) UpdateVolumePurchasingSubscription(ctx context.Context, id string, content *VolumePurchasingSubscription) (*VolumePurchasingSubscription, error) {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingSubscriptionsContext, url.PathEscape(id)))
+	if content == nil {
+		return nil, errors.Wrapf(fmt.Errorf("empty payload"), "unable to process JAMF update request for volume purchasing subscription: %s (%s)", id, ep)
+	}
+
+	res := &VolumePurchasingSubscription{}
+	if err := j.do(ctx, "PUT", ep, content, res); err != nil {
+		return nil, errors.Wrapf(err, "unable to process JAMF update request for volume purchasing subscription: %s (%s)", id, ep)
+	}
+	return res, nil
+}
+
+// DeleteVolumePurchasingSubscription will delete a volume purchasing subscription given its ID
+func (j *Client) DeleteVolumePurchasingSubscription(ctx context.Context, id string) error {
+	ep := j.endpoint(1, fmt.Sprintf("%s/%s", volumePurchasingSubscriptionsContext, url.PathEscape(id)))
+	if err := j.do(ctx, "DELETE", ep, nil, nil); err != nil {
+		return errors.Wrapf(err, "unable to process JAMF deletion request for volume purchasing subscription %s from %s", id, ep)
+	}
+	return nil
+}
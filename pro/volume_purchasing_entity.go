@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro
+
+// Events a volume purchasing subscription notifies its recipients of
+const (
+	VolumePurchasingTriggerNoMoreLicenses      = "NO_MORE_LICENSES"
+	VolumePurchasingTriggerRemovedFromAppStore = "REMOVED_FROM_APP_STORE"
+)
+
+// VolumePurchasingLocation represents an Apple Business Manager or Apple School Manager location
+// whose Apps and Books purchases are synced to Jamf. ServiceToken is the base64 encoded token
+// downloaded from Apple and is only sent when creating or renewing the location.
+type VolumePurchasingLocation struct {
+	ID                                    string `json:"id,omitempty"`
+	Name                                  string `json:"name,omitempty"`
+	AppleID                               string `json:"appleId,omitempty"`
+	OrganizationName                      string `json:"organizationName,omitempty"`
+	TokenExpiration                       string `json:"tokenExpiration,omitempty"`
+	CountryCode                           string `json:"countryCode,omitempty"`
+	LocationName                          string `json:"locationName,omitempty"`
+	ClientContextMismatch                 bool   `json:"clientContextMismatch,omitempty"`
+	AutomaticallyPopulatePurchasedContent bool   `json:"automaticallyPopulatePurchasedContent"`
+	SendNotificationWhenNoLongerAssigned  bool   `json:"sendNotificationWhenNoLongerAssigned"`
+	AutoRegisterManagedUsers              bool   `json:"autoRegisterManagedUsers"`
+	SiteID                                string `json:"siteId,omitempty"`
+	LastSyncTime                          string `json:"lastSyncTime,omitempty"`
+	TotalPurchasedLicenses                int    `json:"totalPurchasedLicenses,omitempty"`
+	TotalUsedLicenses                     int    `json:"totalUsedLicenses,omitempty"`
+	ServiceToken                          string `json:"serviceToken,omitempty"`
+}
+
+// VolumePurchasingContent represents an app or book purchased by a volume purchasing location
+type VolumePurchasingContent struct {
+	Name                 string   `json:"name"`
+	LicenseCountTotal    int      `json:"licenseCountTotal"`
+	LicenseCountInUse    int      `json:"licenseCountInUse"`
+	LicenseCountReported int      `json:"licenseCountReported"`
+	IconURL              string   `json:"iconUrl,omitempty"`
+	DeviceTypes          []string `json:"deviceTypes,omitempty"`
+	ContentType          string   `json:"contentType"`
+	PricingParam         string   `json:"pricingParam,omitempty"`
+	AdamID               string   `json:"adamId"`
+}
+
+// VolumePurchasingSubscription notifies recipients when licenses of its locations run out or
+// content is removed from the App Store
+type VolumePurchasingSubscription struct {
+	ID                 string                              `json:"id,omitempty"`
+	Name               string                              `json:"name"`
+	Enabled            bool                                `json:"enabled"`
+	Triggers           []string                            `json:"triggers,omitempty"`
+	LocationIDs        []string                            `json:"locationIds,omitempty"`
+	InternalRecipients []VolumePurchasingInternalRecipient `json:"internalRecipients,omitempty"`
+	ExternalRecipients []VolumePurchasingExternalRecipient `json:"externalRecipients,omitempty"`
+	SiteID             string                              `json:"siteId,omitempty"`
+}
+
+// VolumePurchasingInternalRecipient is a Jamf Pro account notified by a subscription
+type VolumePurchasingInternalRecipient struct {
+	AccountID string `json:"accountId"`
+	Frequency string `json:"frequency,omitempty"`
+}
+
+// VolumePurchasingExternalRecipient is an email address notified by a subscription
+type VolumePurchasingExternalRecipient struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email"`
+}
@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package pro_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/stretchr/testify/assert"
+)
+
+var VOLUME_PURCHASING_LOCATIONS_API_BASE_ENDPOINT = "/api/v1/volume-purchasing-locations"
+var VOLUME_PURCHASING_SUBSCRIPTIONS_API_BASE_ENDPOINT = "/api/v1/volume-purchasing-subscriptions"
+
+func volumePurchasingResponseMocks(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	locations := &crudMock[pro.VolumePurchasingLocation]{
+		t:      t,
+		base:   VOLUME_PURCHASING_LOCATIONS_API_BASE_ENDPOINT,
+		nextID: 1,
+		items: []pro.VolumePurchasingLocation{
+			{ID: "1", Name: "Example Inc", AppleID: "vpp@example.com", LocationName: "Headquarters", CountryCode: "US", TotalPurchasedLicenses: 200, TotalUsedLicenses: 150},
+		},
+		getID: func(x pro.VolumePurchasingLocation) string { return x.ID },
+		setID: func(x *pro.VolumePurchasingLocation, id string) { x.ID = id },
+	}
+	subscriptions := &crudMock[pro.VolumePurchasingSubscription]{
+		t:     t,
+		base:  VOLUME_PURCHASING_SUBSCRIPTIONS_API_BASE_ENDPOINT,
+		items: []pro.VolumePurchasingSubscription{},
+		getID: func(x pro.VolumePurchasingSubscription) string { return x.ID },
+		setID: func(x *pro.VolumePurchasingSubscription, id string) { x.ID = id },
+	}
+	content := []pro.VolumePurchasingContent{
+		{Name: "Keynote", LicenseCountTotal: 100, LicenseCountInUse: 90, ContentType: "iOS", AdamID: "361285480"},
+		{Name: "Xcode", LicenseCountTotal: 100, LicenseCountInUse: 60, ContentType: "MAC_OS", AdamID: "497799835"},
+	}
+	mux.Handle(locations.base, locations)
+	mux.Handle(locations.base+"/", locations)
+	mux.Handle(subscriptions.base, subscriptions)
+	mux.Handle(subscriptions.base+"/", subscriptions)
+	mux.HandleFunc(locations.base+"/1/content", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Nil(t, json.NewEncoder(w).Encode(pageOf(t, r, content)))
+	})
+	mux.HandleFunc(locations.base+"/1/revoke-licenses", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		for i := range content {
+			content[i].LicenseCountInUse = 0
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc(locations.base+"/1/reclaim", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		locations.items[0].LastSyncTime = "2024-10-01T12:00:00Z"
+		w.WriteHeader(http.StatusAccepted)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestVolumePurchasingLocations(t *testing.T) {
+	testServer := volumePurchasingResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateVolumePurchasingLocation(context.Background(), &pro.VolumePurchasingLocation{Name: "Branch"})
+	assert.Contains(t, err.Error(), "service token required")
+	created, err := j.CreateVolumePurchasingLocation(context.Background(), &pro.VolumePurchasingLocation{Name: "Branch", ServiceToken: "ZmFrZS10b2tlbg=="})
+	assert.Nil(t, err)
+	assert.Equal(t, "2", created.ID)
+
+	locations, err := j.AllVolumePurchasingLocations(context.Background(), &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Len(t, locations, 2)
+
+	updated, err := j.UpdateVolumePurchasingLocation(context.Background(), "1", &pro.VolumePurchasingLocation{AutomaticallyPopulatePurchasedContent: true})
+	assert.Nil(t, err)
+	assert.True(t, updated.AutomaticallyPopulatePurchasedContent)
+	assert.Equal(t, "Example Inc", updated.Name)
+
+	assert.Nil(t, j.ReclaimVolumePurchasingLocation(context.Background(), "1"))
+	location, err := j.VolumePurchasingLocationDetails(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.NotEmpty(t, location.LastSyncTime)
+
+	_, err = j.AddVolumePurchasingLocationHistoryNote(context.Background(), "1", "Token renewed")
+	assert.Nil(t, err)
+	history, err := j.VolumePurchasingLocationHistory(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "Token renewed", history.Results[0].Note)
+
+	assert.Nil(t, j.DeleteVolumePurchasingLocation(context.Background(), created.ID))
+	_, err = j.VolumePurchasingLocationDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
+
+func TestVolumePurchasingLocationContent(t *testing.T) {
+	testServer := volumePurchasingResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	page, err := j.VolumePurchasingLocationContent(context.Background(), "1", &pro.ListOptions{PageSize: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, page.TotalCount)
+	assert.Equal(t, "Keynote", page.Results[0].Name)
+
+	assert.Nil(t, j.RevokeVolumePurchasingLocationLicenses(context.Background(), "1"))
+	content, err := j.AllVolumePurchasingLocationContent(context.Background(), "1", nil)
+	assert.Nil(t, err)
+	assert.Len(t, content, 2)
+	for _, item := range content {
+		assert.Zero(t, item.LicenseCountInUse)
+	}
+}
+
+func TestVolumePurchasingSubscriptions(t *testing.T) {
+	testServer := volumePurchasingResponseMocks(t)
+	defer testServer.Close()
+	j := newTestClient(t, testServer)
+
+	_, err := j.CreateVolumePurchasingSubscription(context.Background(), &pro.VolumePurchasingSubscription{})
+	assert.Contains(t, err.Error(), "name required")
+	created, err := j.CreateVolumePurchasingSubscription(context.Background(), &pro.VolumePurchasingSubscription{
+		Name:               "License alerts",
+		Enabled:            true,
+		Triggers:           []string{pro.VolumePurchasingTriggerNoMoreLicenses},
+		LocationIDs:        []string{"1"},
+		ExternalRecipients: []pro.VolumePurchasingExternalRecipient{{Name: "IT", Email: "it@example.com"}},
+	})
+	assert.Nil(t, err)
+
+	subscription, err := j.VolumePurchasingSubscriptionDetails(context.Background(), created.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, "it@example.com", subscription.ExternalRecipients[0].Email)
+
+	subscription.Triggers = append(subscription.Triggers, pro.VolumePurchasingTriggerRemovedFromAppStore)
+	updated, err := j.UpdateVolumePurchasingSubscription(context.Background(), created.ID, subscription)
+	assert.Nil(t, err)
+	assert.Len(t, updated.Triggers, 2)
+
+	page, err := j.VolumePurchasingSubscriptions(context.Background(), nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, page.TotalCount)
+
+	assert.Nil(t, j.DeleteVolumePurchasingSubscription(context.Background(), created.ID))
+	_, err = j.VolumePurchasingSubscriptionDetails(context.Background(), created.ID)
+	assert.True(t, pro.IsNotFound(err))
+}
@@ -0,0 +1,231 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package queue schedules the requests made to a Jamf server by priority so that interactive calls,
+// e.g. the lookups of a help-desk tool, are not starved by bulk traffic such as background syncs
+// sharing the same server. A Queue is an http.RoundTripper which bounds the number of requests in
+// flight and sends the waiting requests with the highest priority first.
+//
+//	q := &queue.Queue{Concurrency: 4, Limiter: batch.Every(time.Second / 10)}
+//	helpdesk, _ := pro.NewClient(domain, username, password, q.Client(queue.Interactive))
+//	syncer, _ := pro.NewClient(domain, username, password, q.Client(queue.Batch))
+package queue
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/batch"
+)
+
+// DefaultConcurrency is the number of requests in flight when Queue.Concurrency is not set
+const DefaultConcurrency = 4
+
+// Priority is the class of a request, requests of a higher priority are sent first
+type Priority int
+
+// Priorities supported by a Queue, requests without a priority are sent as Batch
+const (
+	Batch Priority = iota
+	Interactive
+)
+
+const priorities = int(Interactive) + 1
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose requests are sent with priority p, overriding the priority of
+// the client making them
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFrom returns the priority set on ctx by WithPriority
+func PriorityFrom(ctx context.Context) (Priority, bool) {
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	return p, ok
+}
+
+// Queue is an http.RoundTripper sending at most Concurrency requests at a time, the waiting requests
+// being sent by priority and then in the order they were made. Its zero value sends DefaultConcurrency
+// requests at a time through http.DefaultTransport. A Queue must not be copied after first use.
+type Queue struct {
+	// Concurrency is the maximum number of requests in flight, a request is in flight until the body
+	// of its response is closed
+	Concurrency int
+	// Limiter, when set, is waited on before each request is sent, once it has left the queue, e.g.
+	// batch.Every(time.Second / 10) to respect the rate limits of the Jamf server
+	Limiter batch.Limiter
+	// Transport sends the requests, http.DefaultTransport when nil
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	inFlight int
+	waiting  [priorities][]*waiter
+}
+
+// waiter is a request waiting in the queue, ready is closed when it may be sent
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+// Client returns an HTTP client sending its requests through the queue with priority p, unless the
+// context of a request sets another one with WithPriority
+func (q *Queue) Client(p Priority) *http.Client {
+	return &http.Client{
+		Timeout:   time.Minute,
+		Transport: &prioritized{queue: q, priority: p},
+	}
+}
+
+// Waiting returns the number of requests of priority p waiting to be sent
+func (q *Queue) Waiting(p Priority) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.waiting[p])
+}
+
+// RoundTrip sends r once the queue allows it, with the priority of its context or Batch
+func (q *Queue) RoundTrip(r *http.Request) (*http.Response, error) {
+	p, _ := PriorityFrom(r.Context())
+	return q.roundTrip(r, p)
+}
+
+func (q *Queue) roundTrip(r *http.Request, p Priority) (*http.Response, error) {
+	if p < Batch || int(p) >= priorities {
+		p = Batch
+	}
+	if err := q.acquire(r.Context(), p); err != nil {
+		closeBody(r)
+		return nil, err
+	}
+	if q.Limiter != nil {
+		if err := q.Limiter.Wait(r.Context()); err != nil {
+			q.release()
+			closeBody(r)
+			return nil, err
+		}
+	}
+
+	transport := q.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	res, err := transport.RoundTrip(r)
+	if err != nil {
+		q.release()
+		return nil, err
+	}
+	res.Body = &releaser{ReadCloser: res.Body, release: q.release}
+	return res, nil
+}
+
+// acquire blocks until a request of priority p may be sent or ctx is done
+func (q *Queue) acquire(ctx context.Context, p Priority) error {
+	q.mu.Lock()
+	if q.inFlight < q.concurrency() && q.empty() {
+		q.inFlight++
+		q.mu.Unlock()
+		return nil
+	}
+	w := &waiter{ready: make(chan struct{})}
+	q.waiting[p] = append(q.waiting[p], w)
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+		if w.granted {
+			// The request was allowed while it was canceled, let the next one go instead
+			q.inFlight--
+			q.dispatch()
+			return ctx.Err()
+		}
+		for i, other := range q.waiting[p] {
+			if other == w {
+				q.waiting[p] = append(q.waiting[p][:i], q.waiting[p][i+1:]...)
+				break
+			}
+		}
+		return ctx.Err()
+	}
+}
+
+// release ends a request in flight and lets the next waiting one go
+func (q *Queue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.inFlight--
+	q.dispatch()
+}
+
+// dispatch lets waiting requests go, highest priority first, while fewer than Concurrency are in flight
+func (q *Queue) dispatch() {
+	for p := priorities - 1; p >= 0 && q.inFlight < q.concurrency(); {
+		if len(q.waiting[p]) == 0 {
+			p--
+			continue
+		}
+		w := q.waiting[p][0]
+		q.waiting[p] = q.waiting[p][1:]
+		w.granted = true
+		q.inFlight++
+		close(w.ready)
+	}
+}
+
+func (q *Queue) empty() bool {
+	for _, waiting := range q.waiting {
+		if len(waiting) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *Queue) concurrency() int {
+	if q.Concurrency <= 0 {
+		return DefaultConcurrency
+	}
+	return q.Concurrency
+}
+
+// prioritized sends requests through a queue with a default priority
+type prioritized struct {
+	queue    *Queue
+	priority Priority
+}
+
+func (t *prioritized) RoundTrip(r *http.Request) (*http.Response, error) {
+	p, ok := PriorityFrom(r.Context())
+	if !ok {
+		p = t.priority
+	}
+	return t.queue.roundTrip(r, p)
+}
+
+// releaser releases the slot of a request once the body of its response is closed
+type releaser struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (r *releaser) Close() error {
+	err := r.ReadCloser.Close()
+	r.once.Do(r.release)
+	return err
+}
+
+// closeBody closes the body of a request which is not sent, as a RoundTripper must
+func closeBody(r *http.Request) {
+	if r.Body != nil {
+		r.Body.Close()
+	}
+}
@@ -0,0 +1,146 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package queue_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/batch"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/DataDog/jamf-api-client-go/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingServer records the paths requested, holding the requests to /block until unblock is closed
+type blockingServer struct {
+	*httptest.Server
+	unblock chan struct{}
+
+	mu    sync.Mutex
+	paths []string
+}
+
+func newBlockingServer(t *testing.T) *blockingServer {
+	s := &blockingServer{unblock: make(chan struct{})}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.paths = append(s.paths, r.URL.Path)
+		s.mu.Unlock()
+		if r.URL.Path == "/block" {
+			<-s.unblock
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func get(t *testing.T, client *http.Client, ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	assert.Nil(t, err)
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, res.Body)
+	return res.Body.Close()
+}
+
+// waitFor waits until the queue holds n requests of priority p
+func waitFor(t *testing.T, q *queue.Queue, p queue.Priority, n int) {
+	assert.Eventually(t, func() bool { return q.Waiting(p) == n }, time.Second, time.Millisecond)
+}
+
+func TestPriority(t *testing.T) {
+	server := newBlockingServer(t)
+	q := &queue.Queue{Concurrency: 1}
+	background, interactive := q.Client(queue.Batch), q.Client(queue.Interactive)
+	ctx := context.Background()
+
+	wg := sync.WaitGroup{}
+	send := func(client *http.Client, ctx context.Context, path string) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, get(t, client, ctx, server.URL+path))
+		}()
+	}
+	send(background, ctx, "/block")
+	assert.Eventually(t, func() bool { server.mu.Lock(); defer server.mu.Unlock(); return len(server.paths) == 1 }, time.Second, time.Millisecond)
+	send(background, ctx, "/batch-1")
+	waitFor(t, q, queue.Batch, 1)
+	send(background, ctx, "/batch-2")
+	waitFor(t, q, queue.Batch, 2)
+	send(interactive, ctx, "/interactive-1")
+	waitFor(t, q, queue.Interactive, 1)
+	send(background, queue.WithPriority(ctx, queue.Interactive), "/interactive-2")
+	waitFor(t, q, queue.Interactive, 2)
+
+	close(server.unblock)
+	wg.Wait()
+	assert.Equal(t, []string{"/block", "/interactive-1", "/interactive-2", "/batch-1", "/batch-2"}, server.paths)
+	assert.Equal(t, 0, q.Waiting(queue.Batch))
+}
+
+func TestCancel(t *testing.T) {
+	server := newBlockingServer(t)
+	q := &queue.Queue{Concurrency: 1}
+	client := q.Client(queue.Batch)
+
+	done := make(chan error)
+	go func() { done <- get(t, client, context.Background(), server.URL+"/block") }()
+	assert.Eventually(t, func() bool { server.mu.Lock(); defer server.mu.Unlock(); return len(server.paths) == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := make(chan error)
+	go func() { canceled <- get(t, client, ctx, server.URL+"/canceled") }()
+	waitFor(t, q, queue.Batch, 1)
+	cancel()
+	assert.NotNil(t, <-canceled)
+	assert.Equal(t, 0, q.Waiting(queue.Batch))
+
+	close(server.unblock)
+	assert.Nil(t, <-done)
+	assert.Nil(t, get(t, client, context.Background(), server.URL+"/after"))
+	assert.Equal(t, []string{"/block", "/after"}, server.paths)
+}
+
+func TestLimiter(t *testing.T) {
+	server := newBlockingServer(t)
+	q := &queue.Queue{Concurrency: 10, Limiter: batch.Every(20 * time.Millisecond)}
+	client := q.Client(queue.Interactive)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		assert.Nil(t, get(t, client, context.Background(), server.URL+"/limited"))
+	}
+	assert.True(t, time.Since(start) >= 60*time.Millisecond)
+}
+
+func TestWithClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+		case "/api/v1/jamf-pro-version":
+			fmt.Fprint(w, `{"version": "11.10.1"}`)
+		}
+	}))
+	defer server.Close()
+
+	q := &queue.Queue{}
+	j, err := pro.NewClient(server.URL, "api", "secret", q.Client(queue.Interactive))
+	assert.Nil(t, err)
+	for i := 0; i < 2*queue.DefaultConcurrency; i++ {
+		version, err := j.JamfProVersion(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "11.10.1", version.Version)
+	}
+}
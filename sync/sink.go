@@ -0,0 +1,43 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	gosync "sync"
+
+	"github.com/pkg/errors"
+)
+
+// Sink receives the records of a synchronization, e.g. to write them to a database or publish them to
+// a message queue. Write is called concurrently and must return once the record is durably handled,
+// since the checkpoint moves past records which were written.
+type Sink interface {
+	Write(ctx context.Context, record *Record) error
+}
+
+// SinkFunc adapts a function to a Sink
+type SinkFunc func(ctx context.Context, record *Record) error
+
+// Write calls f
+func (f SinkFunc) Write(ctx context.Context, record *Record) error {
+	return f(ctx, record)
+}
+
+type jsonSink struct {
+	mu      gosync.Mutex
+	encoder *json.Encoder
+}
+
+// NewJSONSink returns a Sink writing each record to w as a line of JSON
+func NewJSONSink(w io.Writer) Sink {
+	return &jsonSink{encoder: json.NewEncoder(w)}
+}
+
+func (s *jsonSink) Write(ctx context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return errors.Wrap(s.encoder.Encode(record), "unable to encode record")
+}
@@ -0,0 +1,27 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package sync
+
+import (
+	"github.com/DataDog/jamf-api-client-go/internal/checkpoint"
+)
+
+// Store persists the ID of the last device written by a synchronization, an empty ID meaning the
+// synchronization completed or never ran
+type Store = checkpoint.Store[string]
+
+// MemoryStore keeps checkpoints in memory, for the lifetime of a long running process or tests
+type MemoryStore = checkpoint.MemoryStore[string]
+
+// FileStore keeps checkpoints in a JSON file mapping keys to IDs
+type FileStore = checkpoint.FileStore[string]
+
+// NewMemoryStore returns an empty MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return checkpoint.NewMemoryStore[string]()
+}
+
+// NewFileStore returns a FileStore persisting checkpoints to path, which is created on the first save
+func NewFileStore(path string) *FileStore {
+	return checkpoint.NewFileStore[string](path)
+}
@@ -0,0 +1,28 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package sync_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store := sync.NewFileStore(path)
+
+	id, err := store.Load(ctx, "inventory/computer")
+	assert.Nil(t, err)
+	assert.Equal(t, "", id)
+
+	assert.Nil(t, store.Save(ctx, "inventory/computer", "42"))
+	assert.Nil(t, store.Save(ctx, "inventory/mobile_device", "7"))
+	id, err = sync.NewFileStore(path).Load(ctx, "inventory/computer")
+	assert.Nil(t, err)
+	assert.Equal(t, "42", id)
+}
@@ -0,0 +1,295 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package sync walks every computer, and optionally every mobile device, of a Jamf Pro server, fetches
+// their inventory with a pool of workers and writes each record to a Sink, e.g. a database, a message
+// queue or a file. An interrupted synchronization resumes from its last checkpoint when a Store is set.
+package sync
+
+import (
+	"context"
+	"iter"
+	"strconv"
+	gosync "sync"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/batch"
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/pkg/errors"
+)
+
+// Defaults used when the fields of an Engine are not set
+const (
+	DefaultKey             = "inventory"
+	DefaultConcurrency     = 4
+	DefaultCheckpointEvery = 100
+)
+
+// Kind is the kind of device of a record
+type Kind string
+
+// Kinds of devices synchronized
+const (
+	KindComputer     Kind = "computer"
+	KindMobileDevice Kind = "mobile_device"
+)
+
+// Record is the inventory of a single device, only the field matching its kind is set
+type Record struct {
+	Kind         Kind                       `json:"kind"`
+	ID           string                     `json:"id"`
+	Computer     *pro.ComputerInventory     `json:"computer,omitempty"`
+	MobileDevice *pro.MobileDeviceInventory `json:"mobile_device,omitempty"`
+}
+
+// Progress reports how far the synchronization of a kind of device went
+type Progress struct {
+	Kind Kind
+	// Total is the number of devices listed, including those skipped
+	Total int
+	// Skipped is the number of devices written by a previous synchronization which was resumed
+	Skipped int
+	// Written is the number of devices written to the sink
+	Written int
+}
+
+// Report holds the progress of each kind of device synchronized
+type Report struct {
+	Computers     Progress
+	MobileDevices Progress
+}
+
+// Engine synchronizes the inventory of a Jamf Pro server to a sink
+type Engine struct {
+	// Client is used to list the devices and query their inventory, only the devices of its site are
+	// synchronized when it is scoped with WithSite
+	Client *pro.Client
+	// Sink receives the records, Write is called concurrently by the workers
+	Sink Sink
+	// Store, when set, persists the ID of the last device written so that an interrupted
+	// synchronization is resumed instead of starting over
+	Store Store
+	// Key prefixes the checkpoints in the store, DefaultKey when empty
+	Key string
+	// Concurrency is the number of devices fetched and written at once, DefaultConcurrency when zero
+	Concurrency int
+	// CheckpointEvery is the number of records written between checkpoints, DefaultCheckpointEvery when zero
+	CheckpointEvery int
+	// Retries is the number of times a device failing with a transient error is fetched again
+	Retries int
+	// Backoff is the delay before fetching a device again, batch.DefaultBackoff when zero
+	Backoff time.Duration
+	// Limiter, when set, throttles the requests for the inventory of devices
+	Limiter batch.Limiter
+	// ComputerSections of the inventory to fetch, only GENERAL when empty
+	ComputerSections []pro.ComputerInventorySection
+	// MobileDevices enables the synchronization of mobile devices once the computers are synchronized
+	MobileDevices bool
+	// MobileDeviceSections of the inventory to fetch, only GENERAL when empty
+	MobileDeviceSections []pro.MobileDeviceSection
+	// Progress, when set, is called each time a record is written
+	Progress func(Progress)
+}
+
+// Run synchronizes every computer and, when MobileDevices is set, every mobile device. The first
+// error fetching or writing a record stops the synchronization, which resumes from the last
+// checkpoint on the next Run.
+func (e *Engine) Run(ctx context.Context) (*Report, error) {
+	report := &Report{}
+	var err error
+	report.Computers, err = e.run(ctx, KindComputer, e.computerIDs(ctx), func(ctx context.Context, id string) (*Record, error) {
+		computer, err := e.Client.ComputerInventoryDetails(ctx, id, e.ComputerSections...)
+		return &Record{Kind: KindComputer, ID: id, Computer: computer}, err
+	})
+	if err != nil || !e.MobileDevices {
+		return report, err
+	}
+	report.MobileDevices, err = e.run(ctx, KindMobileDevice, e.mobileDeviceIDs(ctx), func(ctx context.Context, id string) (*Record, error) {
+		device, err := e.Client.MobileDeviceDetails(ctx, id, e.MobileDeviceSections...)
+		return &Record{Kind: KindMobileDevice, ID: id, MobileDevice: device}, err
+	})
+	return report, err
+}
+
+func (e *Engine) computerIDs(ctx context.Context) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		for computer, err := range e.Client.IterComputersInventory(ctx, &pro.ListOptions{Sort: []string{"id:asc"}}) {
+			if !yield(computer.ID, err) {
+				return
+			}
+		}
+	}
+}
+
+func (e *Engine) mobileDeviceIDs(ctx context.Context) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		// The inventory is listed rather than the devices, which are not filtered to the site of the client
+		for device, err := range e.Client.IterMobileDevicesInventory(ctx, &pro.ListOptions{Sort: []string{"mobileDeviceId:asc"}}) {
+			if !yield(device.MobileDeviceID, err) {
+				return
+			}
+		}
+	}
+}
+
+func (e *Engine) key(kind Kind) string {
+	if e.Key == "" {
+		return DefaultKey + "/" + string(kind)
+	}
+	return e.Key + "/" + string(kind)
+}
+
+func (e *Engine) run(ctx context.Context, kind Kind, list iter.Seq2[string, error], fetch func(context.Context, string) (*Record, error)) (Progress, error) {
+	progress := Progress{Kind: kind}
+	key := e.key(kind)
+	cursor := 0
+	if e.Store != nil {
+		saved, err := e.Store.Load(ctx, key)
+		if err != nil {
+			return progress, errors.Wrapf(err, "unable to load the %s checkpoint", kind)
+		}
+		if saved != "" {
+			if cursor, err = strconv.Atoi(saved); err != nil {
+				return progress, errors.Wrapf(err, "invalid %s checkpoint %q", kind, saved)
+			}
+		}
+	}
+
+	ids := []string{}
+	for id, err := range list {
+		if err != nil {
+			return progress, errors.Wrapf(err, "unable to list the %s IDs", kind)
+		}
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return progress, errors.Wrapf(err, "unexpected %s ID %q", kind, id)
+		}
+		progress.Total++
+		if n <= cursor {
+			progress.Skipped++
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	t := &tracker{engine: e, key: key, progress: progress, ids: ids, done: make([]bool, len(ids))}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	b := &batch.Batch{Concurrency: 1, Retries: e.Retries, Backoff: e.Backoff, Limiter: e.Limiter}
+
+	concurrency := e.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	indexes := make(chan int)
+	wg := gosync.WaitGroup{}
+	for w := 0; w < concurrency && w < len(ids); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				var record *Record
+				res := b.Run(ctx, []batch.Operation{{Key: ids[i], Do: func(ctx context.Context) (err error) {
+					record, err = fetch(ctx, ids[i])
+					return err
+				}}}).Results[0]
+				err := errors.Wrapf(res.Err, "unable to fetch %s %s", kind, ids[i])
+				if err == nil {
+					err = errors.Wrapf(e.Sink.Write(ctx, record), "unable to write %s %s", kind, ids[i])
+				}
+				if t.complete(ctx, i, err) != nil {
+					cancel()
+				}
+			}
+		}()
+	}
+feed:
+	for i := range ids {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	return t.finish(ctx)
+}
+
+// tracker records the devices written and checkpoints the ID of the last device written after which
+// every device was written too, since workers complete devices out of order
+type tracker struct {
+	engine *Engine
+	key    string
+
+	mu       gosync.Mutex
+	progress Progress
+	ids      []string
+	done     []bool
+	next     int
+	saved    int
+	err      error
+}
+
+func (t *tracker) complete(ctx context.Context, i int, err error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err != nil {
+		if t.err == nil {
+			t.err = err
+		}
+		return t.err
+	}
+	t.done[i] = true
+	for t.next < len(t.done) && t.done[t.next] {
+		t.next++
+	}
+	t.progress.Written++
+	if t.engine.Progress != nil {
+		t.engine.Progress(t.progress)
+	}
+	every := t.engine.CheckpointEvery
+	if every <= 0 {
+		every = DefaultCheckpointEvery
+	}
+	if t.next-t.saved >= every {
+		if err := t.checkpoint(ctx); err != nil && t.err == nil {
+			t.err = err
+		}
+	}
+	return t.err
+}
+
+func (t *tracker) checkpoint(ctx context.Context) error {
+	if t.engine.Store == nil || t.next == t.saved {
+		return nil
+	}
+	// the checkpoint is saved even when ctx is canceled so that the next Run resumes from it
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+	if err := t.engine.Store.Save(saveCtx, t.key, t.ids[t.next-1]); err != nil {
+		return errors.Wrapf(err, "unable to save the %s checkpoint", t.progress.Kind)
+	}
+	t.saved = t.next
+	return nil
+}
+
+func (t *tracker) finish(ctx context.Context) (Progress, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.err == nil && ctx.Err() == nil && t.next == len(t.ids) {
+		// every device was written, the next synchronization starts over
+		if t.engine.Store != nil {
+			if err := t.engine.Store.Save(ctx, t.key, ""); err != nil {
+				return t.progress, errors.Wrapf(err, "unable to reset the %s checkpoint", t.progress.Kind)
+			}
+		}
+		return t.progress, nil
+	}
+	if err := t.checkpoint(ctx); err != nil && t.err == nil {
+		t.err = err
+	}
+	if t.err != nil {
+		return t.progress, t.err
+	}
+	return t.progress, ctx.Err()
+}
@@ -0,0 +1,148 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package sync_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/pro"
+	"github.com/DataDog/jamf-api-client-go/sync"
+	"github.com/stretchr/testify/assert"
+)
+
+func fleetMocks(t *testing.T) *httptest.Server {
+	mu := gosync.Mutex{}
+	attempts := map[string]int{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts[r.URL.String()]++
+		attempt := attempts[r.URL.String()]
+		mu.Unlock()
+		switch {
+		case r.URL.Path == "/api/v1/auth/token":
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+		case r.URL.Path == "/api/v1/computers-inventory":
+			assert.Equal(t, "id:asc", r.URL.Query().Get("sort"))
+			fmt.Fprint(w, `{"totalCount": 5, "results": [{"id": "1"}, {"id": "2"}, {"id": "3"}, {"id": "4"}, {"id": "5"}]}`)
+		case r.URL.Path == "/api/v1/computers-inventory/4" && attempt == 1:
+			http.Error(w, `{"httpStatus": 503, "errors": []}`, http.StatusServiceUnavailable)
+		case strings.HasPrefix(r.URL.Path, "/api/v1/computers-inventory/"):
+			assert.Equal(t, []string{"HARDWARE"}, r.URL.Query()["section"])
+			id := strings.TrimPrefix(r.URL.Path, "/api/v1/computers-inventory/")
+			fmt.Fprintf(w, `{"id": %q, "general": {"name": "lab-0%s"}}`, id, id)
+		case r.URL.Path == "/api/v2/mobile-devices/detail" && !strings.HasPrefix(r.URL.Query().Get("filter"), "mobileDeviceId=="):
+			assert.Equal(t, "mobileDeviceId:asc", r.URL.Query().Get("sort"))
+			fmt.Fprint(w, `{"totalCount": 2, "results": [{"mobileDeviceId": "10"}, {"mobileDeviceId": "11"}]}`)
+		case r.URL.Path == "/api/v2/mobile-devices/detail":
+			id := strings.Trim(strings.TrimPrefix(r.URL.Query().Get("filter"), "mobileDeviceId=="), `"`)
+			fmt.Fprintf(w, `{"totalCount": 1, "results": [{"mobileDeviceId": %q}]}`, id)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestRunResumes(t *testing.T) {
+	server := fleetMocks(t)
+	defer server.Close()
+	client, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	written := []string{}
+	failOn := "3"
+	store := sync.NewMemoryStore()
+	engine := &sync.Engine{
+		Client: client,
+		Store:  store,
+		Sink: sync.SinkFunc(func(ctx context.Context, record *sync.Record) error {
+			if record.ID == failOn {
+				return fmt.Errorf("database unavailable")
+			}
+			written = append(written, string(record.Kind)+"/"+record.ID)
+			return nil
+		}),
+		Concurrency:      1,
+		CheckpointEvery:  1,
+		Retries:          1,
+		Backoff:          time.Millisecond,
+		ComputerSections: []pro.ComputerInventorySection{pro.ComputerInventorySectionHardware},
+		MobileDevices:    true,
+	}
+
+	report, err := engine.Run(context.Background())
+	assert.NotNil(t, err)
+	assert.Equal(t, "unable to write computer 3: database unavailable", err.Error())
+	assert.Equal(t, sync.Progress{Kind: sync.KindComputer, Total: 5, Written: 2}, report.Computers)
+	checkpoint, _ := store.Load(context.Background(), "inventory/computer")
+	assert.Equal(t, "2", checkpoint)
+
+	failOn = ""
+	progress := []sync.Progress{}
+	engine.Progress = func(p sync.Progress) { progress = append(progress, p) }
+	report, err = engine.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, sync.Progress{Kind: sync.KindComputer, Total: 5, Skipped: 2, Written: 3}, report.Computers)
+	assert.Equal(t, sync.Progress{Kind: sync.KindMobileDevice, Total: 2, Written: 2}, report.MobileDevices)
+	assert.Equal(t, []string{"computer/1", "computer/2", "computer/3", "computer/4", "computer/5", "mobile_device/10", "mobile_device/11"}, written)
+	assert.Len(t, progress, 5)
+	checkpoint, _ = store.Load(context.Background(), "inventory/computer")
+	assert.Equal(t, "", checkpoint)
+}
+
+func TestRunJSONSink(t *testing.T) {
+	server := fleetMocks(t)
+	defer server.Close()
+	client, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	out := &bytes.Buffer{}
+	engine := &sync.Engine{
+		Client:           client,
+		Sink:             sync.NewJSONSink(out),
+		Concurrency:      3,
+		Retries:          1,
+		Backoff:          time.Millisecond,
+		ComputerSections: []pro.ComputerInventorySection{pro.ComputerInventorySectionHardware},
+	}
+	report, err := engine.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 5, report.Computers.Written)
+	assert.Equal(t, 0, report.MobileDevices.Total)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.Contains(t, out.String(), `{"kind":"computer","id":"4","computer":{"id":"4","general":{"name":"lab-04",`)
+}
+
+func TestRunSiteScoped(t *testing.T) {
+	filters := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/token":
+			fmt.Fprint(w, `{"token": "test-token", "expires": "2100-01-01T00:00:00Z"}`)
+		case "/api/v1/computers-inventory":
+			filters = append(filters, r.URL.Query().Get("filter"))
+			fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+		case "/api/v2/mobile-devices/detail":
+			filters = append(filters, r.URL.Query().Get("filter"))
+			fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := pro.NewClient(server.URL, "fake-username", "mock-password-cool", nil)
+	assert.Nil(t, err)
+
+	engine := &sync.Engine{Client: client.WithSite("2"), Sink: sync.NewJSONSink(&bytes.Buffer{}), MobileDevices: true}
+	_, err = engine.Run(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`general.site.id=="2"`, `general.siteId=="2"`}, filters)
+}
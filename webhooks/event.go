@@ -0,0 +1,243 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks
+
+import "encoding/json"
+
+// Computer holds the computer details included in computer events, JSSID is the Jamf Pro ID
+type Computer struct {
+	UDID                string `json:"udid"`
+	DeviceName          string `json:"deviceName"`
+	Model               string `json:"model"`
+	MACAddress          string `json:"macAddress"`
+	AlternateMACAddress string `json:"alternateMacAddress"`
+	SerialNumber        string `json:"serialNumber"`
+	OSVersion           string `json:"osVersion"`
+	OSBuild             string `json:"osBuild"`
+	UserDirectoryID     string `json:"userDirectoryID"`
+	Username            string `json:"username"`
+	RealName            string `json:"realName"`
+	EmailAddress        string `json:"emailAddress"`
+	Phone               string `json:"phone"`
+	Position            string `json:"position"`
+	Department          string `json:"department"`
+	Building            string `json:"building"`
+	Room                string `json:"room"`
+	JSSID               int    `json:"jssID"`
+}
+
+// MobileDevice holds the mobile device details included in mobile device events, JSSID is the Jamf Pro ID
+type MobileDevice struct {
+	UDID                string `json:"udid"`
+	DeviceName          string `json:"deviceName"`
+	Version             string `json:"version"`
+	Model               string `json:"model"`
+	ModelDisplay        string `json:"modelDisplay"`
+	Product             string `json:"product"`
+	BluetoothMACAddress string `json:"bluetoothMacAddress"`
+	WifiMACAddress      string `json:"wifiMacAddress"`
+	IMEI                string `json:"imei"`
+	ICCID               string `json:"icciID"`
+	SerialNumber        string `json:"serialNumber"`
+	OSVersion           string `json:"osVersion"`
+	OSBuild             string `json:"osBuild"`
+	UserDirectoryID     string `json:"userDirectoryID"`
+	Username            string `json:"username"`
+	Room                string `json:"room"`
+	JSSID               int    `json:"jssID"`
+}
+
+// ComputerAdded is sent when a computer is added to Jamf Pro
+type ComputerAdded struct {
+	Computer
+}
+
+// EventName returns EventComputerAdded
+func (*ComputerAdded) EventName() string { return EventComputerAdded }
+
+// ComputerCheckIn is sent when a computer checks in, Trigger is the event that ran the check-in
+type ComputerCheckIn struct {
+	Computer Computer `json:"computer"`
+	Trigger  string   `json:"trigger"`
+	Username string   `json:"username"`
+}
+
+// EventName returns EventComputerCheckIn
+func (*ComputerCheckIn) EventName() string { return EventComputerCheckIn }
+
+// ComputerInventoryCompleted is sent when a computer submits inventory
+type ComputerInventoryCompleted struct {
+	Computer
+}
+
+// EventName returns EventComputerInventoryCompleted
+func (*ComputerInventoryCompleted) EventName() string { return EventComputerInventoryCompleted }
+
+// ComputerPolicyFinished is sent when a policy finishes running on a computer
+type ComputerPolicyFinished struct {
+	Computer   Computer `json:"computer"`
+	PolicyID   int      `json:"policyId"`
+	Successful bool     `json:"successful"`
+}
+
+// EventName returns EventComputerPolicyFinished
+func (*ComputerPolicyFinished) EventName() string { return EventComputerPolicyFinished }
+
+// ComputerPushCapabilityChanged is sent when a computer becomes able or unable to receive MDM commands
+type ComputerPushCapabilityChanged struct {
+	Computer
+}
+
+// EventName returns EventComputerPushCapabilityChanged
+func (*ComputerPushCapabilityChanged) EventName() string { return EventComputerPushCapabilityChanged }
+
+// DeviceAddedToDEP is sent when a device is assigned to an Automated Device Enrollment instance
+type DeviceAddedToDEP struct {
+	SerialNumber       string `json:"serialNumber"`
+	AssetTag           string `json:"assetTag"`
+	Model              string `json:"model"`
+	Description        string `json:"description"`
+	Color              string `json:"color"`
+	DeviceFamily       string `json:"deviceFamily"`
+	OS                 string `json:"os"`
+	DeviceAssignedBy   string `json:"deviceAssignedBy"`
+	DeviceAssignedDate string `json:"deviceAssignedDate"`
+}
+
+// EventName returns EventDeviceAddedToDEP
+func (*DeviceAddedToDEP) EventName() string { return EventDeviceAddedToDEP }
+
+// JSSServer holds the Jamf Pro server details included in startup and shutdown events
+type JSSServer struct {
+	HostAddress        string `json:"hostAddress"`
+	WebApplicationPath string `json:"webApplicationPath"`
+	IsClusterMaster    bool   `json:"isClusterMaster"`
+	JSSURL             string `json:"jssUrl"`
+	Institution        string `json:"institution"`
+}
+
+// JSSShutdown is sent when a Jamf Pro server shuts down
+type JSSShutdown struct {
+	JSSServer
+}
+
+// EventName returns EventJSSShutdown
+func (*JSSShutdown) EventName() string { return EventJSSShutdown }
+
+// JSSStartup is sent when a Jamf Pro server starts up
+type JSSStartup struct {
+	JSSServer
+}
+
+// EventName returns EventJSSStartup
+func (*JSSStartup) EventName() string { return EventJSSStartup }
+
+// MobileDeviceCheckIn is sent when a mobile device checks in
+type MobileDeviceCheckIn struct {
+	MobileDevice
+}
+
+// EventName returns EventMobileDeviceCheckIn
+func (*MobileDeviceCheckIn) EventName() string { return EventMobileDeviceCheckIn }
+
+// MobileDeviceEnrolled is sent when a mobile device enrolls
+type MobileDeviceEnrolled struct {
+	MobileDevice
+}
+
+// EventName returns EventMobileDeviceEnrolled
+func (*MobileDeviceEnrolled) EventName() string { return EventMobileDeviceEnrolled }
+
+// MobileDeviceInventoryCompleted is sent when a mobile device submits inventory
+type MobileDeviceInventoryCompleted struct {
+	MobileDevice
+}
+
+// EventName returns EventMobileDeviceInventoryCompleted
+func (*MobileDeviceInventoryCompleted) EventName() string { return EventMobileDeviceInventoryCompleted }
+
+// MobileDevicePushSent is sent when an MDM push notification is sent to a mobile device
+type MobileDevicePushSent struct {
+	MobileDevice
+}
+
+// EventName returns EventMobileDevicePushSent
+func (*MobileDevicePushSent) EventName() string { return EventMobileDevicePushSent }
+
+// MobileDeviceUnEnrolled is sent when a mobile device unenrolls
+type MobileDeviceUnEnrolled struct {
+	MobileDevice
+}
+
+// EventName returns EventMobileDeviceUnEnrolled
+func (*MobileDeviceUnEnrolled) EventName() string { return EventMobileDeviceUnEnrolled }
+
+// RestAPIOperation is sent when an object is created, updated or deleted through the Classic API
+type RestAPIOperation struct {
+	OperationSuccessful  bool   `json:"operationSuccessful"`
+	ObjectID             int    `json:"objectID"`
+	ObjectName           string `json:"objectName"`
+	ObjectTypeName       string `json:"objectTypeName"`
+	AuthorizedUsername   string `json:"authorizedUsername"`
+	RestAPIOperationType string `json:"restAPIOperationType"`
+}
+
+// EventName returns EventRestAPIOperation
+func (*RestAPIOperation) EventName() string { return EventRestAPIOperation }
+
+// SmartGroupComputerMembershipChange is sent when computers join or leave a smart computer group,
+// JSSID is the ID of the group
+type SmartGroupComputerMembershipChange struct {
+	Name                   string `json:"name"`
+	SmartGroup             bool   `json:"smartGroup"`
+	JSSID                  int    `json:"jssid"`
+	GroupAddedDevicesIDs   []int  `json:"groupAddedDevicesIds"`
+	GroupRemovedDevicesIDs []int  `json:"groupRemovedDevicesIds"`
+}
+
+// EventName returns EventSmartGroupComputerMembershipChange
+func (*SmartGroupComputerMembershipChange) EventName() string {
+	return EventSmartGroupComputerMembershipChange
+}
+
+// SmartGroupMobileDeviceMembershipChange is sent when mobile devices join or leave a smart mobile
+// device group, JSSID is the ID of the group
+type SmartGroupMobileDeviceMembershipChange struct {
+	Name                   string `json:"name"`
+	SmartGroup             bool   `json:"smartGroup"`
+	JSSID                  int    `json:"jssid"`
+	GroupAddedDevicesIDs   []int  `json:"groupAddedDevicesIds"`
+	GroupRemovedDevicesIDs []int  `json:"groupRemovedDevicesIds"`
+}
+
+// EventName returns EventSmartGroupMobileDeviceMembershipChange
+func (*SmartGroupMobileDeviceMembershipChange) EventName() string {
+	return EventSmartGroupMobileDeviceMembershipChange
+}
+
+// SmartGroupUserMembershipChange is sent when users join or leave a smart user group, JSSID is the
+// ID of the group
+type SmartGroupUserMembershipChange struct {
+	Name                string `json:"name"`
+	SmartGroup          bool   `json:"smartGroup"`
+	JSSID               int    `json:"jssid"`
+	GroupAddedUserIDs   []int  `json:"groupAddedUserIds"`
+	GroupRemovedUserIDs []int  `json:"groupRemovedUserIds"`
+}
+
+// EventName returns EventSmartGroupUserMembershipChange
+func (*SmartGroupUserMembershipChange) EventName() string { return EventSmartGroupUserMembershipChange }
+
+// UnknownEvent holds an event this package has no type for
+type UnknownEvent struct {
+	Name string
+	Raw  json.RawMessage
+}
+
+// EventName returns the name of the event sent by Jamf Pro
+func (e *UnknownEvent) EventName() string {
+	if e == nil {
+		return ""
+	}
+	return e.Name
+}
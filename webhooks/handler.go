@@ -0,0 +1,142 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"crypto/subtle"
+	"io"
+	"net/http"
+)
+
+// DefaultMaxBodySize is the largest payload accepted by a Handler unless WithMaxBodySize is used
+const DefaultMaxBodySize = 1 << 20
+
+// HandlerFunc is called with each event a Handler receives, returning an error makes the Handler
+// respond with a 500 so Jamf Pro reports the delivery as failed
+type HandlerFunc func(ctx context.Context, webhook *Webhook, event Event) error
+
+// Handler is an http.Handler receiving Jamf Pro webhooks, verifying the credentials configured on
+// the webhook and dispatching each event to the callback registered for it
+type Handler struct {
+	callbacks   map[string]HandlerFunc
+	fallback    HandlerFunc
+	username    string
+	password    string
+	header      string
+	headerValue string
+	maxBodySize int64
+}
+
+// HandlerOption configures a Handler
+type HandlerOption func(*Handler)
+
+// WithBasicAuth requires the username and password set with basic authentication on the Jamf Pro webhook
+func WithBasicAuth(username string, password string) HandlerOption {
+	return func(h *Handler) {
+		h.username, h.password = username, password
+	}
+}
+
+// WithHeaderAuth requires the header set with header authentication on the Jamf Pro webhook
+func WithHeaderAuth(name string, value string) HandlerOption {
+	return func(h *Handler) {
+		h.header, h.headerValue = name, value
+	}
+}
+
+// WithMaxBodySize limits the size of the payloads accepted
+func WithMaxBodySize(size int64) HandlerOption {
+	return func(h *Handler) {
+		h.maxBodySize = size
+	}
+}
+
+// NewHandler returns a Handler without any callbacks, events are acknowledged and dropped until
+// callbacks are registered with On, Handle or HandleUnmatched
+func NewHandler(opts ...HandlerOption) *Handler {
+	h := &Handler{callbacks: map[string]HandlerFunc{}, maxBodySize: DefaultMaxBodySize}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle registers the callback for events with the given name, replacing any previous one
+func (h *Handler) Handle(eventName string, fn HandlerFunc) {
+	h.callbacks[eventName] = fn
+}
+
+// HandleUnmatched registers the callback for events without a callback of their own
+func (h *Handler) HandleUnmatched(fn HandlerFunc) {
+	h.fallback = fn
+}
+
+// On registers a callback receiving events of type E
+//
+//	webhooks.On(h, func(ctx context.Context, w *webhooks.Webhook, e *webhooks.ComputerAdded) error {
+//		log.Printf("%s enrolled", e.SerialNumber)
+//		return nil
+//	})
+func On[E Event](h *Handler, fn func(ctx context.Context, webhook *Webhook, event E) error) {
+	var e E
+	h.Handle(e.EventName(), func(ctx context.Context, webhook *Webhook, event Event) error {
+		return fn(ctx, webhook, event.(E))
+	})
+}
+
+// ServeHTTP verifies, decodes and dispatches a webhook sent by Jamf Pro
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authorized(r) {
+		if h.username != "" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="jamf-webhooks"`)
+		}
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	if err != nil {
+		http.Error(w, "unable to read webhook payload", http.StatusRequestEntityTooLarge)
+		return
+	}
+	webhook, event, err := Parse(data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	fn, ok := h.callbacks[webhook.Event]
+	if !ok {
+		fn = h.fallback
+	}
+	if fn != nil {
+		if err := fn(r.Context(), webhook, event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) authorized(r *http.Request) bool {
+	if h.username != "" {
+		username, password, ok := r.BasicAuth()
+		if !ok || !equal(username, h.username) || !equal(password, h.password) {
+			return false
+		}
+	}
+	if h.header != "" && !equal(r.Header.Get(h.header), h.headerValue) {
+		return false
+	}
+	return true
+}
+
+func equal(a string, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,93 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/jamf-api-client-go/webhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+func postWebhook(t *testing.T, h http.Handler, body string, setup func(r *http.Request)) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("POST", "/jamf", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	if setup != nil {
+		setup(r)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestHandlerDispatch(t *testing.T) {
+	h := webhooks.NewHandler()
+	serials := []string{}
+	webhooks.On(h, func(ctx context.Context, webhook *webhooks.Webhook, event *webhooks.ComputerAdded) error {
+		serials = append(serials, event.SerialNumber)
+		return nil
+	})
+	webhooks.On(h, func(ctx context.Context, webhook *webhooks.Webhook, event *webhooks.ComputerCheckIn) error {
+		return fmt.Errorf("database unavailable")
+	})
+	unmatched := []string{}
+	h.HandleUnmatched(func(ctx context.Context, webhook *webhooks.Webhook, event webhooks.Event) error {
+		unmatched = append(unmatched, event.EventName())
+		return nil
+	})
+
+	w := postWebhook(t, h, computerAddedPayload, nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, []string{"C02XK0AAJGH5"}, serials)
+
+	w = postWebhook(t, h, computerCheckInPayload, nil)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "database unavailable")
+
+	w = postWebhook(t, h, smartGroupPayload, nil)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, []string{webhooks.EventSmartGroupComputerMembershipChange}, unmatched)
+
+	w = postWebhook(t, h, `{}`, nil)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	r := httptest.NewRequest("GET", "/jamf", nil)
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestHandlerBasicAuth(t *testing.T) {
+	h := webhooks.NewHandler(webhooks.WithBasicAuth("jamf", "s3cret"))
+
+	w := postWebhook(t, h, computerAddedPayload, nil)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+
+	w = postWebhook(t, h, computerAddedPayload, func(r *http.Request) { r.SetBasicAuth("jamf", "wrong") })
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = postWebhook(t, h, computerAddedPayload, func(r *http.Request) { r.SetBasicAuth("jamf", "s3cret") })
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandlerHeaderAuth(t *testing.T) {
+	h := webhooks.NewHandler(webhooks.WithHeaderAuth("X-Jamf-Secret", "s3cret"))
+
+	w := postWebhook(t, h, computerAddedPayload, func(r *http.Request) { r.Header.Set("X-Jamf-Secret", "wrong") })
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = postWebhook(t, h, computerAddedPayload, func(r *http.Request) { r.Header.Set("X-Jamf-Secret", "s3cret") })
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandlerMaxBodySize(t *testing.T) {
+	h := webhooks.NewHandler(webhooks.WithMaxBodySize(16))
+	w := postWebhook(t, h, computerAddedPayload, nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
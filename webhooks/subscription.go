@@ -0,0 +1,193 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// Backpressure decides what a Subscription does with an event when its buffer is full
+type Backpressure int
+
+const (
+	// BackpressureBlock holds the webhook request until the event can be buffered, Jamf Pro sees the
+	// delivery fail if the request is cancelled or the subscription closed in the meantime
+	BackpressureBlock Backpressure = iota
+	// BackpressureDrop acknowledges the webhook and discards the event, see Subscription.Dropped
+	BackpressureDrop
+)
+
+// Message is an event received by a Subscription along with the webhook that sent it
+type Message struct {
+	Webhook *Webhook
+	Event   Event
+}
+
+// Subscription runs an HTTP listener receiving Jamf Pro webhooks and publishes their events on a
+// channel, the channel is closed once the subscription is closed
+type Subscription struct {
+	messages     chan Message
+	server       *http.Server
+	listener     net.Listener
+	backpressure Backpressure
+	dropped      uint64
+	done         chan struct{}
+	closeOnce    sync.Once
+	served       chan error
+	err          error
+}
+
+type subscriptionConfig struct {
+	path         string
+	buffer       int
+	backpressure Backpressure
+	events       []string
+	handlerOpts  []HandlerOption
+}
+
+// SubscriptionOption configures a Subscription
+type SubscriptionOption func(*subscriptionConfig)
+
+// WithPath sets the URL path webhooks are received on, "/" by default
+func WithPath(path string) SubscriptionOption {
+	return func(c *subscriptionConfig) {
+		c.path = path
+	}
+}
+
+// WithBuffer sets how many events can wait for the consumer, 0 by default
+func WithBuffer(size int) SubscriptionOption {
+	return func(c *subscriptionConfig) {
+		c.buffer = size
+	}
+}
+
+// WithBackpressure sets what happens to events received while the buffer is full, BackpressureBlock by default
+func WithBackpressure(backpressure Backpressure) SubscriptionOption {
+	return func(c *subscriptionConfig) {
+		c.backpressure = backpressure
+	}
+}
+
+// WithEvents only publishes the events with the given names, every event is published by default
+func WithEvents(names ...string) SubscriptionOption {
+	return func(c *subscriptionConfig) {
+		c.events = append(c.events, names...)
+	}
+}
+
+// WithHandler configures the Handler receiving the webhooks such as the authentication required
+func WithHandler(opts ...HandlerOption) SubscriptionOption {
+	return func(c *subscriptionConfig) {
+		c.handlerOpts = append(c.handlerOpts, opts...)
+	}
+}
+
+// Subscribe starts listening for Jamf Pro webhooks on addr, the subscription is closed when ctx is done
+//
+//	sub, err := webhooks.Subscribe(ctx, ":8080", webhooks.WithBuffer(100), webhooks.WithEvents(webhooks.EventComputerAdded))
+//	if err != nil {
+//		return err
+//	}
+//	for message := range sub.Messages() {
+//		fmt.Println(message.Event.(*webhooks.ComputerAdded).SerialNumber)
+//	}
+func Subscribe(ctx context.Context, addr string, opts ...SubscriptionOption) (*Subscription, error) {
+	config := &subscriptionConfig{path: "/", backpressure: BackpressureBlock}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s := &Subscription{
+		messages:     make(chan Message, config.buffer),
+		listener:     listener,
+		backpressure: config.backpressure,
+		done:         make(chan struct{}),
+		served:       make(chan error, 1),
+	}
+
+	h := NewHandler(config.handlerOpts...)
+	if len(config.events) == 0 {
+		h.HandleUnmatched(s.publish)
+	}
+	for _, name := range config.events {
+		h.Handle(name, s.publish)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(config.path, h)
+	s.server = &http.Server{Handler: mux}
+
+	go func() {
+		s.served <- s.server.Serve(listener)
+	}()
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.Close()
+		case <-s.done:
+		}
+	}()
+	return s, nil
+}
+
+// Messages returns the channel events are published on
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Addr returns the address the subscription is listening on
+func (s *Subscription) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Dropped returns how many events were discarded with BackpressureDrop because the buffer was full
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// Close stops the listener, waits for the webhooks being received and closes the message channel,
+// it returns the error that stopped the listener if any
+func (s *Subscription) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		shutdownErr := s.server.Shutdown(context.Background())
+		if err := <-s.served; err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.err = err
+		} else {
+			s.err = shutdownErr
+		}
+		close(s.messages)
+	})
+	return s.err
+}
+
+func (s *Subscription) publish(ctx context.Context, webhook *Webhook, event Event) error {
+	message := Message{Webhook: webhook, Event: event}
+	if s.backpressure == BackpressureDrop {
+		select {
+		case s.messages <- message:
+		case <-s.done:
+			return errors.New("subscription closed")
+		default:
+			atomic.AddUint64(&s.dropped, 1)
+		}
+		return nil
+	}
+	select {
+	case s.messages <- message:
+		return nil
+	case <-s.done:
+		return errors.New("subscription closed")
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,96 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/webhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+func sendWebhook(t *testing.T, sub *webhooks.Subscription, path string, body string) int {
+	res, err := http.Post(fmt.Sprintf("http://%s%s", sub.Addr(), path), "application/json", strings.NewReader(body))
+	assert.Nil(t, err)
+	defer res.Body.Close()
+	return res.StatusCode
+}
+
+func TestSubscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sub, err := webhooks.Subscribe(ctx, "127.0.0.1:0", webhooks.WithPath("/jamf"), webhooks.WithBuffer(2))
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/jamf", computerAddedPayload))
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/jamf", smartGroupPayload))
+	assert.Equal(t, http.StatusNotFound, sendWebhook(t, sub, "/other", smartGroupPayload))
+
+	message := <-sub.Messages()
+	assert.Equal(t, webhooks.EventComputerAdded, message.Webhook.Event)
+	assert.Equal(t, "C02XK0AAJGH5", message.Event.(*webhooks.ComputerAdded).SerialNumber)
+	message = <-sub.Messages()
+	assert.Equal(t, []int{7}, message.Event.(*webhooks.SmartGroupComputerMembershipChange).GroupRemovedDevicesIDs)
+
+	cancel()
+	select {
+	case _, ok := <-sub.Messages():
+		assert.False(t, ok)
+	case <-time.After(5 * time.Second):
+		t.Fatal("messages channel not closed after the context was cancelled")
+	}
+	assert.Nil(t, sub.Close())
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	sub, err := webhooks.Subscribe(context.Background(), "127.0.0.1:0", webhooks.WithBuffer(2), webhooks.WithEvents(webhooks.EventComputerCheckIn))
+	assert.Nil(t, err)
+	defer sub.Close()
+
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/", computerAddedPayload))
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/", computerCheckInPayload))
+	message := <-sub.Messages()
+	assert.Equal(t, webhooks.EventComputerCheckIn, message.Event.EventName())
+	assert.Len(t, sub.Messages(), 0)
+}
+
+func TestSubscribeBackpressureDrop(t *testing.T) {
+	sub, err := webhooks.Subscribe(context.Background(), "127.0.0.1:0", webhooks.WithBuffer(1), webhooks.WithBackpressure(webhooks.BackpressureDrop))
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/", computerAddedPayload))
+	assert.Equal(t, http.StatusNoContent, sendWebhook(t, sub, "/", computerCheckInPayload))
+	assert.Equal(t, uint64(1), sub.Dropped())
+
+	assert.Nil(t, sub.Close())
+	message, ok := <-sub.Messages()
+	assert.True(t, ok)
+	assert.Equal(t, webhooks.EventComputerAdded, message.Event.EventName())
+	_, ok = <-sub.Messages()
+	assert.False(t, ok)
+}
+
+func TestSubscribeBackpressureBlock(t *testing.T) {
+	sub, err := webhooks.Subscribe(context.Background(), "127.0.0.1:0", webhooks.WithHandler(webhooks.WithHeaderAuth("X-Jamf-Secret", "s3cret")))
+	assert.Nil(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, sendWebhook(t, sub, "/", computerAddedPayload))
+
+	status := make(chan int)
+	go func() {
+		r, _ := http.NewRequest("POST", fmt.Sprintf("http://%s/", sub.Addr()), strings.NewReader(computerAddedPayload))
+		r.Header.Set("X-Jamf-Secret", "s3cret")
+		res, err := http.DefaultClient.Do(r)
+		assert.Nil(t, err)
+		res.Body.Close()
+		status <- res.StatusCode
+	}()
+	message := <-sub.Messages()
+	assert.Equal(t, webhooks.EventComputerAdded, message.Event.EventName())
+	assert.Equal(t, http.StatusNoContent, <-status)
+	assert.Nil(t, sub.Close())
+}
@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+// Package webhooks parses the payloads Jamf Pro sends to webhook URLs into typed events and
+// provides an http.Handler dispatching them to callbacks
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Names of the events Jamf Pro sends webhooks for
+const (
+	EventComputerAdded                          = "ComputerAdded"
+	EventComputerCheckIn                        = "ComputerCheckIn"
+	EventComputerInventoryCompleted             = "ComputerInventoryCompleted"
+	EventComputerPolicyFinished                 = "ComputerPolicyFinished"
+	EventComputerPushCapabilityChanged          = "ComputerPushCapabilityChanged"
+	EventDeviceAddedToDEP                       = "DeviceAddedToDEP"
+	EventJSSShutdown                            = "JSSShutdown"
+	EventJSSStartup                             = "JSSStartup"
+	EventMobileDeviceCheckIn                    = "MobileDeviceCheckIn"
+	EventMobileDeviceEnrolled                   = "MobileDeviceEnrolled"
+	EventMobileDeviceInventoryCompleted         = "MobileDeviceInventoryCompleted"
+	EventMobileDevicePushSent                   = "MobileDevicePushSent"
+	EventMobileDeviceUnEnrolled                 = "MobileDeviceUnEnrolled"
+	EventRestAPIOperation                       = "RestAPIOperation"
+	EventSmartGroupComputerMembershipChange     = "SmartGroupComputerMembershipChange"
+	EventSmartGroupMobileDeviceMembershipChange = "SmartGroupMobileDeviceMembershipChange"
+	EventSmartGroupUserMembershipChange         = "SmartGroupUserMembershipChange"
+)
+
+// Webhook describes the webhook configured in Jamf Pro that sent an event, EventTimestamp is in
+// milliseconds since the epoch
+type Webhook struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Event          string `json:"webhookEvent"`
+	EventTimestamp int64  `json:"eventTimestamp"`
+}
+
+// Time returns when the event was sent
+func (w *Webhook) Time() time.Time {
+	return time.UnixMilli(w.EventTimestamp)
+}
+
+// Event is implemented by every typed event, EventName returns one of the Event constants and
+// can be called on a nil pointer
+type Event interface {
+	EventName() string
+}
+
+type payload struct {
+	Webhook *Webhook        `json:"webhook"`
+	Event   json.RawMessage `json:"event"`
+}
+
+var events = map[string]func() Event{
+	EventComputerAdded:                          func() Event { return &ComputerAdded{} },
+	EventComputerCheckIn:                        func() Event { return &ComputerCheckIn{} },
+	EventComputerInventoryCompleted:             func() Event { return &ComputerInventoryCompleted{} },
+	EventComputerPolicyFinished:                 func() Event { return &ComputerPolicyFinished{} },
+	EventComputerPushCapabilityChanged:          func() Event { return &ComputerPushCapabilityChanged{} },
+	EventDeviceAddedToDEP:                       func() Event { return &DeviceAddedToDEP{} },
+	EventJSSShutdown:                            func() Event { return &JSSShutdown{} },
+	EventJSSStartup:                             func() Event { return &JSSStartup{} },
+	EventMobileDeviceCheckIn:                    func() Event { return &MobileDeviceCheckIn{} },
+	EventMobileDeviceEnrolled:                   func() Event { return &MobileDeviceEnrolled{} },
+	EventMobileDeviceInventoryCompleted:         func() Event { return &MobileDeviceInventoryCompleted{} },
+	EventMobileDevicePushSent:                   func() Event { return &MobileDevicePushSent{} },
+	EventMobileDeviceUnEnrolled:                 func() Event { return &MobileDeviceUnEnrolled{} },
+	EventRestAPIOperation:                       func() Event { return &RestAPIOperation{} },
+	EventSmartGroupComputerMembershipChange:     func() Event { return &SmartGroupComputerMembershipChange{} },
+	EventSmartGroupMobileDeviceMembershipChange: func() Event { return &SmartGroupMobileDeviceMembershipChange{} },
+	EventSmartGroupUserMembershipChange:         func() Event { return &SmartGroupUserMembershipChange{} },
+}
+
+// Parse decodes a webhook payload sent by Jamf Pro, events this package has no type for are
+// returned as an *UnknownEvent holding the raw event
+func Parse(data []byte) (*Webhook, Event, error) {
+	p := &payload{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, nil, errors.Wrap(err, "unable to decode Jamf webhook payload")
+	}
+	if p.Webhook == nil || p.Webhook.Event == "" {
+		return nil, nil, errors.New("unable to decode Jamf webhook payload: missing webhook event")
+	}
+
+	newEvent, ok := events[p.Webhook.Event]
+	if !ok {
+		return p.Webhook, &UnknownEvent{Name: p.Webhook.Event, Raw: p.Event}, nil
+	}
+	event := newEvent()
+	if err := json.Unmarshal(p.Event, event); err != nil {
+		return nil, nil, errors.Wrapf(err, "unable to decode Jamf %s webhook event", p.Webhook.Event)
+	}
+	return p.Webhook, event, nil
+}
@@ -0,0 +1,79 @@
+// Unless explicitly stated otherwise all files in this repository are licensed under the Apache-2.0
+
+package webhooks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/jamf-api-client-go/webhooks"
+	"github.com/stretchr/testify/assert"
+)
+
+const computerAddedPayload = `{
+	"webhook": {"id": 7, "name": "Computer Added", "webhookEvent": "ComputerAdded", "eventTimestamp": 1727784000000},
+	"event": {
+		"udid": "CA0B1F6D-4D38-5B7E-9A29-0F0A8A2E6C11",
+		"deviceName": "TEST-BOX",
+		"model": "MacBookPro18,3",
+		"serialNumber": "C02XK0AAJGH5",
+		"osVersion": "14.6.1",
+		"osBuild": "23G93",
+		"username": "jdoe",
+		"department": "Engineering",
+		"jssID": 42
+	}
+}`
+
+const computerCheckInPayload = `{
+	"webhook": {"id": 8, "name": "Check-in", "webhookEvent": "ComputerCheckIn", "eventTimestamp": 1727784000000},
+	"event": {"computer": {"serialNumber": "C02XK0AAJGH5", "jssID": 42}, "trigger": "CLIENT_CHECKIN", "username": "jdoe"}
+}`
+
+const smartGroupPayload = `{
+	"webhook": {"id": 9, "name": "Group Change", "webhookEvent": "SmartGroupComputerMembershipChange", "eventTimestamp": 1727784000000},
+	"event": {"name": "Sonoma Laptops", "smartGroup": true, "jssid": 2, "groupAddedDevicesIds": [42, 43], "groupRemovedDevicesIds": [7]}
+}`
+
+func TestParse(t *testing.T) {
+	webhook, event, err := webhooks.Parse([]byte(computerAddedPayload))
+	assert.Nil(t, err)
+	assert.Equal(t, webhooks.EventComputerAdded, webhook.Event)
+	assert.Equal(t, time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC), webhook.Time().UTC())
+	added, ok := event.(*webhooks.ComputerAdded)
+	assert.True(t, ok)
+	assert.Equal(t, "C02XK0AAJGH5", added.SerialNumber)
+	assert.Equal(t, 42, added.JSSID)
+
+	_, event, err = webhooks.Parse([]byte(computerCheckInPayload))
+	assert.Nil(t, err)
+	checkIn := event.(*webhooks.ComputerCheckIn)
+	assert.Equal(t, "CLIENT_CHECKIN", checkIn.Trigger)
+	assert.Equal(t, 42, checkIn.Computer.JSSID)
+
+	_, event, err = webhooks.Parse([]byte(smartGroupPayload))
+	assert.Nil(t, err)
+	change := event.(*webhooks.SmartGroupComputerMembershipChange)
+	assert.Equal(t, []int{42, 43}, change.GroupAddedDevicesIDs)
+	assert.Equal(t, []int{7}, change.GroupRemovedDevicesIDs)
+}
+
+func TestParseUnknownEvent(t *testing.T) {
+	webhook, event, err := webhooks.Parse([]byte(`{"webhook": {"id": 1, "webhookEvent": "PatchSoftwareTitleUpdated"}, "event": {"name": "Firefox"}}`))
+	assert.Nil(t, err)
+	assert.Equal(t, "PatchSoftwareTitleUpdated", webhook.Event)
+	unknown := event.(*webhooks.UnknownEvent)
+	assert.Equal(t, "PatchSoftwareTitleUpdated", unknown.EventName())
+	assert.JSONEq(t, `{"name": "Firefox"}`, string(unknown.Raw))
+}
+
+func TestParseErrors(t *testing.T) {
+	_, _, err := webhooks.Parse([]byte(`not json`))
+	assert.Contains(t, err.Error(), "unable to decode Jamf webhook payload")
+
+	_, _, err = webhooks.Parse([]byte(`{"event": {}}`))
+	assert.Contains(t, err.Error(), "missing webhook event")
+
+	_, _, err = webhooks.Parse([]byte(`{"webhook": {"webhookEvent": "ComputerAdded"}, "event": {"jssID": "forty-two"}}`))
+	assert.Contains(t, err.Error(), "unable to decode Jamf ComputerAdded webhook event")
+}